@@ -64,7 +64,7 @@ func (x Experiment_StorageState) String() string {
 	return proto.EnumName(Experiment_StorageState_name, int32(x))
 }
 func (Experiment_StorageState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_experiment_b177a3d23629b3a5, []int{5, 0}
+	return fileDescriptor_experiment_009307920d2eda7c, []int{5, 0}
 }
 
 type CreateExperimentRequest struct {
@@ -78,7 +78,7 @@ func (m *CreateExperimentRequest) Reset()         { *m = CreateExperimentRequest
 func (m *CreateExperimentRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateExperimentRequest) ProtoMessage()    {}
 func (*CreateExperimentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_experiment_b177a3d23629b3a5, []int{0}
+	return fileDescriptor_experiment_009307920d2eda7c, []int{0}
 }
 func (m *CreateExperimentRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateExperimentRequest.Unmarshal(m, b)
@@ -116,7 +116,7 @@ func (m *GetExperimentRequest) Reset()         { *m = GetExperimentRequest{} }
 func (m *GetExperimentRequest) String() string { return proto.CompactTextString(m) }
 func (*GetExperimentRequest) ProtoMessage()    {}
 func (*GetExperimentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_experiment_b177a3d23629b3a5, []int{1}
+	return fileDescriptor_experiment_009307920d2eda7c, []int{1}
 }
 func (m *GetExperimentRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetExperimentRequest.Unmarshal(m, b)
@@ -158,7 +158,7 @@ func (m *ListExperimentsRequest) Reset()         { *m = ListExperimentsRequest{}
 func (m *ListExperimentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListExperimentsRequest) ProtoMessage()    {}
 func (*ListExperimentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_experiment_b177a3d23629b3a5, []int{2}
+	return fileDescriptor_experiment_009307920d2eda7c, []int{2}
 }
 func (m *ListExperimentsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListExperimentsRequest.Unmarshal(m, b)
@@ -226,7 +226,7 @@ func (m *ListExperimentsResponse) Reset()         { *m = ListExperimentsResponse
 func (m *ListExperimentsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListExperimentsResponse) ProtoMessage()    {}
 func (*ListExperimentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_experiment_b177a3d23629b3a5, []int{3}
+	return fileDescriptor_experiment_009307920d2eda7c, []int{3}
 }
 func (m *ListExperimentsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListExperimentsResponse.Unmarshal(m, b)
@@ -278,7 +278,7 @@ func (m *DeleteExperimentRequest) Reset()         { *m = DeleteExperimentRequest
 func (m *DeleteExperimentRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteExperimentRequest) ProtoMessage()    {}
 func (*DeleteExperimentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_experiment_b177a3d23629b3a5, []int{4}
+	return fileDescriptor_experiment_009307920d2eda7c, []int{4}
 }
 func (m *DeleteExperimentRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteExperimentRequest.Unmarshal(m, b)
@@ -312,6 +312,7 @@ type Experiment struct {
 	CreatedAt            *timestamp.Timestamp    `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	ResourceReferences   []*ResourceReference    `protobuf:"bytes,5,rep,name=resource_references,json=resourceReferences,proto3" json:"resource_references,omitempty"`
 	StorageState         Experiment_StorageState `protobuf:"varint,6,opt,name=storage_state,json=storageState,proto3,enum=api.Experiment_StorageState" json:"storage_state,omitempty"`
+	Labels               map[string]string       `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -321,7 +322,7 @@ func (m *Experiment) Reset()         { *m = Experiment{} }
 func (m *Experiment) String() string { return proto.CompactTextString(m) }
 func (*Experiment) ProtoMessage()    {}
 func (*Experiment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_experiment_b177a3d23629b3a5, []int{5}
+	return fileDescriptor_experiment_009307920d2eda7c, []int{5}
 }
 func (m *Experiment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Experiment.Unmarshal(m, b)
@@ -383,6 +384,13 @@ func (m *Experiment) GetStorageState() Experiment_StorageState {
 	return Experiment_STORAGESTATE_UNSPECIFIED
 }
 
+func (m *Experiment) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 type ArchiveExperimentRequest struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -394,7 +402,7 @@ func (m *ArchiveExperimentRequest) Reset()         { *m = ArchiveExperimentReque
 func (m *ArchiveExperimentRequest) String() string { return proto.CompactTextString(m) }
 func (*ArchiveExperimentRequest) ProtoMessage()    {}
 func (*ArchiveExperimentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_experiment_b177a3d23629b3a5, []int{6}
+	return fileDescriptor_experiment_009307920d2eda7c, []int{6}
 }
 func (m *ArchiveExperimentRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ArchiveExperimentRequest.Unmarshal(m, b)
@@ -432,7 +440,7 @@ func (m *UnarchiveExperimentRequest) Reset()         { *m = UnarchiveExperimentR
 func (m *UnarchiveExperimentRequest) String() string { return proto.CompactTextString(m) }
 func (*UnarchiveExperimentRequest) ProtoMessage()    {}
 func (*UnarchiveExperimentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_experiment_b177a3d23629b3a5, []int{7}
+	return fileDescriptor_experiment_009307920d2eda7c, []int{7}
 }
 func (m *UnarchiveExperimentRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnarchiveExperimentRequest.Unmarshal(m, b)
@@ -459,6 +467,52 @@ func (m *UnarchiveExperimentRequest) GetId() string {
 	return ""
 }
 
+type UpdateExperimentLabelsRequest struct {
+	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Labels               map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UpdateExperimentLabelsRequest) Reset()         { *m = UpdateExperimentLabelsRequest{} }
+func (m *UpdateExperimentLabelsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateExperimentLabelsRequest) ProtoMessage()    {}
+func (*UpdateExperimentLabelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_experiment_009307920d2eda7c, []int{8}
+}
+func (m *UpdateExperimentLabelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateExperimentLabelsRequest.Unmarshal(m, b)
+}
+func (m *UpdateExperimentLabelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateExperimentLabelsRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateExperimentLabelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateExperimentLabelsRequest.Merge(dst, src)
+}
+func (m *UpdateExperimentLabelsRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateExperimentLabelsRequest.Size(m)
+}
+func (m *UpdateExperimentLabelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateExperimentLabelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateExperimentLabelsRequest proto.InternalMessageInfo
+
+func (m *UpdateExperimentLabelsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateExperimentLabelsRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CreateExperimentRequest)(nil), "api.CreateExperimentRequest")
 	proto.RegisterType((*GetExperimentRequest)(nil), "api.GetExperimentRequest")
@@ -466,8 +520,11 @@ func init() {
 	proto.RegisterType((*ListExperimentsResponse)(nil), "api.ListExperimentsResponse")
 	proto.RegisterType((*DeleteExperimentRequest)(nil), "api.DeleteExperimentRequest")
 	proto.RegisterType((*Experiment)(nil), "api.Experiment")
+	proto.RegisterMapType((map[string]string)(nil), "api.Experiment.LabelsEntry")
 	proto.RegisterType((*ArchiveExperimentRequest)(nil), "api.ArchiveExperimentRequest")
 	proto.RegisterType((*UnarchiveExperimentRequest)(nil), "api.UnarchiveExperimentRequest")
+	proto.RegisterType((*UpdateExperimentLabelsRequest)(nil), "api.UpdateExperimentLabelsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.UpdateExperimentLabelsRequest.LabelsEntry")
 	proto.RegisterEnum("api.Experiment_StorageState", Experiment_StorageState_name, Experiment_StorageState_value)
 }
 
@@ -489,6 +546,7 @@ type ExperimentServiceClient interface {
 	DeleteExperiment(ctx context.Context, in *DeleteExperimentRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	ArchiveExperiment(ctx context.Context, in *ArchiveExperimentRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	UnarchiveExperiment(ctx context.Context, in *UnarchiveExperimentRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	UpdateExperimentLabels(ctx context.Context, in *UpdateExperimentLabelsRequest, opts ...grpc.CallOption) (*Experiment, error)
 }
 
 type experimentServiceClient struct {
@@ -553,6 +611,15 @@ func (c *experimentServiceClient) UnarchiveExperiment(ctx context.Context, in *U
 	return out, nil
 }
 
+func (c *experimentServiceClient) UpdateExperimentLabels(ctx context.Context, in *UpdateExperimentLabelsRequest, opts ...grpc.CallOption) (*Experiment, error) {
+	out := new(Experiment)
+	err := c.cc.Invoke(ctx, "/api.ExperimentService/UpdateExperimentLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExperimentServiceServer is the server API for ExperimentService service.
 type ExperimentServiceServer interface {
 	CreateExperiment(context.Context, *CreateExperimentRequest) (*Experiment, error)
@@ -561,6 +628,7 @@ type ExperimentServiceServer interface {
 	DeleteExperiment(context.Context, *DeleteExperimentRequest) (*empty.Empty, error)
 	ArchiveExperiment(context.Context, *ArchiveExperimentRequest) (*empty.Empty, error)
 	UnarchiveExperiment(context.Context, *UnarchiveExperimentRequest) (*empty.Empty, error)
+	UpdateExperimentLabels(context.Context, *UpdateExperimentLabelsRequest) (*Experiment, error)
 }
 
 func RegisterExperimentServiceServer(s *grpc.Server, srv ExperimentServiceServer) {
@@ -675,6 +743,24 @@ func _ExperimentService_UnarchiveExperiment_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExperimentService_UpdateExperimentLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExperimentLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentServiceServer).UpdateExperimentLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ExperimentService/UpdateExperimentLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentServiceServer).UpdateExperimentLabels(ctx, req.(*UpdateExperimentLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ExperimentService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.ExperimentService",
 	HandlerType: (*ExperimentServiceServer)(nil),
@@ -703,71 +789,82 @@ var _ExperimentService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnarchiveExperiment",
 			Handler:    _ExperimentService_UnarchiveExperiment_Handler,
 		},
+		{
+			MethodName: "UpdateExperimentLabels",
+			Handler:    _ExperimentService_UpdateExperimentLabels_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "backend/api/experiment.proto",
 }
 
 func init() {
-	proto.RegisterFile("backend/api/experiment.proto", fileDescriptor_experiment_b177a3d23629b3a5)
-}
-
-var fileDescriptor_experiment_b177a3d23629b3a5 = []byte{
-	// 889 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x51, 0x53, 0xdb, 0x46,
-	0x10, 0x8e, 0x4c, 0x70, 0xc2, 0x1a, 0x83, 0x39, 0x52, 0x5b, 0x08, 0x53, 0x5c, 0x4d, 0x87, 0xba,
-	0x4c, 0xb0, 0x0a, 0x79, 0x6a, 0xde, 0x0c, 0x38, 0x94, 0x86, 0xb6, 0x19, 0xd9, 0xc9, 0x43, 0x5e,
-	0x3c, 0x67, 0x79, 0x6d, 0x6e, 0xb0, 0x75, 0xea, 0xdd, 0x89, 0xc4, 0x74, 0x3a, 0xd3, 0xe9, 0x4c,
-	0xff, 0x40, 0xf3, 0xb3, 0x3a, 0x7d, 0xea, 0x5f, 0xe8, 0xef, 0xe8, 0x74, 0x74, 0x96, 0x41, 0xb6,
-	0xec, 0x84, 0x27, 0xb8, 0xdd, 0xcf, 0xb7, 0xf7, 0x7d, 0xfb, 0xed, 0x0a, 0xca, 0x1d, 0xea, 0x5d,
-	0xa1, 0xdf, 0x75, 0x68, 0xc0, 0x1c, 0x7c, 0x1f, 0xa0, 0x60, 0x43, 0xf4, 0x55, 0x2d, 0x10, 0x5c,
-	0x71, 0xb2, 0x44, 0x03, 0x66, 0x95, 0xa6, 0x20, 0x42, 0x70, 0x31, 0xce, 0x5a, 0x5f, 0x26, 0x13,
-	0x02, 0x25, 0x0f, 0x85, 0x87, 0x6d, 0x81, 0x3d, 0x14, 0xe8, 0x7b, 0x18, 0xa3, 0xca, 0x7d, 0xce,
-	0xfb, 0x03, 0xd4, 0x20, 0xea, 0xfb, 0x5c, 0x51, 0xc5, 0xb8, 0x2f, 0xe3, 0xec, 0x76, 0x9c, 0xd5,
-	0xa7, 0x4e, 0xd8, 0x73, 0x70, 0x18, 0xa8, 0x51, 0x9c, 0xdc, 0x9d, 0x4d, 0x2a, 0x36, 0x44, 0xa9,
-	0xe8, 0x30, 0x88, 0x01, 0x4f, 0xf5, 0x1f, 0xef, 0xa0, 0x8f, 0xfe, 0x81, 0x7c, 0x47, 0xfb, 0x7d,
-	0x14, 0x0e, 0x0f, 0xf4, 0xfd, 0xe9, 0x5a, 0xf6, 0xf7, 0x50, 0x3a, 0x11, 0x48, 0x15, 0x36, 0x6e,
-	0x79, 0xba, 0xf8, 0x73, 0x88, 0x52, 0x11, 0x07, 0xe0, 0x8e, 0xbc, 0x69, 0x54, 0x8c, 0x6a, 0xee,
-	0x68, 0xbd, 0x46, 0x03, 0x56, 0x4b, 0x60, 0x13, 0x10, 0x7b, 0x0f, 0x9e, 0x9c, 0xa1, 0x4a, 0x5f,
-	0xb4, 0x06, 0x19, 0xd6, 0xd5, 0x17, 0xac, 0xb8, 0x19, 0xd6, 0xb5, 0xff, 0x36, 0xa0, 0x78, 0xc1,
-	0x64, 0x02, 0x29, 0x27, 0xd0, 0x1d, 0x80, 0x80, 0xf6, 0xb1, 0xad, 0xf8, 0x15, 0xfa, 0xf1, 0x4f,
-	0x56, 0xa2, 0x48, 0x2b, 0x0a, 0x90, 0x6d, 0xd0, 0x87, 0xb6, 0x64, 0x37, 0x68, 0x66, 0x2a, 0x46,
-	0x75, 0xd9, 0x7d, 0x1c, 0x05, 0x9a, 0xec, 0x06, 0x49, 0x09, 0x1e, 0x49, 0x2e, 0x54, 0xbb, 0x33,
-	0x32, 0x97, 0xf4, 0x0f, 0xb3, 0xd1, 0xf1, 0x78, 0x44, 0x8a, 0x90, 0xed, 0xb1, 0x81, 0x42, 0x61,
-	0x3e, 0x1c, 0xc7, 0xc7, 0x27, 0xf2, 0x02, 0x8a, 0xe9, 0x0e, 0xb5, 0xaf, 0x70, 0x64, 0x2e, 0x6b,
-	0xb2, 0x05, 0x4d, 0xd6, 0x8d, 0x21, 0x2f, 0x71, 0xe4, 0x3e, 0x99, 0xe0, 0xdd, 0x09, 0xfc, 0x25,
-	0x8e, 0xec, 0x0f, 0x06, 0x94, 0x52, 0x7c, 0x64, 0xc0, 0x7d, 0x89, 0xe4, 0x10, 0x72, 0x77, 0x0a,
-	0x49, 0xd3, 0xa8, 0x2c, 0xcd, 0x53, 0x31, 0x89, 0x89, 0x34, 0x50, 0x5c, 0xd1, 0xc1, 0x98, 0xe5,
-	0x92, 0x66, 0xb9, 0xa2, 0x23, 0x9a, 0xe6, 0x1e, 0xac, 0xfb, 0xf8, 0x5e, 0xb5, 0x13, 0x3a, 0x65,
-	0x34, 0xad, 0x7c, 0x14, 0x7e, 0x35, 0xd1, 0xca, 0xfe, 0x1a, 0x4a, 0xa7, 0x38, 0xc0, 0x79, 0x9d,
-	0x9d, 0x6d, 0xc8, 0x7f, 0x19, 0x80, 0x3b, 0xd4, 0x6c, 0x9a, 0x10, 0x78, 0xe8, 0xd3, 0x21, 0xc6,
-	0x65, 0xf4, 0xff, 0xa4, 0x02, 0xb9, 0x2e, 0x4a, 0x4f, 0x30, 0xed, 0xac, 0x58, 0xf0, 0x64, 0x88,
-	0x7c, 0x0b, 0xe0, 0x69, 0x67, 0x75, 0xdb, 0x54, 0x69, 0xe5, 0x73, 0x47, 0x56, 0x6d, 0xec, 0xde,
-	0xda, 0xc4, 0xbd, 0xb5, 0xd6, 0xc4, 0xbd, 0xee, 0x4a, 0x8c, 0xae, 0x2b, 0x72, 0x06, 0x9b, 0xe9,
-	0xc6, 0x48, 0x73, 0x59, 0x8b, 0x57, 0x9c, 0xea, 0xca, 0x6d, 0x23, 0x5c, 0x92, 0xea, 0x8d, 0x24,
-	0x75, 0xc8, 0x4b, 0xc5, 0x85, 0xb6, 0x8c, 0xa2, 0x0a, 0xcd, 0x6c, 0xc5, 0xa8, 0xae, 0x1d, 0x95,
-	0x67, 0xf4, 0xaf, 0x35, 0xc7, 0xa0, 0x66, 0x84, 0x71, 0x57, 0x65, 0xe2, 0x64, 0x7b, 0xb0, 0x9a,
-	0xcc, 0x92, 0x32, 0x98, 0xcd, 0xd6, 0x4f, 0x6e, 0xfd, 0xac, 0xd1, 0x6c, 0xd5, 0x5b, 0x8d, 0xf6,
-	0xeb, 0x1f, 0x9b, 0xaf, 0x1a, 0x27, 0xe7, 0x2f, 0xce, 0x1b, 0xa7, 0x85, 0x07, 0xc4, 0x82, 0xe2,
-	0x54, 0xb6, 0xfe, 0xa6, 0x7e, 0x7e, 0x51, 0x3f, 0xbe, 0x68, 0x14, 0x0c, 0xb2, 0x05, 0x9f, 0x4d,
-	0xe7, 0xdc, 0x93, 0xef, 0xce, 0xdf, 0x34, 0x4e, 0x0b, 0x19, 0x7b, 0x1f, 0xcc, 0xba, 0xf0, 0x2e,
-	0xd9, 0xf5, 0x3d, 0x9a, 0xf5, 0x14, 0xac, 0xd7, 0x3e, 0xbd, 0x27, 0xfa, 0xe8, 0xaf, 0x65, 0xd8,
-	0xb8, 0x43, 0x35, 0x51, 0x5c, 0x33, 0x0f, 0x49, 0x00, 0x85, 0xd9, 0xa9, 0x27, 0x63, 0x51, 0x16,
-	0x2c, 0x03, 0x6b, 0xd6, 0xb2, 0xf6, 0xc1, 0xef, 0xff, 0xfc, 0xfb, 0x21, 0xf3, 0x95, 0xbd, 0x15,
-	0x2d, 0x31, 0xe9, 0x5c, 0x1f, 0x76, 0x50, 0xd1, 0xc3, 0xc4, 0xba, 0x94, 0xcf, 0x13, 0xbb, 0x81,
-	0x78, 0x90, 0x9f, 0xda, 0x0d, 0x64, 0x4b, 0x5f, 0x38, 0x6f, 0x5f, 0xa4, 0x6b, 0xed, 0xe9, 0x5a,
-	0x15, 0xf2, 0xf9, 0xc2, 0x5a, 0xce, 0x2f, 0xac, 0xfb, 0x2b, 0xf1, 0x61, 0x6d, 0x7a, 0x0e, 0xc9,
-	0xb6, 0xbe, 0x6a, 0xfe, 0xb2, 0xb1, 0xca, 0xf3, 0x93, 0xe3, 0xc9, 0xb5, 0xbf, 0xd0, 0x45, 0xb7,
-	0xc9, 0x62, 0x82, 0x91, 0x8c, 0xb3, 0x23, 0x16, 0xcb, 0xb8, 0x60, 0xf2, 0xac, 0x62, 0x6a, 0x00,
-	0x1a, 0xd1, 0x6e, 0x9f, 0x30, 0xdc, 0xff, 0x14, 0xc3, 0x1b, 0xd8, 0x48, 0x19, 0x85, 0xec, 0xe8,
-	0x92, 0x8b, 0x0c, 0xb4, 0xb0, 0x66, 0x4d, 0xd7, 0xac, 0xda, 0x7b, 0x1f, 0xaf, 0xf9, 0x3c, 0xf6,
-	0x1a, 0xf9, 0xcd, 0x80, 0xcd, 0x39, 0xce, 0x23, 0xbb, 0xba, 0xfc, 0x62, 0x4f, 0x2e, 0x7c, 0xc0,
-	0x37, 0xfa, 0x01, 0xfb, 0x76, 0xf5, 0x13, 0x0f, 0x08, 0x27, 0x57, 0x1f, 0xff, 0x61, 0xfc, 0x59,
-	0xff, 0xc1, 0x2d, 0xc3, 0xa3, 0x2e, 0xf6, 0x68, 0x38, 0x50, 0x64, 0x83, 0xac, 0x43, 0xde, 0xca,
-	0xe9, 0x17, 0x44, 0xf3, 0x19, 0xca, 0xb7, 0xbb, 0xb0, 0x03, 0xd9, 0x63, 0xa4, 0x02, 0x05, 0xd9,
-	0x7c, 0x9c, 0xb1, 0xf2, 0x34, 0x54, 0x97, 0x5c, 0xb0, 0x1b, 0xfd, 0xdd, 0xab, 0x64, 0x3a, 0xab,
-	0x00, 0xb7, 0x80, 0x07, 0x6f, 0x9f, 0xf5, 0x99, 0xba, 0x0c, 0x3b, 0x35, 0x8f, 0x0f, 0x9d, 0xab,
-	0xb0, 0x83, 0xbd, 0x01, 0x7f, 0xe7, 0x04, 0x2c, 0xc0, 0x01, 0xf3, 0x51, 0x3a, 0xc9, 0xcf, 0x79,
-	0x9f, 0xb7, 0xbd, 0x01, 0x43, 0x5f, 0x75, 0xb2, 0x9a, 0xc9, 0xb3, 0xff, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x0b, 0x8f, 0xfa, 0xad, 0x2a, 0x08, 0x00, 0x00,
+	proto.RegisterFile("backend/api/experiment.proto", fileDescriptor_experiment_009307920d2eda7c)
+}
+
+var fileDescriptor_experiment_009307920d2eda7c = []byte{
+	// 996 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x52, 0xe3, 0xc6,
+	0x13, 0x5e, 0xd9, 0x8b, 0x59, 0xda, 0xfc, 0x31, 0x03, 0x3f, 0x23, 0x84, 0xf9, 0xe1, 0xa8, 0x12,
+	0x42, 0xa8, 0xc5, 0x0a, 0x70, 0xc9, 0x72, 0x33, 0x60, 0x08, 0x59, 0x92, 0x6c, 0xc9, 0xb0, 0x87,
+	0xbd, 0xb8, 0xc6, 0x72, 0xdb, 0x4c, 0x21, 0x4b, 0xca, 0xcc, 0x98, 0x5d, 0x93, 0x4a, 0x25, 0x95,
+	0xaa, 0xbc, 0x40, 0xf6, 0x4d, 0xf2, 0x1c, 0xc9, 0x29, 0xaf, 0x90, 0x73, 0x9e, 0x21, 0xa5, 0x91,
+	0x0c, 0xb2, 0x65, 0xc3, 0xa6, 0x2a, 0x27, 0x7b, 0xba, 0xbf, 0xe9, 0x9e, 0xfe, 0xe6, 0x9b, 0xcf,
+	0x86, 0x52, 0x93, 0x3a, 0xd7, 0xe8, 0xb5, 0x2c, 0x1a, 0x30, 0x0b, 0xdf, 0x05, 0xc8, 0x59, 0x17,
+	0x3d, 0x59, 0x09, 0xb8, 0x2f, 0x7d, 0x92, 0xa5, 0x01, 0x33, 0x56, 0x86, 0x20, 0x9c, 0xfb, 0x3c,
+	0xca, 0x1a, 0x1f, 0x27, 0x13, 0x1c, 0x85, 0xdf, 0xe3, 0x0e, 0x36, 0x38, 0xb6, 0x91, 0xa3, 0xe7,
+	0x60, 0x8c, 0x2a, 0x75, 0x7c, 0xbf, 0xe3, 0xa2, 0x02, 0x51, 0xcf, 0xf3, 0x25, 0x95, 0xcc, 0xf7,
+	0x44, 0x9c, 0x5d, 0x8b, 0xb3, 0x6a, 0xd5, 0xec, 0xb5, 0x2d, 0xec, 0x06, 0xb2, 0x1f, 0x27, 0x37,
+	0x46, 0x93, 0x92, 0x75, 0x51, 0x48, 0xda, 0x0d, 0x62, 0xc0, 0x73, 0xf5, 0xe1, 0xec, 0x74, 0xd0,
+	0xdb, 0x11, 0x6f, 0x69, 0xa7, 0x83, 0xdc, 0xf2, 0x03, 0x55, 0x3f, 0xdd, 0xcb, 0xfc, 0x0a, 0x56,
+	0x8e, 0x38, 0x52, 0x89, 0xb5, 0xbb, 0x39, 0x6d, 0xfc, 0xae, 0x87, 0x42, 0x12, 0x0b, 0xe0, 0x7e,
+	0x78, 0x5d, 0x2b, 0x6b, 0x5b, 0xf9, 0xbd, 0x85, 0x0a, 0x0d, 0x58, 0x25, 0x81, 0x4d, 0x40, 0xcc,
+	0x4d, 0x58, 0x3e, 0x45, 0x99, 0x2e, 0x34, 0x0f, 0x19, 0xd6, 0x52, 0x05, 0x66, 0xec, 0x0c, 0x6b,
+	0x99, 0x7f, 0x68, 0x50, 0x3c, 0x67, 0x22, 0x81, 0x14, 0x03, 0xe8, 0x3a, 0x40, 0x40, 0x3b, 0xd8,
+	0x90, 0xfe, 0x35, 0x7a, 0xf1, 0x96, 0x99, 0x30, 0x72, 0x11, 0x06, 0xc8, 0x1a, 0xa8, 0x45, 0x43,
+	0xb0, 0x5b, 0xd4, 0x33, 0x65, 0x6d, 0x6b, 0xca, 0x7e, 0x16, 0x06, 0xea, 0xec, 0x16, 0xc9, 0x0a,
+	0x4c, 0x0b, 0x9f, 0xcb, 0x46, 0xb3, 0xaf, 0x67, 0xd5, 0xc6, 0x5c, 0xb8, 0x3c, 0xec, 0x93, 0x22,
+	0xe4, 0xda, 0xcc, 0x95, 0xc8, 0xf5, 0xa7, 0x51, 0x3c, 0x5a, 0x91, 0x13, 0x28, 0xa6, 0x6f, 0xa8,
+	0x71, 0x8d, 0x7d, 0x7d, 0x4a, 0x0d, 0x5b, 0x50, 0xc3, 0xda, 0x31, 0xe4, 0x25, 0xf6, 0xed, 0xe5,
+	0x01, 0xde, 0x1e, 0xc0, 0x5f, 0x62, 0xdf, 0x7c, 0xaf, 0xc1, 0x4a, 0x6a, 0x1e, 0x11, 0xf8, 0x9e,
+	0x40, 0xb2, 0x0b, 0xf9, 0x7b, 0x86, 0x84, 0xae, 0x95, 0xb3, 0xe3, 0x58, 0x4c, 0x62, 0x42, 0x0e,
+	0xa4, 0x2f, 0xa9, 0x1b, 0x4d, 0x99, 0x55, 0x53, 0xce, 0xa8, 0x88, 0x1a, 0x73, 0x13, 0x16, 0x3c,
+	0x7c, 0x27, 0x1b, 0x09, 0x9e, 0x32, 0x6a, 0xac, 0xb9, 0x30, 0xfc, 0x6a, 0xc0, 0x95, 0xf9, 0x19,
+	0xac, 0x1c, 0xa3, 0x8b, 0x12, 0x1f, 0xbf, 0x90, 0xbf, 0xb3, 0x00, 0xf7, 0xa8, 0xd1, 0x34, 0x21,
+	0xf0, 0xd4, 0xa3, 0x5d, 0x8c, 0xdb, 0xa8, 0xef, 0xa4, 0x0c, 0xf9, 0x16, 0x0a, 0x87, 0x33, 0xa5,
+	0xac, 0x98, 0xf0, 0x64, 0x88, 0xbc, 0x00, 0x70, 0x94, 0xb2, 0x5a, 0x0d, 0x2a, 0x15, 0xf3, 0xf9,
+	0x3d, 0xa3, 0x12, 0xa9, 0xb7, 0x32, 0x50, 0x6f, 0xe5, 0x62, 0xa0, 0x5e, 0x7b, 0x26, 0x46, 0x57,
+	0x25, 0x39, 0x85, 0xa5, 0xf4, 0xc5, 0x08, 0x7d, 0x4a, 0x91, 0x57, 0x1c, 0xba, 0x95, 0xbb, 0x8b,
+	0xb0, 0x49, 0xea, 0x6e, 0x04, 0xa9, 0xc2, 0x9c, 0x90, 0x3e, 0x57, 0x92, 0x91, 0x54, 0xa2, 0x9e,
+	0x2b, 0x6b, 0x5b, 0xf3, 0x7b, 0xa5, 0x11, 0xfe, 0x2b, 0xf5, 0x08, 0x54, 0x0f, 0x31, 0xf6, 0xac,
+	0x48, 0xac, 0xc8, 0x3e, 0xe4, 0x5c, 0xda, 0x44, 0x57, 0xe8, 0xd3, 0xaa, 0xfd, 0xda, 0xe8, 0xde,
+	0x73, 0x95, 0xad, 0x79, 0x92, 0xf7, 0xed, 0x18, 0x6a, 0xbc, 0x80, 0x7c, 0x22, 0x4c, 0x0a, 0x90,
+	0x0d, 0x55, 0x15, 0x31, 0x1a, 0x7e, 0x25, 0xcb, 0x30, 0x75, 0x43, 0xdd, 0xde, 0x80, 0xd3, 0x68,
+	0x71, 0x90, 0xf9, 0x42, 0x33, 0x1d, 0x98, 0x4d, 0x9e, 0x86, 0x94, 0x40, 0xaf, 0x5f, 0x7c, 0x6b,
+	0x57, 0x4f, 0x6b, 0xf5, 0x8b, 0xea, 0x45, 0xad, 0x71, 0xf9, 0x4d, 0xfd, 0x55, 0xed, 0xe8, 0xec,
+	0xe4, 0xac, 0x76, 0x5c, 0x78, 0x42, 0x0c, 0x28, 0x0e, 0x65, 0xab, 0xaf, 0xab, 0x67, 0xe7, 0xd5,
+	0xc3, 0xf3, 0x5a, 0x41, 0x23, 0xab, 0xf0, 0xbf, 0xe1, 0x9c, 0x7d, 0xf4, 0xe5, 0xd9, 0xeb, 0xda,
+	0x71, 0x21, 0x63, 0x6e, 0x83, 0x5e, 0xe5, 0xce, 0x15, 0xbb, 0xf9, 0x00, 0x71, 0x3c, 0x07, 0xe3,
+	0xd2, 0xa3, 0x1f, 0x8a, 0xfe, 0x4d, 0x83, 0xf5, 0xcb, 0xa0, 0x35, 0x64, 0x28, 0x11, 0x15, 0x13,
+	0x76, 0x90, 0x93, 0x3b, 0x82, 0x33, 0x8a, 0xe0, 0x8a, 0x22, 0xf8, 0xc1, 0x1a, 0xff, 0x31, 0xe7,
+	0x7b, 0xbf, 0xe7, 0x60, 0xf1, 0xbe, 0x55, 0x1d, 0xf9, 0x0d, 0x73, 0x90, 0x04, 0x50, 0x18, 0xb5,
+	0x46, 0x12, 0x29, 0x67, 0x82, 0x63, 0x1a, 0xa3, 0xef, 0xda, 0xdc, 0xf9, 0xf9, 0xcf, 0xbf, 0xde,
+	0x67, 0x3e, 0x35, 0x57, 0x43, 0xa7, 0x17, 0xd6, 0xcd, 0x6e, 0x13, 0x25, 0xdd, 0x4d, 0xfc, 0xa6,
+	0x88, 0x83, 0x84, 0x81, 0x12, 0x07, 0xe6, 0x86, 0x0c, 0x94, 0xac, 0xaa, 0x82, 0xe3, 0x4c, 0x35,
+	0xdd, 0x6b, 0x53, 0xf5, 0x2a, 0x93, 0xff, 0x4f, 0xec, 0x65, 0x7d, 0xcf, 0x5a, 0x3f, 0x10, 0x0f,
+	0xe6, 0x87, 0xcd, 0x8a, 0x44, 0x92, 0x1e, 0xef, 0xc8, 0x46, 0x69, 0x7c, 0x32, 0xb2, 0x37, 0xf3,
+	0x23, 0xd5, 0x74, 0x8d, 0x4c, 0x1e, 0x30, 0xa4, 0x71, 0xd4, 0x87, 0x62, 0x1a, 0x27, 0xd8, 0x93,
+	0x51, 0x4c, 0xb9, 0x44, 0x2d, 0xfc, 0x01, 0x1c, 0x4c, 0xb8, 0xfd, 0xd8, 0x84, 0xb7, 0xb0, 0x98,
+	0x52, 0x37, 0x59, 0x57, 0x2d, 0x27, 0xa9, 0x7e, 0x62, 0xcf, 0x8a, 0xea, 0xb9, 0x65, 0x6e, 0x3e,
+	0xdc, 0xf3, 0x20, 0x7e, 0x20, 0xe4, 0x27, 0x0d, 0x96, 0xc6, 0x3c, 0x17, 0xb2, 0x11, 0xa9, 0xda,
+	0xa3, 0xff, 0xf6, 0x00, 0x9f, 0xab, 0x03, 0x6c, 0x9b, 0x5b, 0x8f, 0x1c, 0xa0, 0x37, 0x28, 0x4d,
+	0x7e, 0x84, 0xe2, 0xf8, 0xd7, 0x43, 0xcc, 0xc7, 0x9f, 0x56, 0x5a, 0x57, 0xf1, 0x01, 0x8c, 0x4f,
+	0x1e, 0x3e, 0x80, 0x15, 0x3d, 0xc3, 0x03, 0x6d, 0xfb, 0xf0, 0x17, 0xed, 0xd7, 0xea, 0xd7, 0x76,
+	0x09, 0xa6, 0x5b, 0xd8, 0xa6, 0x3d, 0x57, 0x92, 0x45, 0xb2, 0x00, 0x73, 0x46, 0x5e, 0x55, 0x0e,
+	0x5d, 0xad, 0x27, 0xde, 0x6c, 0xc0, 0x3a, 0xe4, 0x0e, 0x91, 0x72, 0xe4, 0x64, 0xe9, 0x59, 0xc6,
+	0x98, 0xa3, 0x3d, 0x79, 0xe5, 0x73, 0x76, 0xab, 0xfe, 0x9d, 0x94, 0x33, 0xcd, 0x59, 0x80, 0x3b,
+	0xc0, 0x93, 0x37, 0xfb, 0x1d, 0x26, 0xaf, 0x7a, 0xcd, 0x8a, 0xe3, 0x77, 0xad, 0xeb, 0x5e, 0x13,
+	0xdb, 0xae, 0xff, 0xd6, 0x0a, 0x58, 0x80, 0x2e, 0xf3, 0x50, 0x58, 0xc9, 0x3f, 0x5d, 0x1d, 0xbf,
+	0xe1, 0xb8, 0x0c, 0x3d, 0xd9, 0xcc, 0x29, 0x2a, 0xf7, 0xff, 0x19, 0x00, 0x7c, 0x79, 0x15, 0xd3,
+	0xd0, 0x09, 0x00, 0x00,
 }
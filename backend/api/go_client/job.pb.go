@@ -41,6 +41,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type Backfill_State int32
+
+const (
+	Backfill_UNKNOWN_STATE Backfill_State = 0
+	Backfill_RUNNING       Backfill_State = 1
+	Backfill_COMPLETED     Backfill_State = 2
+	Backfill_CANCELLED     Backfill_State = 3
+)
+
+var Backfill_State_name = map[int32]string{
+	0: "UNKNOWN_STATE",
+	1: "RUNNING",
+	2: "COMPLETED",
+	3: "CANCELLED",
+}
+var Backfill_State_value = map[string]int32{
+	"UNKNOWN_STATE": 0,
+	"RUNNING":       1,
+	"COMPLETED":     2,
+	"CANCELLED":     3,
+}
+
+func (x Backfill_State) String() string {
+	return proto.EnumName(Backfill_State_name, int32(x))
+}
+func (Backfill_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{14, 0}
+}
+
 type Job_Mode int32
 
 const (
@@ -64,7 +93,7 @@ func (x Job_Mode) String() string {
 	return proto.EnumName(Job_Mode_name, int32(x))
 }
 func (Job_Mode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_job_a950c472a7b16ea0, []int{10, 0}
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{20, 0}
 }
 
 type CreateJobRequest struct {
@@ -78,7 +107,7 @@ func (m *CreateJobRequest) Reset()         { *m = CreateJobRequest{} }
 func (m *CreateJobRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateJobRequest) ProtoMessage()    {}
 func (*CreateJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_job_a950c472a7b16ea0, []int{0}
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{0}
 }
 func (m *CreateJobRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateJobRequest.Unmarshal(m, b)
@@ -116,7 +145,7 @@ func (m *GetJobRequest) Reset()         { *m = GetJobRequest{} }
 func (m *GetJobRequest) String() string { return proto.CompactTextString(m) }
 func (*GetJobRequest) ProtoMessage()    {}
 func (*GetJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_job_a950c472a7b16ea0, []int{1}
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{1}
 }
 func (m *GetJobRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetJobRequest.Unmarshal(m, b)
@@ -158,7 +187,7 @@ func (m *ListJobsRequest) Reset()         { *m = ListJobsRequest{} }
 func (m *ListJobsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListJobsRequest) ProtoMessage()    {}
 func (*ListJobsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_job_a950c472a7b16ea0, []int{2}
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{2}
 }
 func (m *ListJobsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListJobsRequest.Unmarshal(m, b)
@@ -226,7 +255,7 @@ func (m *ListJobsResponse) Reset()         { *m = ListJobsResponse{} }
 func (m *ListJobsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListJobsResponse) ProtoMessage()    {}
 func (*ListJobsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_job_a950c472a7b16ea0, []int{3}
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{3}
 }
 func (m *ListJobsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListJobsResponse.Unmarshal(m, b)
@@ -278,7 +307,7 @@ func (m *DeleteJobRequest) Reset()         { *m = DeleteJobRequest{} }
 func (m *DeleteJobRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteJobRequest) ProtoMessage()    {}
 func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_job_a950c472a7b16ea0, []int{4}
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{4}
 }
 func (m *DeleteJobRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteJobRequest.Unmarshal(m, b)
@@ -316,7 +345,7 @@ func (m *EnableJobRequest) Reset()         { *m = EnableJobRequest{} }
 func (m *EnableJobRequest) String() string { return proto.CompactTextString(m) }
 func (*EnableJobRequest) ProtoMessage()    {}
 func (*EnableJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_job_a950c472a7b16ea0, []int{5}
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{5}
 }
 func (m *EnableJobRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EnableJobRequest.Unmarshal(m, b)
@@ -354,7 +383,7 @@ func (m *DisableJobRequest) Reset()         { *m = DisableJobRequest{} }
 func (m *DisableJobRequest) String() string { return proto.CompactTextString(m) }
 func (*DisableJobRequest) ProtoMessage()    {}
 func (*DisableJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_job_a950c472a7b16ea0, []int{6}
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{6}
 }
 func (m *DisableJobRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DisableJobRequest.Unmarshal(m, b)
@@ -381,10 +410,411 @@ func (m *DisableJobRequest) GetId() string {
 	return ""
 }
 
+type UpdateJobRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Job                  *Job     `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateJobRequest) Reset()         { *m = UpdateJobRequest{} }
+func (m *UpdateJobRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateJobRequest) ProtoMessage()    {}
+func (*UpdateJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{7}
+}
+func (m *UpdateJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateJobRequest.Unmarshal(m, b)
+}
+func (m *UpdateJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateJobRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateJobRequest.Merge(dst, src)
+}
+func (m *UpdateJobRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateJobRequest.Size(m)
+}
+func (m *UpdateJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateJobRequest proto.InternalMessageInfo
+
+func (m *UpdateJobRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateJobRequest) GetJob() *Job {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+type UpdateJobLabelsRequest struct {
+	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Labels               map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UpdateJobLabelsRequest) Reset()         { *m = UpdateJobLabelsRequest{} }
+func (m *UpdateJobLabelsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateJobLabelsRequest) ProtoMessage()    {}
+func (*UpdateJobLabelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{8}
+}
+func (m *UpdateJobLabelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateJobLabelsRequest.Unmarshal(m, b)
+}
+func (m *UpdateJobLabelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateJobLabelsRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateJobLabelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateJobLabelsRequest.Merge(dst, src)
+}
+func (m *UpdateJobLabelsRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateJobLabelsRequest.Size(m)
+}
+func (m *UpdateJobLabelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateJobLabelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateJobLabelsRequest proto.InternalMessageInfo
+
+func (m *UpdateJobLabelsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateJobLabelsRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type TriggerJobRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerJobRequest) Reset()         { *m = TriggerJobRequest{} }
+func (m *TriggerJobRequest) String() string { return proto.CompactTextString(m) }
+func (*TriggerJobRequest) ProtoMessage()    {}
+func (*TriggerJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{9}
+}
+func (m *TriggerJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerJobRequest.Unmarshal(m, b)
+}
+func (m *TriggerJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerJobRequest.Marshal(b, m, deterministic)
+}
+func (dst *TriggerJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerJobRequest.Merge(dst, src)
+}
+func (m *TriggerJobRequest) XXX_Size() int {
+	return xxx_messageInfo_TriggerJobRequest.Size(m)
+}
+func (m *TriggerJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerJobRequest proto.InternalMessageInfo
+
+func (m *TriggerJobRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type PreviewScheduleRequest struct {
+	Trigger              *Trigger `protobuf:"bytes,1,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	NoCatchup            bool     `protobuf:"varint,2,opt,name=no_catchup,json=noCatchup,proto3" json:"no_catchup,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreviewScheduleRequest) Reset()         { *m = PreviewScheduleRequest{} }
+func (m *PreviewScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*PreviewScheduleRequest) ProtoMessage()    {}
+func (*PreviewScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{10}
+}
+func (m *PreviewScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreviewScheduleRequest.Unmarshal(m, b)
+}
+func (m *PreviewScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreviewScheduleRequest.Marshal(b, m, deterministic)
+}
+func (dst *PreviewScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewScheduleRequest.Merge(dst, src)
+}
+func (m *PreviewScheduleRequest) XXX_Size() int {
+	return xxx_messageInfo_PreviewScheduleRequest.Size(m)
+}
+func (m *PreviewScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreviewScheduleRequest proto.InternalMessageInfo
+
+func (m *PreviewScheduleRequest) GetTrigger() *Trigger {
+	if m != nil {
+		return m.Trigger
+	}
+	return nil
+}
+
+func (m *PreviewScheduleRequest) GetNoCatchup() bool {
+	if m != nil {
+		return m.NoCatchup
+	}
+	return false
+}
+
+func (m *PreviewScheduleRequest) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type PreviewScheduleResponse struct {
+	TriggerTimes         []*timestamp.Timestamp `protobuf:"bytes,1,rep,name=trigger_times,json=triggerTimes,proto3" json:"trigger_times,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *PreviewScheduleResponse) Reset()         { *m = PreviewScheduleResponse{} }
+func (m *PreviewScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*PreviewScheduleResponse) ProtoMessage()    {}
+func (*PreviewScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{11}
+}
+func (m *PreviewScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreviewScheduleResponse.Unmarshal(m, b)
+}
+func (m *PreviewScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreviewScheduleResponse.Marshal(b, m, deterministic)
+}
+func (dst *PreviewScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewScheduleResponse.Merge(dst, src)
+}
+func (m *PreviewScheduleResponse) XXX_Size() int {
+	return xxx_messageInfo_PreviewScheduleResponse.Size(m)
+}
+func (m *PreviewScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreviewScheduleResponse proto.InternalMessageInfo
+
+func (m *PreviewScheduleResponse) GetTriggerTimes() []*timestamp.Timestamp {
+	if m != nil {
+		return m.TriggerTimes
+	}
+	return nil
+}
+
+type BackfillJobRequest struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BackfillJobRequest) Reset()         { *m = BackfillJobRequest{} }
+func (m *BackfillJobRequest) String() string { return proto.CompactTextString(m) }
+func (*BackfillJobRequest) ProtoMessage()    {}
+func (*BackfillJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{12}
+}
+func (m *BackfillJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackfillJobRequest.Unmarshal(m, b)
+}
+func (m *BackfillJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackfillJobRequest.Marshal(b, m, deterministic)
+}
+func (dst *BackfillJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackfillJobRequest.Merge(dst, src)
+}
+func (m *BackfillJobRequest) XXX_Size() int {
+	return xxx_messageInfo_BackfillJobRequest.Size(m)
+}
+func (m *BackfillJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackfillJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackfillJobRequest proto.InternalMessageInfo
+
+func (m *BackfillJobRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *BackfillJobRequest) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *BackfillJobRequest) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+type CancelJobBackfillRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelJobBackfillRequest) Reset()         { *m = CancelJobBackfillRequest{} }
+func (m *CancelJobBackfillRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelJobBackfillRequest) ProtoMessage()    {}
+func (*CancelJobBackfillRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{13}
+}
+func (m *CancelJobBackfillRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelJobBackfillRequest.Unmarshal(m, b)
+}
+func (m *CancelJobBackfillRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelJobBackfillRequest.Marshal(b, m, deterministic)
+}
+func (dst *CancelJobBackfillRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelJobBackfillRequest.Merge(dst, src)
+}
+func (m *CancelJobBackfillRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelJobBackfillRequest.Size(m)
+}
+func (m *CancelJobBackfillRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelJobBackfillRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelJobBackfillRequest proto.InternalMessageInfo
+
+func (m *CancelJobBackfillRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type Backfill struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	State                Backfill_State       `protobuf:"varint,4,opt,name=state,proto3,enum=api.Backfill_State" json:"state,omitempty"`
+	TotalRuns            int64                `protobuf:"varint,5,opt,name=total_runs,json=totalRuns,proto3" json:"total_runs,omitempty"`
+	CreatedRuns          int64                `protobuf:"varint,6,opt,name=created_runs,json=createdRuns,proto3" json:"created_runs,omitempty"`
+	NextScheduledTime    *timestamp.Timestamp `protobuf:"bytes,7,opt,name=next_scheduled_time,json=nextScheduledTime,proto3" json:"next_scheduled_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Backfill) Reset()         { *m = Backfill{} }
+func (m *Backfill) String() string { return proto.CompactTextString(m) }
+func (*Backfill) ProtoMessage()    {}
+func (*Backfill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{14}
+}
+func (m *Backfill) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Backfill.Unmarshal(m, b)
+}
+func (m *Backfill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Backfill.Marshal(b, m, deterministic)
+}
+func (dst *Backfill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Backfill.Merge(dst, src)
+}
+func (m *Backfill) XXX_Size() int {
+	return xxx_messageInfo_Backfill.Size(m)
+}
+func (m *Backfill) XXX_DiscardUnknown() {
+	xxx_messageInfo_Backfill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Backfill proto.InternalMessageInfo
+
+func (m *Backfill) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Backfill) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *Backfill) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *Backfill) GetState() Backfill_State {
+	if m != nil {
+		return m.State
+	}
+	return Backfill_UNKNOWN_STATE
+}
+
+func (m *Backfill) GetTotalRuns() int64 {
+	if m != nil {
+		return m.TotalRuns
+	}
+	return 0
+}
+
+func (m *Backfill) GetCreatedRuns() int64 {
+	if m != nil {
+		return m.CreatedRuns
+	}
+	return 0
+}
+
+func (m *Backfill) GetNextScheduledTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextScheduledTime
+	}
+	return nil
+}
+
 type CronSchedule struct {
 	StartTime            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
 	EndTime              *timestamp.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	Cron                 string               `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`
+	TimeZone             string               `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -394,7 +824,7 @@ func (m *CronSchedule) Reset()         { *m = CronSchedule{} }
 func (m *CronSchedule) String() string { return proto.CompactTextString(m) }
 func (*CronSchedule) ProtoMessage()    {}
 func (*CronSchedule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_job_a950c472a7b16ea0, []int{7}
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{15}
 }
 func (m *CronSchedule) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CronSchedule.Unmarshal(m, b)
@@ -435,6 +865,13 @@ func (m *CronSchedule) GetCron() string {
 	return ""
 }
 
+func (m *CronSchedule) GetTimeZone() string {
+	if m != nil {
+		return m.TimeZone
+	}
+	return ""
+}
+
 type PeriodicSchedule struct {
 	StartTime            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
 	EndTime              *timestamp.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
@@ -444,55 +881,181 @@ type PeriodicSchedule struct {
 	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *PeriodicSchedule) Reset()         { *m = PeriodicSchedule{} }
-func (m *PeriodicSchedule) String() string { return proto.CompactTextString(m) }
-func (*PeriodicSchedule) ProtoMessage()    {}
-func (*PeriodicSchedule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_job_a950c472a7b16ea0, []int{8}
+func (m *PeriodicSchedule) Reset()         { *m = PeriodicSchedule{} }
+func (m *PeriodicSchedule) String() string { return proto.CompactTextString(m) }
+func (*PeriodicSchedule) ProtoMessage()    {}
+func (*PeriodicSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{16}
+}
+func (m *PeriodicSchedule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeriodicSchedule.Unmarshal(m, b)
+}
+func (m *PeriodicSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeriodicSchedule.Marshal(b, m, deterministic)
+}
+func (dst *PeriodicSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodicSchedule.Merge(dst, src)
+}
+func (m *PeriodicSchedule) XXX_Size() int {
+	return xxx_messageInfo_PeriodicSchedule.Size(m)
+}
+func (m *PeriodicSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodicSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodicSchedule proto.InternalMessageInfo
+
+func (m *PeriodicSchedule) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *PeriodicSchedule) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *PeriodicSchedule) GetIntervalSecond() int64 {
+	if m != nil {
+		return m.IntervalSecond
+	}
+	return 0
+}
+
+type ObjectStoreEvent struct {
+	Bucket               string   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Prefix               string   `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	KeyParameter         string   `protobuf:"bytes,3,opt,name=key_parameter,json=keyParameter,proto3" json:"key_parameter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectStoreEvent) Reset()         { *m = ObjectStoreEvent{} }
+func (m *ObjectStoreEvent) String() string { return proto.CompactTextString(m) }
+func (*ObjectStoreEvent) ProtoMessage()    {}
+func (*ObjectStoreEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{17}
+}
+func (m *ObjectStoreEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectStoreEvent.Unmarshal(m, b)
+}
+func (m *ObjectStoreEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectStoreEvent.Marshal(b, m, deterministic)
+}
+func (dst *ObjectStoreEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectStoreEvent.Merge(dst, src)
+}
+func (m *ObjectStoreEvent) XXX_Size() int {
+	return xxx_messageInfo_ObjectStoreEvent.Size(m)
+}
+func (m *ObjectStoreEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectStoreEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectStoreEvent proto.InternalMessageInfo
+
+func (m *ObjectStoreEvent) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *ObjectStoreEvent) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *ObjectStoreEvent) GetKeyParameter() string {
+	if m != nil {
+		return m.KeyParameter
+	}
+	return ""
+}
+
+type RunCompletion struct {
+	JobId                string            `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	ExperimentId         string            `protobuf:"bytes,2,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+	States               []string          `protobuf:"bytes,3,rep,name=states,proto3" json:"states,omitempty"`
+	RunIdParameter       string            `protobuf:"bytes,4,opt,name=run_id_parameter,json=runIdParameter,proto3" json:"run_id_parameter,omitempty"`
+	OutputParameters     map[string]string `protobuf:"bytes,5,rep,name=output_parameters,json=outputParameters,proto3" json:"output_parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RunCompletion) Reset()         { *m = RunCompletion{} }
+func (m *RunCompletion) String() string { return proto.CompactTextString(m) }
+func (*RunCompletion) ProtoMessage()    {}
+func (*RunCompletion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{18}
 }
-func (m *PeriodicSchedule) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PeriodicSchedule.Unmarshal(m, b)
+func (m *RunCompletion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RunCompletion.Unmarshal(m, b)
 }
-func (m *PeriodicSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PeriodicSchedule.Marshal(b, m, deterministic)
+func (m *RunCompletion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RunCompletion.Marshal(b, m, deterministic)
 }
-func (dst *PeriodicSchedule) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PeriodicSchedule.Merge(dst, src)
+func (dst *RunCompletion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunCompletion.Merge(dst, src)
 }
-func (m *PeriodicSchedule) XXX_Size() int {
-	return xxx_messageInfo_PeriodicSchedule.Size(m)
+func (m *RunCompletion) XXX_Size() int {
+	return xxx_messageInfo_RunCompletion.Size(m)
 }
-func (m *PeriodicSchedule) XXX_DiscardUnknown() {
-	xxx_messageInfo_PeriodicSchedule.DiscardUnknown(m)
+func (m *RunCompletion) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunCompletion.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PeriodicSchedule proto.InternalMessageInfo
+var xxx_messageInfo_RunCompletion proto.InternalMessageInfo
 
-func (m *PeriodicSchedule) GetStartTime() *timestamp.Timestamp {
+func (m *RunCompletion) GetJobId() string {
 	if m != nil {
-		return m.StartTime
+		return m.JobId
 	}
-	return nil
+	return ""
 }
 
-func (m *PeriodicSchedule) GetEndTime() *timestamp.Timestamp {
+func (m *RunCompletion) GetExperimentId() string {
 	if m != nil {
-		return m.EndTime
+		return m.ExperimentId
+	}
+	return ""
+}
+
+func (m *RunCompletion) GetStates() []string {
+	if m != nil {
+		return m.States
 	}
 	return nil
 }
 
-func (m *PeriodicSchedule) GetIntervalSecond() int64 {
+func (m *RunCompletion) GetRunIdParameter() string {
 	if m != nil {
-		return m.IntervalSecond
+		return m.RunIdParameter
 	}
-	return 0
+	return ""
+}
+
+func (m *RunCompletion) GetOutputParameters() map[string]string {
+	if m != nil {
+		return m.OutputParameters
+	}
+	return nil
 }
 
 type Trigger struct {
 	// Types that are valid to be assigned to Trigger:
 	//	*Trigger_CronSchedule
 	//	*Trigger_PeriodicSchedule
+	//	*Trigger_ObjectStoreEvent
+	//	*Trigger_RunCompletion
 	Trigger              isTrigger_Trigger `protobuf_oneof:"trigger"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
@@ -503,7 +1066,7 @@ func (m *Trigger) Reset()         { *m = Trigger{} }
 func (m *Trigger) String() string { return proto.CompactTextString(m) }
 func (*Trigger) ProtoMessage()    {}
 func (*Trigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_job_a950c472a7b16ea0, []int{9}
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{19}
 }
 func (m *Trigger) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Trigger.Unmarshal(m, b)
@@ -535,10 +1098,22 @@ type Trigger_PeriodicSchedule struct {
 	PeriodicSchedule *PeriodicSchedule `protobuf:"bytes,2,opt,name=periodic_schedule,json=periodicSchedule,proto3,oneof"`
 }
 
+type Trigger_ObjectStoreEvent struct {
+	ObjectStoreEvent *ObjectStoreEvent `protobuf:"bytes,3,opt,name=object_store_event,json=objectStoreEvent,proto3,oneof"`
+}
+
+type Trigger_RunCompletion struct {
+	RunCompletion *RunCompletion `protobuf:"bytes,4,opt,name=run_completion,json=runCompletion,proto3,oneof"`
+}
+
 func (*Trigger_CronSchedule) isTrigger_Trigger() {}
 
 func (*Trigger_PeriodicSchedule) isTrigger_Trigger() {}
 
+func (*Trigger_ObjectStoreEvent) isTrigger_Trigger() {}
+
+func (*Trigger_RunCompletion) isTrigger_Trigger() {}
+
 func (m *Trigger) GetTrigger() isTrigger_Trigger {
 	if m != nil {
 		return m.Trigger
@@ -560,11 +1135,27 @@ func (m *Trigger) GetPeriodicSchedule() *PeriodicSchedule {
 	return nil
 }
 
+func (m *Trigger) GetObjectStoreEvent() *ObjectStoreEvent {
+	if x, ok := m.GetTrigger().(*Trigger_ObjectStoreEvent); ok {
+		return x.ObjectStoreEvent
+	}
+	return nil
+}
+
+func (m *Trigger) GetRunCompletion() *RunCompletion {
+	if x, ok := m.GetTrigger().(*Trigger_RunCompletion); ok {
+		return x.RunCompletion
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Trigger) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Trigger_OneofMarshaler, _Trigger_OneofUnmarshaler, _Trigger_OneofSizer, []interface{}{
 		(*Trigger_CronSchedule)(nil),
 		(*Trigger_PeriodicSchedule)(nil),
+		(*Trigger_ObjectStoreEvent)(nil),
+		(*Trigger_RunCompletion)(nil),
 	}
 }
 
@@ -582,6 +1173,16 @@ func _Trigger_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.PeriodicSchedule); err != nil {
 			return err
 		}
+	case *Trigger_ObjectStoreEvent:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ObjectStoreEvent); err != nil {
+			return err
+		}
+	case *Trigger_RunCompletion:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.RunCompletion); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Trigger.Trigger has unexpected type %T", x)
@@ -608,6 +1209,22 @@ func _Trigger_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer
 		err := b.DecodeMessage(msg)
 		m.Trigger = &Trigger_PeriodicSchedule{msg}
 		return true, err
+	case 3: // trigger.object_store_event
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ObjectStoreEvent)
+		err := b.DecodeMessage(msg)
+		m.Trigger = &Trigger_ObjectStoreEvent{msg}
+		return true, err
+	case 4: // trigger.run_completion
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(RunCompletion)
+		err := b.DecodeMessage(msg)
+		m.Trigger = &Trigger_RunCompletion{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -627,6 +1244,16 @@ func _Trigger_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Trigger_ObjectStoreEvent:
+		s := proto.Size(x.ObjectStoreEvent)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Trigger_RunCompletion:
+		s := proto.Size(x.RunCompletion)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -635,31 +1262,36 @@ func _Trigger_OneofSizer(msg proto.Message) (n int) {
 }
 
 type Job struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description          string               `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	PipelineSpec         *PipelineSpec        `protobuf:"bytes,4,opt,name=pipeline_spec,json=pipelineSpec,proto3" json:"pipeline_spec,omitempty"`
-	ResourceReferences   []*ResourceReference `protobuf:"bytes,5,rep,name=resource_references,json=resourceReferences,proto3" json:"resource_references,omitempty"`
-	ServiceAccount       string               `protobuf:"bytes,18,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
-	MaxConcurrency       int64                `protobuf:"varint,6,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
-	Trigger              *Trigger             `protobuf:"bytes,7,opt,name=trigger,proto3" json:"trigger,omitempty"`
-	Mode                 Job_Mode             `protobuf:"varint,8,opt,name=mode,proto3,enum=api.Job_Mode" json:"mode,omitempty"`
-	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Status               string               `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
-	Error                string               `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
-	Enabled              bool                 `protobuf:"varint,16,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	NoCatchup            bool                 `protobuf:"varint,17,opt,name=no_catchup,json=noCatchup,proto3" json:"no_catchup,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Id                     string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                   string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description            string               `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	PipelineSpec           *PipelineSpec        `protobuf:"bytes,4,opt,name=pipeline_spec,json=pipelineSpec,proto3" json:"pipeline_spec,omitempty"`
+	ResourceReferences     []*ResourceReference `protobuf:"bytes,5,rep,name=resource_references,json=resourceReferences,proto3" json:"resource_references,omitempty"`
+	ServiceAccount         string               `protobuf:"bytes,18,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
+	MaxConcurrency         int64                `protobuf:"varint,6,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	Trigger                *Trigger             `protobuf:"bytes,7,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	Mode                   Job_Mode             `protobuf:"varint,8,opt,name=mode,proto3,enum=api.Job_Mode" json:"mode,omitempty"`
+	CreatedAt              *timestamp.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt              *timestamp.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Status                 string               `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	Error                  string               `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+	Enabled                bool                 `protobuf:"varint,16,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	NoCatchup              bool                 `protobuf:"varint,17,opt,name=no_catchup,json=noCatchup,proto3" json:"no_catchup,omitempty"`
+	Labels                 map[string]string    `protobuf:"bytes,19,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextTriggeredTime      *timestamp.Timestamp `protobuf:"bytes,20,opt,name=next_triggered_time,json=nextTriggeredTime,proto3" json:"next_triggered_time,omitempty"`
+	Backfill               *Backfill            `protobuf:"bytes,21,opt,name=backfill,proto3" json:"backfill,omitempty"`
+	MaxConsecutiveFailures int64                `protobuf:"varint,22,opt,name=max_consecutive_failures,json=maxConsecutiveFailures,proto3" json:"max_consecutive_failures,omitempty"`
+	BlackoutWindows        []*TimeWindow        `protobuf:"bytes,23,rep,name=blackout_windows,json=blackoutWindows,proto3" json:"blackout_windows,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}             `json:"-"`
+	XXX_unrecognized       []byte               `json:"-"`
+	XXX_sizecache          int32                `json:"-"`
 }
 
 func (m *Job) Reset()         { *m = Job{} }
 func (m *Job) String() string { return proto.CompactTextString(m) }
 func (*Job) ProtoMessage()    {}
 func (*Job) Descriptor() ([]byte, []int) {
-	return fileDescriptor_job_a950c472a7b16ea0, []int{10}
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{20}
 }
 func (m *Job) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Job.Unmarshal(m, b)
@@ -784,6 +1416,87 @@ func (m *Job) GetNoCatchup() bool {
 	return false
 }
 
+func (m *Job) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Job) GetNextTriggeredTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextTriggeredTime
+	}
+	return nil
+}
+
+func (m *Job) GetBackfill() *Backfill {
+	if m != nil {
+		return m.Backfill
+	}
+	return nil
+}
+
+func (m *Job) GetMaxConsecutiveFailures() int64 {
+	if m != nil {
+		return m.MaxConsecutiveFailures
+	}
+	return 0
+}
+
+func (m *Job) GetBlackoutWindows() []*TimeWindow {
+	if m != nil {
+		return m.BlackoutWindows
+	}
+	return nil
+}
+
+type TimeWindow struct {
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              *timestamp.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TimeWindow) Reset()         { *m = TimeWindow{} }
+func (m *TimeWindow) String() string { return proto.CompactTextString(m) }
+func (*TimeWindow) ProtoMessage()    {}
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_job_3a1229752f4b8aa7, []int{21}
+}
+func (m *TimeWindow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimeWindow.Unmarshal(m, b)
+}
+func (m *TimeWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimeWindow.Marshal(b, m, deterministic)
+}
+func (dst *TimeWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeWindow.Merge(dst, src)
+}
+func (m *TimeWindow) XXX_Size() int {
+	return xxx_messageInfo_TimeWindow.Size(m)
+}
+func (m *TimeWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeWindow proto.InternalMessageInfo
+
+func (m *TimeWindow) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *TimeWindow) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CreateJobRequest)(nil), "api.CreateJobRequest")
 	proto.RegisterType((*GetJobRequest)(nil), "api.GetJobRequest")
@@ -792,10 +1505,25 @@ func init() {
 	proto.RegisterType((*DeleteJobRequest)(nil), "api.DeleteJobRequest")
 	proto.RegisterType((*EnableJobRequest)(nil), "api.EnableJobRequest")
 	proto.RegisterType((*DisableJobRequest)(nil), "api.DisableJobRequest")
+	proto.RegisterType((*UpdateJobRequest)(nil), "api.UpdateJobRequest")
+	proto.RegisterType((*UpdateJobLabelsRequest)(nil), "api.UpdateJobLabelsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.UpdateJobLabelsRequest.LabelsEntry")
+	proto.RegisterType((*TriggerJobRequest)(nil), "api.TriggerJobRequest")
+	proto.RegisterType((*PreviewScheduleRequest)(nil), "api.PreviewScheduleRequest")
+	proto.RegisterType((*PreviewScheduleResponse)(nil), "api.PreviewScheduleResponse")
+	proto.RegisterType((*BackfillJobRequest)(nil), "api.BackfillJobRequest")
+	proto.RegisterType((*CancelJobBackfillRequest)(nil), "api.CancelJobBackfillRequest")
+	proto.RegisterType((*Backfill)(nil), "api.Backfill")
 	proto.RegisterType((*CronSchedule)(nil), "api.CronSchedule")
 	proto.RegisterType((*PeriodicSchedule)(nil), "api.PeriodicSchedule")
+	proto.RegisterType((*ObjectStoreEvent)(nil), "api.ObjectStoreEvent")
+	proto.RegisterType((*RunCompletion)(nil), "api.RunCompletion")
+	proto.RegisterMapType((map[string]string)(nil), "api.RunCompletion.OutputParametersEntry")
 	proto.RegisterType((*Trigger)(nil), "api.Trigger")
 	proto.RegisterType((*Job)(nil), "api.Job")
+	proto.RegisterMapType((map[string]string)(nil), "api.Job.LabelsEntry")
+	proto.RegisterType((*TimeWindow)(nil), "api.TimeWindow")
+	proto.RegisterEnum("api.Backfill_State", Backfill_State_name, Backfill_State_value)
 	proto.RegisterEnum("api.Job_Mode", Job_Mode_name, Job_Mode_value)
 }
 
@@ -817,6 +1545,12 @@ type JobServiceClient interface {
 	EnableJob(ctx context.Context, in *EnableJobRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	DisableJob(ctx context.Context, in *DisableJobRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	UpdateJob(ctx context.Context, in *UpdateJobRequest, opts ...grpc.CallOption) (*Job, error)
+	UpdateJobLabels(ctx context.Context, in *UpdateJobLabelsRequest, opts ...grpc.CallOption) (*Job, error)
+	TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	BackfillJob(ctx context.Context, in *BackfillJobRequest, opts ...grpc.CallOption) (*Backfill, error)
+	CancelJobBackfill(ctx context.Context, in *CancelJobBackfillRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	PreviewSchedule(ctx context.Context, in *PreviewScheduleRequest, opts ...grpc.CallOption) (*PreviewScheduleResponse, error)
 }
 
 type jobServiceClient struct {
@@ -881,6 +1615,60 @@ func (c *jobServiceClient) DeleteJob(ctx context.Context, in *DeleteJobRequest,
 	return out, nil
 }
 
+func (c *jobServiceClient) UpdateJob(ctx context.Context, in *UpdateJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/api.JobService/UpdateJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) UpdateJobLabels(ctx context.Context, in *UpdateJobLabelsRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/api.JobService/UpdateJobLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.JobService/TriggerJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) BackfillJob(ctx context.Context, in *BackfillJobRequest, opts ...grpc.CallOption) (*Backfill, error) {
+	out := new(Backfill)
+	err := c.cc.Invoke(ctx, "/api.JobService/BackfillJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) CancelJobBackfill(ctx context.Context, in *CancelJobBackfillRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.JobService/CancelJobBackfill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) PreviewSchedule(ctx context.Context, in *PreviewScheduleRequest, opts ...grpc.CallOption) (*PreviewScheduleResponse, error) {
+	out := new(PreviewScheduleResponse)
+	err := c.cc.Invoke(ctx, "/api.JobService/PreviewSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JobServiceServer is the server API for JobService service.
 type JobServiceServer interface {
 	CreateJob(context.Context, *CreateJobRequest) (*Job, error)
@@ -889,6 +1677,12 @@ type JobServiceServer interface {
 	EnableJob(context.Context, *EnableJobRequest) (*empty.Empty, error)
 	DisableJob(context.Context, *DisableJobRequest) (*empty.Empty, error)
 	DeleteJob(context.Context, *DeleteJobRequest) (*empty.Empty, error)
+	UpdateJob(context.Context, *UpdateJobRequest) (*Job, error)
+	UpdateJobLabels(context.Context, *UpdateJobLabelsRequest) (*Job, error)
+	TriggerJob(context.Context, *TriggerJobRequest) (*empty.Empty, error)
+	BackfillJob(context.Context, *BackfillJobRequest) (*Backfill, error)
+	CancelJobBackfill(context.Context, *CancelJobBackfillRequest) (*empty.Empty, error)
+	PreviewSchedule(context.Context, *PreviewScheduleRequest) (*PreviewScheduleResponse, error)
 }
 
 func RegisterJobServiceServer(s *grpc.Server, srv JobServiceServer) {
@@ -1003,6 +1797,114 @@ func _JobService_DeleteJob_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobService_UpdateJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).UpdateJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.JobService/UpdateJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).UpdateJob(ctx, req.(*UpdateJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_UpdateJobLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJobLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).UpdateJobLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.JobService/UpdateJobLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).UpdateJobLabels(ctx, req.(*UpdateJobLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_TriggerJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).TriggerJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.JobService/TriggerJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).TriggerJob(ctx, req.(*TriggerJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_BackfillJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackfillJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).BackfillJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.JobService/BackfillJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).BackfillJob(ctx, req.(*BackfillJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_CancelJobBackfill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobBackfillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).CancelJobBackfill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.JobService/CancelJobBackfill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).CancelJobBackfill(ctx, req.(*CancelJobBackfillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_PreviewSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).PreviewSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.JobService/PreviewSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).PreviewSchedule(ctx, req.(*PreviewScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _JobService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.JobService",
 	HandlerType: (*JobServiceServer)(nil),
@@ -1031,86 +1933,160 @@ var _JobService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteJob",
 			Handler:    _JobService_DeleteJob_Handler,
 		},
+		{
+			MethodName: "UpdateJob",
+			Handler:    _JobService_UpdateJob_Handler,
+		},
+		{
+			MethodName: "UpdateJobLabels",
+			Handler:    _JobService_UpdateJobLabels_Handler,
+		},
+		{
+			MethodName: "TriggerJob",
+			Handler:    _JobService_TriggerJob_Handler,
+		},
+		{
+			MethodName: "BackfillJob",
+			Handler:    _JobService_BackfillJob_Handler,
+		},
+		{
+			MethodName: "CancelJobBackfill",
+			Handler:    _JobService_CancelJobBackfill_Handler,
+		},
+		{
+			MethodName: "PreviewSchedule",
+			Handler:    _JobService_PreviewSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "backend/api/job.proto",
 }
 
-func init() { proto.RegisterFile("backend/api/job.proto", fileDescriptor_job_a950c472a7b16ea0) }
-
-var fileDescriptor_job_a950c472a7b16ea0 = []byte{
-	// 1162 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x5d, 0x72, 0x1b, 0xc5,
-	0x13, 0xb7, 0x3e, 0x6c, 0x49, 0x6d, 0xc9, 0x96, 0x27, 0xb6, 0xb3, 0x7f, 0x25, 0xf9, 0x5b, 0x59,
-	0xa8, 0xc4, 0x45, 0x11, 0xa9, 0x92, 0x14, 0x14, 0xf0, 0x66, 0x5b, 0x26, 0x21, 0x89, 0x9d, 0xd4,
-	0x2a, 0x14, 0x55, 0xe1, 0x61, 0x6b, 0x76, 0xb7, 0x2d, 0x6f, 0x2c, 0xcd, 0x2c, 0x33, 0xb3, 0x49,
-	0x64, 0x8a, 0x17, 0xaa, 0xb8, 0x00, 0x70, 0x01, 0x0e, 0xc0, 0x35, 0xb8, 0x00, 0x57, 0xe0, 0x16,
-	0xbc, 0x50, 0x33, 0x3b, 0x2b, 0xeb, 0x23, 0xc6, 0x8f, 0x3c, 0x49, 0xfd, 0xeb, 0x5f, 0xcf, 0xf4,
-	0xc7, 0x74, 0xf7, 0xc2, 0x56, 0x40, 0xc3, 0x33, 0x64, 0x51, 0x97, 0x26, 0x71, 0xf7, 0x35, 0x0f,
-	0x3a, 0x89, 0xe0, 0x8a, 0x93, 0x12, 0x4d, 0xe2, 0xd6, 0xcd, 0x01, 0xe7, 0x83, 0x21, 0x1a, 0x15,
-	0x65, 0x8c, 0x2b, 0xaa, 0x62, 0xce, 0x64, 0x46, 0x69, 0xed, 0x58, 0xad, 0x91, 0x82, 0xf4, 0xa4,
-	0xab, 0xe2, 0x11, 0x4a, 0x45, 0x47, 0x89, 0x25, 0xdc, 0x98, 0x27, 0xe0, 0x28, 0x51, 0xe3, 0x5c,
-	0x39, 0x7d, 0x6f, 0x42, 0x05, 0x1d, 0xa1, 0x42, 0x91, 0x1f, 0x3d, 0xa3, 0x8c, 0x13, 0x1c, 0xc6,
-	0x0c, 0x7d, 0x99, 0x60, 0x68, 0x09, 0x1f, 0x4e, 0x13, 0x04, 0x4a, 0x9e, 0x8a, 0x10, 0x7d, 0x81,
-	0x27, 0x28, 0x90, 0x85, 0x68, 0x59, 0x33, 0xb1, 0x89, 0x94, 0x59, 0xf8, 0x63, 0xf3, 0x13, 0xde,
-	0x1b, 0x20, 0xbb, 0x27, 0xdf, 0xd2, 0xc1, 0x00, 0x45, 0x97, 0x27, 0x26, 0xb4, 0xf7, 0x84, 0x79,
-	0x7d, 0xfa, 0x10, 0x14, 0x82, 0x5b, 0x27, 0xdd, 0x0e, 0x34, 0x0f, 0x04, 0x52, 0x85, 0x4f, 0x78,
-	0xe0, 0xe1, 0x77, 0x29, 0x4a, 0x45, 0x5a, 0x50, 0x7a, 0xcd, 0x03, 0xa7, 0xd0, 0x2e, 0xec, 0xae,
-	0x3e, 0xa8, 0x76, 0x68, 0x12, 0x77, 0xb4, 0x56, 0x83, 0xee, 0x0e, 0x34, 0x1e, 0xa1, 0x9a, 0x22,
-	0xaf, 0x41, 0x31, 0x8e, 0x0c, 0xb7, 0xe6, 0x15, 0xe3, 0xc8, 0xfd, 0xa3, 0x00, 0xeb, 0xcf, 0x62,
-	0xa9, 0x29, 0x32, 0xe7, 0xdc, 0x02, 0x48, 0xe8, 0x00, 0x7d, 0xc5, 0xcf, 0x90, 0x59, 0x6e, 0x4d,
-	0x23, 0x2f, 0x35, 0x40, 0x6e, 0x80, 0x11, 0x7c, 0x19, 0x9f, 0xa3, 0x53, 0x6c, 0x17, 0x76, 0x97,
-	0xbd, 0xaa, 0x06, 0xfa, 0xf1, 0x39, 0x92, 0xeb, 0x50, 0x91, 0x5c, 0x28, 0x3f, 0x18, 0x3b, 0x25,
-	0x63, 0xb8, 0xa2, 0xc5, 0xfd, 0x31, 0xf9, 0x12, 0xb6, 0x17, 0x73, 0xe6, 0x9f, 0xe1, 0xd8, 0x29,
-	0x1b, 0xc7, 0x9b, 0xc6, 0x71, 0xcf, 0x52, 0x9e, 0xe2, 0xd8, 0xdb, 0xcc, 0xf9, 0x5e, 0x4e, 0x7f,
-	0x8a, 0x63, 0xb2, 0x0d, 0x2b, 0x27, 0xf1, 0x50, 0xa1, 0x70, 0x96, 0xb3, 0xf3, 0x33, 0xc9, 0x7d,
-	0x0b, 0xcd, 0x8b, 0x38, 0x64, 0xc2, 0x99, 0x44, 0x72, 0x13, 0xca, 0xaf, 0x79, 0x20, 0x9d, 0x42,
-	0xbb, 0x34, 0x93, 0x1a, 0x83, 0xea, 0x30, 0x15, 0x57, 0x74, 0x98, 0x05, 0x52, 0x32, 0x81, 0xd4,
-	0x0c, 0x62, 0x22, 0xb9, 0x03, 0xeb, 0x0c, 0xdf, 0x29, 0x7f, 0x2a, 0x15, 0x45, 0x73, 0x63, 0x43,
-	0xc3, 0x2f, 0xf2, 0x74, 0xb8, 0x2e, 0x34, 0x7b, 0x38, 0xc4, 0x99, 0x92, 0xcc, 0x67, 0xd9, 0x85,
-	0xe6, 0x21, 0xa3, 0xc1, 0xf0, 0xdf, 0x38, 0x1f, 0xc0, 0x46, 0x2f, 0x96, 0x57, 0x90, 0x7e, 0x2d,
-	0x40, 0xfd, 0x40, 0x70, 0xd6, 0x0f, 0x4f, 0x31, 0x4a, 0x87, 0x48, 0x3e, 0x07, 0x90, 0x8a, 0x0a,
-	0xe5, 0xeb, 0x46, 0xb0, 0x6f, 0xa0, 0xd5, 0xc9, 0x9a, 0xa0, 0x93, 0x37, 0x41, 0xe7, 0x65, 0xde,
-	0x25, 0x5e, 0xcd, 0xb0, 0xb5, 0x4c, 0x3e, 0x81, 0x2a, 0xb2, 0x28, 0x33, 0x2c, 0x5e, 0x69, 0x58,
-	0x41, 0x16, 0x19, 0x33, 0x02, 0xe5, 0x50, 0x70, 0x66, 0xcb, 0x6b, 0xfe, 0xbb, 0xbf, 0x17, 0xa0,
-	0xf9, 0x02, 0x45, 0xcc, 0xa3, 0x38, 0xfc, 0x0f, 0x5d, 0xbb, 0x0b, 0xeb, 0x31, 0x53, 0x28, 0xde,
-	0xe8, 0xa2, 0x62, 0xc8, 0x59, 0x64, 0xbc, 0x2c, 0x79, 0x6b, 0x39, 0xdc, 0x37, 0xa8, 0x4e, 0x63,
-	0xe5, 0xa5, 0x88, 0x75, 0x17, 0x92, 0xcf, 0xa0, 0xa1, 0x63, 0xf0, 0xa5, 0xf5, 0xdb, 0x7a, 0xba,
-	0x61, 0x5e, 0xcb, 0x74, 0xae, 0x1f, 0x2f, 0x79, 0xf5, 0x70, 0x3a, 0xf7, 0x3d, 0xd8, 0x48, 0x6c,
-	0xd0, 0x17, 0xd6, 0x99, 0xbb, 0x5b, 0xc6, 0x7a, 0x3e, 0x25, 0x8f, 0x97, 0xbc, 0x66, 0x32, 0x87,
-	0xed, 0xd7, 0xa0, 0xa2, 0x32, 0x57, 0xdc, 0xbf, 0xcb, 0x50, 0x7a, 0xc2, 0x83, 0xf9, 0xaa, 0xeb,
-	0x94, 0x33, 0x6a, 0x53, 0x51, 0xf3, 0xcc, 0x7f, 0xd2, 0x86, 0xd5, 0x08, 0x65, 0x28, 0x62, 0x33,
-	0x44, 0x6c, 0x35, 0xa6, 0x21, 0xf2, 0x29, 0x34, 0x66, 0xc6, 0x98, 0x6d, 0xb4, 0x2c, 0xb0, 0x17,
-	0x56, 0xd3, 0x4f, 0x30, 0xf4, 0xea, 0xc9, 0x94, 0x44, 0x1e, 0xc1, 0xb5, 0xc5, 0x4e, 0x95, 0xce,
-	0xb2, 0x69, 0xa2, 0xed, 0x99, 0x36, 0x9d, 0x74, 0xa6, 0x47, 0x16, 0x9a, 0x55, 0xea, 0x72, 0x48,
-	0x14, 0x6f, 0xe2, 0x10, 0x7d, 0x1a, 0x86, 0x3c, 0x65, 0xca, 0x21, 0xc6, 0xcd, 0x35, 0x0b, 0xef,
-	0x65, 0xa8, 0x26, 0x8e, 0xe8, 0x3b, 0x3f, 0xe4, 0x2c, 0x4c, 0x85, 0x36, 0x1e, 0x3b, 0x2b, 0x59,
-	0xdd, 0x46, 0xf4, 0xdd, 0xc1, 0x05, 0x4a, 0xee, 0x4c, 0x72, 0xe5, 0x54, 0x4c, 0x30, 0x75, 0xe3,
-	0x8e, 0x2d, 0xa5, 0x97, 0x2b, 0xc9, 0x6d, 0x28, 0x8f, 0x78, 0x84, 0x4e, 0xb5, 0x5d, 0xd8, 0x5d,
-	0x7b, 0xd0, 0xc8, 0x1b, 0xbf, 0x73, 0xc4, 0x23, 0xf4, 0x8c, 0x4a, 0xbf, 0xce, 0xd0, 0x4c, 0xd2,
-	0xc8, 0xa7, 0xca, 0xa9, 0x5d, 0xfd, 0x3a, 0x2d, 0x7b, 0x4f, 0x69, 0xd3, 0x34, 0x89, 0x72, 0x53,
-	0xb8, 0xda, 0xd4, 0xb2, 0xf7, 0x94, 0x9e, 0x5e, 0x52, 0x51, 0x95, 0x4a, 0x67, 0xd5, 0x4e, 0x47,
-	0x23, 0x91, 0x4d, 0x58, 0x36, 0x63, 0xde, 0xa9, 0x1b, 0x38, 0x13, 0x88, 0x03, 0x15, 0x34, 0x63,
-	0x23, 0x72, 0x9a, 0xed, 0xc2, 0x6e, 0xd5, 0xcb, 0x45, 0x3d, 0xbb, 0x18, 0xf7, 0x43, 0xaa, 0xc2,
-	0xd3, 0x34, 0x71, 0x36, 0x8c, 0xb2, 0xc6, 0xf8, 0x41, 0x06, 0xb8, 0x0f, 0xa1, 0xac, 0x43, 0x25,
-	0x4d, 0xa8, 0x7f, 0x7d, 0xfc, 0xf4, 0xf8, 0xf9, 0x37, 0xc7, 0xfe, 0xd1, 0xf3, 0xde, 0x61, 0x73,
-	0x89, 0xac, 0x42, 0xe5, 0xf0, 0x78, 0x6f, 0xff, 0xd9, 0x61, 0xaf, 0x59, 0x20, 0x75, 0xa8, 0xf6,
-	0xbe, 0xea, 0x67, 0x52, 0xf1, 0xc1, 0x6f, 0x65, 0x80, 0x27, 0x3c, 0xe8, 0x67, 0xb5, 0x21, 0x47,
-	0x50, 0x9b, 0xac, 0x1a, 0xb2, 0x65, 0xbb, 0x61, 0x76, 0xf5, 0xb4, 0x26, 0x23, 0xd5, 0xdd, 0xf9,
-	0xf1, 0xcf, 0xbf, 0x7e, 0x29, 0xfe, 0xcf, 0x25, 0x7a, 0x65, 0xc9, 0xee, 0x9b, 0xfb, 0x01, 0x2a,
-	0x7a, 0x5f, 0x2f, 0x77, 0xf9, 0x85, 0xde, 0x44, 0xe4, 0x11, 0xac, 0x64, 0x9b, 0x88, 0x10, 0x63,
-	0x34, 0xb3, 0x96, 0x16, 0x0f, 0x22, 0xd7, 0x17, 0x0f, 0xea, 0x7e, 0x1f, 0x47, 0x3f, 0x90, 0x3e,
-	0x54, 0xf3, 0x41, 0x4f, 0x36, 0x8d, 0xd9, 0xdc, 0xfe, 0x6a, 0x6d, 0xcd, 0xa1, 0xd9, 0x36, 0x70,
-	0x5b, 0xe6, 0xe4, 0x4d, 0xf2, 0x1e, 0x17, 0x49, 0x00, 0xb5, 0xc9, 0x80, 0xb6, 0xc1, 0xce, 0x0f,
-	0xec, 0xd6, 0xf6, 0x42, 0x89, 0x0f, 0xf5, 0xb7, 0x85, 0x7b, 0xc7, 0x9c, 0xdb, 0x76, 0xff, 0x7f,
-	0x89, 0xc7, 0xdd, 0xac, 0x68, 0x04, 0x01, 0x2e, 0x06, 0x3c, 0xc9, 0x1a, 0x69, 0x61, 0xe2, 0x5f,
-	0x7a, 0xcb, 0x5d, 0x73, 0xcb, 0x6d, 0x77, 0xe7, 0xb2, 0x5b, 0xa2, 0xec, 0x28, 0xf2, 0x2d, 0xd4,
-	0x26, 0xfb, 0xc8, 0x86, 0x32, 0xbf, 0x9f, 0x2e, 0xbd, 0xc4, 0x26, 0xff, 0xa3, 0xcb, 0x92, 0xbf,
-	0xff, 0x53, 0xe1, 0xe7, 0xbd, 0x23, 0xef, 0x26, 0x54, 0x22, 0x3c, 0xa1, 0xe9, 0x50, 0x91, 0x0d,
-	0xb2, 0x0e, 0x8d, 0xd6, 0xaa, 0xb9, 0xa6, 0x6f, 0xde, 0xf2, 0xab, 0x1d, 0xb8, 0x05, 0x2b, 0xfb,
-	0x48, 0x05, 0x0a, 0x72, 0xad, 0x5a, 0x6c, 0x35, 0x68, 0xaa, 0x4e, 0xb9, 0x88, 0xcf, 0xcd, 0x27,
-	0x4e, 0xbb, 0x18, 0xd4, 0x01, 0x26, 0x84, 0xa5, 0x57, 0x0f, 0x07, 0xb1, 0x3a, 0x4d, 0x83, 0x4e,
-	0xc8, 0x47, 0xdd, 0xb3, 0x34, 0xc0, 0x93, 0x21, 0x7f, 0x3b, 0xf9, 0xfe, 0x92, 0xdd, 0xe9, 0x2f,
-	0xa1, 0x01, 0xf7, 0xc3, 0x61, 0x8c, 0x4c, 0x05, 0x2b, 0xc6, 0xf1, 0x87, 0xff, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0xfb, 0x9f, 0x07, 0x6b, 0x4a, 0x0a, 0x00, 0x00,
+func init() { proto.RegisterFile("backend/api/job.proto", fileDescriptor_job_3a1229752f4b8aa7) }
+
+var fileDescriptor_job_3a1229752f4b8aa7 = []byte{
+	// 1953 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x38, 0x4b, 0x6f, 0xdb, 0xd8,
+	0xd5, 0x91, 0x64, 0x5b, 0xd2, 0xb1, 0x64, 0xd3, 0xd7, 0x2f, 0x7e, 0x4a, 0x32, 0x76, 0x98, 0xf9,
+	0xe2, 0x24, 0x9d, 0x48, 0x98, 0x04, 0x2d, 0x32, 0x2e, 0xd0, 0x81, 0x1f, 0x9a, 0xc4, 0x89, 0x5f,
+	0xa0, 0x1c, 0x0c, 0x90, 0x2e, 0x08, 0x3e, 0x8e, 0x1d, 0xda, 0x12, 0x2f, 0x4b, 0x5e, 0xda, 0x56,
+	0x82, 0x76, 0x51, 0xa0, 0xbb, 0xae, 0x5a, 0xa0, 0xe8, 0xae, 0x9b, 0x6e, 0xba, 0xe8, 0xa2, 0x7f,
+	0xa2, 0xfb, 0xa2, 0x7f, 0xa1, 0x3f, 0xa4, 0xb8, 0x0f, 0xd2, 0x94, 0x64, 0xd9, 0xd3, 0x29, 0xd0,
+	0x59, 0x49, 0xe7, 0xdc, 0xf3, 0xba, 0xe7, 0x9c, 0x7b, 0x1e, 0x84, 0x45, 0xc7, 0x76, 0xcf, 0x30,
+	0xf0, 0x5a, 0x76, 0xe8, 0xb7, 0x4e, 0xa9, 0xd3, 0x0c, 0x23, 0xca, 0x28, 0x29, 0xd9, 0xa1, 0xdf,
+	0xb8, 0x77, 0x42, 0xe9, 0x49, 0x17, 0xc5, 0x91, 0x1d, 0x04, 0x94, 0xd9, 0xcc, 0xa7, 0x41, 0x2c,
+	0x49, 0x1a, 0x2b, 0xea, 0x54, 0x40, 0x4e, 0x72, 0xdc, 0x62, 0x7e, 0x0f, 0x63, 0x66, 0xf7, 0x42,
+	0x45, 0x70, 0x77, 0x98, 0x00, 0x7b, 0x21, 0xeb, 0xa7, 0x87, 0x79, 0xbd, 0xa1, 0x1d, 0xd9, 0x3d,
+	0x64, 0x18, 0xa5, 0xa2, 0x07, 0x0e, 0xfd, 0x10, 0xbb, 0x7e, 0x80, 0x56, 0x1c, 0xa2, 0xab, 0x08,
+	0x3e, 0xcf, 0x13, 0x44, 0x18, 0xd3, 0x24, 0x72, 0xd1, 0x8a, 0xf0, 0x18, 0x23, 0x0c, 0x5c, 0x54,
+	0x54, 0x03, 0x77, 0x8b, 0x92, 0x40, 0xa1, 0xbf, 0x10, 0x3f, 0xee, 0xb3, 0x13, 0x0c, 0x9e, 0xc5,
+	0x17, 0xf6, 0xc9, 0x09, 0x46, 0x2d, 0x1a, 0x8a, 0xab, 0x5d, 0x73, 0xcd, 0xe5, 0xbc, 0x10, 0x8c,
+	0x22, 0xaa, 0x8c, 0x34, 0x9a, 0xa0, 0x6d, 0x45, 0x68, 0x33, 0x7c, 0x43, 0x1d, 0x13, 0x7f, 0x91,
+	0x60, 0xcc, 0x48, 0x03, 0x4a, 0xa7, 0xd4, 0xd1, 0x0b, 0xab, 0x85, 0xc7, 0xd3, 0xcf, 0x2b, 0x4d,
+	0x3b, 0xf4, 0x9b, 0xfc, 0x94, 0x23, 0x8d, 0x15, 0xa8, 0xbf, 0x42, 0x96, 0x23, 0x9e, 0x81, 0xa2,
+	0xef, 0x09, 0xda, 0xaa, 0x59, 0xf4, 0x3d, 0xe3, 0xef, 0x05, 0x98, 0xdd, 0xf5, 0x63, 0x4e, 0x12,
+	0xa7, 0x34, 0xf7, 0x01, 0x42, 0xfb, 0x04, 0x2d, 0x46, 0xcf, 0x30, 0x50, 0xb4, 0x55, 0x8e, 0x39,
+	0xe2, 0x08, 0x72, 0x17, 0x04, 0x60, 0xc5, 0xfe, 0x47, 0xd4, 0x8b, 0xab, 0x85, 0xc7, 0x93, 0x66,
+	0x85, 0x23, 0x3a, 0xfe, 0x47, 0x24, 0xcb, 0x50, 0x8e, 0x69, 0xc4, 0x2c, 0xa7, 0xaf, 0x97, 0x04,
+	0xe3, 0x14, 0x07, 0x37, 0xfb, 0xe4, 0x1b, 0x58, 0x1a, 0xf5, 0x99, 0x75, 0x86, 0x7d, 0x7d, 0x42,
+	0x18, 0xae, 0x09, 0xc3, 0x4d, 0x45, 0xf2, 0x16, 0xfb, 0xe6, 0x42, 0x4a, 0x6f, 0xa6, 0xe4, 0x6f,
+	0xb1, 0x4f, 0x96, 0x60, 0xea, 0xd8, 0xef, 0x32, 0x8c, 0xf4, 0x49, 0x29, 0x5f, 0x42, 0xc6, 0x05,
+	0x68, 0x57, 0xf7, 0x88, 0x43, 0x1a, 0xc4, 0x48, 0xee, 0xc1, 0xc4, 0x29, 0x75, 0x62, 0xbd, 0xb0,
+	0x5a, 0x1a, 0x70, 0x8d, 0xc0, 0xf2, 0x6b, 0x32, 0xca, 0xec, 0xae, 0xbc, 0x48, 0x49, 0x5c, 0xa4,
+	0x2a, 0x30, 0xe2, 0x26, 0x8f, 0x60, 0x36, 0xc0, 0x4b, 0x66, 0xe5, 0x5c, 0x51, 0x14, 0x1a, 0xeb,
+	0x1c, 0x7d, 0x98, 0xba, 0xc3, 0x30, 0x40, 0xdb, 0xc6, 0x2e, 0x32, 0xbc, 0xc1, 0xcb, 0x06, 0x68,
+	0xed, 0xc0, 0x76, 0xba, 0x37, 0xd1, 0x3c, 0x84, 0xb9, 0x6d, 0x3f, 0xbe, 0x85, 0xe8, 0x67, 0xa0,
+	0xbd, 0x0b, 0x3d, 0xfb, 0x26, 0x65, 0x69, 0x3e, 0x14, 0xaf, 0xcb, 0x87, 0x3f, 0x17, 0x60, 0x29,
+	0x13, 0xb0, 0x6b, 0x3b, 0xd8, 0x8d, 0xc7, 0x89, 0xf9, 0x1a, 0xa6, 0xba, 0x82, 0x40, 0x2f, 0x0a,
+	0xf7, 0xad, 0x09, 0x49, 0xd7, 0x33, 0x37, 0x25, 0xd4, 0x0e, 0x58, 0xd4, 0x37, 0x15, 0x5b, 0xe3,
+	0x2b, 0x98, 0xce, 0xa1, 0x89, 0x06, 0x25, 0x1e, 0x6d, 0xa9, 0x80, 0xff, 0x25, 0x0b, 0x30, 0x79,
+	0x6e, 0x77, 0x13, 0x54, 0x7e, 0x95, 0xc0, 0x7a, 0xf1, 0x65, 0x81, 0xfb, 0xe2, 0x28, 0xf2, 0xf9,
+	0x23, 0xb9, 0xc1, 0x17, 0x09, 0x2c, 0x1d, 0x46, 0x78, 0xee, 0xe3, 0x45, 0xc7, 0xfd, 0x80, 0x5e,
+	0xd2, 0xc5, 0x94, 0xf2, 0x11, 0x94, 0x99, 0x64, 0x57, 0xaf, 0xa2, 0x26, 0x6c, 0x57, 0x22, 0xcd,
+	0xf4, 0x90, 0x67, 0x40, 0x40, 0x2d, 0xd7, 0x66, 0xee, 0x87, 0x24, 0x14, 0x56, 0x54, 0xcc, 0x6a,
+	0x40, 0xb7, 0x24, 0x82, 0xdb, 0xe7, 0xd2, 0x24, 0x60, 0x2a, 0x37, 0x24, 0x60, 0xbc, 0x87, 0xe5,
+	0x11, 0xb5, 0x2a, 0xdf, 0xbe, 0x86, 0xba, 0x12, 0x6d, 0x89, 0xba, 0xa4, 0x12, 0xaf, 0xd1, 0x94,
+	0x45, 0xa9, 0x99, 0x16, 0xa5, 0xe6, 0x51, 0x5a, 0xb5, 0xcc, 0x9a, 0x62, 0x10, 0x18, 0xe3, 0x0f,
+	0x05, 0x20, 0x9b, 0xb6, 0x7b, 0x76, 0xec, 0x77, 0xbb, 0x37, 0x44, 0xf8, 0x2b, 0x80, 0x98, 0xd9,
+	0x11, 0x13, 0x5a, 0x54, 0xa0, 0x6f, 0x52, 0x52, 0x15, 0xd4, 0x1c, 0x26, 0x3f, 0x86, 0x0a, 0x06,
+	0x9e, 0x64, 0x2c, 0xdd, 0xca, 0x58, 0xc6, 0xc0, 0xe3, 0x90, 0xf1, 0x14, 0xf4, 0x2d, 0x3b, 0x70,
+	0x91, 0x5b, 0x95, 0x1a, 0x38, 0x2e, 0x2e, 0xbf, 0x2d, 0x41, 0x25, 0xa5, 0xf9, 0xe1, 0x4d, 0x27,
+	0x4f, 0x60, 0x32, 0x66, 0x36, 0x43, 0x51, 0x67, 0x66, 0x9e, 0xcf, 0x8b, 0x54, 0x48, 0xed, 0x6b,
+	0x76, 0xf8, 0x91, 0x29, 0x29, 0xae, 0x2a, 0x42, 0x94, 0x04, 0xb1, 0xa8, 0x2f, 0x25, 0x55, 0x11,
+	0xcc, 0x24, 0x88, 0xc9, 0x03, 0xa8, 0xb9, 0xa2, 0xf8, 0x7a, 0x92, 0x60, 0x4a, 0x10, 0x4c, 0x2b,
+	0x9c, 0x20, 0x79, 0x03, 0xf3, 0xa2, 0x68, 0xc4, 0x2a, 0x35, 0x94, 0xb9, 0xe5, 0x5b, 0xcd, 0x9d,
+	0xe3, 0x6c, 0x69, 0x42, 0x49, 0x9f, 0xb7, 0x61, 0x52, 0x58, 0x47, 0xe6, 0xa0, 0xfe, 0x6e, 0xff,
+	0xed, 0xfe, 0xc1, 0xb7, 0xfb, 0x56, 0xe7, 0x68, 0xe3, 0xa8, 0xad, 0xdd, 0x21, 0xd3, 0x50, 0x36,
+	0xdf, 0xed, 0xef, 0xef, 0xec, 0xbf, 0xd2, 0x0a, 0xa4, 0x0e, 0xd5, 0xad, 0x83, 0xbd, 0xc3, 0xdd,
+	0xf6, 0x51, 0x7b, 0x5b, 0x2b, 0x0a, 0x70, 0x63, 0x7f, 0xab, 0xbd, 0xbb, 0xdb, 0xde, 0xd6, 0x4a,
+	0xc6, 0xdf, 0x0a, 0x50, 0xdb, 0x8a, 0x68, 0x90, 0x0a, 0x1f, 0x0a, 0x41, 0xe1, 0xfb, 0x86, 0xa0,
+	0xf8, 0xdd, 0x43, 0x40, 0x60, 0xc2, 0x8d, 0x68, 0xa0, 0x3a, 0x82, 0xf8, 0xcf, 0xbb, 0x08, 0x17,
+	0x63, 0x7d, 0xa4, 0x81, 0x0c, 0x4d, 0xd5, 0xac, 0x70, 0xc4, 0x7b, 0x1a, 0xa0, 0xf1, 0xd7, 0x02,
+	0x68, 0x87, 0x18, 0xf9, 0xd4, 0xf3, 0xdd, 0x1f, 0xd0, 0xee, 0x35, 0x98, 0xf5, 0x03, 0x86, 0xd1,
+	0x39, 0x6f, 0x12, 0xe8, 0xd2, 0xc0, 0x13, 0x57, 0x28, 0x99, 0x33, 0x29, 0xba, 0x23, 0xb0, 0xc6,
+	0x09, 0x68, 0x07, 0xce, 0x29, 0xba, 0xac, 0xc3, 0x68, 0x84, 0xed, 0x73, 0x0c, 0x18, 0x6f, 0x54,
+	0x4e, 0xe2, 0x9e, 0x21, 0x53, 0xd9, 0xaf, 0x20, 0x8e, 0x0f, 0x23, 0x3c, 0xf6, 0x2f, 0x55, 0xd9,
+	0x53, 0x10, 0x79, 0x08, 0xf5, 0x33, 0xec, 0x5b, 0xd9, 0x58, 0xa2, 0xbc, 0x55, 0x3b, 0xc3, 0xfe,
+	0x61, 0x8a, 0x33, 0xfe, 0x52, 0x84, 0xba, 0x99, 0x04, 0x5b, 0xb4, 0x17, 0x76, 0x91, 0xf9, 0x34,
+	0x20, 0x8b, 0x30, 0x75, 0x4a, 0x1d, 0x2b, 0x7b, 0x64, 0x93, 0xa7, 0xd4, 0xd9, 0xf1, 0xb8, 0x34,
+	0xbc, 0x0c, 0x31, 0xf2, 0x7b, 0x18, 0x30, 0x7e, 0x2a, 0x95, 0xd5, 0xae, 0x90, 0x3b, 0x1e, 0x37,
+	0x45, 0x24, 0x7e, 0xac, 0x97, 0x56, 0x4b, 0xa2, 0x57, 0x0b, 0x88, 0x3c, 0x06, 0x2d, 0x4a, 0x02,
+	0xcb, 0xf7, 0x72, 0xd6, 0xc8, 0x10, 0xcd, 0x44, 0x49, 0xb0, 0xe3, 0x65, 0xf6, 0x90, 0x77, 0x30,
+	0x47, 0x13, 0x16, 0x26, 0xec, 0x8a, 0x92, 0x3f, 0x1c, 0x5e, 0xf5, 0x1e, 0xcb, 0x86, 0x9e, 0x37,
+	0xb6, 0x79, 0x20, 0x68, 0x33, 0x76, 0xd5, 0x30, 0x34, 0x3a, 0x84, 0x6e, 0x6c, 0xc1, 0xe2, 0xb5,
+	0xa4, 0xff, 0x51, 0x13, 0xf9, 0x63, 0x11, 0xca, 0xaa, 0xe4, 0x93, 0x97, 0x50, 0xe7, 0x59, 0x97,
+	0xbd, 0x4b, 0x95, 0x3e, 0x73, 0xc2, 0xc6, 0xfc, 0xeb, 0x78, 0x7d, 0xc7, 0xac, 0xb9, 0x39, 0x98,
+	0x6c, 0xc3, 0x5c, 0xa8, 0x32, 0xf1, 0x8a, 0x5b, 0xe6, 0xd0, 0xa2, 0xe0, 0x1e, 0xce, 0xd3, 0xd7,
+	0x77, 0x4c, 0x2d, 0x1c, 0xc2, 0x91, 0x36, 0x10, 0x2a, 0x12, 0xc4, 0x8a, 0x79, 0x86, 0x58, 0xc8,
+	0x53, 0x44, 0x2f, 0xe5, 0xc4, 0x0c, 0xe7, 0x0f, 0x17, 0x43, 0x87, 0x70, 0xe4, 0xa7, 0xc0, 0x03,
+	0x60, 0xb9, 0x99, 0x47, 0xd5, 0xf0, 0x44, 0x46, 0x7d, 0xfd, 0xfa, 0x8e, 0x59, 0x8f, 0xf2, 0x88,
+	0xcd, 0x6a, 0xd6, 0x15, 0x8d, 0x7f, 0x94, 0xa1, 0xf4, 0x86, 0x3a, 0x23, 0xd5, 0x99, 0xc0, 0x44,
+	0x60, 0xf7, 0x52, 0x5f, 0x8a, 0xff, 0x64, 0x15, 0xa6, 0x3d, 0x8c, 0xdd, 0xc8, 0x17, 0xd3, 0xaa,
+	0xca, 0xca, 0x3c, 0x8a, 0xfc, 0x04, 0xea, 0x03, 0xf3, 0xb2, 0x3e, 0x91, 0x73, 0xee, 0xa1, 0x3a,
+	0xe9, 0x84, 0xe8, 0x9a, 0xb5, 0x30, 0x07, 0x91, 0x57, 0x30, 0x3f, 0x3a, 0x12, 0xa6, 0xe9, 0xb3,
+	0x34, 0x30, 0x0f, 0x66, 0x23, 0xa0, 0x49, 0x46, 0xa6, 0xc2, 0x98, 0xbf, 0xd3, 0x18, 0xa3, 0x73,
+	0xdf, 0x45, 0xcb, 0x76, 0x65, 0xcb, 0x26, 0x32, 0x5d, 0x15, 0x7a, 0x43, 0x62, 0x39, 0x61, 0xcf,
+	0xbe, 0xb4, 0x5c, 0x1a, 0xb8, 0x49, 0xc4, 0x99, 0xfb, 0xaa, 0x88, 0xcf, 0xf4, 0xec, 0xcb, 0xad,
+	0x2b, 0x6c, 0x7e, 0x82, 0x28, 0xdf, 0x34, 0x41, 0x3c, 0x80, 0x89, 0x1e, 0xf5, 0x50, 0xaf, 0x88,
+	0xde, 0x52, 0x4f, 0x87, 0xad, 0xe6, 0x1e, 0xf5, 0xd0, 0x14, 0x47, 0xbc, 0x6c, 0xa5, 0x5d, 0xc3,
+	0x66, 0x7a, 0xf5, 0xf6, 0xb2, 0xa5, 0xa8, 0x37, 0x18, 0x67, 0x4d, 0x42, 0x2f, 0x65, 0x85, 0xdb,
+	0x59, 0x15, 0xf5, 0x06, 0x4b, 0x9f, 0x76, 0x12, 0xeb, 0xd3, 0x6a, 0x0c, 0x17, 0x10, 0x7f, 0x2e,
+	0x62, 0x9f, 0xd0, 0x6b, 0xf2, 0xb9, 0x08, 0x80, 0xe8, 0x50, 0x46, 0x31, 0x9f, 0x7a, 0xba, 0x26,
+	0xa6, 0xa0, 0x14, 0x1c, 0x1a, 0x91, 0xe6, 0x86, 0x47, 0xa4, 0x2f, 0xb2, 0x21, 0x71, 0x5e, 0x44,
+	0x6d, 0x21, 0xf3, 0xc0, 0x35, 0x13, 0x61, 0xd6, 0x1d, 0x95, 0xf7, 0xd2, 0xee, 0xb8, 0xf0, 0xdd,
+	0xba, 0xe3, 0x51, 0xca, 0xa5, 0xda, 0x7a, 0xc5, 0x51, 0x4d, 0x5c, 0x5f, 0x14, 0x02, 0xea, 0x03,
+	0x9d, 0xdd, 0xcc, 0x8e, 0xc9, 0x4b, 0xd0, 0x55, 0xd4, 0x63, 0x74, 0x13, 0xe6, 0x9f, 0xa3, 0x75,
+	0x6c, 0xfb, 0xdd, 0x24, 0xc2, 0x58, 0x5f, 0x12, 0xe1, 0x5f, 0x92, 0xe1, 0x4f, 0x8f, 0xbf, 0x51,
+	0xa7, 0x64, 0x1d, 0x34, 0xa7, 0x6b, 0xbb, 0x67, 0x34, 0x61, 0xd6, 0x85, 0x1f, 0x78, 0xf4, 0x22,
+	0xd6, 0x97, 0xc5, 0x45, 0x67, 0x65, 0x3e, 0xf8, 0x3d, 0xfc, 0x56, 0xe0, 0xcd, 0xd9, 0x94, 0x50,
+	0xc2, 0xff, 0xd5, 0xf8, 0xfb, 0x02, 0x26, 0x78, 0x02, 0x11, 0x0d, 0x6a, 0x69, 0xe3, 0xdf, 0x3b,
+	0xd8, 0x56, 0x7d, 0xbf, 0xbd, 0xbf, 0xb1, 0xc9, 0x3b, 0x7b, 0x81, 0xd4, 0xa0, 0xb2, 0xbd, 0xd3,
+	0x91, 0x50, 0xd1, 0xf8, 0x15, 0xc0, 0x95, 0x39, 0xff, 0xfb, 0x66, 0xf9, 0xfc, 0x4f, 0x55, 0x80,
+	0x37, 0xd4, 0xe9, 0xc8, 0x17, 0x47, 0xf6, 0xa0, 0x9a, 0x6d, 0xaa, 0x64, 0x51, 0xd5, 0xd9, 0xc1,
+	0xcd, 0xb5, 0x91, 0x2d, 0x27, 0xc6, 0xca, 0xaf, 0xff, 0xf9, 0xaf, 0xdf, 0x17, 0xff, 0xcf, 0x20,
+	0x7c, 0xe3, 0x8d, 0x5b, 0xe7, 0x5f, 0x3a, 0xc8, 0xec, 0x2f, 0xf9, 0xb7, 0x81, 0x78, 0x9d, 0x2f,
+	0x2e, 0xe4, 0x15, 0x4c, 0xc9, 0x45, 0x96, 0xc8, 0x5a, 0x37, 0xb0, 0xd5, 0x8e, 0x0a, 0x22, 0xcb,
+	0xa3, 0x82, 0x5a, 0x9f, 0x7c, 0xef, 0x97, 0xa4, 0x03, 0x95, 0x74, 0x4f, 0x24, 0x32, 0x5b, 0x87,
+	0xd6, 0xdf, 0xc6, 0xe2, 0x10, 0x56, 0x0e, 0xf7, 0x46, 0x43, 0x48, 0x5e, 0x20, 0xd7, 0x98, 0x48,
+	0x1c, 0xa8, 0x66, 0xfb, 0x9d, 0xba, 0xec, 0xf0, 0xbe, 0xd7, 0x58, 0x1a, 0x71, 0x62, 0x9b, 0x7f,
+	0x9a, 0x30, 0x1e, 0x09, 0xb9, 0xab, 0xc6, 0x67, 0x63, 0x2c, 0x6e, 0xc9, 0xa7, 0x48, 0x10, 0xe0,
+	0x6a, 0x3f, 0x24, 0xb2, 0x3c, 0x8e, 0x2c, 0x8c, 0x63, 0xb5, 0xac, 0x09, 0x2d, 0x0f, 0x8c, 0x95,
+	0x71, 0x5a, 0x3c, 0x29, 0x8a, 0xfc, 0x1c, 0xaa, 0xd9, 0x3a, 0xab, 0xae, 0x32, 0xbc, 0xde, 0x8e,
+	0x55, 0xa2, 0x9c, 0xff, 0x74, 0xac, 0xf3, 0x4d, 0xa8, 0x66, 0x0b, 0xa4, 0x12, 0x3e, 0xbc, 0xce,
+	0xe6, 0x62, 0xf9, 0xb9, 0x10, 0xf7, 0x59, 0x63, 0x9c, 0x38, 0x99, 0x19, 0x2e, 0xcc, 0x0e, 0x2d,
+	0xa5, 0xe4, 0xee, 0x0d, 0xab, 0x6a, 0x4e, 0xfe, 0x13, 0x21, 0xff, 0x61, 0x63, 0xac, 0xe7, 0x65,
+	0xd9, 0x5a, 0x2f, 0x3c, 0xe5, 0xce, 0xbf, 0x5a, 0x48, 0x95, 0xf3, 0x47, 0x36, 0xd4, 0xef, 0xef,
+	0xfc, 0xb4, 0x9d, 0x38, 0x30, 0x9d, 0x5b, 0xff, 0xc8, 0xf2, 0x40, 0x45, 0xcb, 0x29, 0x1a, 0x2c,
+	0x75, 0xc6, 0x8f, 0x84, 0xfc, 0xff, 0x37, 0x56, 0xc7, 0xc9, 0x4f, 0x4b, 0x21, 0xbf, 0xca, 0x27,
+	0x98, 0x1b, 0x59, 0xe5, 0xc8, 0x7d, 0xf9, 0x40, 0xc7, 0xac, 0x78, 0x63, 0x2f, 0xd6, 0x12, 0x8a,
+	0x9f, 0x18, 0x6b, 0xb7, 0x2a, 0x76, 0x85, 0x68, 0xf2, 0x09, 0x66, 0x87, 0x96, 0x67, 0x15, 0xac,
+	0xeb, 0x37, 0xf9, 0xc6, 0xbd, 0xeb, 0x0f, 0xd5, 0x93, 0x7c, 0x26, 0xd4, 0xaf, 0x19, 0xc6, 0x35,
+	0x55, 0x23, 0x1c, 0xe4, 0x59, 0x2f, 0x3c, 0xdd, 0xfc, 0x4d, 0xe1, 0x77, 0x1b, 0x7b, 0xe6, 0x3d,
+	0x28, 0x7b, 0x78, 0x6c, 0x27, 0x5d, 0x46, 0xe6, 0xc8, 0x2c, 0xd4, 0x1b, 0xd3, 0x42, 0x47, 0x47,
+	0xf4, 0xc7, 0xf7, 0x2b, 0x70, 0x1f, 0xa6, 0x36, 0xd1, 0x8e, 0x30, 0x22, 0xf3, 0x95, 0x62, 0xa3,
+	0x6e, 0x27, 0xec, 0x03, 0x8d, 0xfc, 0x8f, 0xe2, 0xfb, 0xdc, 0x6a, 0xd1, 0xa9, 0x01, 0x64, 0x04,
+	0x77, 0xde, 0xbf, 0x38, 0xf1, 0xd9, 0x87, 0xc4, 0x69, 0xba, 0xb4, 0xd7, 0x3a, 0x4b, 0x1c, 0x3c,
+	0xee, 0xd2, 0x8b, 0xec, 0xe3, 0x61, 0xdc, 0xca, 0x7f, 0xc6, 0x3b, 0xa1, 0x96, 0xdb, 0xf5, 0x31,
+	0x60, 0xce, 0x94, 0xf0, 0xe2, 0x8b, 0x7f, 0x0f, 0x00, 0x11, 0x50, 0xa5, 0x17, 0x07, 0x15, 0x00,
+	0x00,
 }
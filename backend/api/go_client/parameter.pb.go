@@ -20,6 +20,7 @@ package go_client // import "github.com/kubeflow/pipelines/backend/api/go_client
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import wrappers "github.com/golang/protobuf/ptypes/wrappers"
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -32,6 +33,44 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type ParameterSpec_Type int32
+
+const (
+	ParameterSpec_STRING       ParameterSpec_Type = 0
+	ParameterSpec_INT          ParameterSpec_Type = 1
+	ParameterSpec_FLOAT        ParameterSpec_Type = 2
+	ParameterSpec_BOOL         ParameterSpec_Type = 3
+	ParameterSpec_ENUM         ParameterSpec_Type = 4
+	ParameterSpec_JSON         ParameterSpec_Type = 5
+	ParameterSpec_ARTIFACT_URI ParameterSpec_Type = 6
+)
+
+var ParameterSpec_Type_name = map[int32]string{
+	0: "STRING",
+	1: "INT",
+	2: "FLOAT",
+	3: "BOOL",
+	4: "ENUM",
+	5: "JSON",
+	6: "ARTIFACT_URI",
+}
+var ParameterSpec_Type_value = map[string]int32{
+	"STRING":       0,
+	"INT":          1,
+	"FLOAT":        2,
+	"BOOL":         3,
+	"ENUM":         4,
+	"JSON":         5,
+	"ARTIFACT_URI": 6,
+}
+
+func (x ParameterSpec_Type) String() string {
+	return proto.EnumName(ParameterSpec_Type_name, int32(x))
+}
+func (ParameterSpec_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_parameter_2817e1cb9e9394d6, []int{1, 0}
+}
+
 type Parameter struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -44,7 +83,7 @@ func (m *Parameter) Reset()         { *m = Parameter{} }
 func (m *Parameter) String() string { return proto.CompactTextString(m) }
 func (*Parameter) ProtoMessage()    {}
 func (*Parameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_parameter_096b3b61dd8773be, []int{0}
+	return fileDescriptor_parameter_2817e1cb9e9394d6, []int{0}
 }
 func (m *Parameter) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Parameter.Unmarshal(m, b)
@@ -78,24 +117,144 @@ func (m *Parameter) GetValue() string {
 	return ""
 }
 
+type ParameterSpec struct {
+	Name                 string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 ParameterSpec_Type    `protobuf:"varint,2,opt,name=type,proto3,enum=api.ParameterSpec_Type" json:"type,omitempty"`
+	Description          string                `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Required             bool                  `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	DefaultValue         *wrappers.StringValue `protobuf:"bytes,5,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Min                  *wrappers.DoubleValue `protobuf:"bytes,6,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  *wrappers.DoubleValue `protobuf:"bytes,7,opt,name=max,proto3" json:"max,omitempty"`
+	Pattern              string                `protobuf:"bytes,8,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	EnumValues           []string              `protobuf:"bytes,9,rep,name=enum_values,json=enumValues,proto3" json:"enum_values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ParameterSpec) Reset()         { *m = ParameterSpec{} }
+func (m *ParameterSpec) String() string { return proto.CompactTextString(m) }
+func (*ParameterSpec) ProtoMessage()    {}
+func (*ParameterSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_parameter_2817e1cb9e9394d6, []int{1}
+}
+func (m *ParameterSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParameterSpec.Unmarshal(m, b)
+}
+func (m *ParameterSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParameterSpec.Marshal(b, m, deterministic)
+}
+func (dst *ParameterSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParameterSpec.Merge(dst, src)
+}
+func (m *ParameterSpec) XXX_Size() int {
+	return xxx_messageInfo_ParameterSpec.Size(m)
+}
+func (m *ParameterSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParameterSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParameterSpec proto.InternalMessageInfo
+
+func (m *ParameterSpec) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ParameterSpec) GetType() ParameterSpec_Type {
+	if m != nil {
+		return m.Type
+	}
+	return ParameterSpec_STRING
+}
+
+func (m *ParameterSpec) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ParameterSpec) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
+func (m *ParameterSpec) GetDefaultValue() *wrappers.StringValue {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return nil
+}
+
+func (m *ParameterSpec) GetMin() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Min
+	}
+	return nil
+}
+
+func (m *ParameterSpec) GetMax() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Max
+	}
+	return nil
+}
+
+func (m *ParameterSpec) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *ParameterSpec) GetEnumValues() []string {
+	if m != nil {
+		return m.EnumValues
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Parameter)(nil), "api.Parameter")
+	proto.RegisterType((*ParameterSpec)(nil), "api.ParameterSpec")
+	proto.RegisterEnum("api.ParameterSpec_Type", ParameterSpec_Type_name, ParameterSpec_Type_value)
 }
 
 func init() {
-	proto.RegisterFile("backend/api/parameter.proto", fileDescriptor_parameter_096b3b61dd8773be)
-}
-
-var fileDescriptor_parameter_096b3b61dd8773be = []byte{
-	// 147 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0x4a, 0x4c, 0xce,
-	0x4e, 0xcd, 0x4b, 0xd1, 0x4f, 0x2c, 0xc8, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x4d, 0x2d, 0x49,
-	0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x4e, 0x2c, 0xc8, 0x54, 0x32, 0xe5, 0xe2,
-	0x0c, 0x80, 0x89, 0x0b, 0x09, 0x71, 0xb1, 0xe4, 0x25, 0xe6, 0xa6, 0x4a, 0x30, 0x2a, 0x30, 0x6a,
-	0x70, 0x06, 0x81, 0xd9, 0x42, 0x22, 0x5c, 0xac, 0x65, 0x89, 0x39, 0xa5, 0xa9, 0x12, 0x4c, 0x60,
-	0x41, 0x08, 0xc7, 0xc9, 0x34, 0xca, 0x38, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f,
-	0x57, 0x3f, 0xbb, 0x34, 0x29, 0x35, 0x2d, 0x27, 0xbf, 0x5c, 0xbf, 0x20, 0xb3, 0x20, 0x35, 0x27,
-	0x33, 0x2f, 0xb5, 0x58, 0x1f, 0xd9, 0xe2, 0xf4, 0xfc, 0xf8, 0xe4, 0x9c, 0xcc, 0xd4, 0xbc, 0x92,
-	0x24, 0x36, 0xb0, 0xcd, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x47, 0x98, 0xfd, 0x98,
-	0x00, 0x00, 0x00,
+	proto.RegisterFile("backend/api/parameter.proto", fileDescriptor_parameter_2817e1cb9e9394d6)
+}
+
+var fileDescriptor_parameter_2817e1cb9e9394d6 = []byte{
+	// 412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xdf, 0x6a, 0xd4, 0x40,
+	0x18, 0xc5, 0x4d, 0x93, 0xfd, 0x93, 0x6f, 0x5b, 0x09, 0x83, 0xe0, 0x50, 0x45, 0xc3, 0x5e, 0x2d,
+	0x08, 0x13, 0x68, 0xe9, 0x03, 0x6c, 0xd5, 0xca, 0x4a, 0xdd, 0x95, 0x6c, 0xda, 0x0b, 0x6f, 0x96,
+	0x49, 0xf6, 0xdb, 0x38, 0x34, 0x99, 0x19, 0x27, 0x13, 0xdb, 0xbe, 0x9f, 0x0f, 0x26, 0x99, 0xb8,
+	0xa5, 0x82, 0x42, 0xef, 0xce, 0x39, 0x9c, 0x5f, 0xf8, 0x4e, 0x18, 0x78, 0x95, 0xf3, 0xe2, 0x06,
+	0xe5, 0x36, 0xe1, 0x5a, 0x24, 0x9a, 0x1b, 0x5e, 0xa3, 0x45, 0xc3, 0xb4, 0x51, 0x56, 0x11, 0x9f,
+	0x6b, 0x71, 0xfc, 0xa6, 0x54, 0xaa, 0xac, 0x30, 0x71, 0x51, 0xde, 0xee, 0x92, 0x5b, 0xc3, 0xb5,
+	0x46, 0xd3, 0xf4, 0xa5, 0xe9, 0x19, 0x84, 0x5f, 0xf7, 0x1c, 0x21, 0x10, 0x48, 0x5e, 0x23, 0xf5,
+	0x62, 0x6f, 0x16, 0xa6, 0x4e, 0x93, 0x17, 0x30, 0xf8, 0xc9, 0xab, 0x16, 0xe9, 0x81, 0x0b, 0x7b,
+	0x33, 0xfd, 0xe5, 0xc3, 0xd1, 0x03, 0xb7, 0xd6, 0x58, 0xfc, 0x93, 0x7d, 0x07, 0x81, 0xbd, 0xd7,
+	0x3d, 0xfa, 0xfc, 0xe4, 0x25, 0xe3, 0x5a, 0xb0, 0xbf, 0x28, 0x96, 0xdd, 0x6b, 0x4c, 0x5d, 0x89,
+	0xc4, 0x30, 0xd9, 0x62, 0x53, 0x18, 0xa1, 0xad, 0x50, 0x92, 0xfa, 0xee, 0x3b, 0x8f, 0x23, 0x72,
+	0x0c, 0x63, 0x83, 0x3f, 0x5a, 0x61, 0x70, 0x4b, 0x83, 0xd8, 0x9b, 0x8d, 0xd3, 0x07, 0x4f, 0xe6,
+	0x70, 0xb4, 0xc5, 0x1d, 0x6f, 0x2b, 0xbb, 0xe9, 0xcf, 0x1d, 0xc4, 0xde, 0x6c, 0x72, 0xf2, 0x9a,
+	0xf5, 0xfb, 0xd9, 0x7e, 0x3f, 0x5b, 0x5b, 0x23, 0x64, 0x79, 0xdd, 0x75, 0xd2, 0xc3, 0x3f, 0x88,
+	0x73, 0x84, 0x81, 0x5f, 0x0b, 0x49, 0x87, 0xff, 0x01, 0x3f, 0xa8, 0x36, 0xaf, 0xb0, 0x07, 0xbb,
+	0xa2, 0xeb, 0xf3, 0x3b, 0x3a, 0x7a, 0x52, 0x9f, 0xdf, 0x11, 0x0a, 0x23, 0xcd, 0xad, 0x45, 0x23,
+	0xe9, 0xd8, 0x8d, 0xdb, 0x5b, 0xf2, 0x16, 0x26, 0x28, 0xdb, 0xba, 0xbf, 0xbc, 0xa1, 0x61, 0xec,
+	0xcf, 0xc2, 0x14, 0xba, 0xc8, 0xe1, 0xcd, 0xf4, 0x1a, 0x82, 0xee, 0x4f, 0x11, 0x80, 0xe1, 0x3a,
+	0x4b, 0x17, 0xcb, 0x4f, 0xd1, 0x33, 0x32, 0x02, 0x7f, 0xb1, 0xcc, 0x22, 0x8f, 0x84, 0x30, 0xb8,
+	0xb8, 0x5c, 0xcd, 0xb3, 0xe8, 0x80, 0x8c, 0x21, 0x38, 0x5f, 0xad, 0x2e, 0x23, 0xbf, 0x53, 0x1f,
+	0x97, 0x57, 0x5f, 0xa2, 0xa0, 0x53, 0x9f, 0xd7, 0xab, 0x65, 0x34, 0x20, 0x11, 0x1c, 0xce, 0xd3,
+	0x6c, 0x71, 0x31, 0x7f, 0x9f, 0x6d, 0xae, 0xd2, 0x45, 0x34, 0x3c, 0x3f, 0xfb, 0x76, 0x5a, 0x0a,
+	0xfb, 0xbd, 0xcd, 0x59, 0xa1, 0xea, 0xe4, 0xa6, 0xcd, 0x71, 0x57, 0xa9, 0xdb, 0x44, 0x0b, 0x8d,
+	0x95, 0x90, 0xd8, 0x24, 0x8f, 0xdf, 0x57, 0xa9, 0x36, 0x45, 0x25, 0x50, 0xda, 0x7c, 0xe8, 0x46,
+	0x9e, 0xfe, 0x1e, 0x00, 0x2d, 0x1f, 0xb9, 0x65, 0x7f, 0x02, 0x00, 0x00,
 }
@@ -52,7 +52,7 @@ func (m *Url) Reset()         { *m = Url{} }
 func (m *Url) String() string { return proto.CompactTextString(m) }
 func (*Url) ProtoMessage()    {}
 func (*Url) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pipeline_1d626cf7548f7a80, []int{0}
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{0}
 }
 func (m *Url) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Url.Unmarshal(m, b)
@@ -90,7 +90,7 @@ func (m *CreatePipelineRequest) Reset()         { *m = CreatePipelineRequest{} }
 func (m *CreatePipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePipelineRequest) ProtoMessage()    {}
 func (*CreatePipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pipeline_1d626cf7548f7a80, []int{1}
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{1}
 }
 func (m *CreatePipelineRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreatePipelineRequest.Unmarshal(m, b)
@@ -128,7 +128,7 @@ func (m *GetPipelineRequest) Reset()         { *m = GetPipelineRequest{} }
 func (m *GetPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPipelineRequest) ProtoMessage()    {}
 func (*GetPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pipeline_1d626cf7548f7a80, []int{2}
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{2}
 }
 func (m *GetPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetPipelineRequest.Unmarshal(m, b)
@@ -169,7 +169,7 @@ func (m *ListPipelinesRequest) Reset()         { *m = ListPipelinesRequest{} }
 func (m *ListPipelinesRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPipelinesRequest) ProtoMessage()    {}
 func (*ListPipelinesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pipeline_1d626cf7548f7a80, []int{3}
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{3}
 }
 func (m *ListPipelinesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPipelinesRequest.Unmarshal(m, b)
@@ -230,7 +230,7 @@ func (m *ListPipelinesResponse) Reset()         { *m = ListPipelinesResponse{} }
 func (m *ListPipelinesResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPipelinesResponse) ProtoMessage()    {}
 func (*ListPipelinesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pipeline_1d626cf7548f7a80, []int{4}
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{4}
 }
 func (m *ListPipelinesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPipelinesResponse.Unmarshal(m, b)
@@ -282,7 +282,7 @@ func (m *DeletePipelineRequest) Reset()         { *m = DeletePipelineRequest{} }
 func (m *DeletePipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*DeletePipelineRequest) ProtoMessage()    {}
 func (*DeletePipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pipeline_1d626cf7548f7a80, []int{5}
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{5}
 }
 func (m *DeletePipelineRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeletePipelineRequest.Unmarshal(m, b)
@@ -320,7 +320,7 @@ func (m *GetTemplateRequest) Reset()         { *m = GetTemplateRequest{} }
 func (m *GetTemplateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTemplateRequest) ProtoMessage()    {}
 func (*GetTemplateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pipeline_1d626cf7548f7a80, []int{6}
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{6}
 }
 func (m *GetTemplateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTemplateRequest.Unmarshal(m, b)
@@ -358,7 +358,7 @@ func (m *GetTemplateResponse) Reset()         { *m = GetTemplateResponse{} }
 func (m *GetTemplateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTemplateResponse) ProtoMessage()    {}
 func (*GetTemplateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pipeline_1d626cf7548f7a80, []int{7}
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{7}
 }
 func (m *GetTemplateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTemplateResponse.Unmarshal(m, b)
@@ -396,7 +396,7 @@ func (m *GetPipelineVersionTemplateRequest) Reset()         { *m = GetPipelineVe
 func (m *GetPipelineVersionTemplateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPipelineVersionTemplateRequest) ProtoMessage()    {}
 func (*GetPipelineVersionTemplateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pipeline_1d626cf7548f7a80, []int{8}
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{8}
 }
 func (m *GetPipelineVersionTemplateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetPipelineVersionTemplateRequest.Unmarshal(m, b)
@@ -423,8 +423,93 @@ func (m *GetPipelineVersionTemplateRequest) GetVersionId() string {
 	return ""
 }
 
+type GetPipelineVersionAssetRequest struct {
+	VersionId            string   `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPipelineVersionAssetRequest) Reset()         { *m = GetPipelineVersionAssetRequest{} }
+func (m *GetPipelineVersionAssetRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPipelineVersionAssetRequest) ProtoMessage()    {}
+func (*GetPipelineVersionAssetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{9}
+}
+func (m *GetPipelineVersionAssetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPipelineVersionAssetRequest.Unmarshal(m, b)
+}
+func (m *GetPipelineVersionAssetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPipelineVersionAssetRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetPipelineVersionAssetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPipelineVersionAssetRequest.Merge(dst, src)
+}
+func (m *GetPipelineVersionAssetRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPipelineVersionAssetRequest.Size(m)
+}
+func (m *GetPipelineVersionAssetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPipelineVersionAssetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPipelineVersionAssetRequest proto.InternalMessageInfo
+
+func (m *GetPipelineVersionAssetRequest) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *GetPipelineVersionAssetRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type GetPipelineVersionAssetResponse struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPipelineVersionAssetResponse) Reset()         { *m = GetPipelineVersionAssetResponse{} }
+func (m *GetPipelineVersionAssetResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPipelineVersionAssetResponse) ProtoMessage()    {}
+func (*GetPipelineVersionAssetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{10}
+}
+func (m *GetPipelineVersionAssetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPipelineVersionAssetResponse.Unmarshal(m, b)
+}
+func (m *GetPipelineVersionAssetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPipelineVersionAssetResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetPipelineVersionAssetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPipelineVersionAssetResponse.Merge(dst, src)
+}
+func (m *GetPipelineVersionAssetResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPipelineVersionAssetResponse.Size(m)
+}
+func (m *GetPipelineVersionAssetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPipelineVersionAssetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPipelineVersionAssetResponse proto.InternalMessageInfo
+
+func (m *GetPipelineVersionAssetResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 type CreatePipelineVersionRequest struct {
 	Version              *PipelineVersion `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Deduplicate          bool             `protobuf:"varint,2,opt,name=deduplicate,proto3" json:"deduplicate,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -434,7 +519,7 @@ func (m *CreatePipelineVersionRequest) Reset()         { *m = CreatePipelineVers
 func (m *CreatePipelineVersionRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePipelineVersionRequest) ProtoMessage()    {}
 func (*CreatePipelineVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pipeline_1d626cf7548f7a80, []int{9}
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{11}
 }
 func (m *CreatePipelineVersionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreatePipelineVersionRequest.Unmarshal(m, b)
@@ -461,6 +546,13 @@ func (m *CreatePipelineVersionRequest) GetVersion() *PipelineVersion {
 	return nil
 }
 
+func (m *CreatePipelineVersionRequest) GetDeduplicate() bool {
+	if m != nil {
+		return m.Deduplicate
+	}
+	return false
+}
+
 type GetPipelineVersionRequest struct {
 	VersionId            string   `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -472,7 +564,7 @@ func (m *GetPipelineVersionRequest) Reset()         { *m = GetPipelineVersionReq
 func (m *GetPipelineVersionRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPipelineVersionRequest) ProtoMessage()    {}
 func (*GetPipelineVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pipeline_1d626cf7548f7a80, []int{10}
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{12}
 }
 func (m *GetPipelineVersionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetPipelineVersionRequest.Unmarshal(m, b)
@@ -514,7 +606,7 @@ func (m *ListPipelineVersionsRequest) Reset()         { *m = ListPipelineVersion
 func (m *ListPipelineVersionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPipelineVersionsRequest) ProtoMessage()    {}
 func (*ListPipelineVersionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pipeline_1d626cf7548f7a80, []int{11}
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{13}
 }
 func (m *ListPipelineVersionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPipelineVersionsRequest.Unmarshal(m, b)
@@ -582,7 +674,7 @@ func (m *ListPipelineVersionsResponse) Reset()         { *m = ListPipelineVersio
 func (m *ListPipelineVersionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPipelineVersionsResponse) ProtoMessage()    {}
 func (*ListPipelineVersionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pipeline_1d626cf7548f7a80, []int{12}
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{14}
 }
 func (m *ListPipelineVersionsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPipelineVersionsResponse.Unmarshal(m, b)
@@ -634,7 +726,7 @@ func (m *DeletePipelineVersionRequest) Reset()         { *m = DeletePipelineVers
 func (m *DeletePipelineVersionRequest) String() string { return proto.CompactTextString(m) }
 func (*DeletePipelineVersionRequest) ProtoMessage()    {}
 func (*DeletePipelineVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pipeline_1d626cf7548f7a80, []int{13}
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{15}
 }
 func (m *DeletePipelineVersionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeletePipelineVersionRequest.Unmarshal(m, b)
@@ -661,6 +753,144 @@ func (m *DeletePipelineVersionRequest) GetVersionId() string {
 	return ""
 }
 
+type UpdatePipelineLabelsRequest struct {
+	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Labels               map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UpdatePipelineLabelsRequest) Reset()         { *m = UpdatePipelineLabelsRequest{} }
+func (m *UpdatePipelineLabelsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdatePipelineLabelsRequest) ProtoMessage()    {}
+func (*UpdatePipelineLabelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{16}
+}
+func (m *UpdatePipelineLabelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdatePipelineLabelsRequest.Unmarshal(m, b)
+}
+func (m *UpdatePipelineLabelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdatePipelineLabelsRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdatePipelineLabelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePipelineLabelsRequest.Merge(dst, src)
+}
+func (m *UpdatePipelineLabelsRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdatePipelineLabelsRequest.Size(m)
+}
+func (m *UpdatePipelineLabelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePipelineLabelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatePipelineLabelsRequest proto.InternalMessageInfo
+
+func (m *UpdatePipelineLabelsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdatePipelineLabelsRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type UpdatePipelineVersionLabelsRequest struct {
+	VersionId            string            `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Labels               map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UpdatePipelineVersionLabelsRequest) Reset()         { *m = UpdatePipelineVersionLabelsRequest{} }
+func (m *UpdatePipelineVersionLabelsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdatePipelineVersionLabelsRequest) ProtoMessage()    {}
+func (*UpdatePipelineVersionLabelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{17}
+}
+func (m *UpdatePipelineVersionLabelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdatePipelineVersionLabelsRequest.Unmarshal(m, b)
+}
+func (m *UpdatePipelineVersionLabelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdatePipelineVersionLabelsRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdatePipelineVersionLabelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePipelineVersionLabelsRequest.Merge(dst, src)
+}
+func (m *UpdatePipelineVersionLabelsRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdatePipelineVersionLabelsRequest.Size(m)
+}
+func (m *UpdatePipelineVersionLabelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePipelineVersionLabelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatePipelineVersionLabelsRequest proto.InternalMessageInfo
+
+func (m *UpdatePipelineVersionLabelsRequest) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *UpdatePipelineVersionLabelsRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type UpdatePipelineDefaultVersionRequest struct {
+	PipelineId           string   `protobuf:"bytes,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	VersionId            string   `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdatePipelineDefaultVersionRequest) Reset()         { *m = UpdatePipelineDefaultVersionRequest{} }
+func (m *UpdatePipelineDefaultVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdatePipelineDefaultVersionRequest) ProtoMessage()    {}
+func (*UpdatePipelineDefaultVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{18}
+}
+func (m *UpdatePipelineDefaultVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdatePipelineDefaultVersionRequest.Unmarshal(m, b)
+}
+func (m *UpdatePipelineDefaultVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdatePipelineDefaultVersionRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdatePipelineDefaultVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePipelineDefaultVersionRequest.Merge(dst, src)
+}
+func (m *UpdatePipelineDefaultVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdatePipelineDefaultVersionRequest.Size(m)
+}
+func (m *UpdatePipelineDefaultVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePipelineDefaultVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatePipelineDefaultVersionRequest proto.InternalMessageInfo
+
+func (m *UpdatePipelineDefaultVersionRequest) GetPipelineId() string {
+	if m != nil {
+		return m.PipelineId
+	}
+	return ""
+}
+
+func (m *UpdatePipelineDefaultVersionRequest) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
 type Pipeline struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
@@ -670,6 +900,7 @@ type Pipeline struct {
 	Url                  *Url                 `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
 	Error                string               `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
 	DefaultVersion       *PipelineVersion     `protobuf:"bytes,8,opt,name=default_version,json=defaultVersion,proto3" json:"default_version,omitempty"`
+	Labels               map[string]string    `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -679,7 +910,7 @@ func (m *Pipeline) Reset()         { *m = Pipeline{} }
 func (m *Pipeline) String() string { return proto.CompactTextString(m) }
 func (*Pipeline) ProtoMessage()    {}
 func (*Pipeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pipeline_1d626cf7548f7a80, []int{14}
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{19}
 }
 func (m *Pipeline) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Pipeline.Unmarshal(m, b)
@@ -755,6 +986,13 @@ func (m *Pipeline) GetDefaultVersion() *PipelineVersion {
 	return nil
 }
 
+func (m *Pipeline) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 type PipelineVersion struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -763,6 +1001,9 @@ type PipelineVersion struct {
 	CodeSourceUrl        string               `protobuf:"bytes,5,opt,name=code_source_url,json=codeSourceUrl,proto3" json:"code_source_url,omitempty"`
 	PackageUrl           *Url                 `protobuf:"bytes,6,opt,name=package_url,json=packageUrl,proto3" json:"package_url,omitempty"`
 	ResourceReferences   []*ResourceReference `protobuf:"bytes,7,rep,name=resource_references,json=resourceReferences,proto3" json:"resource_references,omitempty"`
+	Labels               map[string]string    `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Digest               string               `protobuf:"bytes,9,opt,name=digest,proto3" json:"digest,omitempty"`
+	ParameterSchema      []*ParameterSpec     `protobuf:"bytes,10,rep,name=parameter_schema,json=parameterSchema,proto3" json:"parameter_schema,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -772,7 +1013,7 @@ func (m *PipelineVersion) Reset()         { *m = PipelineVersion{} }
 func (m *PipelineVersion) String() string { return proto.CompactTextString(m) }
 func (*PipelineVersion) ProtoMessage()    {}
 func (*PipelineVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pipeline_1d626cf7548f7a80, []int{15}
+	return fileDescriptor_pipeline_816af56ca0d7579f, []int{20}
 }
 func (m *PipelineVersion) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PipelineVersion.Unmarshal(m, b)
@@ -841,6 +1082,27 @@ func (m *PipelineVersion) GetResourceReferences() []*ResourceReference {
 	return nil
 }
 
+func (m *PipelineVersion) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *PipelineVersion) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
+func (m *PipelineVersion) GetParameterSchema() []*ParameterSpec {
+	if m != nil {
+		return m.ParameterSchema
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Url)(nil), "api.Url")
 	proto.RegisterType((*CreatePipelineRequest)(nil), "api.CreatePipelineRequest")
@@ -851,13 +1113,22 @@ func init() {
 	proto.RegisterType((*GetTemplateRequest)(nil), "api.GetTemplateRequest")
 	proto.RegisterType((*GetTemplateResponse)(nil), "api.GetTemplateResponse")
 	proto.RegisterType((*GetPipelineVersionTemplateRequest)(nil), "api.GetPipelineVersionTemplateRequest")
+	proto.RegisterType((*GetPipelineVersionAssetRequest)(nil), "api.GetPipelineVersionAssetRequest")
+	proto.RegisterType((*GetPipelineVersionAssetResponse)(nil), "api.GetPipelineVersionAssetResponse")
 	proto.RegisterType((*CreatePipelineVersionRequest)(nil), "api.CreatePipelineVersionRequest")
 	proto.RegisterType((*GetPipelineVersionRequest)(nil), "api.GetPipelineVersionRequest")
 	proto.RegisterType((*ListPipelineVersionsRequest)(nil), "api.ListPipelineVersionsRequest")
 	proto.RegisterType((*ListPipelineVersionsResponse)(nil), "api.ListPipelineVersionsResponse")
 	proto.RegisterType((*DeletePipelineVersionRequest)(nil), "api.DeletePipelineVersionRequest")
+	proto.RegisterType((*UpdatePipelineLabelsRequest)(nil), "api.UpdatePipelineLabelsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.UpdatePipelineLabelsRequest.LabelsEntry")
+	proto.RegisterType((*UpdatePipelineVersionLabelsRequest)(nil), "api.UpdatePipelineVersionLabelsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.UpdatePipelineVersionLabelsRequest.LabelsEntry")
+	proto.RegisterType((*UpdatePipelineDefaultVersionRequest)(nil), "api.UpdatePipelineDefaultVersionRequest")
 	proto.RegisterType((*Pipeline)(nil), "api.Pipeline")
+	proto.RegisterMapType((map[string]string)(nil), "api.Pipeline.LabelsEntry")
 	proto.RegisterType((*PipelineVersion)(nil), "api.PipelineVersion")
+	proto.RegisterMapType((map[string]string)(nil), "api.PipelineVersion.LabelsEntry")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -882,6 +1153,10 @@ type PipelineServiceClient interface {
 	ListPipelineVersions(ctx context.Context, in *ListPipelineVersionsRequest, opts ...grpc.CallOption) (*ListPipelineVersionsResponse, error)
 	DeletePipelineVersion(ctx context.Context, in *DeletePipelineVersionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	GetPipelineVersionTemplate(ctx context.Context, in *GetPipelineVersionTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error)
+	GetPipelineVersionAsset(ctx context.Context, in *GetPipelineVersionAssetRequest, opts ...grpc.CallOption) (*GetPipelineVersionAssetResponse, error)
+	UpdatePipelineLabels(ctx context.Context, in *UpdatePipelineLabelsRequest, opts ...grpc.CallOption) (*Pipeline, error)
+	UpdatePipelineVersionLabels(ctx context.Context, in *UpdatePipelineVersionLabelsRequest, opts ...grpc.CallOption) (*PipelineVersion, error)
+	UpdatePipelineDefaultVersion(ctx context.Context, in *UpdatePipelineDefaultVersionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type pipelineServiceClient struct {
@@ -982,6 +1257,42 @@ func (c *pipelineServiceClient) GetPipelineVersionTemplate(ctx context.Context,
 	return out, nil
 }
 
+func (c *pipelineServiceClient) GetPipelineVersionAsset(ctx context.Context, in *GetPipelineVersionAssetRequest, opts ...grpc.CallOption) (*GetPipelineVersionAssetResponse, error) {
+	out := new(GetPipelineVersionAssetResponse)
+	err := c.cc.Invoke(ctx, "/api.PipelineService/GetPipelineVersionAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) UpdatePipelineLabels(ctx context.Context, in *UpdatePipelineLabelsRequest, opts ...grpc.CallOption) (*Pipeline, error) {
+	out := new(Pipeline)
+	err := c.cc.Invoke(ctx, "/api.PipelineService/UpdatePipelineLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) UpdatePipelineVersionLabels(ctx context.Context, in *UpdatePipelineVersionLabelsRequest, opts ...grpc.CallOption) (*PipelineVersion, error) {
+	out := new(PipelineVersion)
+	err := c.cc.Invoke(ctx, "/api.PipelineService/UpdatePipelineVersionLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelineServiceClient) UpdatePipelineDefaultVersion(ctx context.Context, in *UpdatePipelineDefaultVersionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.PipelineService/UpdatePipelineDefaultVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PipelineServiceServer is the server API for PipelineService service.
 type PipelineServiceServer interface {
 	CreatePipeline(context.Context, *CreatePipelineRequest) (*Pipeline, error)
@@ -994,6 +1305,10 @@ type PipelineServiceServer interface {
 	ListPipelineVersions(context.Context, *ListPipelineVersionsRequest) (*ListPipelineVersionsResponse, error)
 	DeletePipelineVersion(context.Context, *DeletePipelineVersionRequest) (*empty.Empty, error)
 	GetPipelineVersionTemplate(context.Context, *GetPipelineVersionTemplateRequest) (*GetTemplateResponse, error)
+	GetPipelineVersionAsset(context.Context, *GetPipelineVersionAssetRequest) (*GetPipelineVersionAssetResponse, error)
+	UpdatePipelineLabels(context.Context, *UpdatePipelineLabelsRequest) (*Pipeline, error)
+	UpdatePipelineVersionLabels(context.Context, *UpdatePipelineVersionLabelsRequest) (*PipelineVersion, error)
+	UpdatePipelineDefaultVersion(context.Context, *UpdatePipelineDefaultVersionRequest) (*empty.Empty, error)
 }
 
 func RegisterPipelineServiceServer(s *grpc.Server, srv PipelineServiceServer) {
@@ -1180,6 +1495,78 @@ func _PipelineService_GetPipelineVersionTemplate_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PipelineService_GetPipelineVersionAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineVersionAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).GetPipelineVersionAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PipelineService/GetPipelineVersionAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).GetPipelineVersionAsset(ctx, req.(*GetPipelineVersionAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_UpdatePipelineLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePipelineLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).UpdatePipelineLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PipelineService/UpdatePipelineLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).UpdatePipelineLabels(ctx, req.(*UpdatePipelineLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_UpdatePipelineVersionLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePipelineVersionLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).UpdatePipelineVersionLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PipelineService/UpdatePipelineVersionLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).UpdatePipelineVersionLabels(ctx, req.(*UpdatePipelineVersionLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipelineService_UpdatePipelineDefaultVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePipelineDefaultVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelineServiceServer).UpdatePipelineDefaultVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.PipelineService/UpdatePipelineDefaultVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelineServiceServer).UpdatePipelineDefaultVersion(ctx, req.(*UpdatePipelineDefaultVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PipelineService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.PipelineService",
 	HandlerType: (*PipelineServiceServer)(nil),
@@ -1224,89 +1611,127 @@ var _PipelineService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPipelineVersionTemplate",
 			Handler:    _PipelineService_GetPipelineVersionTemplate_Handler,
 		},
+		{
+			MethodName: "GetPipelineVersionAsset",
+			Handler:    _PipelineService_GetPipelineVersionAsset_Handler,
+		},
+		{
+			MethodName: "UpdatePipelineLabels",
+			Handler:    _PipelineService_UpdatePipelineLabels_Handler,
+		},
+		{
+			MethodName: "UpdatePipelineVersionLabels",
+			Handler:    _PipelineService_UpdatePipelineVersionLabels_Handler,
+		},
+		{
+			MethodName: "UpdatePipelineDefaultVersion",
+			Handler:    _PipelineService_UpdatePipelineDefaultVersion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "backend/api/pipeline.proto",
 }
 
 func init() {
-	proto.RegisterFile("backend/api/pipeline.proto", fileDescriptor_pipeline_1d626cf7548f7a80)
-}
-
-var fileDescriptor_pipeline_1d626cf7548f7a80 = []byte{
-	// 1184 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0x66, 0xed, 0xc4, 0xb1, 0x8f, 0xeb, 0x04, 0xa6, 0x4d, 0xe2, 0x6c, 0x9c, 0xc6, 0x59, 0xa2,
-	0x34, 0x09, 0xad, 0xdd, 0x24, 0x08, 0x41, 0xa4, 0x22, 0x35, 0x80, 0x2a, 0xc4, 0x8f, 0x2a, 0x27,
-	0xe1, 0xa2, 0x5c, 0x58, 0xe3, 0xf5, 0xb1, 0xbb, 0x64, 0xbd, 0xbb, 0xcc, 0x8c, 0x53, 0x92, 0xaa,
-	0x12, 0x2a, 0x20, 0x21, 0x71, 0x07, 0x17, 0xdc, 0xf1, 0x04, 0x3c, 0x02, 0x12, 0x0f, 0xc1, 0x0d,
-	0x0f, 0xc0, 0x83, 0xa0, 0x1d, 0xcf, 0xac, 0x77, 0xd7, 0x3f, 0x49, 0xae, 0x92, 0x39, 0xe7, 0x9b,
-	0x3d, 0xe7, 0x7c, 0xf3, 0xcd, 0x39, 0x63, 0x30, 0x5b, 0xd4, 0x3e, 0x43, 0xaf, 0x5d, 0xa7, 0x81,
-	0x53, 0x0f, 0x9c, 0x00, 0x5d, 0xc7, 0xc3, 0x5a, 0xc0, 0x7c, 0xe1, 0x93, 0x2c, 0x0d, 0x1c, 0xb3,
-	0xd2, 0xf5, 0xfd, 0xae, 0x8b, 0xd2, 0x4f, 0x3d, 0xcf, 0x17, 0x54, 0x38, 0xbe, 0xc7, 0x07, 0x10,
-	0x73, 0x5d, 0x79, 0xe5, 0xaa, 0xd5, 0xef, 0xd4, 0x85, 0xd3, 0x43, 0x2e, 0x68, 0x2f, 0x50, 0x80,
-	0xd5, 0x34, 0x00, 0x7b, 0x81, 0xb8, 0x50, 0xce, 0xe5, 0x78, 0x70, 0x64, 0xcc, 0x67, 0x7a, 0x57,
-	0x22, 0x2b, 0xca, 0x68, 0x0f, 0x05, 0x6a, 0xe7, 0xfa, 0xb8, 0x94, 0x9b, 0x3c, 0x40, 0x5b, 0x01,
-	0x36, 0xe3, 0x00, 0x86, 0xdc, 0xef, 0x33, 0x1b, 0x9b, 0x0c, 0x3b, 0xc8, 0xd0, 0xb3, 0x55, 0x75,
-	0xe6, 0x7d, 0xf9, 0xc7, 0x7e, 0xd0, 0x45, 0xef, 0x01, 0x7f, 0x41, 0xbb, 0x5d, 0x64, 0x75, 0x3f,
-	0x90, 0xc5, 0x8d, 0x16, 0x6a, 0x6d, 0x43, 0xf6, 0x94, 0xb9, 0x64, 0x03, 0x6e, 0x45, 0x11, 0xfb,
-	0xcc, 0x2d, 0x1b, 0x55, 0x63, 0xbb, 0xd0, 0x28, 0x6a, 0xdb, 0x29, 0x73, 0xad, 0x23, 0x58, 0xfc,
-	0x88, 0x21, 0x15, 0xf8, 0x54, 0x19, 0x1b, 0xf8, 0x6d, 0x1f, 0xb9, 0x20, 0x3b, 0x90, 0xd7, 0x38,
-	0xb9, 0xaf, 0xb8, 0x5f, 0xaa, 0xd1, 0xc0, 0xa9, 0x45, 0xb8, 0xc8, 0x6d, 0x6d, 0x02, 0x79, 0x82,
-	0x22, 0xfd, 0x81, 0x79, 0xc8, 0x38, 0x6d, 0x15, 0x32, 0xe3, 0xb4, 0xad, 0x1f, 0x0c, 0xb8, 0xf3,
-	0xb9, 0xc3, 0x23, 0x1c, 0xd7, 0xc0, 0x35, 0x80, 0x80, 0x76, 0xb1, 0x29, 0xfc, 0x33, 0xf4, 0xd4,
-	0x86, 0x42, 0x68, 0x39, 0x09, 0x0d, 0x64, 0x15, 0xe4, 0xa2, 0xc9, 0x9d, 0x4b, 0x2c, 0x67, 0xaa,
-	0xc6, 0xf6, 0x6c, 0x23, 0x1f, 0x1a, 0x8e, 0x9d, 0x4b, 0x24, 0xcb, 0x30, 0xc7, 0x7d, 0x26, 0x9a,
-	0xad, 0x8b, 0x72, 0x56, 0x6e, 0xcc, 0x85, 0xcb, 0xa3, 0x0b, 0xb2, 0x04, 0xb9, 0x8e, 0xe3, 0x0a,
-	0x64, 0xe5, 0x99, 0x81, 0x7d, 0xb0, 0xb2, 0x7e, 0x31, 0x60, 0x31, 0x95, 0x05, 0x0f, 0x7c, 0x8f,
-	0x23, 0x79, 0x07, 0x0a, 0xba, 0x22, 0x5e, 0x36, 0xaa, 0xd9, 0xd1, 0x8a, 0x87, 0xfe, 0x30, 0x67,
-	0xe1, 0x0b, 0xea, 0x0e, 0xb2, 0xca, 0xca, 0xac, 0x0a, 0xd2, 0x22, 0xd3, 0xda, 0x82, 0x05, 0x0f,
-	0xbf, 0x13, 0xcd, 0x58, 0x5d, 0x19, 0x99, 0x46, 0x29, 0x34, 0x3f, 0xd5, 0xb5, 0x59, 0xf7, 0x60,
-	0xf1, 0x63, 0x74, 0x71, 0x94, 0xfd, 0x34, 0x79, 0x03, 0x8a, 0x4f, 0xb0, 0x17, 0xb8, 0x54, 0x4c,
-	0x44, 0xed, 0xc1, 0xed, 0x04, 0x4a, 0x55, 0x66, 0x42, 0x5e, 0x28, 0x9b, 0x02, 0x47, 0x6b, 0xeb,
-	0x08, 0x36, 0x62, 0x67, 0xf7, 0x15, 0x32, 0xee, 0xf8, 0x5e, 0x3a, 0xce, 0x1a, 0xc0, 0xf9, 0xc0,
-	0xd3, 0x8c, 0xe2, 0x15, 0x94, 0xe5, 0xd3, 0xb6, 0xf5, 0x25, 0x54, 0x92, 0x1a, 0x52, 0x9f, 0xd1,
-	0xdb, 0x6b, 0x30, 0xa7, 0xc0, 0x4a, 0x49, 0x77, 0x12, 0xbc, 0x6a, 0xb4, 0x06, 0x59, 0x87, 0xb0,
-	0x32, 0x9a, 0xd3, 0x35, 0x73, 0xf9, 0xdb, 0x80, 0xd5, 0xf8, 0xf9, 0xaa, 0xdd, 0x91, 0xd8, 0x0e,
-	0xe0, 0x56, 0x74, 0xc7, 0xce, 0xf0, 0x42, 0x25, 0xf4, 0xa6, 0x4c, 0xa8, 0xa1, 0x1c, 0x9f, 0xe1,
-	0x45, 0xa3, 0xc8, 0x86, 0x8b, 0xe9, 0x12, 0x4c, 0xca, 0x37, 0x9b, 0x96, 0x6f, 0x4c, 0xa1, 0x33,
-	0x13, 0x14, 0x3a, 0x9b, 0x50, 0xe8, 0xef, 0x06, 0x54, 0xc6, 0x57, 0xa0, 0x8e, 0xf3, 0x21, 0xe4,
-	0x55, 0xbd, 0x5a, 0xa7, 0xe3, 0xf9, 0x8c, 0x50, 0xd7, 0x95, 0xe3, 0x15, 0xaa, 0xb6, 0x1e, 0x41,
-	0x25, 0xa9, 0xd6, 0x9b, 0x1d, 0xcd, 0x9f, 0x19, 0xc8, 0xeb, 0x9d, 0x69, 0xe9, 0x92, 0x0f, 0x00,
-	0x6c, 0xa9, 0xa1, 0x76, 0x93, 0x0a, 0x99, 0x5d, 0x71, 0xdf, 0xac, 0x0d, 0xda, 0x71, 0x4d, 0xb7,
-	0xe3, 0xda, 0x89, 0xee, 0xd7, 0x8d, 0x82, 0x42, 0x3f, 0x16, 0x84, 0xc0, 0x8c, 0x47, 0x7b, 0xa8,
-	0xa8, 0x97, 0xff, 0x93, 0x2a, 0x14, 0xdb, 0xc8, 0x6d, 0xe6, 0xc8, 0x16, 0xa9, 0x98, 0x8f, 0x9b,
-	0x48, 0x2d, 0x3c, 0x36, 0xd5, 0xaa, 0x79, 0x79, 0x56, 0xf2, 0x38, 0x3f, 0xe0, 0x51, 0x9b, 0x1b,
-	0x31, 0x04, 0x31, 0x21, 0x1b, 0xb6, 0xd0, 0x39, 0x99, 0x59, 0x5e, 0x02, 0x4f, 0x99, 0xdb, 0x08,
-	0x8d, 0xe4, 0x0e, 0xcc, 0xca, 0x79, 0x50, 0xce, 0xc9, 0x38, 0x83, 0x05, 0x79, 0x04, 0x0b, 0x6d,
-	0xec, 0xd0, 0xbe, 0x2b, 0x9a, 0x5a, 0xfe, 0xf9, 0x29, 0xf2, 0x9f, 0x57, 0x60, 0xb5, 0xb6, 0xfe,
-	0xca, 0xc0, 0x42, 0x0a, 0x33, 0xc2, 0x9a, 0x2e, 0x3d, 0x13, 0x2b, 0x3d, 0xc9, 0x64, 0xf6, 0x26,
-	0x4c, 0x26, 0x39, 0x99, 0xb9, 0x92, 0x93, 0x2d, 0x58, 0xb0, 0xfd, 0x36, 0x36, 0xd5, 0x7d, 0x0a,
-	0xf9, 0x19, 0x68, 0xb9, 0x14, 0x9a, 0x8f, 0xa5, 0x35, 0x9c, 0x43, 0x3b, 0x50, 0x0c, 0xa8, 0x7d,
-	0x16, 0xaa, 0x2f, 0xc4, 0xe4, 0x52, 0x1c, 0x82, 0x72, 0x86, 0xd0, 0x27, 0x70, 0x7b, 0x74, 0x06,
-	0xf2, 0xf2, 0x9c, 0xcc, 0x65, 0x29, 0x71, 0x4d, 0x1b, 0xda, 0xdd, 0x20, 0x2c, 0x6d, 0xe2, 0xfb,
-	0xff, 0x16, 0x86, 0xf4, 0x1d, 0x23, 0x3b, 0x77, 0x6c, 0x24, 0x1d, 0x98, 0x4f, 0x36, 0x2a, 0x62,
-	0xca, 0x2f, 0x8e, 0x9d, 0x80, 0x66, 0xb2, 0xfb, 0x5b, 0x3b, 0xaf, 0xff, 0xf9, 0xef, 0xb7, 0xcc,
-	0xdb, 0xd6, 0x72, 0x38, 0xa8, 0x79, 0xfd, 0x7c, 0xaf, 0x85, 0x82, 0xee, 0x45, 0x23, 0x9d, 0x1f,
-	0x46, 0x03, 0x91, 0x7c, 0x0d, 0xc5, 0x58, 0x03, 0x23, 0xcb, 0xf2, 0x43, 0xa3, 0x23, 0x32, 0x1d,
-	0x61, 0x53, 0x46, 0xb8, 0x4b, 0x2a, 0x13, 0x22, 0xd4, 0x5f, 0x3a, 0xed, 0x57, 0xa4, 0x0b, 0xa5,
-	0xc4, 0x00, 0x23, 0x2b, 0xf2, 0x2b, 0xe3, 0x46, 0xab, 0x69, 0x8e, 0x73, 0x0d, 0xda, 0x88, 0xb5,
-	0x2e, 0xa3, 0xad, 0x90, 0x49, 0xf5, 0x90, 0x6f, 0x60, 0x3e, 0x79, 0xdd, 0x15, 0x5b, 0x63, 0x27,
-	0x96, 0xb9, 0x34, 0x22, 0xb1, 0x4f, 0xc2, 0xb7, 0x93, 0x2e, 0x6a, 0x77, 0x7a, 0x51, 0x81, 0x64,
-	0x4c, 0xcf, 0x9d, 0x21, 0x63, 0xa9, 0x49, 0x64, 0x96, 0x47, 0x1d, 0xaa, 0x9c, 0x9a, 0x8c, 0xb3,
-	0x4d, 0xb6, 0xa6, 0xc5, 0xa9, 0xeb, 0xb9, 0xc7, 0xc9, 0x6b, 0x23, 0xfd, 0xf2, 0xd1, 0x97, 0x6c,
-	0x63, 0x8c, 0x26, 0x92, 0x9d, 0xce, 0x1c, 0x7b, 0x83, 0xad, 0x87, 0x32, 0x85, 0x5d, 0x6b, 0x7d,
-	0x7c, 0x0a, 0xba, 0x0b, 0xf0, 0x43, 0x3d, 0xe9, 0xc8, 0xf7, 0x46, 0xe2, 0xe9, 0xa4, 0x33, 0xb8,
-	0x9b, 0x16, 0xcc, 0xb5, 0xc2, 0xbf, 0x2b, 0xc3, 0xd7, 0xc8, 0xfd, 0x2b, 0xc2, 0xd7, 0x5f, 0x0e,
-	0xbb, 0xf4, 0x2b, 0xf2, 0x63, 0xea, 0x59, 0xa6, 0xc7, 0x0d, 0xa9, 0x8e, 0x68, 0x27, 0x35, 0x4b,
-	0xcd, 0x8d, 0x29, 0x08, 0x75, 0x2a, 0xf7, 0x64, 0x4e, 0x1b, 0xe4, 0x2a, 0x4a, 0xc8, 0xcf, 0x46,
-	0xfa, 0x29, 0x94, 0x3c, 0x8e, 0x69, 0x83, 0x67, 0xa2, 0xf6, 0x14, 0x23, 0xbb, 0x37, 0x63, 0xe4,
-	0x0f, 0x03, 0xcc, 0xc9, 0x6f, 0x22, 0xb2, 0x35, 0xe1, 0x70, 0xae, 0x2f, 0xd5, 0x0f, 0x65, 0x5a,
-	0xef, 0x93, 0xf7, 0x6e, 0x92, 0xd6, 0x50, 0xba, 0x47, 0x3f, 0x19, 0xbf, 0x3e, 0xfe, 0xa2, 0x51,
-	0x81, 0x39, 0x35, 0x31, 0xc8, 0x5b, 0x64, 0x01, 0x4a, 0x66, 0x51, 0x06, 0x3c, 0x16, 0x54, 0xf4,
-	0xf9, 0xb3, 0x75, 0x58, 0x83, 0xdc, 0x11, 0x52, 0x86, 0x8c, 0xdc, 0xce, 0x67, 0xcc, 0x12, 0xed,
-	0x8b, 0xe7, 0x3e, 0x73, 0x2e, 0xe5, 0xef, 0x84, 0x6a, 0xa6, 0x75, 0x0b, 0x20, 0x02, 0xbc, 0xf1,
-	0xec, 0xa0, 0xeb, 0x88, 0xe7, 0xfd, 0x56, 0xcd, 0xf6, 0x7b, 0xf5, 0xb3, 0x7e, 0x0b, 0x3b, 0xae,
-	0xff, 0x22, 0x76, 0x77, 0xe2, 0x3f, 0x51, 0xba, 0x7e, 0xd3, 0x76, 0x1d, 0xf4, 0x44, 0x2b, 0x27,
-	0xe9, 0x3e, 0xf8, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xb1, 0x47, 0x56, 0x80, 0x96, 0x0d, 0x00, 0x00,
+	proto.RegisterFile("backend/api/pipeline.proto", fileDescriptor_pipeline_816af56ca0d7579f)
+}
+
+var fileDescriptor_pipeline_816af56ca0d7579f = []byte{
+	// 1535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0xbb, 0xf9, 0xb3, 0xfb, 0x36, 0x7f, 0xca, 0x34, 0x6d, 0xb6, 0x4e, 0xda, 0x24, 0x4e,
+	0x94, 0xa6, 0xa1, 0xdd, 0x6d, 0x1a, 0x8a, 0xda, 0xa0, 0x22, 0x35, 0xa4, 0xaa, 0x4a, 0x8b, 0x54,
+	0x39, 0x2d, 0x87, 0x72, 0x58, 0xcd, 0xda, 0x2f, 0x1b, 0x13, 0xaf, 0x6d, 0x3c, 0xb3, 0x29, 0x69,
+	0x55, 0x09, 0x15, 0x90, 0x2a, 0x71, 0x83, 0x03, 0x12, 0x42, 0x48, 0xdc, 0x11, 0x5f, 0x81, 0x03,
+	0x47, 0x8e, 0x7c, 0x05, 0x3e, 0x08, 0xf2, 0x78, 0xec, 0xb5, 0xbd, 0xf6, 0x26, 0x11, 0x3d, 0x65,
+	0xe7, 0xcd, 0x9b, 0x79, 0xbf, 0xf7, 0x77, 0x7e, 0x0e, 0xa8, 0x6d, 0x6a, 0x1c, 0xa0, 0x63, 0x36,
+	0xa9, 0x67, 0x35, 0x3d, 0xcb, 0x43, 0xdb, 0x72, 0xb0, 0xe1, 0xf9, 0x2e, 0x77, 0x49, 0x99, 0x7a,
+	0x96, 0x3a, 0xdf, 0x71, 0xdd, 0x8e, 0x8d, 0x62, 0x9f, 0x3a, 0x8e, 0xcb, 0x29, 0xb7, 0x5c, 0x87,
+	0x85, 0x2a, 0xea, 0x82, 0xdc, 0x15, 0xab, 0x76, 0x6f, 0xaf, 0xc9, 0xad, 0x2e, 0x32, 0x4e, 0xbb,
+	0x9e, 0x54, 0x98, 0xcb, 0x2a, 0x60, 0xd7, 0xe3, 0x47, 0x72, 0x73, 0x36, 0x69, 0x1c, 0x7d, 0xdf,
+	0xf5, 0xa3, 0x53, 0x29, 0x54, 0xd4, 0xa7, 0x5d, 0xe4, 0x18, 0x6d, 0x2e, 0xe4, 0x41, 0x6e, 0x31,
+	0x0f, 0x0d, 0xa9, 0xb0, 0x92, 0x54, 0xf0, 0x91, 0xb9, 0x3d, 0xdf, 0xc0, 0x96, 0x8f, 0x7b, 0xe8,
+	0xa3, 0x63, 0x48, 0xef, 0xd4, 0xab, 0xe2, 0x8f, 0x71, 0xad, 0x83, 0xce, 0x35, 0xf6, 0x9c, 0x76,
+	0x3a, 0xe8, 0x37, 0x5d, 0x4f, 0x38, 0x37, 0xe8, 0xa8, 0xb6, 0x06, 0xe5, 0xa7, 0xbe, 0x4d, 0x96,
+	0x60, 0x22, 0xb6, 0xd8, 0xf3, 0xed, 0xba, 0xb2, 0xa8, 0xac, 0x55, 0xf5, 0x5a, 0x24, 0x7b, 0xea,
+	0xdb, 0xda, 0x36, 0x9c, 0xfb, 0xd8, 0x47, 0xca, 0xf1, 0xb1, 0x14, 0xea, 0xf8, 0x65, 0x0f, 0x19,
+	0x27, 0x57, 0xa0, 0x12, 0xe9, 0x89, 0x73, 0xb5, 0x1b, 0x93, 0x0d, 0xea, 0x59, 0x8d, 0x58, 0x2f,
+	0xde, 0xd6, 0x56, 0x80, 0xdc, 0x47, 0x9e, 0xbd, 0x60, 0x0a, 0x4a, 0x96, 0x29, 0x4d, 0x96, 0x2c,
+	0x53, 0xfb, 0x46, 0x81, 0x99, 0x47, 0x16, 0x8b, 0xf5, 0x58, 0xa4, 0x78, 0x11, 0xc0, 0xa3, 0x1d,
+	0x6c, 0x71, 0xf7, 0x00, 0x1d, 0x79, 0xa0, 0x1a, 0x48, 0x9e, 0x04, 0x02, 0x32, 0x07, 0x62, 0xd1,
+	0x62, 0xd6, 0x0b, 0xac, 0x97, 0x16, 0x95, 0xb5, 0x51, 0xbd, 0x12, 0x08, 0x76, 0xad, 0x17, 0x48,
+	0x66, 0x61, 0x9c, 0xb9, 0x3e, 0x6f, 0xb5, 0x8f, 0xea, 0x65, 0x71, 0x70, 0x2c, 0x58, 0x6e, 0x1f,
+	0x91, 0xf3, 0x30, 0xb6, 0x67, 0xd9, 0x1c, 0xfd, 0xfa, 0x48, 0x28, 0x0f, 0x57, 0xda, 0xf7, 0x0a,
+	0x9c, 0xcb, 0xa0, 0x60, 0x9e, 0xeb, 0x30, 0x24, 0xef, 0x41, 0x35, 0xf2, 0x88, 0xd5, 0x95, 0xc5,
+	0xf2, 0xa0, 0xc7, 0xfd, 0xfd, 0x00, 0x33, 0x77, 0x39, 0xb5, 0x43, 0x54, 0x65, 0x81, 0xaa, 0x2a,
+	0x24, 0x02, 0xd6, 0x2a, 0x4c, 0x3b, 0xf8, 0x15, 0x6f, 0x25, 0xfc, 0x2a, 0x09, 0x18, 0x93, 0x81,
+	0xf8, 0x71, 0xe4, 0x9b, 0x76, 0x19, 0xce, 0xed, 0xa0, 0x8d, 0x1c, 0x8f, 0x0b, 0x5e, 0x18, 0xe2,
+	0x27, 0xd8, 0xf5, 0x6c, 0xca, 0x0b, 0xb5, 0x36, 0xe0, 0x6c, 0x4a, 0x4b, 0x7a, 0xa6, 0x42, 0x85,
+	0x4b, 0x99, 0x54, 0x8e, 0xd7, 0xda, 0x36, 0x2c, 0x25, 0x72, 0xf7, 0x19, 0xfa, 0xcc, 0x72, 0x9d,
+	0xac, 0x9d, 0x8b, 0x00, 0x87, 0xe1, 0x4e, 0x2b, 0xb6, 0x57, 0x95, 0x92, 0x07, 0xa6, 0xb6, 0x0b,
+	0x97, 0x06, 0xef, 0xb8, 0xcb, 0x18, 0xf2, 0x93, 0x5d, 0x40, 0x08, 0x8c, 0x78, 0x94, 0xef, 0xcb,
+	0x18, 0x89, 0xdf, 0xda, 0x4d, 0x58, 0x28, 0xbc, 0x54, 0xfa, 0x45, 0x60, 0xc4, 0xa4, 0x9c, 0x8a,
+	0xfb, 0x26, 0x74, 0xf1, 0x5b, 0xf3, 0x60, 0x3e, 0x5d, 0xcf, 0xf2, 0x64, 0x84, 0xa4, 0x01, 0xe3,
+	0xd2, 0xae, 0xac, 0xea, 0x99, 0x54, 0x8e, 0x23, 0xed, 0x48, 0x89, 0x2c, 0x42, 0xcd, 0x44, 0xb3,
+	0xe7, 0xd9, 0x96, 0x11, 0x84, 0x2f, 0x40, 0x58, 0xd1, 0x93, 0x22, 0x6d, 0x0b, 0x2e, 0x0c, 0x02,
+	0x3d, 0x61, 0xe4, 0xfe, 0x54, 0x60, 0x2e, 0x59, 0x8d, 0xf2, 0x74, 0xdc, 0x1a, 0x9b, 0x30, 0x11,
+	0x4f, 0x84, 0x03, 0x3c, 0x92, 0x90, 0xcf, 0x08, 0xc8, 0xba, 0xdc, 0x78, 0x88, 0x47, 0x7a, 0xcd,
+	0xef, 0x2f, 0x86, 0x37, 0x4c, 0xba, 0xd9, 0xca, 0xd9, 0x66, 0x4b, 0xf4, 0xd3, 0x48, 0x41, 0x3f,
+	0x8d, 0xa6, 0xfa, 0xe9, 0x27, 0x05, 0xe6, 0xf3, 0x3d, 0x90, 0x49, 0xba, 0x0e, 0x15, 0xe9, 0x6f,
+	0xd4, 0x55, 0xf9, 0x11, 0x8f, 0xb5, 0x4e, 0xda, 0x3c, 0xc7, 0xf4, 0xa0, 0x76, 0x07, 0xe6, 0xd3,
+	0xbd, 0x75, 0xba, 0xd4, 0xfc, 0xa1, 0xc0, 0xdc, 0x53, 0xcf, 0x4c, 0x54, 0xd2, 0x23, 0xda, 0x46,
+	0x9b, 0x15, 0xf4, 0x1e, 0xd9, 0x81, 0x31, 0x5b, 0x28, 0xd4, 0x4b, 0xc2, 0xcb, 0xab, 0xc2, 0xcb,
+	0x21, 0x37, 0x34, 0xc2, 0xd5, 0x3d, 0x87, 0xfb, 0x47, 0xba, 0x3c, 0xab, 0xde, 0x86, 0x5a, 0x42,
+	0x4c, 0xce, 0x40, 0x39, 0x4a, 0x7b, 0x55, 0x0f, 0x7e, 0x92, 0x19, 0x18, 0x3d, 0xa4, 0x76, 0x0f,
+	0x65, 0x48, 0xc2, 0xc5, 0x56, 0xe9, 0x96, 0xa2, 0xfd, 0xad, 0x80, 0x96, 0x36, 0x27, 0x1d, 0x4e,
+	0xe3, 0x3e, 0xa6, 0x15, 0x1f, 0x66, 0xdc, 0xd8, 0xcc, 0x71, 0x23, 0xef, 0xde, 0xb7, 0xed, 0x0d,
+	0xc2, 0x72, 0xda, 0xe8, 0x0e, 0xee, 0xd1, 0x9e, 0xcd, 0x33, 0x49, 0x5c, 0x80, 0xf8, 0x35, 0xeb,
+	0xbb, 0x03, 0x91, 0xe8, 0x81, 0x99, 0x71, 0xb7, 0x94, 0xcd, 0xf2, 0xcf, 0x65, 0xa8, 0x44, 0x16,
+	0x06, 0x52, 0x7a, 0x1b, 0xc0, 0x10, 0xb3, 0xc4, 0x6c, 0x51, 0x2e, 0xce, 0xd6, 0x6e, 0xa8, 0x8d,
+	0x90, 0x22, 0x34, 0x22, 0x8a, 0xd0, 0x78, 0x12, 0x71, 0x08, 0xbd, 0x2a, 0xb5, 0xef, 0xf2, 0x60,
+	0x34, 0x39, 0xb4, 0x8b, 0xb2, 0xc1, 0xc4, 0xef, 0x70, 0x94, 0x30, 0xc3, 0xb7, 0xc4, 0xb3, 0x2d,
+	0xfb, 0x2b, 0x29, 0x22, 0x0d, 0x80, 0x98, 0x3e, 0xb0, 0xfa, 0xa8, 0x48, 0xc0, 0x54, 0xd8, 0x2d,
+	0x91, 0x58, 0x4f, 0x68, 0x10, 0x15, 0xca, 0xc1, 0xb3, 0x3e, 0x2e, 0x90, 0x55, 0xc2, 0x4c, 0xf9,
+	0xb6, 0x1e, 0x08, 0x83, 0xd0, 0x0a, 0x8e, 0x52, 0x1f, 0x0b, 0x43, 0x2b, 0x16, 0xe4, 0x0e, 0x4c,
+	0x9b, 0x61, 0x20, 0x5b, 0xd1, 0x18, 0xac, 0x0c, 0x19, 0x83, 0x53, 0x66, 0x2a, 0xea, 0x64, 0x23,
+	0xae, 0x8e, 0xaa, 0x00, 0x77, 0x21, 0x75, 0xea, 0x6d, 0xd7, 0xc0, 0x9b, 0x11, 0x98, 0xce, 0x20,
+	0x1a, 0xc8, 0x51, 0x14, 0xe8, 0x52, 0x22, 0xd0, 0xe9, 0xbc, 0x95, 0x4f, 0x93, 0xb7, 0x74, 0x06,
+	0x46, 0x8e, 0xcd, 0xc0, 0x2a, 0x4c, 0x1b, 0xae, 0x89, 0x2d, 0x39, 0xa3, 0x83, 0x6c, 0x84, 0xf3,
+	0x71, 0x32, 0x10, 0xef, 0x0a, 0x69, 0xc0, 0xc4, 0xae, 0x40, 0xcd, 0xa3, 0xc6, 0x01, 0xed, 0x84,
+	0x3a, 0x63, 0x99, 0x8c, 0x81, 0xdc, 0x0c, 0x54, 0xef, 0xc3, 0xd9, 0x41, 0x16, 0xc8, 0xea, 0xe3,
+	0x02, 0xcb, 0xf9, 0xd4, 0xe8, 0xd7, 0xa3, 0x6d, 0x9d, 0xf8, 0x59, 0x11, 0x23, 0xb7, 0xe2, 0x64,
+	0x55, 0xc4, 0xd9, 0xc5, 0xbc, 0x14, 0xe7, 0xe5, 0x2c, 0x18, 0xf6, 0xa6, 0xd5, 0x41, 0xc6, 0xeb,
+	0xd5, 0x70, 0xd8, 0x87, 0x2b, 0x72, 0x07, 0xce, 0xc4, 0xbe, 0xb7, 0x98, 0xb1, 0x8f, 0x5d, 0x5a,
+	0x07, 0x71, 0x37, 0x49, 0xc7, 0x68, 0xd7, 0x43, 0x43, 0x9f, 0x8e, 0x75, 0x77, 0x85, 0xea, 0xff,
+	0x28, 0x85, 0x1b, 0x7f, 0x4d, 0xf5, 0x4b, 0x61, 0x17, 0xfd, 0x43, 0xcb, 0x40, 0xb2, 0x07, 0x53,
+	0xe9, 0xa7, 0x9e, 0xa8, 0x02, 0x45, 0x2e, 0x9f, 0x55, 0xd3, 0x5c, 0x4e, 0xbb, 0xf2, 0xfa, 0x9f,
+	0x7f, 0x7f, 0x2c, 0x2d, 0x6b, 0xb3, 0x01, 0xed, 0x66, 0xcd, 0xc3, 0x8d, 0x36, 0x72, 0xba, 0x11,
+	0x13, 0x74, 0xb6, 0x15, 0xd3, 0x5b, 0xf2, 0x39, 0xd4, 0x12, 0x0f, 0x3c, 0x99, 0x15, 0x17, 0x0d,
+	0x12, 0xde, 0xac, 0x85, 0x15, 0x61, 0xe1, 0x12, 0x99, 0x2f, 0xb0, 0xd0, 0x7c, 0x69, 0x99, 0xaf,
+	0x48, 0x07, 0x26, 0x53, 0x74, 0x94, 0x84, 0x2d, 0x95, 0x47, 0x94, 0x55, 0x35, 0x6f, 0x2b, 0x7c,
+	0x66, 0xb5, 0x05, 0x61, 0xed, 0x02, 0x29, 0xf2, 0x87, 0x7c, 0x01, 0x53, 0xe9, 0xe7, 0x50, 0x46,
+	0x2b, 0x97, 0x7f, 0xaa, 0xe7, 0x07, 0xda, 0xe5, 0x5e, 0xf0, 0x25, 0x14, 0x39, 0xb5, 0x3e, 0xdc,
+	0x29, 0x4f, 0x44, 0x2c, 0x62, 0x91, 0xfd, 0x88, 0x65, 0x78, 0xa5, 0x5a, 0x1f, 0xdc, 0x90, 0xee,
+	0x34, 0x84, 0x9d, 0x35, 0xb2, 0x3a, 0xcc, 0x4e, 0x33, 0x62, 0xb1, 0x8c, 0xbc, 0x56, 0xb2, 0xdf,
+	0x31, 0xd1, 0xc0, 0x58, 0xca, 0xa9, 0x89, 0xf4, 0x23, 0xa2, 0xe6, 0xce, 0x3e, 0xed, 0xba, 0x80,
+	0xb0, 0xae, 0x2d, 0xe4, 0x43, 0x88, 0xe6, 0x27, 0xdb, 0x8a, 0xb9, 0xe2, 0xd7, 0x4a, 0xea, 0x43,
+	0x28, 0x42, 0x70, 0x29, 0x5b, 0x30, 0x27, 0x32, 0xff, 0xbe, 0x30, 0xdf, 0x20, 0x57, 0x8f, 0x31,
+	0xdf, 0x7c, 0xd9, 0x7f, 0xdf, 0x5e, 0x91, 0x6f, 0x33, 0x1f, 0x59, 0xf2, 0x36, 0x46, 0x16, 0x07,
+	0x6a, 0x27, 0xc3, 0x35, 0xd5, 0xa5, 0x21, 0x1a, 0x32, 0x2b, 0x97, 0x05, 0xa6, 0x25, 0x72, 0x5c,
+	0x48, 0xc8, 0x1b, 0x25, 0xfb, 0x61, 0x93, 0x4e, 0xc7, 0x30, 0x62, 0x56, 0x58, 0x7b, 0x32, 0x22,
+	0xeb, 0xa7, 0x8b, 0xc8, 0xaf, 0x0a, 0xa8, 0xc5, 0x5f, 0x38, 0x64, 0xb5, 0x20, 0x39, 0x27, 0x2f,
+	0xd5, 0x8f, 0x04, 0xac, 0x5b, 0xe4, 0x83, 0xd3, 0xc0, 0x4a, 0x94, 0xee, 0x6f, 0x0a, 0xcc, 0x16,
+	0x7c, 0xe9, 0x90, 0xe5, 0x02, 0x74, 0xc9, 0x8f, 0x2b, 0x75, 0x65, 0xb8, 0x92, 0x84, 0xf9, 0xa1,
+	0x80, 0x79, 0x93, 0x6c, 0x9e, 0x0a, 0x26, 0x0d, 0xee, 0x60, 0xe4, 0x39, 0xcc, 0xe4, 0x31, 0x59,
+	0x59, 0x55, 0x43, 0x48, 0x6e, 0x76, 0x28, 0xca, 0xbe, 0x56, 0x97, 0x87, 0xf6, 0x75, 0xf8, 0x0c,
+	0x6d, 0x29, 0xeb, 0xe4, 0x97, 0x01, 0x16, 0x9e, 0x22, 0x9f, 0xe4, 0xf2, 0x09, 0xe9, 0x69, 0x41,
+	0x93, 0xc9, 0xdc, 0xa9, 0xa7, 0x0b, 0x4a, 0x1f, 0xde, 0xef, 0x0a, 0xcc, 0x0f, 0xa3, 0xa9, 0x64,
+	0x2d, 0x07, 0x5f, 0x2e, 0x93, 0x2d, 0xac, 0xfa, 0x4f, 0x04, 0xc4, 0x1d, 0x6d, 0xbb, 0x30, 0x62,
+	0x09, 0x02, 0xfc, 0xaa, 0x99, 0x61, 0x77, 0x29, 0xe0, 0xdb, 0xdf, 0x29, 0x3f, 0xdc, 0xfd, 0x54,
+	0x9f, 0x87, 0x71, 0xa9, 0x45, 0xde, 0x25, 0xd3, 0x30, 0xa9, 0xd6, 0x04, 0xc8, 0x5d, 0x4e, 0x79,
+	0x8f, 0x3d, 0x5b, 0x80, 0x8b, 0x30, 0xb6, 0x8d, 0xd4, 0x47, 0x9f, 0x9c, 0xad, 0x94, 0xd4, 0x49,
+	0xda, 0xe3, 0xfb, 0xae, 0x6f, 0xbd, 0x10, 0xff, 0x60, 0x5a, 0x2c, 0xb5, 0x27, 0x00, 0x62, 0x85,
+	0x77, 0x9e, 0x6d, 0x76, 0x2c, 0xbe, 0xdf, 0x6b, 0x37, 0x0c, 0xb7, 0xdb, 0x3c, 0xe8, 0xb5, 0x71,
+	0xcf, 0x76, 0x9f, 0x27, 0xc0, 0x25, 0xff, 0xb7, 0xd5, 0x71, 0x5b, 0x86, 0x6d, 0xa1, 0xc3, 0xdb,
+	0x63, 0xc2, 0xc7, 0xcd, 0xff, 0x06, 0x00, 0x05, 0x5c, 0xda, 0x4e, 0xcf, 0x13, 0x00, 0x00,
 }
@@ -38,6 +38,7 @@ type PipelineSpec struct {
 	WorkflowManifest     string       `protobuf:"bytes,2,opt,name=workflow_manifest,json=workflowManifest,proto3" json:"workflow_manifest,omitempty"`
 	PipelineManifest     string       `protobuf:"bytes,3,opt,name=pipeline_manifest,json=pipelineManifest,proto3" json:"pipeline_manifest,omitempty"`
 	Parameters           []*Parameter `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	WorkflowDigest       string       `protobuf:"bytes,6,opt,name=workflow_digest,json=workflowDigest,proto3" json:"workflow_digest,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -47,7 +48,7 @@ func (m *PipelineSpec) Reset()         { *m = PipelineSpec{} }
 func (m *PipelineSpec) String() string { return proto.CompactTextString(m) }
 func (*PipelineSpec) ProtoMessage()    {}
 func (*PipelineSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pipeline_spec_ab99afe9ca6994cc, []int{0}
+	return fileDescriptor_pipeline_spec_4fc51490e9f632ec, []int{0}
 }
 func (m *PipelineSpec) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PipelineSpec.Unmarshal(m, b)
@@ -102,29 +103,37 @@ func (m *PipelineSpec) GetParameters() []*Parameter {
 	return nil
 }
 
+func (m *PipelineSpec) GetWorkflowDigest() string {
+	if m != nil {
+		return m.WorkflowDigest
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*PipelineSpec)(nil), "api.PipelineSpec")
 }
 
 func init() {
-	proto.RegisterFile("backend/api/pipeline_spec.proto", fileDescriptor_pipeline_spec_ab99afe9ca6994cc)
-}
-
-var fileDescriptor_pipeline_spec_ab99afe9ca6994cc = []byte{
-	// 236 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0xd0, 0xc1, 0x4a, 0xc3, 0x40,
-	0x10, 0x06, 0x60, 0x62, 0x54, 0x70, 0x5a, 0x45, 0x73, 0x0a, 0x7a, 0x68, 0xd1, 0x4b, 0x41, 0xd8,
-	0x80, 0xc5, 0x17, 0xf0, 0xe6, 0x41, 0x29, 0xf5, 0xe6, 0x25, 0x4c, 0x36, 0xd3, 0x3a, 0x24, 0xbb,
-	0x3b, 0x24, 0x5b, 0xfa, 0xb6, 0x3e, 0x8b, 0x34, 0xed, 0x2e, 0xf1, 0xfa, 0xcf, 0xb7, 0xb3, 0xcc,
-	0x0f, 0xb3, 0x0a, 0x75, 0x43, 0xb6, 0x2e, 0x50, 0xb8, 0x10, 0x16, 0x6a, 0xd9, 0x52, 0xd9, 0x0b,
-	0x69, 0x25, 0x9d, 0xf3, 0x2e, 0x4b, 0x51, 0xf8, 0xfe, 0xe1, 0x9f, 0xc2, 0x0e, 0x0d, 0x79, 0xea,
-	0x8e, 0xe2, 0xf1, 0x37, 0x81, 0xe9, 0xea, 0xf4, 0xf2, 0x4b, 0x48, 0x67, 0x33, 0x98, 0xc4, 0x4d,
-	0x5c, 0xe7, 0xc9, 0x3c, 0x59, 0x5c, 0xad, 0x21, 0x44, 0xef, 0x75, 0xf6, 0x04, 0xd7, 0x11, 0x58,
-	0x34, 0x94, 0x5f, 0x0c, 0x64, 0x1a, 0xc2, 0x4f, 0x34, 0x94, 0x3d, 0xc3, 0xdd, 0xde, 0x75, 0xcd,
-	0xa6, 0x75, 0xfb, 0xd2, 0xa0, 0xe5, 0x0d, 0xf5, 0x3e, 0x3f, 0x1b, 0xe0, 0x6d, 0x18, 0x7c, 0x9c,
-	0xf2, 0x03, 0x8e, 0x1b, 0x23, 0x4e, 0x8f, 0x38, 0x0c, 0x22, 0x56, 0x00, 0xf1, 0x86, 0x3e, 0x3f,
-	0x9f, 0xa7, 0x8b, 0xc9, 0xcb, 0x8d, 0x42, 0x61, 0xb5, 0x0a, 0xf1, 0x7a, 0x24, 0xde, 0x5e, 0xbf,
-	0x97, 0x5b, 0xf6, 0x3f, 0xbb, 0x4a, 0x69, 0x67, 0x8a, 0x66, 0x57, 0xd1, 0xe1, 0xef, 0xd8, 0x56,
-	0x5f, 0x8c, 0xdb, 0xd9, 0xba, 0x52, 0xb7, 0x4c, 0xd6, 0x57, 0x97, 0x43, 0x3d, 0xcb, 0xbf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xeb, 0x6c, 0x41, 0xaf, 0x63, 0x01, 0x00, 0x00,
+	proto.RegisterFile("backend/api/pipeline_spec.proto", fileDescriptor_pipeline_spec_4fc51490e9f632ec)
+}
+
+var fileDescriptor_pipeline_spec_4fc51490e9f632ec = []byte{
+	// 250 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xcd, 0x4a, 0xc3, 0x40,
+	0x14, 0x85, 0x69, 0xa3, 0x05, 0xa7, 0xb5, 0xea, 0xac, 0x82, 0x2e, 0x5a, 0x74, 0x61, 0x41, 0x98,
+	0x80, 0xc5, 0x17, 0x10, 0x37, 0x2e, 0x94, 0x52, 0x77, 0x6e, 0xc2, 0xcd, 0xe4, 0x36, 0x5e, 0x92,
+	0xf9, 0x21, 0x99, 0xd2, 0x47, 0xf0, 0xb5, 0x25, 0xd3, 0xce, 0x10, 0xb7, 0xdf, 0xf9, 0xe6, 0x30,
+	0xf7, 0xb0, 0x45, 0x01, 0xb2, 0x46, 0x5d, 0x66, 0x60, 0x29, 0xb3, 0x64, 0xb1, 0x21, 0x8d, 0x79,
+	0x67, 0x51, 0x0a, 0xdb, 0x1a, 0x67, 0x78, 0x02, 0x96, 0x6e, 0xef, 0xfe, 0x59, 0xd0, 0x82, 0x42,
+	0x87, 0xed, 0xd1, 0xb8, 0xff, 0x1d, 0xb3, 0xd9, 0xe6, 0xf4, 0xf2, 0xcb, 0xa2, 0xe4, 0x0b, 0x36,
+	0x8d, 0x4d, 0x54, 0xa6, 0xa3, 0xe5, 0x68, 0x75, 0xb1, 0x65, 0x01, 0xbd, 0x97, 0xfc, 0x81, 0x5d,
+	0x46, 0x41, 0x83, 0xc2, 0xf4, 0xdc, 0x2b, 0xb3, 0x00, 0x3f, 0x41, 0x21, 0x7f, 0x62, 0x37, 0x07,
+	0xd3, 0xd6, 0xbb, 0xc6, 0x1c, 0x72, 0x05, 0x9a, 0x76, 0xd8, 0xb9, 0x74, 0xec, 0xc5, 0xeb, 0x10,
+	0x7c, 0x9c, 0x78, 0x2f, 0xc7, 0xc6, 0x28, 0x27, 0x47, 0x39, 0x04, 0x51, 0x16, 0x8c, 0xc5, 0x1b,
+	0xba, 0xf4, 0x6c, 0x99, 0xac, 0xa6, 0xcf, 0x73, 0x01, 0x96, 0xc4, 0x26, 0xe0, 0xed, 0xc0, 0xe0,
+	0x8f, 0xec, 0x2a, 0xfe, 0xa4, 0xa4, 0xaa, 0xaf, 0x9e, 0xf8, 0xea, 0x79, 0xc0, 0x6f, 0x9e, 0xbe,
+	0xbe, 0x7c, 0xaf, 0x2b, 0x72, 0x3f, 0xfb, 0x42, 0x48, 0xa3, 0xb2, 0x7a, 0x5f, 0x60, 0x1f, 0xc6,
+	0x59, 0xbb, 0x6c, 0x38, 0x63, 0x65, 0x72, 0xd9, 0x10, 0x6a, 0x57, 0x4c, 0xfc, 0x8e, 0xeb, 0xbf,
+	0x01, 0x00, 0x66, 0x42, 0xfa, 0x3f, 0x8c, 0x01, 0x00, 0x00,
 }
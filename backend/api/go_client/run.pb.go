@@ -41,6 +41,29 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type CompareRunsRequest_ExportFormat int32
+
+const (
+	CompareRunsRequest_JSON CompareRunsRequest_ExportFormat = 0
+	CompareRunsRequest_CSV  CompareRunsRequest_ExportFormat = 1
+)
+
+var CompareRunsRequest_ExportFormat_name = map[int32]string{
+	0: "JSON",
+	1: "CSV",
+}
+var CompareRunsRequest_ExportFormat_value = map[string]int32{
+	"JSON": 0,
+	"CSV":  1,
+}
+
+func (x CompareRunsRequest_ExportFormat) String() string {
+	return proto.EnumName(CompareRunsRequest_ExportFormat_name, int32(x))
+}
+func (CompareRunsRequest_ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_run_b316c66a05f400d2, []int{3, 0}
+}
+
 type Run_StorageState int32
 
 const (
@@ -61,7 +84,7 @@ func (x Run_StorageState) String() string {
 	return proto.EnumName(Run_StorageState_name, int32(x))
 }
 func (Run_StorageState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{9, 0}
+	return fileDescriptor_run_b316c66a05f400d2, []int{12, 0}
 }
 
 type RunMetric_Format int32
@@ -87,7 +110,33 @@ func (x RunMetric_Format) String() string {
 	return proto.EnumName(RunMetric_Format_name, int32(x))
 }
 func (RunMetric_Format) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{12, 0}
+	return fileDescriptor_run_b316c66a05f400d2, []int{15, 0}
+}
+
+type RunMetric_SeriesSummary int32
+
+const (
+	RunMetric_LAST RunMetric_SeriesSummary = 0
+	RunMetric_MIN  RunMetric_SeriesSummary = 1
+	RunMetric_MAX  RunMetric_SeriesSummary = 2
+)
+
+var RunMetric_SeriesSummary_name = map[int32]string{
+	0: "LAST",
+	1: "MIN",
+	2: "MAX",
+}
+var RunMetric_SeriesSummary_value = map[string]int32{
+	"LAST": 0,
+	"MIN":  1,
+	"MAX":  2,
+}
+
+func (x RunMetric_SeriesSummary) String() string {
+	return proto.EnumName(RunMetric_SeriesSummary_name, int32(x))
+}
+func (RunMetric_SeriesSummary) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_run_b316c66a05f400d2, []int{15, 1}
 }
 
 type ReportRunMetricsResponse_ReportRunMetricResult_Status int32
@@ -98,6 +147,7 @@ const (
 	ReportRunMetricsResponse_ReportRunMetricResult_INVALID_ARGUMENT    ReportRunMetricsResponse_ReportRunMetricResult_Status = 2
 	ReportRunMetricsResponse_ReportRunMetricResult_DUPLICATE_REPORTING ReportRunMetricsResponse_ReportRunMetricResult_Status = 3
 	ReportRunMetricsResponse_ReportRunMetricResult_INTERNAL_ERROR      ReportRunMetricsResponse_ReportRunMetricResult_Status = 4
+	ReportRunMetricsResponse_ReportRunMetricResult_RESOURCE_EXHAUSTED  ReportRunMetricsResponse_ReportRunMetricResult_Status = 5
 )
 
 var ReportRunMetricsResponse_ReportRunMetricResult_Status_name = map[int32]string{
@@ -106,6 +156,7 @@ var ReportRunMetricsResponse_ReportRunMetricResult_Status_name = map[int32]strin
 	2: "INVALID_ARGUMENT",
 	3: "DUPLICATE_REPORTING",
 	4: "INTERNAL_ERROR",
+	5: "RESOURCE_EXHAUSTED",
 }
 var ReportRunMetricsResponse_ReportRunMetricResult_Status_value = map[string]int32{
 	"UNSPECIFIED":         0,
@@ -113,13 +164,40 @@ var ReportRunMetricsResponse_ReportRunMetricResult_Status_value = map[string]int
 	"INVALID_ARGUMENT":    2,
 	"DUPLICATE_REPORTING": 3,
 	"INTERNAL_ERROR":      4,
+	"RESOURCE_EXHAUSTED":  5,
 }
 
 func (x ReportRunMetricsResponse_ReportRunMetricResult_Status) String() string {
 	return proto.EnumName(ReportRunMetricsResponse_ReportRunMetricResult_Status_name, int32(x))
 }
 func (ReportRunMetricsResponse_ReportRunMetricResult_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{14, 0, 0}
+	return fileDescriptor_run_b316c66a05f400d2, []int{18, 0, 0}
+}
+
+type RunArtifact_Type int32
+
+const (
+	RunArtifact_UNSPECIFIED RunArtifact_Type = 0
+	RunArtifact_INPUT       RunArtifact_Type = 1
+	RunArtifact_OUTPUT      RunArtifact_Type = 2
+)
+
+var RunArtifact_Type_name = map[int32]string{
+	0: "UNSPECIFIED",
+	1: "INPUT",
+	2: "OUTPUT",
+}
+var RunArtifact_Type_value = map[string]int32{
+	"UNSPECIFIED": 0,
+	"INPUT":       1,
+	"OUTPUT":      2,
+}
+
+func (x RunArtifact_Type) String() string {
+	return proto.EnumName(RunArtifact_Type_name, int32(x))
+}
+func (RunArtifact_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_run_b316c66a05f400d2, []int{24, 0}
 }
 
 type CreateRunRequest struct {
@@ -133,7 +211,7 @@ func (m *CreateRunRequest) Reset()         { *m = CreateRunRequest{} }
 func (m *CreateRunRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRunRequest) ProtoMessage()    {}
 func (*CreateRunRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{0}
+	return fileDescriptor_run_b316c66a05f400d2, []int{0}
 }
 func (m *CreateRunRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRunRequest.Unmarshal(m, b)
@@ -171,7 +249,7 @@ func (m *GetRunRequest) Reset()         { *m = GetRunRequest{} }
 func (m *GetRunRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRunRequest) ProtoMessage()    {}
 func (*GetRunRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{1}
+	return fileDescriptor_run_b316c66a05f400d2, []int{1}
 }
 func (m *GetRunRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRunRequest.Unmarshal(m, b)
@@ -213,7 +291,7 @@ func (m *ListRunsRequest) Reset()         { *m = ListRunsRequest{} }
 func (m *ListRunsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRunsRequest) ProtoMessage()    {}
 func (*ListRunsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{2}
+	return fileDescriptor_run_b316c66a05f400d2, []int{2}
 }
 func (m *ListRunsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListRunsRequest.Unmarshal(m, b)
@@ -268,6 +346,232 @@ func (m *ListRunsRequest) GetFilter() string {
 	return ""
 }
 
+type CompareRunsRequest struct {
+	RunIds               []string                        `protobuf:"bytes,1,rep,name=run_ids,json=runIds,proto3" json:"run_ids,omitempty"`
+	ExperimentId         string                          `protobuf:"bytes,2,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+	Filter               string                          `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Format               CompareRunsRequest_ExportFormat `protobuf:"varint,4,opt,name=format,proto3,enum=api.CompareRunsRequest_ExportFormat" json:"format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *CompareRunsRequest) Reset()         { *m = CompareRunsRequest{} }
+func (m *CompareRunsRequest) String() string { return proto.CompactTextString(m) }
+func (*CompareRunsRequest) ProtoMessage()    {}
+func (*CompareRunsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_run_b316c66a05f400d2, []int{3}
+}
+func (m *CompareRunsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompareRunsRequest.Unmarshal(m, b)
+}
+func (m *CompareRunsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompareRunsRequest.Marshal(b, m, deterministic)
+}
+func (dst *CompareRunsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompareRunsRequest.Merge(dst, src)
+}
+func (m *CompareRunsRequest) XXX_Size() int {
+	return xxx_messageInfo_CompareRunsRequest.Size(m)
+}
+func (m *CompareRunsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompareRunsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompareRunsRequest proto.InternalMessageInfo
+
+func (m *CompareRunsRequest) GetRunIds() []string {
+	if m != nil {
+		return m.RunIds
+	}
+	return nil
+}
+
+func (m *CompareRunsRequest) GetExperimentId() string {
+	if m != nil {
+		return m.ExperimentId
+	}
+	return ""
+}
+
+func (m *CompareRunsRequest) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
+func (m *CompareRunsRequest) GetFormat() CompareRunsRequest_ExportFormat {
+	if m != nil {
+		return m.Format
+	}
+	return CompareRunsRequest_JSON
+}
+
+type RunComparison struct {
+	RunId                string               `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status               string               `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DurationSeconds      int64                `protobuf:"varint,5,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	PipelineVersionId    string               `protobuf:"bytes,6,opt,name=pipeline_version_id,json=pipelineVersionId,proto3" json:"pipeline_version_id,omitempty"`
+	PipelineVersionName  string               `protobuf:"bytes,7,opt,name=pipeline_version_name,json=pipelineVersionName,proto3" json:"pipeline_version_name,omitempty"`
+	Parameters           map[string]string    `protobuf:"bytes,8,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Metrics              map[string]float64   `protobuf:"bytes,9,rep,name=metrics,proto3" json:"metrics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RunComparison) Reset()         { *m = RunComparison{} }
+func (m *RunComparison) String() string { return proto.CompactTextString(m) }
+func (*RunComparison) ProtoMessage()    {}
+func (*RunComparison) Descriptor() ([]byte, []int) {
+	return fileDescriptor_run_b316c66a05f400d2, []int{4}
+}
+func (m *RunComparison) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RunComparison.Unmarshal(m, b)
+}
+func (m *RunComparison) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RunComparison.Marshal(b, m, deterministic)
+}
+func (dst *RunComparison) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunComparison.Merge(dst, src)
+}
+func (m *RunComparison) XXX_Size() int {
+	return xxx_messageInfo_RunComparison.Size(m)
+}
+func (m *RunComparison) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunComparison.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunComparison proto.InternalMessageInfo
+
+func (m *RunComparison) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *RunComparison) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RunComparison) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *RunComparison) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *RunComparison) GetDurationSeconds() int64 {
+	if m != nil {
+		return m.DurationSeconds
+	}
+	return 0
+}
+
+func (m *RunComparison) GetPipelineVersionId() string {
+	if m != nil {
+		return m.PipelineVersionId
+	}
+	return ""
+}
+
+func (m *RunComparison) GetPipelineVersionName() string {
+	if m != nil {
+		return m.PipelineVersionName
+	}
+	return ""
+}
+
+func (m *RunComparison) GetParameters() map[string]string {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
+func (m *RunComparison) GetMetrics() map[string]float64 {
+	if m != nil {
+		return m.Metrics
+	}
+	return nil
+}
+
+type CompareRunsResponse struct {
+	ParameterNames       []string         `protobuf:"bytes,1,rep,name=parameter_names,json=parameterNames,proto3" json:"parameter_names,omitempty"`
+	MetricNames          []string         `protobuf:"bytes,2,rep,name=metric_names,json=metricNames,proto3" json:"metric_names,omitempty"`
+	Runs                 []*RunComparison `protobuf:"bytes,3,rep,name=runs,proto3" json:"runs,omitempty"`
+	TotalSize            int32            `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CompareRunsResponse) Reset()         { *m = CompareRunsResponse{} }
+func (m *CompareRunsResponse) String() string { return proto.CompactTextString(m) }
+func (*CompareRunsResponse) ProtoMessage()    {}
+func (*CompareRunsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_run_b316c66a05f400d2, []int{5}
+}
+func (m *CompareRunsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompareRunsResponse.Unmarshal(m, b)
+}
+func (m *CompareRunsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompareRunsResponse.Marshal(b, m, deterministic)
+}
+func (dst *CompareRunsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompareRunsResponse.Merge(dst, src)
+}
+func (m *CompareRunsResponse) XXX_Size() int {
+	return xxx_messageInfo_CompareRunsResponse.Size(m)
+}
+func (m *CompareRunsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompareRunsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompareRunsResponse proto.InternalMessageInfo
+
+func (m *CompareRunsResponse) GetParameterNames() []string {
+	if m != nil {
+		return m.ParameterNames
+	}
+	return nil
+}
+
+func (m *CompareRunsResponse) GetMetricNames() []string {
+	if m != nil {
+		return m.MetricNames
+	}
+	return nil
+}
+
+func (m *CompareRunsResponse) GetRuns() []*RunComparison {
+	if m != nil {
+		return m.Runs
+	}
+	return nil
+}
+
+func (m *CompareRunsResponse) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
 type TerminateRunRequest struct {
 	RunId                string   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -279,7 +583,7 @@ func (m *TerminateRunRequest) Reset()         { *m = TerminateRunRequest{} }
 func (m *TerminateRunRequest) String() string { return proto.CompactTextString(m) }
 func (*TerminateRunRequest) ProtoMessage()    {}
 func (*TerminateRunRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{3}
+	return fileDescriptor_run_b316c66a05f400d2, []int{6}
 }
 func (m *TerminateRunRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TerminateRunRequest.Unmarshal(m, b)
@@ -317,7 +621,7 @@ func (m *RetryRunRequest) Reset()         { *m = RetryRunRequest{} }
 func (m *RetryRunRequest) String() string { return proto.CompactTextString(m) }
 func (*RetryRunRequest) ProtoMessage()    {}
 func (*RetryRunRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{4}
+	return fileDescriptor_run_b316c66a05f400d2, []int{7}
 }
 func (m *RetryRunRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RetryRunRequest.Unmarshal(m, b)
@@ -357,7 +661,7 @@ func (m *ListRunsResponse) Reset()         { *m = ListRunsResponse{} }
 func (m *ListRunsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListRunsResponse) ProtoMessage()    {}
 func (*ListRunsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{5}
+	return fileDescriptor_run_b316c66a05f400d2, []int{8}
 }
 func (m *ListRunsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListRunsResponse.Unmarshal(m, b)
@@ -409,7 +713,7 @@ func (m *ArchiveRunRequest) Reset()         { *m = ArchiveRunRequest{} }
 func (m *ArchiveRunRequest) String() string { return proto.CompactTextString(m) }
 func (*ArchiveRunRequest) ProtoMessage()    {}
 func (*ArchiveRunRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{6}
+	return fileDescriptor_run_b316c66a05f400d2, []int{9}
 }
 func (m *ArchiveRunRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ArchiveRunRequest.Unmarshal(m, b)
@@ -447,7 +751,7 @@ func (m *UnarchiveRunRequest) Reset()         { *m = UnarchiveRunRequest{} }
 func (m *UnarchiveRunRequest) String() string { return proto.CompactTextString(m) }
 func (*UnarchiveRunRequest) ProtoMessage()    {}
 func (*UnarchiveRunRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{7}
+	return fileDescriptor_run_b316c66a05f400d2, []int{10}
 }
 func (m *UnarchiveRunRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnarchiveRunRequest.Unmarshal(m, b)
@@ -485,7 +789,7 @@ func (m *DeleteRunRequest) Reset()         { *m = DeleteRunRequest{} }
 func (m *DeleteRunRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRunRequest) ProtoMessage()    {}
 func (*DeleteRunRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{8}
+	return fileDescriptor_run_b316c66a05f400d2, []int{11}
 }
 func (m *DeleteRunRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteRunRequest.Unmarshal(m, b)
@@ -526,6 +830,7 @@ type Run struct {
 	Status               string               `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
 	Error                string               `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
 	Metrics              []*RunMetric         `protobuf:"bytes,9,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	Warnings             []string             `protobuf:"bytes,15,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -535,7 +840,7 @@ func (m *Run) Reset()         { *m = Run{} }
 func (m *Run) String() string { return proto.CompactTextString(m) }
 func (*Run) ProtoMessage()    {}
 func (*Run) Descriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{9}
+	return fileDescriptor_run_b316c66a05f400d2, []int{12}
 }
 func (m *Run) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Run.Unmarshal(m, b)
@@ -646,6 +951,13 @@ func (m *Run) GetMetrics() []*RunMetric {
 	return nil
 }
 
+func (m *Run) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
 type PipelineRuntime struct {
 	PipelineManifest     string   `protobuf:"bytes,10,opt,name=pipeline_manifest,json=pipelineManifest,proto3" json:"pipeline_manifest,omitempty"`
 	WorkflowManifest     string   `protobuf:"bytes,11,opt,name=workflow_manifest,json=workflowManifest,proto3" json:"workflow_manifest,omitempty"`
@@ -658,7 +970,7 @@ func (m *PipelineRuntime) Reset()         { *m = PipelineRuntime{} }
 func (m *PipelineRuntime) String() string { return proto.CompactTextString(m) }
 func (*PipelineRuntime) ProtoMessage()    {}
 func (*PipelineRuntime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{10}
+	return fileDescriptor_run_b316c66a05f400d2, []int{13}
 }
 func (m *PipelineRuntime) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PipelineRuntime.Unmarshal(m, b)
@@ -704,7 +1016,7 @@ func (m *RunDetail) Reset()         { *m = RunDetail{} }
 func (m *RunDetail) String() string { return proto.CompactTextString(m) }
 func (*RunDetail) ProtoMessage()    {}
 func (*RunDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{11}
+	return fileDescriptor_run_b316c66a05f400d2, []int{14}
 }
 func (m *RunDetail) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RunDetail.Unmarshal(m, b)
@@ -743,18 +1055,20 @@ type RunMetric struct {
 	NodeId string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 	// Types that are valid to be assigned to Value:
 	//	*RunMetric_NumberValue
-	Value                isRunMetric_Value `protobuf_oneof:"value"`
-	Format               RunMetric_Format  `protobuf:"varint,4,opt,name=format,proto3,enum=api.RunMetric_Format" json:"format,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Value                isRunMetric_Value       `protobuf_oneof:"value"`
+	Format               RunMetric_Format        `protobuf:"varint,4,opt,name=format,proto3,enum=api.RunMetric_Format" json:"format,omitempty"`
+	Series               []*RunMetricPoint       `protobuf:"bytes,5,rep,name=series,proto3" json:"series,omitempty"`
+	SeriesSummary        RunMetric_SeriesSummary `protobuf:"varint,6,opt,name=series_summary,json=seriesSummary,proto3,enum=api.RunMetric_SeriesSummary" json:"series_summary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *RunMetric) Reset()         { *m = RunMetric{} }
 func (m *RunMetric) String() string { return proto.CompactTextString(m) }
 func (*RunMetric) ProtoMessage()    {}
 func (*RunMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{12}
+	return fileDescriptor_run_b316c66a05f400d2, []int{15}
 }
 func (m *RunMetric) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RunMetric.Unmarshal(m, b)
@@ -819,6 +1133,20 @@ func (m *RunMetric) GetFormat() RunMetric_Format {
 	return RunMetric_UNSPECIFIED
 }
 
+func (m *RunMetric) GetSeries() []*RunMetricPoint {
+	if m != nil {
+		return m.Series
+	}
+	return nil
+}
+
+func (m *RunMetric) GetSeriesSummary() RunMetric_SeriesSummary {
+	if m != nil {
+		return m.SeriesSummary
+	}
+	return RunMetric_LAST
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*RunMetric) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _RunMetric_OneofMarshaler, _RunMetric_OneofUnmarshaler, _RunMetric_OneofSizer, []interface{}{
@@ -869,6 +1197,60 @@ func _RunMetric_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+type RunMetricPoint struct {
+	Step                 int64                `protobuf:"varint,1,opt,name=step,proto3" json:"step,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	NumberValue          float64              `protobuf:"fixed64,3,opt,name=number_value,json=numberValue,proto3" json:"number_value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RunMetricPoint) Reset()         { *m = RunMetricPoint{} }
+func (m *RunMetricPoint) String() string { return proto.CompactTextString(m) }
+func (*RunMetricPoint) ProtoMessage()    {}
+func (*RunMetricPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_run_b316c66a05f400d2, []int{16}
+}
+func (m *RunMetricPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RunMetricPoint.Unmarshal(m, b)
+}
+func (m *RunMetricPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RunMetricPoint.Marshal(b, m, deterministic)
+}
+func (dst *RunMetricPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunMetricPoint.Merge(dst, src)
+}
+func (m *RunMetricPoint) XXX_Size() int {
+	return xxx_messageInfo_RunMetricPoint.Size(m)
+}
+func (m *RunMetricPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunMetricPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunMetricPoint proto.InternalMessageInfo
+
+func (m *RunMetricPoint) GetStep() int64 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
+}
+
+func (m *RunMetricPoint) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *RunMetricPoint) GetNumberValue() float64 {
+	if m != nil {
+		return m.NumberValue
+	}
+	return 0
+}
+
 type ReportRunMetricsRequest struct {
 	RunId                string       `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	Metrics              []*RunMetric `protobuf:"bytes,2,rep,name=metrics,proto3" json:"metrics,omitempty"`
@@ -881,7 +1263,7 @@ func (m *ReportRunMetricsRequest) Reset()         { *m = ReportRunMetricsRequest
 func (m *ReportRunMetricsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReportRunMetricsRequest) ProtoMessage()    {}
 func (*ReportRunMetricsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{13}
+	return fileDescriptor_run_b316c66a05f400d2, []int{17}
 }
 func (m *ReportRunMetricsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReportRunMetricsRequest.Unmarshal(m, b)
@@ -926,7 +1308,7 @@ func (m *ReportRunMetricsResponse) Reset()         { *m = ReportRunMetricsRespon
 func (m *ReportRunMetricsResponse) String() string { return proto.CompactTextString(m) }
 func (*ReportRunMetricsResponse) ProtoMessage()    {}
 func (*ReportRunMetricsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{14}
+	return fileDescriptor_run_b316c66a05f400d2, []int{18}
 }
 func (m *ReportRunMetricsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReportRunMetricsResponse.Unmarshal(m, b)
@@ -971,7 +1353,7 @@ func (m *ReportRunMetricsResponse_ReportRunMetricResult) String() string {
 }
 func (*ReportRunMetricsResponse_ReportRunMetricResult) ProtoMessage() {}
 func (*ReportRunMetricsResponse_ReportRunMetricResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{14, 0}
+	return fileDescriptor_run_b316c66a05f400d2, []int{18, 0}
 }
 func (m *ReportRunMetricsResponse_ReportRunMetricResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReportRunMetricsResponse_ReportRunMetricResult.Unmarshal(m, b)
@@ -1032,7 +1414,7 @@ func (m *ReadArtifactRequest) Reset()         { *m = ReadArtifactRequest{} }
 func (m *ReadArtifactRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadArtifactRequest) ProtoMessage()    {}
 func (*ReadArtifactRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{15}
+	return fileDescriptor_run_b316c66a05f400d2, []int{19}
 }
 func (m *ReadArtifactRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReadArtifactRequest.Unmarshal(m, b)
@@ -1084,7 +1466,7 @@ func (m *ReadArtifactResponse) Reset()         { *m = ReadArtifactResponse{} }
 func (m *ReadArtifactResponse) String() string { return proto.CompactTextString(m) }
 func (*ReadArtifactResponse) ProtoMessage()    {}
 func (*ReadArtifactResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_run_63600a8e2f7114d2, []int{16}
+	return fileDescriptor_run_b316c66a05f400d2, []int{20}
 }
 func (m *ReadArtifactResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReadArtifactResponse.Unmarshal(m, b)
@@ -1111,50 +1493,449 @@ func (m *ReadArtifactResponse) GetData() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*CreateRunRequest)(nil), "api.CreateRunRequest")
-	proto.RegisterType((*GetRunRequest)(nil), "api.GetRunRequest")
-	proto.RegisterType((*ListRunsRequest)(nil), "api.ListRunsRequest")
-	proto.RegisterType((*TerminateRunRequest)(nil), "api.TerminateRunRequest")
-	proto.RegisterType((*RetryRunRequest)(nil), "api.RetryRunRequest")
-	proto.RegisterType((*ListRunsResponse)(nil), "api.ListRunsResponse")
-	proto.RegisterType((*ArchiveRunRequest)(nil), "api.ArchiveRunRequest")
-	proto.RegisterType((*UnarchiveRunRequest)(nil), "api.UnarchiveRunRequest")
-	proto.RegisterType((*DeleteRunRequest)(nil), "api.DeleteRunRequest")
-	proto.RegisterType((*Run)(nil), "api.Run")
-	proto.RegisterType((*PipelineRuntime)(nil), "api.PipelineRuntime")
-	proto.RegisterType((*RunDetail)(nil), "api.RunDetail")
-	proto.RegisterType((*RunMetric)(nil), "api.RunMetric")
-	proto.RegisterType((*ReportRunMetricsRequest)(nil), "api.ReportRunMetricsRequest")
-	proto.RegisterType((*ReportRunMetricsResponse)(nil), "api.ReportRunMetricsResponse")
-	proto.RegisterType((*ReportRunMetricsResponse_ReportRunMetricResult)(nil), "api.ReportRunMetricsResponse.ReportRunMetricResult")
-	proto.RegisterType((*ReadArtifactRequest)(nil), "api.ReadArtifactRequest")
-	proto.RegisterType((*ReadArtifactResponse)(nil), "api.ReadArtifactResponse")
-	proto.RegisterEnum("api.Run_StorageState", Run_StorageState_name, Run_StorageState_value)
-	proto.RegisterEnum("api.RunMetric_Format", RunMetric_Format_name, RunMetric_Format_value)
-	proto.RegisterEnum("api.ReportRunMetricsResponse_ReportRunMetricResult_Status", ReportRunMetricsResponse_ReportRunMetricResult_Status_name, ReportRunMetricsResponse_ReportRunMetricResult_Status_value)
+type GetRunMetricHistoryRequest struct {
+	RunId                string   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	NodeId               string   `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	MetricName           string   `protobuf:"bytes,3,opt,name=metric_name,json=metricName,proto3" json:"metric_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *GetRunMetricHistoryRequest) Reset()         { *m = GetRunMetricHistoryRequest{} }
+func (m *GetRunMetricHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRunMetricHistoryRequest) ProtoMessage()    {}
+func (*GetRunMetricHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_run_b316c66a05f400d2, []int{21}
+}
+func (m *GetRunMetricHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRunMetricHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetRunMetricHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRunMetricHistoryRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetRunMetricHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRunMetricHistoryRequest.Merge(dst, src)
+}
+func (m *GetRunMetricHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRunMetricHistoryRequest.Size(m)
+}
+func (m *GetRunMetricHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRunMetricHistoryRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_GetRunMetricHistoryRequest proto.InternalMessageInfo
 
-// RunServiceClient is the client API for RunService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RunServiceClient interface {
-	CreateRun(ctx context.Context, in *CreateRunRequest, opts ...grpc.CallOption) (*RunDetail, error)
-	GetRun(ctx context.Context, in *GetRunRequest, opts ...grpc.CallOption) (*RunDetail, error)
-	ListRuns(ctx context.Context, in *ListRunsRequest, opts ...grpc.CallOption) (*ListRunsResponse, error)
-	ArchiveRun(ctx context.Context, in *ArchiveRunRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	UnarchiveRun(ctx context.Context, in *UnarchiveRunRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	DeleteRun(ctx context.Context, in *DeleteRunRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+func (m *GetRunMetricHistoryRequest) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *GetRunMetricHistoryRequest) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *GetRunMetricHistoryRequest) GetMetricName() string {
+	if m != nil {
+		return m.MetricName
+	}
+	return ""
+}
+
+type GetRunMetricHistoryResponse struct {
+	Points               []*RunMetricPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetRunMetricHistoryResponse) Reset()         { *m = GetRunMetricHistoryResponse{} }
+func (m *GetRunMetricHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRunMetricHistoryResponse) ProtoMessage()    {}
+func (*GetRunMetricHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_run_b316c66a05f400d2, []int{22}
+}
+func (m *GetRunMetricHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRunMetricHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetRunMetricHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRunMetricHistoryResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetRunMetricHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRunMetricHistoryResponse.Merge(dst, src)
+}
+func (m *GetRunMetricHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRunMetricHistoryResponse.Size(m)
+}
+func (m *GetRunMetricHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRunMetricHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRunMetricHistoryResponse proto.InternalMessageInfo
+
+func (m *GetRunMetricHistoryResponse) GetPoints() []*RunMetricPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+type ListRunArtifactsRequest struct {
+	RunId                string   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRunArtifactsRequest) Reset()         { *m = ListRunArtifactsRequest{} }
+func (m *ListRunArtifactsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRunArtifactsRequest) ProtoMessage()    {}
+func (*ListRunArtifactsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_run_b316c66a05f400d2, []int{23}
+}
+func (m *ListRunArtifactsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRunArtifactsRequest.Unmarshal(m, b)
+}
+func (m *ListRunArtifactsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRunArtifactsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListRunArtifactsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRunArtifactsRequest.Merge(dst, src)
+}
+func (m *ListRunArtifactsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRunArtifactsRequest.Size(m)
+}
+func (m *ListRunArtifactsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRunArtifactsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRunArtifactsRequest proto.InternalMessageInfo
+
+func (m *ListRunArtifactsRequest) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+type RunArtifact struct {
+	Name                 string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NodeId               string           `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Type                 RunArtifact_Type `protobuf:"varint,3,opt,name=type,proto3,enum=api.RunArtifact_Type" json:"type,omitempty"`
+	Key                  string           `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Size                 int64            `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	ProducerTemplate     string           `protobuf:"bytes,6,opt,name=producer_template,json=producerTemplate,proto3" json:"producer_template,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *RunArtifact) Reset()         { *m = RunArtifact{} }
+func (m *RunArtifact) String() string { return proto.CompactTextString(m) }
+func (*RunArtifact) ProtoMessage()    {}
+func (*RunArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_run_b316c66a05f400d2, []int{24}
+}
+func (m *RunArtifact) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RunArtifact.Unmarshal(m, b)
+}
+func (m *RunArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RunArtifact.Marshal(b, m, deterministic)
+}
+func (dst *RunArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunArtifact.Merge(dst, src)
+}
+func (m *RunArtifact) XXX_Size() int {
+	return xxx_messageInfo_RunArtifact.Size(m)
+}
+func (m *RunArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunArtifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunArtifact proto.InternalMessageInfo
+
+func (m *RunArtifact) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RunArtifact) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *RunArtifact) GetType() RunArtifact_Type {
+	if m != nil {
+		return m.Type
+	}
+	return RunArtifact_UNSPECIFIED
+}
+
+func (m *RunArtifact) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *RunArtifact) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *RunArtifact) GetProducerTemplate() string {
+	if m != nil {
+		return m.ProducerTemplate
+	}
+	return ""
+}
+
+type ListRunArtifactsResponse struct {
+	Artifacts            []*RunArtifact `protobuf:"bytes,1,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListRunArtifactsResponse) Reset()         { *m = ListRunArtifactsResponse{} }
+func (m *ListRunArtifactsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRunArtifactsResponse) ProtoMessage()    {}
+func (*ListRunArtifactsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_run_b316c66a05f400d2, []int{25}
+}
+func (m *ListRunArtifactsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRunArtifactsResponse.Unmarshal(m, b)
+}
+func (m *ListRunArtifactsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRunArtifactsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListRunArtifactsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRunArtifactsResponse.Merge(dst, src)
+}
+func (m *ListRunArtifactsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRunArtifactsResponse.Size(m)
+}
+func (m *ListRunArtifactsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRunArtifactsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRunArtifactsResponse proto.InternalMessageInfo
+
+func (m *ListRunArtifactsResponse) GetArtifacts() []*RunArtifact {
+	if m != nil {
+		return m.Artifacts
+	}
+	return nil
+}
+
+type StreamArtifactRequest struct {
+	RunId                string   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	NodeId               string   `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ArtifactName         string   `protobuf:"bytes,3,opt,name=artifact_name,json=artifactName,proto3" json:"artifact_name,omitempty"`
+	Offset               int64    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length               int64    `protobuf:"varint,5,opt,name=length,proto3" json:"length,omitempty"`
+	Untar                bool     `protobuf:"varint,6,opt,name=untar,proto3" json:"untar,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamArtifactRequest) Reset()         { *m = StreamArtifactRequest{} }
+func (m *StreamArtifactRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamArtifactRequest) ProtoMessage()    {}
+func (*StreamArtifactRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_run_b316c66a05f400d2, []int{26}
+}
+func (m *StreamArtifactRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamArtifactRequest.Unmarshal(m, b)
+}
+func (m *StreamArtifactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamArtifactRequest.Marshal(b, m, deterministic)
+}
+func (dst *StreamArtifactRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamArtifactRequest.Merge(dst, src)
+}
+func (m *StreamArtifactRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamArtifactRequest.Size(m)
+}
+func (m *StreamArtifactRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamArtifactRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamArtifactRequest proto.InternalMessageInfo
+
+func (m *StreamArtifactRequest) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *StreamArtifactRequest) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *StreamArtifactRequest) GetArtifactName() string {
+	if m != nil {
+		return m.ArtifactName
+	}
+	return ""
+}
+
+func (m *StreamArtifactRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *StreamArtifactRequest) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *StreamArtifactRequest) GetUntar() bool {
+	if m != nil {
+		return m.Untar
+	}
+	return false
+}
+
+type StreamArtifactResponse struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Size                 int64    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	ContentType          string   `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamArtifactResponse) Reset()         { *m = StreamArtifactResponse{} }
+func (m *StreamArtifactResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamArtifactResponse) ProtoMessage()    {}
+func (*StreamArtifactResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_run_b316c66a05f400d2, []int{27}
+}
+func (m *StreamArtifactResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamArtifactResponse.Unmarshal(m, b)
+}
+func (m *StreamArtifactResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamArtifactResponse.Marshal(b, m, deterministic)
+}
+func (dst *StreamArtifactResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamArtifactResponse.Merge(dst, src)
+}
+func (m *StreamArtifactResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamArtifactResponse.Size(m)
+}
+func (m *StreamArtifactResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamArtifactResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamArtifactResponse proto.InternalMessageInfo
+
+func (m *StreamArtifactResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *StreamArtifactResponse) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *StreamArtifactResponse) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CreateRunRequest)(nil), "api.CreateRunRequest")
+	proto.RegisterType((*GetRunRequest)(nil), "api.GetRunRequest")
+	proto.RegisterType((*ListRunsRequest)(nil), "api.ListRunsRequest")
+	proto.RegisterType((*CompareRunsRequest)(nil), "api.CompareRunsRequest")
+	proto.RegisterType((*RunComparison)(nil), "api.RunComparison")
+	proto.RegisterMapType((map[string]float64)(nil), "api.RunComparison.MetricsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.RunComparison.ParametersEntry")
+	proto.RegisterType((*CompareRunsResponse)(nil), "api.CompareRunsResponse")
+	proto.RegisterType((*TerminateRunRequest)(nil), "api.TerminateRunRequest")
+	proto.RegisterType((*RetryRunRequest)(nil), "api.RetryRunRequest")
+	proto.RegisterType((*ListRunsResponse)(nil), "api.ListRunsResponse")
+	proto.RegisterType((*ArchiveRunRequest)(nil), "api.ArchiveRunRequest")
+	proto.RegisterType((*UnarchiveRunRequest)(nil), "api.UnarchiveRunRequest")
+	proto.RegisterType((*DeleteRunRequest)(nil), "api.DeleteRunRequest")
+	proto.RegisterType((*Run)(nil), "api.Run")
+	proto.RegisterType((*PipelineRuntime)(nil), "api.PipelineRuntime")
+	proto.RegisterType((*RunDetail)(nil), "api.RunDetail")
+	proto.RegisterType((*RunMetric)(nil), "api.RunMetric")
+	proto.RegisterType((*RunMetricPoint)(nil), "api.RunMetricPoint")
+	proto.RegisterType((*ReportRunMetricsRequest)(nil), "api.ReportRunMetricsRequest")
+	proto.RegisterType((*ReportRunMetricsResponse)(nil), "api.ReportRunMetricsResponse")
+	proto.RegisterType((*ReportRunMetricsResponse_ReportRunMetricResult)(nil), "api.ReportRunMetricsResponse.ReportRunMetricResult")
+	proto.RegisterType((*ReadArtifactRequest)(nil), "api.ReadArtifactRequest")
+	proto.RegisterType((*ReadArtifactResponse)(nil), "api.ReadArtifactResponse")
+	proto.RegisterType((*GetRunMetricHistoryRequest)(nil), "api.GetRunMetricHistoryRequest")
+	proto.RegisterType((*GetRunMetricHistoryResponse)(nil), "api.GetRunMetricHistoryResponse")
+	proto.RegisterType((*ListRunArtifactsRequest)(nil), "api.ListRunArtifactsRequest")
+	proto.RegisterType((*RunArtifact)(nil), "api.RunArtifact")
+	proto.RegisterType((*ListRunArtifactsResponse)(nil), "api.ListRunArtifactsResponse")
+	proto.RegisterType((*StreamArtifactRequest)(nil), "api.StreamArtifactRequest")
+	proto.RegisterType((*StreamArtifactResponse)(nil), "api.StreamArtifactResponse")
+	proto.RegisterEnum("api.CompareRunsRequest_ExportFormat", CompareRunsRequest_ExportFormat_name, CompareRunsRequest_ExportFormat_value)
+	proto.RegisterEnum("api.Run_StorageState", Run_StorageState_name, Run_StorageState_value)
+	proto.RegisterEnum("api.RunMetric_Format", RunMetric_Format_name, RunMetric_Format_value)
+	proto.RegisterEnum("api.RunMetric_SeriesSummary", RunMetric_SeriesSummary_name, RunMetric_SeriesSummary_value)
+	proto.RegisterEnum("api.ReportRunMetricsResponse_ReportRunMetricResult_Status", ReportRunMetricsResponse_ReportRunMetricResult_Status_name, ReportRunMetricsResponse_ReportRunMetricResult_Status_value)
+	proto.RegisterEnum("api.RunArtifact_Type", RunArtifact_Type_name, RunArtifact_Type_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RunServiceClient is the client API for RunService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RunServiceClient interface {
+	CreateRun(ctx context.Context, in *CreateRunRequest, opts ...grpc.CallOption) (*RunDetail, error)
+	GetRun(ctx context.Context, in *GetRunRequest, opts ...grpc.CallOption) (*RunDetail, error)
+	ListRuns(ctx context.Context, in *ListRunsRequest, opts ...grpc.CallOption) (*ListRunsResponse, error)
+	CompareRuns(ctx context.Context, in *CompareRunsRequest, opts ...grpc.CallOption) (*CompareRunsResponse, error)
+	ArchiveRun(ctx context.Context, in *ArchiveRunRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	UnarchiveRun(ctx context.Context, in *UnarchiveRunRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	DeleteRun(ctx context.Context, in *DeleteRunRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	ReportRunMetrics(ctx context.Context, in *ReportRunMetricsRequest, opts ...grpc.CallOption) (*ReportRunMetricsResponse, error)
+	PushRunMetrics(ctx context.Context, in *ReportRunMetricsRequest, opts ...grpc.CallOption) (*ReportRunMetricsResponse, error)
 	ReadArtifact(ctx context.Context, in *ReadArtifactRequest, opts ...grpc.CallOption) (*ReadArtifactResponse, error)
+	GetRunMetricHistory(ctx context.Context, in *GetRunMetricHistoryRequest, opts ...grpc.CallOption) (*GetRunMetricHistoryResponse, error)
+	ListRunArtifacts(ctx context.Context, in *ListRunArtifactsRequest, opts ...grpc.CallOption) (*ListRunArtifactsResponse, error)
+	StreamArtifact(ctx context.Context, in *StreamArtifactRequest, opts ...grpc.CallOption) (RunService_StreamArtifactClient, error)
 	TerminateRun(ctx context.Context, in *TerminateRunRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	RetryRun(ctx context.Context, in *RetryRunRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
@@ -1194,6 +1975,15 @@ func (c *runServiceClient) ListRuns(ctx context.Context, in *ListRunsRequest, op
 	return out, nil
 }
 
+func (c *runServiceClient) CompareRuns(ctx context.Context, in *CompareRunsRequest, opts ...grpc.CallOption) (*CompareRunsResponse, error) {
+	out := new(CompareRunsResponse)
+	err := c.cc.Invoke(ctx, "/api.RunService/CompareRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runServiceClient) ArchiveRun(ctx context.Context, in *ArchiveRunRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/api.RunService/ArchiveRun", in, out, opts...)
@@ -1230,6 +2020,15 @@ func (c *runServiceClient) ReportRunMetrics(ctx context.Context, in *ReportRunMe
 	return out, nil
 }
 
+func (c *runServiceClient) PushRunMetrics(ctx context.Context, in *ReportRunMetricsRequest, opts ...grpc.CallOption) (*ReportRunMetricsResponse, error) {
+	out := new(ReportRunMetricsResponse)
+	err := c.cc.Invoke(ctx, "/api.RunService/PushRunMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runServiceClient) ReadArtifact(ctx context.Context, in *ReadArtifactRequest, opts ...grpc.CallOption) (*ReadArtifactResponse, error) {
 	out := new(ReadArtifactResponse)
 	err := c.cc.Invoke(ctx, "/api.RunService/ReadArtifact", in, out, opts...)
@@ -1239,6 +2038,56 @@ func (c *runServiceClient) ReadArtifact(ctx context.Context, in *ReadArtifactReq
 	return out, nil
 }
 
+func (c *runServiceClient) GetRunMetricHistory(ctx context.Context, in *GetRunMetricHistoryRequest, opts ...grpc.CallOption) (*GetRunMetricHistoryResponse, error) {
+	out := new(GetRunMetricHistoryResponse)
+	err := c.cc.Invoke(ctx, "/api.RunService/GetRunMetricHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runServiceClient) ListRunArtifacts(ctx context.Context, in *ListRunArtifactsRequest, opts ...grpc.CallOption) (*ListRunArtifactsResponse, error) {
+	out := new(ListRunArtifactsResponse)
+	err := c.cc.Invoke(ctx, "/api.RunService/ListRunArtifacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runServiceClient) StreamArtifact(ctx context.Context, in *StreamArtifactRequest, opts ...grpc.CallOption) (RunService_StreamArtifactClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RunService_serviceDesc.Streams[0], "/api.RunService/StreamArtifact", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &runServiceStreamArtifactClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RunService_StreamArtifactClient interface {
+	Recv() (*StreamArtifactResponse, error)
+	grpc.ClientStream
+}
+
+type runServiceStreamArtifactClient struct {
+	grpc.ClientStream
+}
+
+func (x *runServiceStreamArtifactClient) Recv() (*StreamArtifactResponse, error) {
+	m := new(StreamArtifactResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *runServiceClient) TerminateRun(ctx context.Context, in *TerminateRunRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/api.RunService/TerminateRun", in, out, opts...)
@@ -1262,11 +2111,16 @@ type RunServiceServer interface {
 	CreateRun(context.Context, *CreateRunRequest) (*RunDetail, error)
 	GetRun(context.Context, *GetRunRequest) (*RunDetail, error)
 	ListRuns(context.Context, *ListRunsRequest) (*ListRunsResponse, error)
+	CompareRuns(context.Context, *CompareRunsRequest) (*CompareRunsResponse, error)
 	ArchiveRun(context.Context, *ArchiveRunRequest) (*empty.Empty, error)
 	UnarchiveRun(context.Context, *UnarchiveRunRequest) (*empty.Empty, error)
 	DeleteRun(context.Context, *DeleteRunRequest) (*empty.Empty, error)
 	ReportRunMetrics(context.Context, *ReportRunMetricsRequest) (*ReportRunMetricsResponse, error)
+	PushRunMetrics(context.Context, *ReportRunMetricsRequest) (*ReportRunMetricsResponse, error)
 	ReadArtifact(context.Context, *ReadArtifactRequest) (*ReadArtifactResponse, error)
+	GetRunMetricHistory(context.Context, *GetRunMetricHistoryRequest) (*GetRunMetricHistoryResponse, error)
+	ListRunArtifacts(context.Context, *ListRunArtifactsRequest) (*ListRunArtifactsResponse, error)
+	StreamArtifact(*StreamArtifactRequest, RunService_StreamArtifactServer) error
 	TerminateRun(context.Context, *TerminateRunRequest) (*empty.Empty, error)
 	RetryRun(context.Context, *RetryRunRequest) (*empty.Empty, error)
 }
@@ -1329,6 +2183,24 @@ func _RunService_ListRuns_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RunService_CompareRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunServiceServer).CompareRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RunService/CompareRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunServiceServer).CompareRuns(ctx, req.(*CompareRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RunService_ArchiveRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ArchiveRunRequest)
 	if err := dec(in); err != nil {
@@ -1401,6 +2273,24 @@ func _RunService_ReportRunMetrics_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RunService_PushRunMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportRunMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunServiceServer).PushRunMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RunService/PushRunMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunServiceServer).PushRunMetrics(ctx, req.(*ReportRunMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RunService_ReadArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReadArtifactRequest)
 	if err := dec(in); err != nil {
@@ -1419,6 +2309,63 @@ func _RunService_ReadArtifact_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RunService_GetRunMetricHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRunMetricHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunServiceServer).GetRunMetricHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RunService/GetRunMetricHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunServiceServer).GetRunMetricHistory(ctx, req.(*GetRunMetricHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RunService_ListRunArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRunArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunServiceServer).ListRunArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RunService/ListRunArtifacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunServiceServer).ListRunArtifacts(ctx, req.(*ListRunArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RunService_StreamArtifact_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamArtifactRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RunServiceServer).StreamArtifact(m, &runServiceStreamArtifactServer{stream})
+}
+
+type RunService_StreamArtifactServer interface {
+	Send(*StreamArtifactResponse) error
+	grpc.ServerStream
+}
+
+type runServiceStreamArtifactServer struct {
+	grpc.ServerStream
+}
+
+func (x *runServiceStreamArtifactServer) Send(m *StreamArtifactResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _RunService_TerminateRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TerminateRunRequest)
 	if err := dec(in); err != nil {
@@ -1471,6 +2418,10 @@ var _RunService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListRuns",
 			Handler:    _RunService_ListRuns_Handler,
 		},
+		{
+			MethodName: "CompareRuns",
+			Handler:    _RunService_CompareRuns_Handler,
+		},
 		{
 			MethodName: "ArchiveRun",
 			Handler:    _RunService_ArchiveRun_Handler,
@@ -1487,10 +2438,22 @@ var _RunService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReportRunMetrics",
 			Handler:    _RunService_ReportRunMetrics_Handler,
 		},
+		{
+			MethodName: "PushRunMetrics",
+			Handler:    _RunService_PushRunMetrics_Handler,
+		},
 		{
 			MethodName: "ReadArtifact",
 			Handler:    _RunService_ReadArtifact_Handler,
 		},
+		{
+			MethodName: "GetRunMetricHistory",
+			Handler:    _RunService_GetRunMetricHistory_Handler,
+		},
+		{
+			MethodName: "ListRunArtifacts",
+			Handler:    _RunService_ListRunArtifacts_Handler,
+		},
 		{
 			MethodName: "TerminateRun",
 			Handler:    _RunService_TerminateRun_Handler,
@@ -1500,111 +2463,169 @@ var _RunService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _RunService_RetryRun_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamArtifact",
+			Handler:       _RunService_StreamArtifact_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "backend/api/run.proto",
 }
 
-func init() { proto.RegisterFile("backend/api/run.proto", fileDescriptor_run_63600a8e2f7114d2) }
-
-var fileDescriptor_run_63600a8e2f7114d2 = []byte{
-	// 1580 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x6e, 0xe3, 0xc8,
-	0x11, 0x36, 0x25, 0x5b, 0xb2, 0x4a, 0x7f, 0x74, 0xfb, 0x8f, 0xa3, 0xb1, 0x61, 0x2f, 0xbd, 0xbb,
-	0xe3, 0x9d, 0xac, 0x25, 0xac, 0x27, 0x08, 0x10, 0x07, 0x41, 0x40, 0xdb, 0x1a, 0xaf, 0x32, 0xb6,
-	0xec, 0xb4, 0x64, 0x07, 0x98, 0x1c, 0x08, 0x8a, 0x6a, 0xc9, 0x8c, 0x25, 0x92, 0xe9, 0x6e, 0xda,
-	0xf1, 0x0c, 0xe6, 0x12, 0x60, 0x91, 0x7b, 0x72, 0xc8, 0x2d, 0x0f, 0xb1, 0x0f, 0x11, 0x20, 0xe7,
-	0xbc, 0x42, 0x1e, 0x64, 0xc1, 0x6e, 0x52, 0x43, 0xfd, 0x58, 0x06, 0xf6, 0x24, 0x75, 0xd5, 0xd7,
-	0x55, 0xc5, 0xaa, 0xfa, 0x8a, 0x45, 0x58, 0xef, 0x58, 0xf6, 0x1d, 0x71, 0xbb, 0x35, 0xcb, 0x77,
-	0x6a, 0x34, 0x70, 0xab, 0x3e, 0xf5, 0xb8, 0x87, 0xd2, 0x96, 0xef, 0x54, 0x36, 0x93, 0x3a, 0x42,
-	0xa9, 0x47, 0xa5, 0xb6, 0xf2, 0xb2, 0xef, 0x79, 0xfd, 0x01, 0xa9, 0x89, 0x53, 0x27, 0xe8, 0xd5,
-	0xc8, 0xd0, 0xe7, 0x8f, 0x91, 0x72, 0x2b, 0x52, 0x86, 0x97, 0x2c, 0xd7, 0xf5, 0xb8, 0xc5, 0x1d,
-	0xcf, 0x65, 0x91, 0x76, 0x67, 0xf2, 0x2a, 0x77, 0x86, 0x84, 0x71, 0x6b, 0xe8, 0xc7, 0x80, 0xa4,
-	0x53, 0xdf, 0xf1, 0xc9, 0xc0, 0x71, 0x89, 0xc9, 0x7c, 0x62, 0x47, 0x80, 0x2f, 0xc7, 0x22, 0x26,
-	0xcc, 0x0b, 0xa8, 0x4d, 0x4c, 0x4a, 0x7a, 0x84, 0x12, 0xd7, 0x26, 0x11, 0xea, 0x5b, 0xf1, 0x63,
-	0x1f, 0xf4, 0x89, 0x7b, 0xc0, 0x1e, 0xac, 0x7e, 0x9f, 0xd0, 0x9a, 0xe7, 0x8b, 0x48, 0xa6, 0xa3,
-	0xd2, 0xab, 0xa0, 0x9e, 0x50, 0x62, 0x71, 0x82, 0x03, 0x17, 0x93, 0xbf, 0x04, 0x84, 0x71, 0x54,
-	0x81, 0x34, 0x0d, 0x5c, 0x4d, 0xd9, 0x55, 0xf6, 0xf3, 0x87, 0xcb, 0x55, 0xcb, 0x77, 0xaa, 0xa1,
-	0x36, 0x14, 0xea, 0x5f, 0x43, 0xf1, 0x8c, 0xf0, 0x04, 0x78, 0x1d, 0x32, 0x34, 0x70, 0x4d, 0xa7,
-	0x2b, 0xf0, 0x39, 0xbc, 0x44, 0x03, 0xb7, 0xd1, 0xd5, 0xff, 0xa3, 0x40, 0xf9, 0xdc, 0x61, 0x21,
-	0x92, 0xc5, 0xd0, 0x6d, 0x00, 0xdf, 0xea, 0x13, 0x93, 0x7b, 0x77, 0xc4, 0x8d, 0xe0, 0xb9, 0x50,
-	0xd2, 0x0e, 0x05, 0xe8, 0x25, 0x88, 0x83, 0xc9, 0x9c, 0x0f, 0x44, 0x4b, 0xed, 0x2a, 0xfb, 0x4b,
-	0x78, 0x39, 0x14, 0xb4, 0x9c, 0x0f, 0x04, 0x6d, 0x42, 0x96, 0x79, 0x94, 0x9b, 0x9d, 0x47, 0x2d,
-	0x2d, 0x2e, 0x66, 0xc2, 0xe3, 0xf1, 0x23, 0x7a, 0x0b, 0x1b, 0xd3, 0xa9, 0x30, 0xef, 0xc8, 0xa3,
-	0xb6, 0x28, 0xe2, 0x57, 0x65, 0xfc, 0x11, 0xe4, 0x1d, 0x79, 0xc4, 0x6b, 0x31, 0x1e, 0xc7, 0xf0,
-	0x77, 0xe4, 0x11, 0x6d, 0x40, 0xa6, 0xe7, 0x0c, 0x38, 0xa1, 0xda, 0x92, 0xb4, 0x2f, 0x4f, 0xfa,
-	0xb7, 0xb0, 0xda, 0x26, 0x74, 0xe8, 0xb8, 0xe3, 0x39, 0x7a, 0xe2, 0xb1, 0xf7, 0xa1, 0x8c, 0x09,
-	0xa7, 0x8f, 0xcf, 0x23, 0x1f, 0x40, 0xfd, 0x9c, 0x1f, 0xe6, 0x7b, 0x2e, 0x23, 0x68, 0x0b, 0x16,
-	0x69, 0xe0, 0x32, 0x4d, 0xd9, 0x4d, 0x8f, 0x65, 0x5e, 0x48, 0xc3, 0xf4, 0x71, 0x8f, 0x5b, 0x03,
-	0x99, 0xa0, 0xb4, 0x48, 0x50, 0x4e, 0x48, 0x44, 0x86, 0xbe, 0x86, 0xb2, 0x4b, 0xfe, 0xca, 0xcd,
-	0x44, 0x8a, 0x53, 0xc2, 0x61, 0x31, 0x14, 0x5f, 0xc5, 0x69, 0xd6, 0xf7, 0x60, 0xc5, 0xa0, 0xf6,
-	0xad, 0x73, 0x9f, 0x7c, 0x9c, 0x12, 0xa4, 0x46, 0x01, 0xa6, 0x9c, 0xae, 0xfe, 0x15, 0xac, 0x5e,
-	0xbb, 0xd6, 0xb3, 0x30, 0x1d, 0xd4, 0x53, 0x32, 0x20, 0x7c, 0x1e, 0xe6, 0xef, 0x4b, 0x90, 0xc6,
-	0x81, 0x3b, 0x29, 0x47, 0x08, 0x16, 0x5d, 0x6b, 0x48, 0xa2, 0x20, 0xc5, 0x7f, 0x74, 0x04, 0x45,
-	0xc6, 0x3d, 0x2a, 0xba, 0x80, 0x5b, 0x9c, 0x68, 0xb0, 0xab, 0xec, 0x97, 0x0e, 0xd7, 0xe3, 0x4c,
-	0x54, 0x5b, 0x52, 0xdb, 0x0a, 0x95, 0xb8, 0xc0, 0x12, 0x27, 0xb4, 0x0b, 0xf9, 0x2e, 0x61, 0x36,
-	0x75, 0x44, 0xaf, 0x47, 0x5d, 0x92, 0x14, 0xa1, 0x5f, 0x41, 0x71, 0x8c, 0x56, 0x51, 0x87, 0xac,
-	0x08, 0xeb, 0x57, 0x91, 0xa6, 0xe5, 0x13, 0x1b, 0x17, 0xfc, 0xc4, 0x09, 0x9d, 0xc1, 0xea, 0x74,
-	0x8b, 0x31, 0x6d, 0x49, 0x54, 0x69, 0x63, 0xac, 0xbf, 0x46, 0x2d, 0x85, 0xd1, 0x54, 0x97, 0x31,
-	0xf4, 0x0a, 0xca, 0x8c, 0xd0, 0x7b, 0xc7, 0x26, 0xa6, 0x65, 0xdb, 0x5e, 0xe0, 0x72, 0xad, 0x24,
-	0xc2, 0x2c, 0x45, 0x62, 0x43, 0x4a, 0xd1, 0xaf, 0x01, 0x6c, 0xc1, 0xca, 0xae, 0x69, 0x71, 0x2d,
-	0x23, 0xc2, 0xac, 0x54, 0xe5, 0x00, 0xa9, 0xc6, 0x03, 0xa4, 0xda, 0x8e, 0x07, 0x08, 0xce, 0x45,
-	0x68, 0x83, 0xa3, 0xdf, 0x42, 0x81, 0xd9, 0xb7, 0xa4, 0x1b, 0x0c, 0xe4, 0xe5, 0xec, 0xb3, 0x97,
-	0xf3, 0x23, 0xbc, 0xc1, 0xd1, 0x6f, 0x20, 0xdf, 0x73, 0x5c, 0x87, 0xdd, 0xca, 0xdb, 0xc5, 0x67,
-	0x6f, 0x43, 0x0c, 0x37, 0x78, 0xc8, 0xa1, 0xb0, 0x6c, 0x01, 0xd3, 0x96, 0x23, 0x8e, 0x8a, 0x13,
-	0x5a, 0x83, 0x25, 0x31, 0x44, 0xb5, 0x82, 0x64, 0x80, 0x38, 0xa0, 0x7d, 0xc8, 0x0e, 0x09, 0xa7,
-	0x8e, 0xcd, 0xb4, 0x9c, 0x48, 0x65, 0x29, 0x2e, 0xf3, 0x85, 0x10, 0xe3, 0x58, 0xad, 0xd7, 0xa1,
-	0x90, 0x2c, 0x3c, 0xaa, 0xc0, 0x46, 0xab, 0x7d, 0x89, 0x8d, 0xb3, 0x7a, 0xab, 0x6d, 0xb4, 0xeb,
-	0xa6, 0x71, 0x63, 0x34, 0xce, 0x8d, 0xe3, 0xf3, 0xba, 0xba, 0x80, 0x5e, 0xc0, 0xfa, 0xb8, 0x0e,
-	0x9f, 0x7c, 0xdf, 0xb8, 0xa9, 0x9f, 0xaa, 0x8a, 0x7e, 0x07, 0xe5, 0xb8, 0xca, 0x38, 0x70, 0xc3,
-	0xf1, 0x8b, 0x7e, 0x01, 0x2b, 0xa3, 0x96, 0x18, 0x5a, 0xae, 0xd3, 0x23, 0x8c, 0x8b, 0xa6, 0xcb,
-	0x61, 0x35, 0x56, 0x5c, 0x44, 0xf2, 0x10, 0xfc, 0xe0, 0xd1, 0xbb, 0xde, 0xc0, 0x7b, 0xf8, 0x0c,
-	0xce, 0x4b, 0x70, 0xac, 0x88, 0xc1, 0xfa, 0x2d, 0xe4, 0x70, 0xe0, 0x9e, 0x12, 0x6e, 0x39, 0x83,
-	0x79, 0x13, 0x15, 0xfd, 0x0e, 0x46, 0x9e, 0x4c, 0x2a, 0xc3, 0x12, 0x9c, 0xc8, 0x1f, 0xae, 0x8d,
-	0x35, 0x66, 0x14, 0x32, 0x2e, 0xfb, 0xe3, 0x02, 0xfd, 0xbf, 0x8a, 0x70, 0x25, 0x93, 0x36, 0xa2,
-	0x95, 0x92, 0xa0, 0xd5, 0x26, 0x64, 0x5d, 0xaf, 0x4b, 0xc2, 0x19, 0x24, 0xd9, 0x96, 0x09, 0x8f,
-	0x8d, 0x2e, 0xda, 0x83, 0x82, 0x1b, 0x0c, 0x3b, 0x84, 0x9a, 0xf7, 0xd6, 0x20, 0x90, 0x43, 0x45,
-	0xf9, 0x7e, 0x01, 0xe7, 0xa5, 0xf4, 0x26, 0x14, 0xa2, 0x03, 0xc8, 0xf4, 0x3c, 0x3a, 0xb4, 0xb8,
-	0xe0, 0x4b, 0x82, 0x8d, 0xd2, 0x63, 0xf5, 0xad, 0x50, 0xe2, 0x08, 0xa4, 0x1f, 0x42, 0x46, 0x4a,
-	0x50, 0x19, 0xf2, 0xd7, 0xcd, 0xd6, 0x55, 0xfd, 0xa4, 0xf1, 0xb6, 0x51, 0x3f, 0x55, 0x17, 0x50,
-	0x16, 0xd2, 0xd8, 0xf8, 0xa3, 0xaa, 0xa0, 0x12, 0xc0, 0x55, 0x1d, 0x9f, 0xd4, 0x9b, 0x6d, 0xe3,
-	0xac, 0xae, 0xa6, 0x8e, 0xb3, 0xb0, 0x24, 0x02, 0xd0, 0xdf, 0xc3, 0x26, 0x26, 0xbe, 0x47, 0xf9,
-	0xc8, 0x3c, 0x9b, 0x3f, 0x47, 0x93, 0x5d, 0x94, 0x9a, 0xdf, 0x45, 0xff, 0x4e, 0x83, 0x36, 0x6d,
-	0x3c, 0x1a, 0xbd, 0x17, 0x90, 0xa5, 0x84, 0x05, 0x03, 0x1e, 0x4f, 0xdf, 0x37, 0x11, 0xaf, 0x67,
-	0xe3, 0x27, 0x15, 0x58, 0xdc, 0xc5, 0xb1, 0x8d, 0xca, 0x8f, 0x29, 0x58, 0x9f, 0x09, 0x41, 0x3b,
-	0x90, 0x97, 0x01, 0x99, 0x89, 0x32, 0x81, 0x14, 0x35, 0xc3, 0x62, 0x7d, 0x09, 0xa5, 0x18, 0x30,
-	0x56, 0xb3, 0x42, 0x84, 0x91, 0x95, 0xc3, 0x23, 0xaa, 0xa5, 0x45, 0x51, 0x8e, 0x7e, 0x46, 0xb8,
-	0xd5, 0x96, 0xb0, 0x30, 0xa2, 0xa9, 0x16, 0xa6, 0x92, 0x31, 0xab, 0x4f, 0x44, 0xa5, 0x73, 0x38,
-	0x3e, 0xea, 0x5d, 0xc8, 0x48, 0xec, 0x74, 0x4d, 0x33, 0x90, 0xba, 0x7c, 0xa7, 0x2a, 0x68, 0x0d,
-	0xd4, 0x46, 0xf3, 0xc6, 0x38, 0x6f, 0x9c, 0x9a, 0x06, 0x3e, 0xbb, 0xbe, 0xa8, 0x37, 0xdb, 0x6a,
-	0x0a, 0x6d, 0xc2, 0xea, 0xe9, 0xf5, 0xd5, 0x79, 0xe3, 0x24, 0xa4, 0x22, 0xae, 0x5f, 0x5d, 0xe2,
-	0x76, 0xa3, 0x79, 0xa6, 0xa6, 0x11, 0x82, 0x52, 0xa3, 0xd9, 0xae, 0xe3, 0xa6, 0x71, 0x6e, 0xd6,
-	0x31, 0xbe, 0xc4, 0xea, 0xa2, 0xfe, 0x67, 0x58, 0xc5, 0xc4, 0xea, 0x1a, 0x94, 0x3b, 0x3d, 0xcb,
-	0xe6, 0xcf, 0x14, 0x7e, 0x4e, 0x53, 0x17, 0xad, 0xc8, 0x84, 0xcc, 0xb1, 0x7c, 0x15, 0x14, 0x62,
-	0x61, 0x98, 0x65, 0xfd, 0x35, 0xac, 0x8d, 0xfb, 0x8a, 0xfa, 0x00, 0xc1, 0x62, 0xd7, 0xe2, 0x96,
-	0x70, 0x55, 0xc0, 0xe2, 0xff, 0xe1, 0x8f, 0xcb, 0x00, 0x38, 0x70, 0x5b, 0x72, 0x46, 0xa3, 0x16,
-	0xe4, 0x46, 0x2b, 0x13, 0x92, 0x64, 0x98, 0x5c, 0xa1, 0x2a, 0xa3, 0x26, 0x94, 0x03, 0x40, 0xdf,
-	0xf9, 0xdb, 0xff, 0xfe, 0xff, 0xcf, 0xd4, 0x0b, 0x1d, 0x85, 0xbb, 0x1b, 0xab, 0xdd, 0x7f, 0xd7,
-	0x21, 0xdc, 0xfa, 0x2e, 0x5c, 0x3b, 0xd9, 0x91, 0x98, 0x02, 0x7f, 0x80, 0x8c, 0xdc, 0xab, 0x10,
-	0x12, 0x57, 0xc7, 0x96, 0xac, 0x29, 0x73, 0x7b, 0xc2, 0xdc, 0x36, 0x7a, 0x39, 0x6d, 0xae, 0xf6,
-	0x51, 0x26, 0xeb, 0x13, 0x6a, 0xc1, 0x72, 0xbc, 0x61, 0x20, 0x39, 0x4a, 0x26, 0x16, 0xb2, 0xca,
-	0xfa, 0x84, 0x54, 0xe6, 0x40, 0xaf, 0x08, 0xeb, 0x6b, 0x68, 0x46, 0xb0, 0x88, 0x00, 0x7c, 0xde,
-	0x1e, 0x90, 0x7c, 0xf9, 0x4d, 0xad, 0x13, 0x95, 0x8d, 0xa9, 0x17, 0x46, 0x3d, 0xdc, 0x93, 0xf5,
-	0x57, 0xc2, 0xf2, 0x17, 0xfa, 0xce, 0xac, 0xb8, 0x9d, 0xee, 0xa7, 0xa3, 0x68, 0xe5, 0x40, 0x77,
-	0x50, 0x48, 0xee, 0x1f, 0x48, 0x13, 0x8e, 0x66, 0xac, 0x24, 0x4f, 0xba, 0xfa, 0x46, 0xb8, 0xda,
-	0xd3, 0xbf, 0x78, 0xca, 0x55, 0x10, 0x1b, 0x43, 0x7f, 0x82, 0xdc, 0x68, 0x8b, 0x89, 0x0a, 0x3a,
-	0xb9, 0xd5, 0x3c, 0xe9, 0x26, 0x2a, 0xec, 0xeb, 0xcd, 0x27, 0xdc, 0xa0, 0x1f, 0x14, 0x50, 0x27,
-	0x69, 0x89, 0xb6, 0x9e, 0x60, 0xab, 0xf4, 0xb5, 0x3d, 0x97, 0xcb, 0xfa, 0x2f, 0x85, 0xcb, 0xaa,
-	0xfe, 0xcd, 0x9c, 0xe2, 0x1f, 0x51, 0x71, 0x3b, 0xba, 0x7a, 0xa4, 0xbc, 0x46, 0xff, 0x52, 0xa0,
-	0x90, 0xec, 0xf8, 0x28, 0xa5, 0x33, 0x08, 0x57, 0x79, 0x31, 0x43, 0x13, 0xf9, 0xc6, 0xc2, 0xf7,
-	0x39, 0xfa, 0xfd, 0x1c, 0xdf, 0xb5, 0x90, 0x87, 0xac, 0xf6, 0x31, 0x62, 0xe7, 0xa7, 0x5a, 0x4c,
-	0x3c, 0x56, 0xfb, 0x38, 0x46, 0xcc, 0x30, 0x4a, 0xab, 0x8b, 0x3c, 0x28, 0x24, 0x37, 0xec, 0x28,
-	0xb0, 0x19, 0x4b, 0xf7, 0x93, 0x45, 0x38, 0x10, 0x51, 0xbd, 0xd2, 0xbf, 0x9a, 0x17, 0x15, 0x8f,
-	0x0d, 0x22, 0x1b, 0x96, 0xe3, 0x25, 0x3d, 0x22, 0xc6, 0xc4, 0xce, 0xfe, 0xf3, 0x9a, 0x2a, 0x76,
-	0x44, 0x43, 0x63, 0xc7, 0x3f, 0x28, 0xff, 0x30, 0x2e, 0xf0, 0x16, 0x64, 0xbb, 0xa4, 0x67, 0x85,
-	0x83, 0x7f, 0x05, 0x95, 0xa1, 0x58, 0xc9, 0x0b, 0x7f, 0x72, 0x98, 0xbe, 0xdf, 0x81, 0x6d, 0xc8,
-	0x1c, 0x13, 0x8b, 0x12, 0x8a, 0x56, 0x97, 0x53, 0x95, 0xa2, 0x15, 0xf0, 0x5b, 0x8f, 0x3a, 0x1f,
-	0xc4, 0x27, 0xda, 0x6e, 0xaa, 0x53, 0x00, 0x18, 0x01, 0x16, 0xde, 0xbf, 0xe9, 0x3b, 0xfc, 0x36,
-	0xe8, 0x54, 0x6d, 0x6f, 0x58, 0xbb, 0x0b, 0x3a, 0x24, 0xdc, 0x37, 0x46, 0x1f, 0x8a, 0xac, 0x96,
-	0xfc, 0x3a, 0xec, 0x7b, 0xa6, 0x3d, 0x70, 0x88, 0xcb, 0x3b, 0x19, 0xf1, 0x08, 0x6f, 0x7e, 0x0a,
-	0x00, 0x00, 0xff, 0xff, 0xd6, 0x6b, 0x76, 0xde, 0xef, 0x0e, 0x00, 0x00,
+func init() { proto.RegisterFile("backend/api/run.proto", fileDescriptor_run_b316c66a05f400d2) }
+
+var fileDescriptor_run_b316c66a05f400d2 = []byte{
+	// 2410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6e, 0x1b, 0xc9,
+	0xf1, 0xf7, 0x90, 0x12, 0x25, 0x16, 0x3f, 0x34, 0x6e, 0x7d, 0x71, 0x69, 0x19, 0x92, 0xc7, 0xbb,
+	0x6b, 0x7b, 0xbd, 0x26, 0x77, 0xe5, 0x3f, 0xfe, 0xd8, 0x55, 0xb2, 0x08, 0x28, 0x89, 0x96, 0x69,
+	0x4b, 0x94, 0xb6, 0x49, 0x29, 0x0b, 0xe7, 0x40, 0x8c, 0xc8, 0x96, 0x34, 0x11, 0x39, 0x33, 0xe9,
+	0xee, 0xb1, 0x2d, 0x3b, 0x0e, 0x90, 0x00, 0xfb, 0x02, 0x09, 0x90, 0x20, 0xe7, 0x5c, 0x02, 0xe4,
+	0x96, 0x43, 0x1e, 0x21, 0x87, 0xe4, 0x98, 0x57, 0xc8, 0x61, 0x1f, 0x23, 0xe8, 0x8f, 0x19, 0xcd,
+	0xf0, 0x43, 0x82, 0x8d, 0x20, 0x27, 0xb1, 0xab, 0x7f, 0x5d, 0x55, 0x5d, 0x5d, 0xfd, 0xab, 0x9a,
+	0x16, 0x2c, 0x1e, 0xdb, 0xdd, 0x73, 0xe2, 0xf6, 0xaa, 0xb6, 0xef, 0x54, 0x69, 0xe0, 0x56, 0x7c,
+	0xea, 0x71, 0x0f, 0xa5, 0x6d, 0xdf, 0x29, 0x2f, 0xc7, 0xe7, 0x08, 0xa5, 0x1e, 0x55, 0xb3, 0xe5,
+	0x5b, 0xa7, 0x9e, 0x77, 0xda, 0x27, 0x55, 0x39, 0x3a, 0x0e, 0x4e, 0xaa, 0x64, 0xe0, 0xf3, 0x0b,
+	0x3d, 0xb9, 0xa2, 0x27, 0xc5, 0x22, 0xdb, 0x75, 0x3d, 0x6e, 0x73, 0xc7, 0x73, 0x99, 0x9e, 0x5d,
+	0x1d, 0x5e, 0xca, 0x9d, 0x01, 0x61, 0xdc, 0x1e, 0xf8, 0x21, 0x20, 0x6e, 0xd4, 0x77, 0x7c, 0xd2,
+	0x77, 0x5c, 0xd2, 0x61, 0x3e, 0xe9, 0x6a, 0xc0, 0xc7, 0x09, 0x8f, 0x09, 0xf3, 0x02, 0xda, 0x25,
+	0x1d, 0x4a, 0x4e, 0x08, 0x25, 0x6e, 0x97, 0x68, 0xd4, 0xe7, 0xf2, 0x4f, 0xf7, 0xd1, 0x29, 0x71,
+	0x1f, 0xb1, 0x57, 0xf6, 0xe9, 0x29, 0xa1, 0x55, 0xcf, 0x97, 0x9e, 0x8c, 0x7a, 0x65, 0x55, 0xc0,
+	0xdc, 0xa2, 0xc4, 0xe6, 0x04, 0x07, 0x2e, 0x26, 0xbf, 0x08, 0x08, 0xe3, 0xa8, 0x0c, 0x69, 0x1a,
+	0xb8, 0x25, 0x63, 0xcd, 0xb8, 0x9f, 0x5b, 0x9f, 0xad, 0xd8, 0xbe, 0x53, 0x11, 0xb3, 0x42, 0x68,
+	0x7d, 0x0a, 0x85, 0x1d, 0xc2, 0x63, 0xe0, 0x45, 0xc8, 0xd0, 0xc0, 0xed, 0x38, 0x3d, 0x89, 0xcf,
+	0xe2, 0x69, 0x1a, 0xb8, 0x8d, 0x9e, 0xf5, 0x77, 0x03, 0xe6, 0x76, 0x1d, 0x26, 0x90, 0x2c, 0x84,
+	0xde, 0x06, 0xf0, 0xed, 0x53, 0xd2, 0xe1, 0xde, 0x39, 0x71, 0x35, 0x3c, 0x2b, 0x24, 0x6d, 0x21,
+	0x40, 0xb7, 0x40, 0x0e, 0x3a, 0xcc, 0x79, 0x43, 0x4a, 0xa9, 0x35, 0xe3, 0xfe, 0x34, 0x9e, 0x15,
+	0x82, 0x96, 0xf3, 0x86, 0xa0, 0x65, 0x98, 0x61, 0x1e, 0xe5, 0x9d, 0xe3, 0x8b, 0x52, 0x5a, 0x2e,
+	0xcc, 0x88, 0xe1, 0xe6, 0x05, 0x7a, 0x02, 0x4b, 0xa3, 0xa1, 0xe8, 0x9c, 0x93, 0x8b, 0xd2, 0x94,
+	0xf4, 0xdf, 0x54, 0xfe, 0x6b, 0xc8, 0x73, 0x72, 0x81, 0x17, 0x42, 0x3c, 0x0e, 0xe1, 0xcf, 0xc9,
+	0x05, 0x5a, 0x82, 0xcc, 0x89, 0xd3, 0xe7, 0x84, 0x96, 0xa6, 0x95, 0x7e, 0x35, 0xb2, 0xfe, 0x69,
+	0x00, 0xda, 0xf2, 0x06, 0xbe, 0x4d, 0x49, 0x7c, 0x2f, 0xcb, 0x30, 0xa3, 0xb6, 0xcd, 0x4a, 0xc6,
+	0x5a, 0x5a, 0xe0, 0xe5, 0xbe, 0x19, 0xba, 0x0b, 0x05, 0xf2, 0xda, 0x27, 0xd4, 0x19, 0x10, 0x97,
+	0x8b, 0xb0, 0xa4, 0xa4, 0xba, 0xfc, 0xa5, 0xb0, 0xd1, 0x8b, 0x19, 0x4b, 0xc7, 0x8d, 0xa1, 0x1f,
+	0x43, 0xe6, 0xc4, 0xa3, 0x03, 0x9b, 0x4b, 0xe7, 0x8b, 0xeb, 0x1f, 0x4b, 0xe7, 0x47, 0xcd, 0x57,
+	0xea, 0xaf, 0x7d, 0x8f, 0xf2, 0x27, 0x12, 0x8b, 0xf5, 0x1a, 0xeb, 0x0e, 0xe4, 0xe3, 0x72, 0x34,
+	0x0b, 0x53, 0xcf, 0x5a, 0xfb, 0x4d, 0xf3, 0x06, 0x9a, 0x81, 0xf4, 0x56, 0xeb, 0xc8, 0x34, 0xac,
+	0xdf, 0x4f, 0x41, 0x01, 0x07, 0xae, 0xd2, 0xe8, 0x30, 0xcf, 0x9d, 0x70, 0x7e, 0x08, 0xc1, 0x94,
+	0x6b, 0x0f, 0x88, 0xf6, 0x5e, 0xfe, 0x16, 0x5e, 0x33, 0x6e, 0xf3, 0x80, 0x45, 0x47, 0x20, 0x47,
+	0xe8, 0x6b, 0x80, 0xae, 0xcc, 0xa1, 0x5e, 0x47, 0x7b, 0x9e, 0x5b, 0x2f, 0x57, 0x54, 0xba, 0x57,
+	0xc2, 0x74, 0xaf, 0xb4, 0xc3, 0x74, 0xc7, 0x59, 0x8d, 0xae, 0x71, 0xf4, 0x00, 0xcc, 0x5e, 0x40,
+	0x65, 0x46, 0x76, 0x18, 0xe9, 0x7a, 0x6e, 0x8f, 0xc9, 0xf8, 0xa7, 0xf1, 0x5c, 0x28, 0x6f, 0x29,
+	0x31, 0xaa, 0xc0, 0x7c, 0x74, 0x29, 0x5e, 0x12, 0xca, 0xc4, 0x12, 0xa7, 0x57, 0xca, 0x48, 0x57,
+	0x6e, 0x86, 0x53, 0x47, 0x6a, 0xa6, 0xd1, 0x43, 0xeb, 0xb0, 0x38, 0x82, 0x97, 0x5b, 0x9a, 0x91,
+	0x2b, 0xe6, 0x87, 0x56, 0x34, 0xc5, 0x0e, 0x37, 0x45, 0x86, 0x52, 0x7b, 0x40, 0x38, 0xa1, 0xac,
+	0x34, 0xbb, 0x96, 0xbe, 0x9f, 0x5b, 0xb7, 0xc2, 0x0b, 0x70, 0x19, 0xb4, 0xca, 0x41, 0x04, 0xaa,
+	0xbb, 0x9c, 0x5e, 0xe0, 0xd8, 0x2a, 0xf4, 0x35, 0xcc, 0x0c, 0x08, 0xa7, 0x4e, 0x97, 0x95, 0xb2,
+	0x52, 0xc1, 0xea, 0x18, 0x05, 0x7b, 0x0a, 0xa1, 0x56, 0x87, 0xf8, 0xf2, 0x37, 0x30, 0x37, 0xa4,
+	0x19, 0x99, 0x90, 0x16, 0xb9, 0xac, 0xce, 0x46, 0xfc, 0x44, 0x0b, 0x30, 0xfd, 0xd2, 0xee, 0x07,
+	0xe1, 0xd1, 0xa8, 0xc1, 0x46, 0xea, 0x2b, 0xa3, 0xbc, 0x01, 0xf9, 0xb8, 0xde, 0xeb, 0xd6, 0x1a,
+	0xb1, 0xb5, 0xd6, 0x9f, 0x0d, 0x98, 0x4f, 0xe4, 0x19, 0xf3, 0x3d, 0x97, 0x11, 0x74, 0x0f, 0xe6,
+	0xa2, 0xbd, 0xc9, 0xf0, 0x85, 0xf9, 0x5e, 0x8c, 0xc4, 0x22, 0x72, 0x0c, 0xdd, 0x81, 0xbc, 0xda,
+	0x86, 0x46, 0xa5, 0x24, 0x2a, 0xa7, 0x64, 0x0a, 0xf2, 0x29, 0x4c, 0xd1, 0xc0, 0x15, 0xd9, 0x23,
+	0xc2, 0x82, 0x46, 0xc3, 0x82, 0xe5, 0xbc, 0xe0, 0x09, 0xee, 0x71, 0xbb, 0xaf, 0x98, 0x60, 0x4a,
+	0x32, 0x41, 0x56, 0x4a, 0x04, 0x15, 0x58, 0x9f, 0xc3, 0x7c, 0x9b, 0xd0, 0x81, 0xe3, 0x26, 0x59,
+	0x6b, 0x02, 0x11, 0xdd, 0x87, 0x39, 0x4c, 0x44, 0x94, 0xaf, 0x45, 0xbe, 0x02, 0xf3, 0x92, 0xb1,
+	0xf4, 0xf6, 0x57, 0xb4, 0xcb, 0xc6, 0x5a, 0x3a, 0xc1, 0x85, 0xe3, 0x1c, 0x4d, 0x0f, 0x39, 0x8a,
+	0x3e, 0x85, 0x39, 0x97, 0xbc, 0xe6, 0x9d, 0x18, 0xe9, 0xa9, 0x33, 0x2b, 0x08, 0xf1, 0x41, 0x48,
+	0x7c, 0xd6, 0x5d, 0xb8, 0x59, 0xa3, 0xdd, 0x33, 0xe7, 0x65, 0x7c, 0x3b, 0x45, 0x48, 0x45, 0x0e,
+	0xa6, 0x9c, 0x9e, 0xf5, 0x09, 0xcc, 0x1f, 0xba, 0xf6, 0xb5, 0x30, 0x0b, 0xcc, 0x6d, 0xd2, 0x27,
+	0xfc, 0x2a, 0xcc, 0x9f, 0xa6, 0x21, 0x8d, 0x03, 0x77, 0x58, 0x3e, 0xf6, 0xce, 0x6f, 0x40, 0x81,
+	0x71, 0x8f, 0x4a, 0x5e, 0xe6, 0x36, 0x27, 0x25, 0x90, 0xc4, 0xb4, 0x18, 0x46, 0xa2, 0xd2, 0x52,
+	0xb3, 0x2d, 0x31, 0x89, 0xf3, 0x2c, 0x36, 0x42, 0x6b, 0x90, 0xeb, 0x11, 0xd6, 0xa5, 0x8e, 0xac,
+	0x3e, 0x9a, 0x34, 0xe2, 0x22, 0xf4, 0xff, 0x50, 0x48, 0x14, 0x3a, 0x4d, 0x1e, 0x37, 0xa5, 0xf6,
+	0x03, 0x3d, 0xd3, 0xf2, 0x49, 0x17, 0xe7, 0xfd, 0xd8, 0x08, 0xed, 0xc0, 0xfc, 0x28, 0xe9, 0x0b,
+	0xe6, 0x10, 0xa7, 0xb4, 0x94, 0x60, 0xfc, 0x88, 0xe4, 0x31, 0x1a, 0xe1, 0x7d, 0x26, 0xd2, 0x9b,
+	0x11, 0xfa, 0xd2, 0xe9, 0x92, 0x8e, 0xdd, 0xed, 0x7a, 0x81, 0xcb, 0x4b, 0x45, 0xe9, 0x66, 0x51,
+	0x8b, 0x6b, 0x4a, 0x3a, 0xc4, 0x71, 0x99, 0xf7, 0xe1, 0xb8, 0x6f, 0x20, 0xcf, 0xba, 0x67, 0xa4,
+	0x17, 0xf4, 0xd5, 0xe2, 0x99, 0x6b, 0x17, 0xe7, 0x22, 0x7c, 0x8d, 0xa3, 0x1f, 0x41, 0xee, 0xc4,
+	0x71, 0x1d, 0x76, 0xa6, 0x56, 0x17, 0xae, 0x5d, 0x0d, 0x21, 0xbc, 0xc6, 0x63, 0x94, 0x3d, 0x9b,
+	0xa0, 0xec, 0x05, 0x98, 0x96, 0x6d, 0x4d, 0x29, 0xaf, 0x6e, 0x80, 0x1c, 0xa0, 0xfb, 0xc3, 0xd4,
+	0x55, 0x0c, 0x8f, 0x59, 0xf1, 0x4a, 0xc4, 0x54, 0xa8, 0x0c, 0xb3, 0xaf, 0x6c, 0xea, 0x3a, 0xee,
+	0x29, 0x2b, 0xcd, 0xc9, 0x9b, 0x1e, 0x8d, 0xad, 0x3a, 0xe4, 0xe3, 0x49, 0x81, 0xca, 0xb0, 0xd4,
+	0x6a, 0xef, 0xe3, 0xda, 0x4e, 0xbd, 0xd5, 0xae, 0xb5, 0xeb, 0x9d, 0xda, 0x51, 0xad, 0xb1, 0x5b,
+	0xdb, 0xdc, 0xad, 0x9b, 0x37, 0xd0, 0x47, 0xb0, 0x98, 0x9c, 0xc3, 0x5b, 0x4f, 0x1b, 0x47, 0xf5,
+	0x6d, 0xd3, 0xb0, 0xce, 0x61, 0x2e, 0xcc, 0x00, 0x1c, 0xb8, 0xa2, 0x59, 0x42, 0x0f, 0x21, 0xe2,
+	0xf9, 0xce, 0xc0, 0x76, 0x9d, 0x13, 0xc2, 0xb8, 0x4c, 0xc8, 0x2c, 0x36, 0xc3, 0x89, 0x3d, 0x2d,
+	0x17, 0xe0, 0x57, 0x1e, 0x3d, 0x3f, 0xe9, 0x7b, 0xaf, 0x2e, 0xc1, 0x39, 0x05, 0x0e, 0x27, 0x42,
+	0xb0, 0x75, 0x06, 0x59, 0x1c, 0xb8, 0xdb, 0x84, 0xdb, 0x4e, 0xff, 0xaa, 0xfe, 0x07, 0xfd, 0x04,
+	0x22, 0x4b, 0x1d, 0xaa, 0xdc, 0x92, 0xf7, 0x25, 0xb7, 0xbe, 0x90, 0x48, 0x5a, 0xed, 0x32, 0x9e,
+	0xf3, 0x93, 0x02, 0xeb, 0x87, 0x14, 0x64, 0xa3, 0x80, 0x46, 0x57, 0xce, 0x88, 0x5d, 0xb9, 0x65,
+	0x98, 0x71, 0xbd, 0x1e, 0xb9, 0xec, 0x1d, 0x32, 0x62, 0xd8, 0xe8, 0xa1, 0xbb, 0x90, 0x77, 0x83,
+	0xc1, 0x31, 0xa1, 0x1d, 0x45, 0xe2, 0xe2, 0x42, 0x19, 0x4f, 0x6f, 0xe0, 0x9c, 0x92, 0x1e, 0x09,
+	0x21, 0x7a, 0x34, 0xd4, 0x42, 0x2c, 0x26, 0x8f, 0xb0, 0x92, 0xec, 0x19, 0xd0, 0x43, 0xc8, 0x30,
+	0x42, 0x9d, 0xe8, 0xf2, 0xcc, 0x27, 0xe1, 0x07, 0x9e, 0xe3, 0x72, 0xac, 0x21, 0x68, 0x0b, 0x8a,
+	0xea, 0x57, 0x87, 0x05, 0x83, 0x81, 0x4d, 0x2f, 0xe4, 0x45, 0x28, 0xae, 0xaf, 0x0c, 0xd9, 0x68,
+	0x49, 0x50, 0x4b, 0x61, 0x70, 0x81, 0xc5, 0x87, 0xd6, 0x3a, 0x64, 0x74, 0x7f, 0x32, 0x07, 0xb9,
+	0xc3, 0x66, 0xeb, 0xa0, 0xbe, 0xd5, 0x78, 0xd2, 0xa8, 0x6f, 0xab, 0x36, 0x05, 0xd7, 0x7e, 0x6a,
+	0x1a, 0xa8, 0x08, 0x70, 0x50, 0xc7, 0x5b, 0xf5, 0x66, 0xbb, 0xb6, 0x53, 0x37, 0x53, 0xd6, 0x43,
+	0x28, 0x24, 0x74, 0x8a, 0xd6, 0x66, 0xb7, 0xd6, 0x6a, 0xab, 0x35, 0x7b, 0x8d, 0xa6, 0x69, 0xc8,
+	0x1f, 0xb5, 0xef, 0xcc, 0xd4, 0xe6, 0x8c, 0x2e, 0x72, 0xd6, 0xaf, 0x0d, 0x28, 0x26, 0x77, 0x22,
+	0xe2, 0xcd, 0x38, 0xf1, 0x65, 0xbc, 0xd3, 0x58, 0xfe, 0x46, 0x5f, 0x41, 0x36, 0x6a, 0xc5, 0x4b,
+	0xa9, 0x6b, 0xaf, 0xd7, 0x25, 0x58, 0xd4, 0xbc, 0xd1, 0x03, 0x49, 0x1c, 0x87, 0xf5, 0x02, 0x96,
+	0x31, 0x11, 0x3d, 0x59, 0xe4, 0x08, 0xbb, 0xba, 0x0c, 0xc5, 0x2f, 0x61, 0xea, 0xca, 0x4b, 0x68,
+	0xfd, 0x2d, 0x0d, 0xa5, 0x51, 0xe5, 0xba, 0x72, 0xed, 0xc1, 0x0c, 0x25, 0x2c, 0xe8, 0xf3, 0xb0,
+	0x78, 0x3d, 0x56, 0x6a, 0x26, 0xe0, 0x87, 0x27, 0xb0, 0x5c, 0x8b, 0x43, 0x1d, 0xe5, 0x7f, 0xa4,
+	0x60, 0x71, 0x2c, 0x04, 0xad, 0x42, 0x2e, 0x56, 0xf8, 0xf5, 0x5e, 0xe0, 0xb2, 0xee, 0xa3, 0x8f,
+	0xa1, 0x18, 0x02, 0x12, 0x69, 0xad, 0xfb, 0x85, 0xa6, 0x4a, 0x6e, 0x9c, 0x68, 0x2e, 0x8b, 0xeb,
+	0x1b, 0x1f, 0xe0, 0x6e, 0xa5, 0x25, 0x35, 0x44, 0x2c, 0x57, 0x12, 0xa1, 0x64, 0xcc, 0x3e, 0x55,
+	0x5d, 0x44, 0x16, 0x87, 0x43, 0xeb, 0x97, 0x90, 0x51, 0xd8, 0xd1, 0x24, 0xcc, 0x40, 0x6a, 0xff,
+	0xb9, 0x69, 0xa0, 0x05, 0x30, 0x1b, 0xcd, 0xa3, 0xda, 0x6e, 0x63, 0xbb, 0x53, 0xc3, 0x3b, 0x87,
+	0x7b, 0xf5, 0x66, 0xdb, 0x4c, 0xa1, 0x65, 0x98, 0xdf, 0x3e, 0x3c, 0xd8, 0x6d, 0x6c, 0x09, 0xb6,
+	0xc2, 0xf5, 0x83, 0x7d, 0xdc, 0x6e, 0x34, 0x77, 0xcc, 0x34, 0x42, 0x50, 0x6c, 0x34, 0xdb, 0x75,
+	0xdc, 0xac, 0xed, 0x76, 0xea, 0x18, 0xef, 0x63, 0x73, 0x0a, 0x2d, 0x01, 0xc2, 0xf5, 0xd6, 0xfe,
+	0x21, 0xde, 0xaa, 0x77, 0xea, 0xdf, 0x3d, 0xad, 0x1d, 0xb6, 0xda, 0xf5, 0x6d, 0x73, 0xda, 0xfa,
+	0x39, 0xcc, 0x63, 0x62, 0xf7, 0x6a, 0x94, 0x3b, 0x27, 0x76, 0x97, 0x5f, 0x93, 0x10, 0x57, 0xf0,
+	0x41, 0xc1, 0xd6, 0x2a, 0x54, 0xec, 0x55, 0x85, 0xcd, 0x87, 0x42, 0x11, 0x7d, 0xeb, 0x33, 0x58,
+	0x48, 0xda, 0xd2, 0xf9, 0x81, 0x60, 0xaa, 0x67, 0x73, 0x5b, 0x9a, 0xca, 0x63, 0xf9, 0xdb, 0x1a,
+	0x40, 0x79, 0x87, 0x5c, 0x06, 0xf5, 0xa9, 0x23, 0xca, 0xf9, 0xc5, 0x87, 0xba, 0x37, 0x94, 0x18,
+	0xe9, 0xe1, 0xc4, 0xb0, 0x9e, 0xc1, 0xad, 0xb1, 0xe6, 0xb4, 0x87, 0x0f, 0x21, 0xe3, 0x8b, 0x4b,
+	0x1b, 0x26, 0xf0, 0x78, 0x6a, 0x52, 0x10, 0xeb, 0x0b, 0x58, 0xd6, 0xcd, 0x5b, 0xb8, 0xd3, 0x6b,
+	0xee, 0x99, 0xf5, 0x83, 0x01, 0xb9, 0x18, 0xfc, 0xfd, 0xa8, 0xf8, 0x01, 0x4c, 0xf1, 0x0b, 0x9f,
+	0x94, 0xd2, 0x49, 0x8e, 0x0d, 0x95, 0x55, 0xda, 0x17, 0x3e, 0xc1, 0x12, 0x12, 0x76, 0xe1, 0x53,
+	0x97, 0x5d, 0xb8, 0x20, 0x21, 0xd1, 0x30, 0x4e, 0x6b, 0x12, 0x72, 0xde, 0xa8, 0xd2, 0x46, 0xbd,
+	0x5e, 0xd0, 0x25, 0xb4, 0xc3, 0xc9, 0xc0, 0xef, 0x8b, 0x5e, 0x2b, 0xa3, 0x4b, 0x9b, 0x9e, 0x68,
+	0x6b, 0xb9, 0x55, 0x81, 0x29, 0x61, 0x60, 0x34, 0x77, 0xb3, 0x30, 0xdd, 0x68, 0x1e, 0x1c, 0xb6,
+	0x4d, 0x03, 0x01, 0x64, 0xf6, 0x0f, 0xdb, 0xe2, 0x77, 0xca, 0x7a, 0x06, 0xa5, 0xd1, 0xe0, 0xe8,
+	0x28, 0x57, 0x20, 0x1b, 0xe6, 0x4b, 0x18, 0x68, 0x73, 0x78, 0x3b, 0xf8, 0x12, 0x62, 0xfd, 0xd5,
+	0x80, 0xc5, 0x16, 0xa7, 0xc4, 0x1e, 0xfc, 0x2f, 0xd2, 0x57, 0x34, 0x30, 0xde, 0xc9, 0x09, 0x23,
+	0xaa, 0x9c, 0xa5, 0xb1, 0x1e, 0x09, 0x79, 0x9f, 0xb8, 0xa7, 0xfc, 0x4c, 0x47, 0x51, 0x8f, 0x44,
+	0x63, 0x13, 0xb8, 0xdc, 0xa6, 0x32, 0x76, 0xb3, 0x58, 0x0d, 0xac, 0x2e, 0x2c, 0x0d, 0xfb, 0x3c,
+	0xf9, 0x1a, 0x44, 0xe7, 0x93, 0x8a, 0x9d, 0xcf, 0x1d, 0xc8, 0x77, 0x3d, 0x97, 0x8b, 0x6f, 0xfa,
+	0xe8, 0xe0, 0xb3, 0x38, 0xa7, 0x65, 0xe2, 0x34, 0xd6, 0xff, 0x58, 0x00, 0xc0, 0x81, 0xdb, 0x52,
+	0x8d, 0x23, 0x6a, 0x41, 0x36, 0x7a, 0x59, 0x41, 0x2a, 0x43, 0x86, 0x5f, 0x5a, 0xca, 0x11, 0xb5,
+	0xab, 0xce, 0xc3, 0x5a, 0xfd, 0xcd, 0xbf, 0xfe, 0xfd, 0xbb, 0xd4, 0x47, 0x16, 0x12, 0x4f, 0x3c,
+	0xac, 0xfa, 0xf2, 0xcb, 0x63, 0xc2, 0xed, 0x2f, 0xc5, 0xeb, 0x14, 0xdb, 0x90, 0xed, 0xc7, 0xb7,
+	0x90, 0x51, 0x57, 0x06, 0xa9, 0xcf, 0xa7, 0xc4, 0x5b, 0xcc, 0x88, 0xba, 0xbb, 0x52, 0xdd, 0x6d,
+	0x74, 0x6b, 0x54, 0x5d, 0xf5, 0xad, 0x3a, 0xab, 0x77, 0xa8, 0x05, 0xb3, 0xe1, 0x67, 0x0f, 0x52,
+	0x3d, 0xcc, 0xd0, 0xbb, 0x4d, 0x79, 0x71, 0x48, 0xaa, 0x42, 0x67, 0x95, 0xa5, 0xf6, 0x05, 0x34,
+	0xc6, 0x59, 0xd4, 0x83, 0x5c, 0xec, 0x6b, 0x12, 0x2d, 0x4f, 0x78, 0xc7, 0x28, 0x97, 0x46, 0x27,
+	0xb4, 0x76, 0x4b, 0x6a, 0x5f, 0x41, 0xe5, 0x31, 0xa1, 0xe8, 0x2a, 0x3c, 0x22, 0x00, 0x97, 0x1f,
+	0x4e, 0x48, 0xf5, 0xfd, 0x23, 0x5f, 0x52, 0xe5, 0xa5, 0x91, 0x62, 0x5e, 0x17, 0x8f, 0x76, 0xd6,
+	0x3d, 0x69, 0xe1, 0x8e, 0xb5, 0x3a, 0x2e, 0x3a, 0x4e, 0xef, 0xdd, 0x86, 0xfe, 0xda, 0x42, 0xe7,
+	0x90, 0x8f, 0x7f, 0x7a, 0x21, 0xe5, 0xf4, 0x98, 0xaf, 0xb1, 0x89, 0xa6, 0x1e, 0x48, 0x53, 0x77,
+	0xad, 0x3b, 0x93, 0x4c, 0x05, 0xa1, 0x32, 0xf4, 0x33, 0xc8, 0x46, 0x1f, 0x70, 0x3a, 0x6d, 0x86,
+	0x3f, 0xe8, 0x26, 0x9a, 0xd1, 0xe9, 0xf3, 0xd9, 0xf2, 0x04, 0x33, 0xe8, 0x7b, 0x03, 0xcc, 0xe1,
+	0x92, 0x8a, 0x56, 0x26, 0x54, 0x5a, 0x65, 0xeb, 0xf6, 0x95, 0x75, 0xd8, 0xfa, 0x3f, 0x69, 0xb2,
+	0x62, 0x3d, 0xb8, 0x22, 0xc5, 0x36, 0xa8, 0x5c, 0xad, 0x97, 0x6e, 0x18, 0x9f, 0x21, 0xd1, 0x99,
+	0x1d, 0x04, 0xec, 0xec, 0xbf, 0xe5, 0xc5, 0xba, 0xf4, 0xe2, 0x73, 0xeb, 0xde, 0x55, 0x5e, 0xf8,
+	0x01, 0x3b, 0x8b, 0xf9, 0xf0, 0x07, 0x03, 0xf2, 0xf1, 0xca, 0xa8, 0x8f, 0x75, 0x4c, 0x61, 0x2e,
+	0x7f, 0x34, 0x66, 0x46, 0x5b, 0xc6, 0xd2, 0xf2, 0x2e, 0x7a, 0x76, 0x85, 0xe5, 0xaa, 0x20, 0x3c,
+	0x56, 0x7d, 0xab, 0x69, 0xf0, 0x5d, 0x35, 0x62, 0xd3, 0xea, 0xdb, 0x04, 0x03, 0x8a, 0x48, 0xd9,
+	0x3d, 0xf4, 0x17, 0x03, 0xe6, 0xc7, 0x14, 0x46, 0xb4, 0x1a, 0xbb, 0xf2, 0xe3, 0x2a, 0x74, 0x79,
+	0x6d, 0x32, 0x40, 0xbb, 0xfb, 0xad, 0x74, 0xf7, 0x39, 0x6a, 0xbc, 0x8f, 0xbb, 0xba, 0xdf, 0xac,
+	0xbe, 0x8d, 0x95, 0xf3, 0x77, 0x1b, 0x67, 0xda, 0xab, 0x5f, 0x45, 0xcf, 0x26, 0x51, 0x71, 0xd1,
+	0x87, 0x39, 0xa1, 0x20, 0x97, 0x6f, 0x4f, 0x98, 0xd5, 0x3e, 0x3e, 0x92, 0x3e, 0xde, 0x43, 0x9f,
+	0x5c, 0xe5, 0x63, 0x14, 0x42, 0xb4, 0x07, 0xc5, 0x24, 0xb7, 0xa3, 0xb2, 0xd4, 0x3f, 0xb6, 0x48,
+	0x95, 0x6f, 0x8d, 0x9d, 0xd3, 0x96, 0x6f, 0x7c, 0x61, 0x20, 0x0f, 0xf2, 0xf1, 0xd7, 0x25, 0x9d,
+	0x15, 0x63, 0x1e, 0x9c, 0x26, 0xde, 0x42, 0xed, 0xbf, 0x75, 0xa5, 0xff, 0x3c, 0x54, 0x88, 0xba,
+	0x30, 0x1b, 0x3e, 0x50, 0x69, 0xfe, 0x1d, 0x7a, 0xaf, 0xfa, 0x30, 0x56, 0x09, 0x0d, 0x51, 0xa1,
+	0x6c, 0xf3, 0x7b, 0xe3, 0xb7, 0xb5, 0x3d, 0xbc, 0x02, 0x33, 0x3d, 0x72, 0x62, 0x8b, 0xae, 0xfd,
+	0x26, 0x9a, 0x83, 0x42, 0x39, 0xa7, 0xa3, 0x21, 0x3a, 0xe1, 0x17, 0xab, 0x70, 0x1b, 0x32, 0x9b,
+	0xc4, 0xa6, 0x84, 0xa2, 0xf9, 0xd9, 0x54, 0xb9, 0x60, 0x07, 0xfc, 0xcc, 0xa3, 0xce, 0x1b, 0xf9,
+	0x0c, 0xbb, 0x96, 0x3a, 0xce, 0x03, 0x44, 0x80, 0x1b, 0x2f, 0x1e, 0x9f, 0x3a, 0xfc, 0x2c, 0x38,
+	0xae, 0x74, 0xbd, 0x41, 0xf5, 0x3c, 0x38, 0x26, 0xe2, 0x7b, 0x3a, 0xfa, 0xb7, 0x05, 0xab, 0xc6,
+	0xff, 0x57, 0x71, 0xea, 0x75, 0xba, 0x7d, 0x87, 0xb8, 0xfc, 0x38, 0x23, 0xb7, 0xf0, 0xf8, 0x3f,
+	0x03, 0x00, 0xf3, 0xf0, 0xa0, 0xbb, 0x7d, 0x19, 0x00, 0x00,
 }
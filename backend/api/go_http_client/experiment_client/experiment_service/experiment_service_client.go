@@ -212,6 +212,35 @@ func (a *Client) UnarchiveExperiment(params *UnarchiveExperimentParams, authInfo
 
 }
 
+/*
+UpdateExperimentLabels replaces the labels of an experiment
+*/
+func (a *Client) UpdateExperimentLabels(params *UpdateExperimentLabelsParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateExperimentLabelsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateExperimentLabelsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdateExperimentLabels",
+		Method:             "PUT",
+		PathPattern:        "/apis/v1beta1/experiments/{id}/labels",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateExperimentLabelsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateExperimentLabelsOK), nil
+
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
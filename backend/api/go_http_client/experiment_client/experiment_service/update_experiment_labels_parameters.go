@@ -0,0 +1,172 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package experiment_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	experiment_model "github.com/kubeflow/pipelines/backend/api/go_http_client/experiment_model"
+)
+
+// NewUpdateExperimentLabelsParams creates a new UpdateExperimentLabelsParams object
+// with the default values initialized.
+func NewUpdateExperimentLabelsParams() *UpdateExperimentLabelsParams {
+	var ()
+	return &UpdateExperimentLabelsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdateExperimentLabelsParamsWithTimeout creates a new UpdateExperimentLabelsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewUpdateExperimentLabelsParamsWithTimeout(timeout time.Duration) *UpdateExperimentLabelsParams {
+	var ()
+	return &UpdateExperimentLabelsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewUpdateExperimentLabelsParamsWithContext creates a new UpdateExperimentLabelsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewUpdateExperimentLabelsParamsWithContext(ctx context.Context) *UpdateExperimentLabelsParams {
+	var ()
+	return &UpdateExperimentLabelsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewUpdateExperimentLabelsParamsWithHTTPClient creates a new UpdateExperimentLabelsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewUpdateExperimentLabelsParamsWithHTTPClient(client *http.Client) *UpdateExperimentLabelsParams {
+	var ()
+	return &UpdateExperimentLabelsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+UpdateExperimentLabelsParams contains all the parameters to send to the API endpoint
+for the update experiment labels operation typically these are written to a http.Request
+*/
+type UpdateExperimentLabelsParams struct {
+
+	/*Body*/
+	Body *experiment_model.APIUpdateExperimentLabelsRequest
+	/*ID
+	  The ID of the experiment to be updated.
+
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the update experiment labels params
+func (o *UpdateExperimentLabelsParams) WithTimeout(timeout time.Duration) *UpdateExperimentLabelsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update experiment labels params
+func (o *UpdateExperimentLabelsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update experiment labels params
+func (o *UpdateExperimentLabelsParams) WithContext(ctx context.Context) *UpdateExperimentLabelsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update experiment labels params
+func (o *UpdateExperimentLabelsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update experiment labels params
+func (o *UpdateExperimentLabelsParams) WithHTTPClient(client *http.Client) *UpdateExperimentLabelsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update experiment labels params
+func (o *UpdateExperimentLabelsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the update experiment labels params
+func (o *UpdateExperimentLabelsParams) WithBody(body *experiment_model.APIUpdateExperimentLabelsRequest) *UpdateExperimentLabelsParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the update experiment labels params
+func (o *UpdateExperimentLabelsParams) SetBody(body *experiment_model.APIUpdateExperimentLabelsRequest) {
+	o.Body = body
+}
+
+// WithID adds the id to the update experiment labels params
+func (o *UpdateExperimentLabelsParams) WithID(id string) *UpdateExperimentLabelsParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the update experiment labels params
+func (o *UpdateExperimentLabelsParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdateExperimentLabelsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
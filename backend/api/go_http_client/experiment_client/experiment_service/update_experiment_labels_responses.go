@@ -0,0 +1,128 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package experiment_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	experiment_model "github.com/kubeflow/pipelines/backend/api/go_http_client/experiment_model"
+)
+
+// UpdateExperimentLabelsReader is a Reader for the UpdateExperimentLabels structure.
+type UpdateExperimentLabelsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateExperimentLabelsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewUpdateExperimentLabelsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewUpdateExperimentLabelsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUpdateExperimentLabelsOK creates a UpdateExperimentLabelsOK with default headers values
+func NewUpdateExperimentLabelsOK() *UpdateExperimentLabelsOK {
+	return &UpdateExperimentLabelsOK{}
+}
+
+/*
+UpdateExperimentLabelsOK handles this case with default header values.
+
+A successful response.
+*/
+type UpdateExperimentLabelsOK struct {
+	Payload *experiment_model.APIExperiment
+}
+
+func (o *UpdateExperimentLabelsOK) Error() string {
+	return fmt.Sprintf("[PUT /apis/v1beta1/experiments/{id}/labels][%d] updateExperimentLabelsOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateExperimentLabelsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(experiment_model.APIExperiment)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateExperimentLabelsDefault creates a UpdateExperimentLabelsDefault with default headers values
+func NewUpdateExperimentLabelsDefault(code int) *UpdateExperimentLabelsDefault {
+	return &UpdateExperimentLabelsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+UpdateExperimentLabelsDefault handles this case with default header values.
+
+UpdateExperimentLabelsDefault update experiment labels default
+*/
+type UpdateExperimentLabelsDefault struct {
+	_statusCode int
+
+	Payload *experiment_model.APIStatus
+}
+
+// Code gets the status code for the update experiment labels default response
+func (o *UpdateExperimentLabelsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *UpdateExperimentLabelsDefault) Error() string {
+	return fmt.Sprintf("[PUT /apis/v1beta1/experiments/{id}/labels][%d] UpdateExperimentLabels default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UpdateExperimentLabelsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(experiment_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
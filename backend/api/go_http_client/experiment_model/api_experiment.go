@@ -43,6 +43,11 @@ type APIExperiment struct {
 	// Output. Unique experiment ID. Generated by API server.
 	ID string `json:"id,omitempty"`
 
+	// Optional input field. Key/value labels used to organize experiments, e.g.
+	// team=ranking. Experiments can be filtered by label using "label.<key>" as
+	// the filter key.
+	Labels map[string]string `json:"labels,omitempty"`
+
 	// Required input field. Unique experiment name provided by user.
 	Name string `json:"name,omitempty"`
 
@@ -0,0 +1,60 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package experiment_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// APIUpdateExperimentLabelsRequest api update experiment labels request
+// swagger:model apiUpdateExperimentLabelsRequest
+type APIUpdateExperimentLabelsRequest struct {
+
+	// The ID of the experiment to be updated.
+	ID string `json:"id,omitempty"`
+
+	// The new labels of the experiment. They replace all existing labels.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// Validate validates this api update experiment labels request
+func (m *APIUpdateExperimentLabelsRequest) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIUpdateExperimentLabelsRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIUpdateExperimentLabelsRequest) UnmarshalBinary(b []byte) error {
+	var res APIUpdateExperimentLabelsRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,151 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package job_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewCancelJobBackfillParams creates a new CancelJobBackfillParams object
+// with the default values initialized.
+func NewCancelJobBackfillParams() *CancelJobBackfillParams {
+	var ()
+	return &CancelJobBackfillParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCancelJobBackfillParamsWithTimeout creates a new CancelJobBackfillParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewCancelJobBackfillParamsWithTimeout(timeout time.Duration) *CancelJobBackfillParams {
+	var ()
+	return &CancelJobBackfillParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewCancelJobBackfillParamsWithContext creates a new CancelJobBackfillParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewCancelJobBackfillParamsWithContext(ctx context.Context) *CancelJobBackfillParams {
+	var ()
+	return &CancelJobBackfillParams{
+
+		Context: ctx,
+	}
+}
+
+// NewCancelJobBackfillParamsWithHTTPClient creates a new CancelJobBackfillParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewCancelJobBackfillParamsWithHTTPClient(client *http.Client) *CancelJobBackfillParams {
+	var ()
+	return &CancelJobBackfillParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+CancelJobBackfillParams contains all the parameters to send to the API endpoint
+for the cancel job backfill operation typically these are written to a http.Request
+*/
+type CancelJobBackfillParams struct {
+
+	/*ID
+	  The ID of the job whose backfill is cancelled.
+
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the cancel job backfill params
+func (o *CancelJobBackfillParams) WithTimeout(timeout time.Duration) *CancelJobBackfillParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the cancel job backfill params
+func (o *CancelJobBackfillParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the cancel job backfill params
+func (o *CancelJobBackfillParams) WithContext(ctx context.Context) *CancelJobBackfillParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the cancel job backfill params
+func (o *CancelJobBackfillParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the cancel job backfill params
+func (o *CancelJobBackfillParams) WithHTTPClient(client *http.Client) *CancelJobBackfillParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the cancel job backfill params
+func (o *CancelJobBackfillParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the cancel job backfill params
+func (o *CancelJobBackfillParams) WithID(id string) *CancelJobBackfillParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the cancel job backfill params
+func (o *CancelJobBackfillParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CancelJobBackfillParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package job_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	job_model "github.com/kubeflow/pipelines/backend/api/go_http_client/job_model"
+)
+
+// CancelJobBackfillReader is a Reader for the CancelJobBackfill structure.
+type CancelJobBackfillReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CancelJobBackfillReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewCancelJobBackfillOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewCancelJobBackfillDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewCancelJobBackfillOK creates a CancelJobBackfillOK with default headers values
+func NewCancelJobBackfillOK() *CancelJobBackfillOK {
+	return &CancelJobBackfillOK{}
+}
+
+/*
+CancelJobBackfillOK handles this case with default header values.
+
+A successful response.
+*/
+type CancelJobBackfillOK struct {
+	Payload interface{}
+}
+
+func (o *CancelJobBackfillOK) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/jobs/{id}/backfill:cancel][%d] cancelJobBackfillOK  %+v", 200, o.Payload)
+}
+
+func (o *CancelJobBackfillOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCancelJobBackfillDefault creates a CancelJobBackfillDefault with default headers values
+func NewCancelJobBackfillDefault(code int) *CancelJobBackfillDefault {
+	return &CancelJobBackfillDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+CancelJobBackfillDefault handles this case with default header values.
+
+CancelJobBackfillDefault cancel job backfill default
+*/
+type CancelJobBackfillDefault struct {
+	_statusCode int
+
+	Payload *job_model.APIStatus
+}
+
+// Code gets the status code for the cancel job backfill default response
+func (o *CancelJobBackfillDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *CancelJobBackfillDefault) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/jobs/{id}/backfill:cancel][%d] CancelJobBackfill default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *CancelJobBackfillDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(job_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
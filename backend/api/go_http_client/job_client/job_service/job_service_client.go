@@ -38,6 +38,64 @@ type Client struct {
 	formats   strfmt.Registry
 }
 
+/*
+BackfillJob creates one run of a job per tick of its schedule within a past time range the runs are created by the scheduled workflow controller at most max concurrency at a time the progress is reported in job backfill
+*/
+func (a *Client) BackfillJob(params *BackfillJobParams, authInfo runtime.ClientAuthInfoWriter) (*BackfillJobOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackfillJobParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "BackfillJob",
+		Method:             "POST",
+		PathPattern:        "/apis/v1beta1/jobs/{id}/backfill",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &BackfillJobReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*BackfillJobOK), nil
+
+}
+
+/*
+CancelJobBackfill stops the running backfill of a job the runs already created continue
+*/
+func (a *Client) CancelJobBackfill(params *CancelJobBackfillParams, authInfo runtime.ClientAuthInfoWriter) (*CancelJobBackfillOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCancelJobBackfillParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "CancelJobBackfill",
+		Method:             "POST",
+		PathPattern:        "/apis/v1beta1/jobs/{id}/backfill:cancel",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CancelJobBackfillReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*CancelJobBackfillOK), nil
+
+}
+
 /*
 CreateJob creates a new job
 */
@@ -212,6 +270,122 @@ func (a *Client) ListJobs(params *ListJobsParams, authInfo runtime.ClientAuthInf
 
 }
 
+/*
+PreviewSchedule computes the next times a job with the given trigger would run without creating the job
+*/
+func (a *Client) PreviewSchedule(params *PreviewScheduleParams, authInfo runtime.ClientAuthInfoWriter) (*PreviewScheduleOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPreviewScheduleParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PreviewSchedule",
+		Method:             "POST",
+		PathPattern:        "/apis/v1beta1/jobs:previewSchedule",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &PreviewScheduleReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PreviewScheduleOK), nil
+
+}
+
+/*
+TriggerJob creates one run of a job right away in addition to the runs of its trigger the run is created by the scheduled workflow controller once max concurrency allows it even if the job is disabled
+*/
+func (a *Client) TriggerJob(params *TriggerJobParams, authInfo runtime.ClientAuthInfoWriter) (*TriggerJobOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTriggerJobParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "TriggerJob",
+		Method:             "POST",
+		PathPattern:        "/apis/v1beta1/jobs/{id}/trigger",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &TriggerJobReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*TriggerJobOK), nil
+
+}
+
+/*
+UpdateJob updates the trigger pipeline parameters max concurrency and catchup of a job in place the run history and the schedule progress of the job are kept
+*/
+func (a *Client) UpdateJob(params *UpdateJobParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateJobOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateJobParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdateJob",
+		Method:             "PUT",
+		PathPattern:        "/apis/v1beta1/jobs/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateJobReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateJobOK), nil
+
+}
+
+/*
+UpdateJobLabels replaces the labels of a job
+*/
+func (a *Client) UpdateJobLabels(params *UpdateJobLabelsParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateJobLabelsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateJobLabelsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdateJobLabels",
+		Method:             "PUT",
+		PathPattern:        "/apis/v1beta1/jobs/{id}/labels",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateJobLabelsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateJobLabelsOK), nil
+
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -0,0 +1,151 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package job_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	job_model "github.com/kubeflow/pipelines/backend/api/go_http_client/job_model"
+)
+
+// NewPreviewScheduleParams creates a new PreviewScheduleParams object
+// with the default values initialized.
+func NewPreviewScheduleParams() *PreviewScheduleParams {
+	var ()
+	return &PreviewScheduleParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPreviewScheduleParamsWithTimeout creates a new PreviewScheduleParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewPreviewScheduleParamsWithTimeout(timeout time.Duration) *PreviewScheduleParams {
+	var ()
+	return &PreviewScheduleParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewPreviewScheduleParamsWithContext creates a new PreviewScheduleParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewPreviewScheduleParamsWithContext(ctx context.Context) *PreviewScheduleParams {
+	var ()
+	return &PreviewScheduleParams{
+
+		Context: ctx,
+	}
+}
+
+// NewPreviewScheduleParamsWithHTTPClient creates a new PreviewScheduleParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewPreviewScheduleParamsWithHTTPClient(client *http.Client) *PreviewScheduleParams {
+	var ()
+	return &PreviewScheduleParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PreviewScheduleParams contains all the parameters to send to the API endpoint
+for the preview schedule operation typically these are written to a http.Request
+*/
+type PreviewScheduleParams struct {
+
+	/*Body*/
+	Body *job_model.APIPreviewScheduleRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the preview schedule params
+func (o *PreviewScheduleParams) WithTimeout(timeout time.Duration) *PreviewScheduleParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the preview schedule params
+func (o *PreviewScheduleParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the preview schedule params
+func (o *PreviewScheduleParams) WithContext(ctx context.Context) *PreviewScheduleParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the preview schedule params
+func (o *PreviewScheduleParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the preview schedule params
+func (o *PreviewScheduleParams) WithHTTPClient(client *http.Client) *PreviewScheduleParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the preview schedule params
+func (o *PreviewScheduleParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the preview schedule params
+func (o *PreviewScheduleParams) WithBody(body *job_model.APIPreviewScheduleRequest) *PreviewScheduleParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the preview schedule params
+func (o *PreviewScheduleParams) SetBody(body *job_model.APIPreviewScheduleRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PreviewScheduleParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package job_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	job_model "github.com/kubeflow/pipelines/backend/api/go_http_client/job_model"
+)
+
+// TriggerJobReader is a Reader for the TriggerJob structure.
+type TriggerJobReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *TriggerJobReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewTriggerJobOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewTriggerJobDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewTriggerJobOK creates a TriggerJobOK with default headers values
+func NewTriggerJobOK() *TriggerJobOK {
+	return &TriggerJobOK{}
+}
+
+/*
+TriggerJobOK handles this case with default header values.
+
+A successful response.
+*/
+type TriggerJobOK struct {
+	Payload interface{}
+}
+
+func (o *TriggerJobOK) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/jobs/{id}/trigger][%d] triggerJobOK  %+v", 200, o.Payload)
+}
+
+func (o *TriggerJobOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewTriggerJobDefault creates a TriggerJobDefault with default headers values
+func NewTriggerJobDefault(code int) *TriggerJobDefault {
+	return &TriggerJobDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+TriggerJobDefault handles this case with default header values.
+
+TriggerJobDefault trigger job default
+*/
+type TriggerJobDefault struct {
+	_statusCode int
+
+	Payload *job_model.APIStatus
+}
+
+// Code gets the status code for the trigger job default response
+func (o *TriggerJobDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *TriggerJobDefault) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/jobs/{id}/trigger][%d] TriggerJob default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *TriggerJobDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(job_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package job_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	job_model "github.com/kubeflow/pipelines/backend/api/go_http_client/job_model"
+)
+
+// NewUpdateJobLabelsParams creates a new UpdateJobLabelsParams object
+// with the default values initialized.
+func NewUpdateJobLabelsParams() *UpdateJobLabelsParams {
+	var ()
+	return &UpdateJobLabelsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdateJobLabelsParamsWithTimeout creates a new UpdateJobLabelsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewUpdateJobLabelsParamsWithTimeout(timeout time.Duration) *UpdateJobLabelsParams {
+	var ()
+	return &UpdateJobLabelsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewUpdateJobLabelsParamsWithContext creates a new UpdateJobLabelsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewUpdateJobLabelsParamsWithContext(ctx context.Context) *UpdateJobLabelsParams {
+	var ()
+	return &UpdateJobLabelsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewUpdateJobLabelsParamsWithHTTPClient creates a new UpdateJobLabelsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewUpdateJobLabelsParamsWithHTTPClient(client *http.Client) *UpdateJobLabelsParams {
+	var ()
+	return &UpdateJobLabelsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+UpdateJobLabelsParams contains all the parameters to send to the API endpoint
+for the update job labels operation typically these are written to a http.Request
+*/
+type UpdateJobLabelsParams struct {
+
+	/*Body*/
+	Body *job_model.APIUpdateJobLabelsRequest
+	/*ID
+	  The ID of the job to be updated.
+
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the update job labels params
+func (o *UpdateJobLabelsParams) WithTimeout(timeout time.Duration) *UpdateJobLabelsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update job labels params
+func (o *UpdateJobLabelsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update job labels params
+func (o *UpdateJobLabelsParams) WithContext(ctx context.Context) *UpdateJobLabelsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update job labels params
+func (o *UpdateJobLabelsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update job labels params
+func (o *UpdateJobLabelsParams) WithHTTPClient(client *http.Client) *UpdateJobLabelsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update job labels params
+func (o *UpdateJobLabelsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the update job labels params
+func (o *UpdateJobLabelsParams) WithBody(body *job_model.APIUpdateJobLabelsRequest) *UpdateJobLabelsParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the update job labels params
+func (o *UpdateJobLabelsParams) SetBody(body *job_model.APIUpdateJobLabelsRequest) {
+	o.Body = body
+}
+
+// WithID adds the id to the update job labels params
+func (o *UpdateJobLabelsParams) WithID(id string) *UpdateJobLabelsParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the update job labels params
+func (o *UpdateJobLabelsParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdateJobLabelsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
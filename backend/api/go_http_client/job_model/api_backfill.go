@@ -0,0 +1,157 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package job_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIBackfill Backfill describes the progress of the backfill of a job.
+// swagger:model apiBackfill
+type APIBackfill struct {
+
+	// The number of runs created so far.
+	CreatedRuns string `json:"created_runs,omitempty"`
+
+	// The end of the time range, exclusive.
+	// Format: date-time
+	EndTime strfmt.DateTime `json:"end_time,omitempty"`
+
+	// Unique backfill ID. Generated by API server.
+	ID string `json:"id,omitempty"`
+
+	// The scheduled time of the next run to create, if any.
+	// Format: date-time
+	NextScheduledTime strfmt.DateTime `json:"next_scheduled_time,omitempty"`
+
+	// The start of the time range, inclusive.
+	// Format: date-time
+	StartTime strfmt.DateTime `json:"start_time,omitempty"`
+
+	// state
+	State BackfillState `json:"state,omitempty"`
+
+	// The number of ticks of the schedule within the range, i.e. the number of
+	// runs the backfill creates.
+	TotalRuns string `json:"total_runs,omitempty"`
+}
+
+// Validate validates this api backfill
+func (m *APIBackfill) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEndTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNextScheduledTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIBackfill) validateEndTime(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.EndTime) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("end_time", "body", "date-time", m.EndTime.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIBackfill) validateNextScheduledTime(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NextScheduledTime) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("next_scheduled_time", "body", "date-time", m.NextScheduledTime.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIBackfill) validateStartTime(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.StartTime) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("start_time", "body", "date-time", m.StartTime.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIBackfill) validateState(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.State) { // not required
+		return nil
+	}
+
+	if err := m.State.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("state")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIBackfill) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIBackfill) UnmarshalBinary(b []byte) error {
+	var res APIBackfill
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
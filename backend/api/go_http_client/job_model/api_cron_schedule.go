@@ -42,6 +42,10 @@ type APICronSchedule struct {
 	// The start time of the cron job
 	// Format: date-time
 	StartTime strfmt.DateTime `json:"start_time,omitempty"`
+
+	// The IANA time zone, such as "America/New_York", in which the cron string
+	// is evaluated and the scheduled time is formatted. Defaults to UTC.
+	TimeZone string `json:"time_zone,omitempty"`
 }
 
 // Validate validates this api cron schedule
@@ -33,6 +33,16 @@ import (
 // swagger:model apiJob
 type APIJob struct {
 
+	// Output. The progress of the latest backfill of the job, if any, as
+	// reported by the scheduled workflow controller.
+	Backfill *APIBackfill `json:"backfill,omitempty"`
+
+	// Optional input field. Time windows during which the job schedules no
+	// run, and its status is Paused. The runs scheduled during a window are
+	// handled after it, following no_catchup, as if the job was disabled
+	// during the window.
+	BlackoutWindows []*APITimeWindow `json:"blackout_windows"`
+
 	// Output. The time this job is created.
 	// Format: date-time
 	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
@@ -51,16 +61,31 @@ type APIJob struct {
 	// Output. Unique run ID. Generated by API server.
 	ID string `json:"id,omitempty"`
 
+	// Optional input field. Key/value labels used to organize jobs, e.g.
+	// team=ranking. Jobs can be filtered by label using "label.<key>" as the
+	// filter key.
+	Labels map[string]string `json:"labels,omitempty"`
+
 	// Required input field.
 	// Specify how many runs can be executed concurrently. Rage [1-10]
 	MaxConcurrency int64 `json:"max_concurrency,omitempty,string"`
 
+	// Optional input field. The job is disabled after this many consecutive
+	// runs failed, and its status becomes DisabledByFailures. Range [0-10].
+	// 0 means that failed runs never disable the job.
+	MaxConsecutiveFailures string `json:"max_consecutive_failures,omitempty"`
+
 	// mode
 	Mode JobMode `json:"mode,omitempty"`
 
 	// Required input field. Job name provided by user. Not unique.
 	Name string `json:"name,omitempty"`
 
+	// Output. The next time the job is scheduled to run, as reported by the
+	// scheduled workflow controller.
+	// Format: date-time
+	NextTriggeredTime strfmt.DateTime `json:"next_triggered_time,omitempty"`
+
 	// Optional input field. Whether the job should catch up if behind schedule.
 	// If true, the job will only schedule the latest interval if behind schedule.
 	// If false, the job will catch up on each past interval.
@@ -78,7 +103,7 @@ type APIJob struct {
 	ServiceAccount string `json:"service_account,omitempty"`
 
 	// Output. The status of the job.
-	// One of [Enable, Disable, Error]
+	// One of [Enabled, Disabled, DisabledByFailures, Paused, Error]
 	Status string `json:"status,omitempty"`
 
 	// Required input field.
@@ -94,6 +119,14 @@ type APIJob struct {
 func (m *APIJob) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateBackfill(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateBlackoutWindows(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCreatedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -102,6 +135,10 @@ func (m *APIJob) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateNextTriggeredTime(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePipelineSpec(formats); err != nil {
 		res = append(res, err)
 	}
@@ -124,6 +161,49 @@ func (m *APIJob) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *APIJob) validateBackfill(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Backfill) { // not required
+		return nil
+	}
+
+	if m.Backfill != nil {
+		if err := m.Backfill.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("backfill")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *APIJob) validateBlackoutWindows(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.BlackoutWindows) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.BlackoutWindows); i++ {
+		if swag.IsZero(m.BlackoutWindows[i]) { // not required
+			continue
+		}
+
+		if m.BlackoutWindows[i] != nil {
+			if err := m.BlackoutWindows[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("blackout_windows" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *APIJob) validateCreatedAt(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.CreatedAt) { // not required
@@ -153,6 +233,19 @@ func (m *APIJob) validateMode(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *APIJob) validateNextTriggeredTime(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NextTriggeredTime) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("next_triggered_time", "body", "date-time", m.NextTriggeredTime.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *APIJob) validatePipelineSpec(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.PipelineSpec) { // not required
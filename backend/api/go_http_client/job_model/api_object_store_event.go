@@ -0,0 +1,67 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package job_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// APIObjectStoreEvent Trigger defines what starts a pipeline run.
+// ObjectStoreEvent allow running the job whenever a new object is stored in a
+// bucket of the object store
+// swagger:model apiObjectStoreEvent
+type APIObjectStoreEvent struct {
+
+	// The bucket to watch for new objects
+	Bucket string `json:"bucket,omitempty"`
+
+	// The name of the pipeline parameter that is set to the key of the new
+	// object
+	KeyParameter string `json:"key_parameter,omitempty"`
+
+	// Only objects whose key starts with the prefix trigger a run. If empty,
+	// all the objects of the bucket trigger a run.
+	Prefix string `json:"prefix,omitempty"`
+}
+
+// Validate validates this api object store event
+func (m *APIObjectStoreEvent) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIObjectStoreEvent) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIObjectStoreEvent) UnmarshalBinary(b []byte) error {
+	var res APIObjectStoreEvent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package job_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIPreviewScheduleRequest api preview schedule request
+// swagger:model apiPreviewScheduleRequest
+type APIPreviewScheduleRequest struct {
+
+	// The number of trigger times to compute. Defaults to 10, at most 100.
+	Count int32 `json:"count,omitempty"`
+
+	// Whether the job would skip the intervals it is behind schedule on. See
+	// Job.no_catchup.
+	NoCatchup bool `json:"no_catchup,omitempty"`
+
+	// The trigger of the job, with a cron or periodic schedule.
+	Trigger *APITrigger `json:"trigger,omitempty"`
+}
+
+// Validate validates this api preview schedule request
+func (m *APIPreviewScheduleRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateTrigger(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIPreviewScheduleRequest) validateTrigger(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Trigger) { // not required
+		return nil
+	}
+
+	if m.Trigger != nil {
+		if err := m.Trigger.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("trigger")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIPreviewScheduleRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIPreviewScheduleRequest) UnmarshalBinary(b []byte) error {
+	var res APIPreviewScheduleRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package job_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIPreviewScheduleResponse api preview schedule response
+// swagger:model apiPreviewScheduleResponse
+type APIPreviewScheduleResponse struct {
+
+	// The next trigger times, in chronological order, as if the job was created
+	// now. Fewer times than requested are returned if the schedule ends before.
+	TriggerTimes []strfmt.DateTime `json:"trigger_times"`
+}
+
+// Validate validates this api preview schedule response
+func (m *APIPreviewScheduleResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateTriggerTimes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIPreviewScheduleResponse) validateTriggerTimes(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TriggerTimes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.TriggerTimes); i++ {
+
+		if err := validate.FormatOf("trigger_times"+"."+strconv.Itoa(i), "body", "date-time", m.TriggerTimes[i].String(), formats); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIPreviewScheduleResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIPreviewScheduleResponse) UnmarshalBinary(b []byte) error {
+	var res APIPreviewScheduleResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
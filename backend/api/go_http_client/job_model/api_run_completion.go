@@ -0,0 +1,73 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package job_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// APIRunCompletion RunCompletion allow running the job whenever an upstream run completes
+// swagger:model apiRunCompletion
+type APIRunCompletion struct {
+
+	// The experiment whose runs trigger this job.
+	ExperimentID string `json:"experiment_id,omitempty"`
+
+	// The job whose runs trigger this job. Exactly one of job_id and
+	// experiment_id must be set.
+	JobID string `json:"job_id,omitempty"`
+
+	// Maps names of pipeline parameters to names of output parameters of the
+	// upstream run, whose values they are set to.
+	OutputParameters map[string]string `json:"output_parameters,omitempty"`
+
+	// The name of the pipeline parameter that is set to the ID of the upstream
+	// run, if any.
+	RunIDParameter string `json:"run_id_parameter,omitempty"`
+
+	// The final states of the upstream run that trigger this job, among
+	// Succeeded, Failed and Error. If empty, only Succeeded triggers the job.
+	States []string `json:"states"`
+}
+
+// Validate validates this api run completion
+func (m *APIRunCompletion) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIRunCompletion) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIRunCompletion) UnmarshalBinary(b []byte) error {
+	var res APIRunCompletion
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
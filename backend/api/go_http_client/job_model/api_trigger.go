@@ -26,15 +26,21 @@ import (
 	"github.com/go-openapi/swag"
 )
 
-// APITrigger Trigger defines what starts a pipeline run.
+// APITrigger api trigger
 // swagger:model apiTrigger
 type APITrigger struct {
 
 	// cron schedule
 	CronSchedule *APICronSchedule `json:"cron_schedule,omitempty"`
 
+	// object store event
+	ObjectStoreEvent *APIObjectStoreEvent `json:"object_store_event,omitempty"`
+
 	// periodic schedule
 	PeriodicSchedule *APIPeriodicSchedule `json:"periodic_schedule,omitempty"`
+
+	// run completion
+	RunCompletion *APIRunCompletion `json:"run_completion,omitempty"`
 }
 
 // Validate validates this api trigger
@@ -45,10 +51,18 @@ func (m *APITrigger) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateObjectStoreEvent(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePeriodicSchedule(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateRunCompletion(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -73,6 +87,24 @@ func (m *APITrigger) validateCronSchedule(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *APITrigger) validateObjectStoreEvent(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ObjectStoreEvent) { // not required
+		return nil
+	}
+
+	if m.ObjectStoreEvent != nil {
+		if err := m.ObjectStoreEvent.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("object_store_event")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *APITrigger) validatePeriodicSchedule(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.PeriodicSchedule) { // not required
@@ -91,6 +123,24 @@ func (m *APITrigger) validatePeriodicSchedule(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *APITrigger) validateRunCompletion(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RunCompletion) { // not required
+		return nil
+	}
+
+	if m.RunCompletion != nil {
+		if err := m.RunCompletion.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("run_completion")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *APITrigger) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -0,0 +1,86 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package job_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// BackfillState  - RUNNING: Runs remain to be created.
+//   - COMPLETED: A run was created for every tick of the schedule within the range.
+//   - CANCELLED: The backfill was cancelled before all the runs were created.
+//
+// swagger:model BackfillState
+type BackfillState string
+
+const (
+
+	// BackfillStateUNKNOWNSTATE captures enum value "UNKNOWN_STATE"
+	BackfillStateUNKNOWNSTATE BackfillState = "UNKNOWN_STATE"
+
+	// BackfillStateRUNNING captures enum value "RUNNING"
+	BackfillStateRUNNING BackfillState = "RUNNING"
+
+	// BackfillStateCOMPLETED captures enum value "COMPLETED"
+	BackfillStateCOMPLETED BackfillState = "COMPLETED"
+
+	// BackfillStateCANCELLED captures enum value "CANCELLED"
+	BackfillStateCANCELLED BackfillState = "CANCELLED"
+)
+
+// for schema
+var backfillStateEnum []interface{}
+
+func init() {
+	var res []BackfillState
+	if err := json.Unmarshal([]byte(`["UNKNOWN_STATE","RUNNING","COMPLETED","CANCELLED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		backfillStateEnum = append(backfillStateEnum, v)
+	}
+}
+
+func (m BackfillState) validateBackfillStateEnum(path, location string, value BackfillState) error {
+	if err := validate.Enum(path, location, value, backfillStateEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this backfill state
+func (m BackfillState) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateBackfillStateEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pipeline_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetPipelineVersionAssetParams creates a new GetPipelineVersionAssetParams object
+// with the default values initialized.
+func NewGetPipelineVersionAssetParams() *GetPipelineVersionAssetParams {
+	var ()
+	return &GetPipelineVersionAssetParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetPipelineVersionAssetParamsWithTimeout creates a new GetPipelineVersionAssetParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetPipelineVersionAssetParamsWithTimeout(timeout time.Duration) *GetPipelineVersionAssetParams {
+	var ()
+	return &GetPipelineVersionAssetParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetPipelineVersionAssetParamsWithContext creates a new GetPipelineVersionAssetParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetPipelineVersionAssetParamsWithContext(ctx context.Context) *GetPipelineVersionAssetParams {
+	var ()
+	return &GetPipelineVersionAssetParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetPipelineVersionAssetParamsWithHTTPClient creates a new GetPipelineVersionAssetParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetPipelineVersionAssetParamsWithHTTPClient(client *http.Client) *GetPipelineVersionAssetParams {
+	var ()
+	return &GetPipelineVersionAssetParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetPipelineVersionAssetParams contains all the parameters to send to the API endpoint
+for the get pipeline version asset operation typically these are written to a http.Request
+*/
+type GetPipelineVersionAssetParams struct {
+
+	/*Path
+	  The path of the file inside the pipeline package, e.g. "README.md" or
+	"components/train/component.yaml".
+
+	*/
+	Path *string
+	/*VersionID
+	  The ID of the pipeline version whose asset is to be retrieved.
+
+	*/
+	VersionID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get pipeline version asset params
+func (o *GetPipelineVersionAssetParams) WithTimeout(timeout time.Duration) *GetPipelineVersionAssetParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get pipeline version asset params
+func (o *GetPipelineVersionAssetParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get pipeline version asset params
+func (o *GetPipelineVersionAssetParams) WithContext(ctx context.Context) *GetPipelineVersionAssetParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get pipeline version asset params
+func (o *GetPipelineVersionAssetParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get pipeline version asset params
+func (o *GetPipelineVersionAssetParams) WithHTTPClient(client *http.Client) *GetPipelineVersionAssetParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get pipeline version asset params
+func (o *GetPipelineVersionAssetParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithPath adds the path to the get pipeline version asset params
+func (o *GetPipelineVersionAssetParams) WithPath(path *string) *GetPipelineVersionAssetParams {
+	o.SetPath(path)
+	return o
+}
+
+// SetPath adds the path to the get pipeline version asset params
+func (o *GetPipelineVersionAssetParams) SetPath(path *string) {
+	o.Path = path
+}
+
+// WithVersionID adds the versionID to the get pipeline version asset params
+func (o *GetPipelineVersionAssetParams) WithVersionID(versionID string) *GetPipelineVersionAssetParams {
+	o.SetVersionID(versionID)
+	return o
+}
+
+// SetVersionID adds the versionId to the get pipeline version asset params
+func (o *GetPipelineVersionAssetParams) SetVersionID(versionID string) {
+	o.VersionID = versionID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetPipelineVersionAssetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Path != nil {
+
+		// query param path
+		var qrPath string
+		if o.Path != nil {
+			qrPath = *o.Path
+		}
+		qPath := qrPath
+		if qPath != "" {
+			if err := r.SetQueryParam("path", qPath); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// path param version_id
+	if err := r.SetPathParam("version_id", o.VersionID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
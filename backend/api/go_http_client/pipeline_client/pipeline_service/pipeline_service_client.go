@@ -212,6 +212,35 @@ func (a *Client) GetPipelineVersion(params *GetPipelineVersionParams, authInfo r
 
 }
 
+/*
+GetPipelineVersionAsset returns a file bundled in the zip or tar gz package the specified pipeline version was uploaded in e g a component spec or a r e a d m e
+*/
+func (a *Client) GetPipelineVersionAsset(params *GetPipelineVersionAssetParams, authInfo runtime.ClientAuthInfoWriter) (*GetPipelineVersionAssetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetPipelineVersionAssetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetPipelineVersionAsset",
+		Method:             "GET",
+		PathPattern:        "/apis/v1beta1/pipeline_versions/{version_id}/assets",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetPipelineVersionAssetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetPipelineVersionAssetOK), nil
+
+}
+
 /*
 GetPipelineVersionTemplate returns a y a m l template that contains the specified pipeline version s description parameters and metadata
 */
@@ -328,6 +357,93 @@ func (a *Client) ListPipelines(params *ListPipelinesParams, authInfo runtime.Cli
 
 }
 
+/*
+UpdatePipelineDefaultVersion sets the default version of a pipeline the version must belong to the pipeline
+*/
+func (a *Client) UpdatePipelineDefaultVersion(params *UpdatePipelineDefaultVersionParams, authInfo runtime.ClientAuthInfoWriter) (*UpdatePipelineDefaultVersionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdatePipelineDefaultVersionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdatePipelineDefaultVersion",
+		Method:             "POST",
+		PathPattern:        "/apis/v1beta1/pipelines/{pipeline_id}/default_version/{version_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdatePipelineDefaultVersionReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdatePipelineDefaultVersionOK), nil
+
+}
+
+/*
+UpdatePipelineLabels replaces the labels of a pipeline
+*/
+func (a *Client) UpdatePipelineLabels(params *UpdatePipelineLabelsParams, authInfo runtime.ClientAuthInfoWriter) (*UpdatePipelineLabelsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdatePipelineLabelsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdatePipelineLabels",
+		Method:             "PUT",
+		PathPattern:        "/apis/v1beta1/pipelines/{id}/labels",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdatePipelineLabelsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdatePipelineLabelsOK), nil
+
+}
+
+/*
+UpdatePipelineVersionLabels replaces the labels of a pipeline version
+*/
+func (a *Client) UpdatePipelineVersionLabels(params *UpdatePipelineVersionLabelsParams, authInfo runtime.ClientAuthInfoWriter) (*UpdatePipelineVersionLabelsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdatePipelineVersionLabelsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdatePipelineVersionLabels",
+		Method:             "PUT",
+		PathPattern:        "/apis/v1beta1/pipeline_versions/{version_id}/labels",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdatePipelineVersionLabelsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdatePipelineVersionLabelsOK), nil
+
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
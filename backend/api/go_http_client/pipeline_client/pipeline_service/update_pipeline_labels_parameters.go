@@ -0,0 +1,172 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pipeline_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	pipeline_model "github.com/kubeflow/pipelines/backend/api/go_http_client/pipeline_model"
+)
+
+// NewUpdatePipelineLabelsParams creates a new UpdatePipelineLabelsParams object
+// with the default values initialized.
+func NewUpdatePipelineLabelsParams() *UpdatePipelineLabelsParams {
+	var ()
+	return &UpdatePipelineLabelsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdatePipelineLabelsParamsWithTimeout creates a new UpdatePipelineLabelsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewUpdatePipelineLabelsParamsWithTimeout(timeout time.Duration) *UpdatePipelineLabelsParams {
+	var ()
+	return &UpdatePipelineLabelsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewUpdatePipelineLabelsParamsWithContext creates a new UpdatePipelineLabelsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewUpdatePipelineLabelsParamsWithContext(ctx context.Context) *UpdatePipelineLabelsParams {
+	var ()
+	return &UpdatePipelineLabelsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewUpdatePipelineLabelsParamsWithHTTPClient creates a new UpdatePipelineLabelsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewUpdatePipelineLabelsParamsWithHTTPClient(client *http.Client) *UpdatePipelineLabelsParams {
+	var ()
+	return &UpdatePipelineLabelsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+UpdatePipelineLabelsParams contains all the parameters to send to the API endpoint
+for the update pipeline labels operation typically these are written to a http.Request
+*/
+type UpdatePipelineLabelsParams struct {
+
+	/*Body*/
+	Body *pipeline_model.APIUpdatePipelineLabelsRequest
+	/*ID
+	  The ID of the pipeline to be updated.
+
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the update pipeline labels params
+func (o *UpdatePipelineLabelsParams) WithTimeout(timeout time.Duration) *UpdatePipelineLabelsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update pipeline labels params
+func (o *UpdatePipelineLabelsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update pipeline labels params
+func (o *UpdatePipelineLabelsParams) WithContext(ctx context.Context) *UpdatePipelineLabelsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update pipeline labels params
+func (o *UpdatePipelineLabelsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update pipeline labels params
+func (o *UpdatePipelineLabelsParams) WithHTTPClient(client *http.Client) *UpdatePipelineLabelsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update pipeline labels params
+func (o *UpdatePipelineLabelsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the update pipeline labels params
+func (o *UpdatePipelineLabelsParams) WithBody(body *pipeline_model.APIUpdatePipelineLabelsRequest) *UpdatePipelineLabelsParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the update pipeline labels params
+func (o *UpdatePipelineLabelsParams) SetBody(body *pipeline_model.APIUpdatePipelineLabelsRequest) {
+	o.Body = body
+}
+
+// WithID adds the id to the update pipeline labels params
+func (o *UpdatePipelineLabelsParams) WithID(id string) *UpdatePipelineLabelsParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the update pipeline labels params
+func (o *UpdatePipelineLabelsParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdatePipelineLabelsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
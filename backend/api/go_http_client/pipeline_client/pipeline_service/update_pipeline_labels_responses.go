@@ -0,0 +1,128 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pipeline_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	pipeline_model "github.com/kubeflow/pipelines/backend/api/go_http_client/pipeline_model"
+)
+
+// UpdatePipelineLabelsReader is a Reader for the UpdatePipelineLabels structure.
+type UpdatePipelineLabelsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdatePipelineLabelsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewUpdatePipelineLabelsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewUpdatePipelineLabelsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUpdatePipelineLabelsOK creates a UpdatePipelineLabelsOK with default headers values
+func NewUpdatePipelineLabelsOK() *UpdatePipelineLabelsOK {
+	return &UpdatePipelineLabelsOK{}
+}
+
+/*
+UpdatePipelineLabelsOK handles this case with default header values.
+
+A successful response.
+*/
+type UpdatePipelineLabelsOK struct {
+	Payload *pipeline_model.APIPipeline
+}
+
+func (o *UpdatePipelineLabelsOK) Error() string {
+	return fmt.Sprintf("[PUT /apis/v1beta1/pipelines/{id}/labels][%d] updatePipelineLabelsOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdatePipelineLabelsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(pipeline_model.APIPipeline)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdatePipelineLabelsDefault creates a UpdatePipelineLabelsDefault with default headers values
+func NewUpdatePipelineLabelsDefault(code int) *UpdatePipelineLabelsDefault {
+	return &UpdatePipelineLabelsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+UpdatePipelineLabelsDefault handles this case with default header values.
+
+UpdatePipelineLabelsDefault update pipeline labels default
+*/
+type UpdatePipelineLabelsDefault struct {
+	_statusCode int
+
+	Payload *pipeline_model.APIStatus
+}
+
+// Code gets the status code for the update pipeline labels default response
+func (o *UpdatePipelineLabelsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *UpdatePipelineLabelsDefault) Error() string {
+	return fmt.Sprintf("[PUT /apis/v1beta1/pipelines/{id}/labels][%d] UpdatePipelineLabels default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UpdatePipelineLabelsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(pipeline_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pipeline_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	pipeline_model "github.com/kubeflow/pipelines/backend/api/go_http_client/pipeline_model"
+)
+
+// NewUpdatePipelineVersionLabelsParams creates a new UpdatePipelineVersionLabelsParams object
+// with the default values initialized.
+func NewUpdatePipelineVersionLabelsParams() *UpdatePipelineVersionLabelsParams {
+	var ()
+	return &UpdatePipelineVersionLabelsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdatePipelineVersionLabelsParamsWithTimeout creates a new UpdatePipelineVersionLabelsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewUpdatePipelineVersionLabelsParamsWithTimeout(timeout time.Duration) *UpdatePipelineVersionLabelsParams {
+	var ()
+	return &UpdatePipelineVersionLabelsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewUpdatePipelineVersionLabelsParamsWithContext creates a new UpdatePipelineVersionLabelsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewUpdatePipelineVersionLabelsParamsWithContext(ctx context.Context) *UpdatePipelineVersionLabelsParams {
+	var ()
+	return &UpdatePipelineVersionLabelsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewUpdatePipelineVersionLabelsParamsWithHTTPClient creates a new UpdatePipelineVersionLabelsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewUpdatePipelineVersionLabelsParamsWithHTTPClient(client *http.Client) *UpdatePipelineVersionLabelsParams {
+	var ()
+	return &UpdatePipelineVersionLabelsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+UpdatePipelineVersionLabelsParams contains all the parameters to send to the API endpoint
+for the update pipeline version labels operation typically these are written to a http.Request
+*/
+type UpdatePipelineVersionLabelsParams struct {
+
+	/*Body*/
+	Body *pipeline_model.APIUpdatePipelineVersionLabelsRequest
+	/*VersionID
+	  The ID of the pipeline version to be updated.
+
+	*/
+	VersionID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the update pipeline version labels params
+func (o *UpdatePipelineVersionLabelsParams) WithTimeout(timeout time.Duration) *UpdatePipelineVersionLabelsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update pipeline version labels params
+func (o *UpdatePipelineVersionLabelsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update pipeline version labels params
+func (o *UpdatePipelineVersionLabelsParams) WithContext(ctx context.Context) *UpdatePipelineVersionLabelsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update pipeline version labels params
+func (o *UpdatePipelineVersionLabelsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update pipeline version labels params
+func (o *UpdatePipelineVersionLabelsParams) WithHTTPClient(client *http.Client) *UpdatePipelineVersionLabelsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update pipeline version labels params
+func (o *UpdatePipelineVersionLabelsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the update pipeline version labels params
+func (o *UpdatePipelineVersionLabelsParams) WithBody(body *pipeline_model.APIUpdatePipelineVersionLabelsRequest) *UpdatePipelineVersionLabelsParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the update pipeline version labels params
+func (o *UpdatePipelineVersionLabelsParams) SetBody(body *pipeline_model.APIUpdatePipelineVersionLabelsRequest) {
+	o.Body = body
+}
+
+// WithVersionID adds the versionID to the update pipeline version labels params
+func (o *UpdatePipelineVersionLabelsParams) WithVersionID(versionID string) *UpdatePipelineVersionLabelsParams {
+	o.SetVersionID(versionID)
+	return o
+}
+
+// SetVersionID adds the versionId to the update pipeline version labels params
+func (o *UpdatePipelineVersionLabelsParams) SetVersionID(versionID string) {
+	o.VersionID = versionID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdatePipelineVersionLabelsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param version_id
+	if err := r.SetPathParam("version_id", o.VersionID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pipeline_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIParameterSpec Typed metadata of a pipeline parameter, declared in the
+// pipelines.kubeflow.org/parameter_schema annotation of the workflow.
+// swagger:model apiParameterSpec
+type APIParameterSpec struct {
+
+	// The default value of the parameter in the workflow, if any.
+	DefaultValue string `json:"default_value,omitempty"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// The allowed values of ENUM parameters.
+	EnumValues []string `json:"enum_values"`
+
+	// Inclusive upper bound of INT and FLOAT parameters.
+	Max float64 `json:"max,omitempty"`
+
+	// Inclusive lower bound of INT and FLOAT parameters.
+	Min float64 `json:"min,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// Regular expression that STRING and ARTIFACT_URI values must match
+	// entirely.
+	Pattern string `json:"pattern,omitempty"`
+
+	// Whether a non-empty value must be provided when the pipeline has no
+	// default value for the parameter.
+	Required bool `json:"required,omitempty"`
+
+	// type
+	Type APIParameterSpecType `json:"type,omitempty"`
+}
+
+// Validate validates this api parameter spec
+func (m *APIParameterSpec) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIParameterSpec) validateType(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	if err := m.Type.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("type")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIParameterSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIParameterSpec) UnmarshalBinary(b []byte) error {
+	var res APIParameterSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pipeline_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// APIParameterSpecType api parameter spec type
+// swagger:model apiParameterSpecType
+type APIParameterSpecType string
+
+const (
+
+	// APIParameterSpecTypeSTRING captures enum value "STRING"
+	APIParameterSpecTypeSTRING APIParameterSpecType = "STRING"
+
+	// APIParameterSpecTypeINT captures enum value "INT"
+	APIParameterSpecTypeINT APIParameterSpecType = "INT"
+
+	// APIParameterSpecTypeFLOAT captures enum value "FLOAT"
+	APIParameterSpecTypeFLOAT APIParameterSpecType = "FLOAT"
+
+	// APIParameterSpecTypeBOOL captures enum value "BOOL"
+	APIParameterSpecTypeBOOL APIParameterSpecType = "BOOL"
+
+	// APIParameterSpecTypeENUM captures enum value "ENUM"
+	APIParameterSpecTypeENUM APIParameterSpecType = "ENUM"
+
+	// APIParameterSpecTypeJSON captures enum value "JSON"
+	APIParameterSpecTypeJSON APIParameterSpecType = "JSON"
+
+	// APIParameterSpecTypeARTIFACTURI captures enum value "ARTIFACT_URI"
+	APIParameterSpecTypeARTIFACTURI APIParameterSpecType = "ARTIFACT_URI"
+)
+
+// for schema
+var apiParameterSpecTypeEnum []interface{}
+
+func init() {
+	var res []APIParameterSpecType
+	if err := json.Unmarshal([]byte(`["STRING","INT","FLOAT","BOOL","ENUM","JSON","ARTIFACT_URI"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		apiParameterSpecTypeEnum = append(apiParameterSpecTypeEnum, v)
+	}
+}
+
+func (m APIParameterSpecType) validateAPIParameterSpecTypeEnum(path, location string, value APIParameterSpecType) error {
+	if err := validate.Enum(path, location, value, apiParameterSpecTypeEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this api parameter spec type
+func (m APIParameterSpecType) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateAPIParameterSpecTypeEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
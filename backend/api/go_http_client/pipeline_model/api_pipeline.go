@@ -54,6 +54,11 @@ type APIPipeline struct {
 	// Output. Unique pipeline ID. Generated by API server.
 	ID string `json:"id,omitempty"`
 
+	// Optional input field. Key/value labels used to organize pipelines, e.g.
+	// team=ranking. Pipelines can be filtered by label using "label.<key>" as
+	// the filter key.
+	Labels map[string]string `json:"labels,omitempty"`
+
 	// Optional input field. Pipeline name provided by user. If not specified,
 	// file name is used as pipeline name.
 	Name string `json:"name,omitempty"`
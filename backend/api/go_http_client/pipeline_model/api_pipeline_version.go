@@ -40,9 +40,18 @@ type APIPipelineVersion struct {
 	// Format: date-time
 	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
 
+	// Output. The content digest of the stored pipeline template, in the form of
+	// "sha256:<hex>". Identical templates always have the same digest.
+	Digest string `json:"digest,omitempty"`
+
 	// Output. Unique version ID. Generated by API server.
 	ID string `json:"id,omitempty"`
 
+	// Optional input field. Key/value labels used to organize pipeline versions,
+	// e.g. stage=prod. Pipeline versions can be filtered by label using
+	// "label.<key>" as the filter key.
+	Labels map[string]string `json:"labels,omitempty"`
+
 	// Optional input field. Version name provided by user.
 	Name string `json:"name,omitempty"`
 
@@ -51,6 +60,11 @@ type APIPipelineVersion struct {
 	// file location.
 	PackageURL *APIURL `json:"package_url,omitempty"`
 
+	// Output. Typed metadata of the parameters, if the pipeline template
+	// declares a parameter schema. Run and job parameters are validated against
+	// it.
+	ParameterSchema []*APIParameterSpec `json:"parameter_schema"`
+
 	// Output. The input parameters for this pipeline.
 	Parameters []*APIParameter `json:"parameters"`
 
@@ -71,6 +85,10 @@ func (m *APIPipelineVersion) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateParameterSchema(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateParameters(formats); err != nil {
 		res = append(res, err)
 	}
@@ -116,6 +134,31 @@ func (m *APIPipelineVersion) validatePackageURL(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *APIPipelineVersion) validateParameterSchema(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ParameterSchema) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ParameterSchema); i++ {
+		if swag.IsZero(m.ParameterSchema[i]) { // not required
+			continue
+		}
+
+		if m.ParameterSchema[i] != nil {
+			if err := m.ParameterSchema[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("parameter_schema" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *APIPipelineVersion) validateParameters(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Parameters) { // not required
@@ -27,6 +27,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
 
 	strfmt "github.com/go-openapi/strfmt"
 )
@@ -70,11 +71,17 @@ func NewUploadPipelineVersionParamsWithHTTPClient(client *http.Client) *UploadPi
 	}
 }
 
-/*UploadPipelineVersionParams contains all the parameters to send to the API endpoint
+/*
+UploadPipelineVersionParams contains all the parameters to send to the API endpoint
 for the upload pipeline version operation typically these are written to a http.Request
 */
 type UploadPipelineVersionParams struct {
 
+	/*Deduplicate
+	  If true and the pipeline already has a version with the same content digest, that version is returned instead of creating a new one.
+
+	*/
+	Deduplicate *bool
 	/*Name*/
 	Name *string
 	/*Pipelineid*/
@@ -123,6 +130,17 @@ func (o *UploadPipelineVersionParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithDeduplicate adds the deduplicate to the upload pipeline version params
+func (o *UploadPipelineVersionParams) WithDeduplicate(deduplicate *bool) *UploadPipelineVersionParams {
+	o.SetDeduplicate(deduplicate)
+	return o
+}
+
+// SetDeduplicate adds the deduplicate to the upload pipeline version params
+func (o *UploadPipelineVersionParams) SetDeduplicate(deduplicate *bool) {
+	o.Deduplicate = deduplicate
+}
+
 // WithName adds the name to the upload pipeline version params
 func (o *UploadPipelineVersionParams) WithName(name *string) *UploadPipelineVersionParams {
 	o.SetName(name)
@@ -164,6 +182,22 @@ func (o *UploadPipelineVersionParams) WriteToRequest(r runtime.ClientRequest, re
 	}
 	var res []error
 
+	if o.Deduplicate != nil {
+
+		// query param deduplicate
+		var qrDeduplicate bool
+		if o.Deduplicate != nil {
+			qrDeduplicate = *o.Deduplicate
+		}
+		qDeduplicate := swag.FormatBool(qrDeduplicate)
+		if qDeduplicate != "" {
+			if err := r.SetQueryParam("deduplicate", qDeduplicate); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.Name != nil {
 
 		// query param name
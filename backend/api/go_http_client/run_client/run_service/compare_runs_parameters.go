@@ -0,0 +1,269 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewCompareRunsParams creates a new CompareRunsParams object
+// with the default values initialized.
+func NewCompareRunsParams() *CompareRunsParams {
+	var (
+		formatDefault = string("JSON")
+	)
+	return &CompareRunsParams{
+		Format: &formatDefault,
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCompareRunsParamsWithTimeout creates a new CompareRunsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewCompareRunsParamsWithTimeout(timeout time.Duration) *CompareRunsParams {
+	var (
+		formatDefault = string("JSON")
+	)
+	return &CompareRunsParams{
+		Format: &formatDefault,
+
+		timeout: timeout,
+	}
+}
+
+// NewCompareRunsParamsWithContext creates a new CompareRunsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewCompareRunsParamsWithContext(ctx context.Context) *CompareRunsParams {
+	var (
+		formatDefault = string("JSON")
+	)
+	return &CompareRunsParams{
+		Format: &formatDefault,
+
+		Context: ctx,
+	}
+}
+
+// NewCompareRunsParamsWithHTTPClient creates a new CompareRunsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewCompareRunsParamsWithHTTPClient(client *http.Client) *CompareRunsParams {
+	var (
+		formatDefault = string("JSON")
+	)
+	return &CompareRunsParams{
+		Format:     &formatDefault,
+		HTTPClient: client,
+	}
+}
+
+/*
+CompareRunsParams contains all the parameters to send to the API endpoint
+for the compare runs operation typically these are written to a http.Request
+*/
+type CompareRunsParams struct {
+
+	/*ExperimentID
+	  The ID of the experiment whose runs to compare. The most recently created
+	runs are compared, up to 100.
+
+	*/
+	ExperimentID *string
+	/*Filter
+	  A url-encoded, JSON-serialized Filter protocol buffer (see
+	[filter.proto](https://github.com/kubeflow/pipelines/
+	blob/master/backend/api/filter.proto)) on the runs of the experiment.
+
+	*/
+	Filter *string
+	/*Format
+	   - JSON: The comparison is returned as a CompareRunsResponse.
+	 - CSV: The comparison is exported as CSV, with a row per run. Only supported
+	over HTTP.
+
+	*/
+	Format *string
+	/*RunIds
+	  The IDs of the runs to compare. Either run_ids or experiment_id must be
+	set.
+
+	*/
+	RunIds []string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the compare runs params
+func (o *CompareRunsParams) WithTimeout(timeout time.Duration) *CompareRunsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the compare runs params
+func (o *CompareRunsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the compare runs params
+func (o *CompareRunsParams) WithContext(ctx context.Context) *CompareRunsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the compare runs params
+func (o *CompareRunsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the compare runs params
+func (o *CompareRunsParams) WithHTTPClient(client *http.Client) *CompareRunsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the compare runs params
+func (o *CompareRunsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithExperimentID adds the experimentID to the compare runs params
+func (o *CompareRunsParams) WithExperimentID(experimentID *string) *CompareRunsParams {
+	o.SetExperimentID(experimentID)
+	return o
+}
+
+// SetExperimentID adds the experimentId to the compare runs params
+func (o *CompareRunsParams) SetExperimentID(experimentID *string) {
+	o.ExperimentID = experimentID
+}
+
+// WithFilter adds the filter to the compare runs params
+func (o *CompareRunsParams) WithFilter(filter *string) *CompareRunsParams {
+	o.SetFilter(filter)
+	return o
+}
+
+// SetFilter adds the filter to the compare runs params
+func (o *CompareRunsParams) SetFilter(filter *string) {
+	o.Filter = filter
+}
+
+// WithFormat adds the format to the compare runs params
+func (o *CompareRunsParams) WithFormat(format *string) *CompareRunsParams {
+	o.SetFormat(format)
+	return o
+}
+
+// SetFormat adds the format to the compare runs params
+func (o *CompareRunsParams) SetFormat(format *string) {
+	o.Format = format
+}
+
+// WithRunIds adds the runIds to the compare runs params
+func (o *CompareRunsParams) WithRunIds(runIds []string) *CompareRunsParams {
+	o.SetRunIds(runIds)
+	return o
+}
+
+// SetRunIds adds the runIds to the compare runs params
+func (o *CompareRunsParams) SetRunIds(runIds []string) {
+	o.RunIds = runIds
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CompareRunsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.ExperimentID != nil {
+
+		// query param experiment_id
+		var qrExperimentID string
+		if o.ExperimentID != nil {
+			qrExperimentID = *o.ExperimentID
+		}
+		qExperimentID := qrExperimentID
+		if qExperimentID != "" {
+			if err := r.SetQueryParam("experiment_id", qExperimentID); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Filter != nil {
+
+		// query param filter
+		var qrFilter string
+		if o.Filter != nil {
+			qrFilter = *o.Filter
+		}
+		qFilter := qrFilter
+		if qFilter != "" {
+			if err := r.SetQueryParam("filter", qFilter); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Format != nil {
+
+		// query param format
+		var qrFormat string
+		if o.Format != nil {
+			qrFormat = *o.Format
+		}
+		qFormat := qrFormat
+		if qFormat != "" {
+			if err := r.SetQueryParam("format", qFormat); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	valuesRunIds := o.RunIds
+
+	joinedRunIds := swag.JoinByFormat(valuesRunIds, "multi")
+	// query array param run_ids
+	if err := r.SetQueryParam("run_ids", joinedRunIds...); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
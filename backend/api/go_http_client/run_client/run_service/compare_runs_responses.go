@@ -0,0 +1,128 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	run_model "github.com/kubeflow/pipelines/backend/api/go_http_client/run_model"
+)
+
+// CompareRunsReader is a Reader for the CompareRuns structure.
+type CompareRunsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CompareRunsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewCompareRunsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewCompareRunsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewCompareRunsOK creates a CompareRunsOK with default headers values
+func NewCompareRunsOK() *CompareRunsOK {
+	return &CompareRunsOK{}
+}
+
+/*
+CompareRunsOK handles this case with default header values.
+
+A successful response.
+*/
+type CompareRunsOK struct {
+	Payload *run_model.APICompareRunsResponse
+}
+
+func (o *CompareRunsOK) Error() string {
+	return fmt.Sprintf("[GET /apis/v1beta1/runs:compare][%d] compareRunsOK  %+v", 200, o.Payload)
+}
+
+func (o *CompareRunsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.APICompareRunsResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCompareRunsDefault creates a CompareRunsDefault with default headers values
+func NewCompareRunsDefault(code int) *CompareRunsDefault {
+	return &CompareRunsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+CompareRunsDefault handles this case with default header values.
+
+CompareRunsDefault compare runs default
+*/
+type CompareRunsDefault struct {
+	_statusCode int
+
+	Payload *run_model.APIStatus
+}
+
+// Code gets the status code for the compare runs default response
+func (o *CompareRunsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *CompareRunsDefault) Error() string {
+	return fmt.Sprintf("[GET /apis/v1beta1/runs:compare][%d] CompareRuns default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *CompareRunsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetRunMetricHistoryParams creates a new GetRunMetricHistoryParams object
+// with the default values initialized.
+func NewGetRunMetricHistoryParams() *GetRunMetricHistoryParams {
+	var ()
+	return &GetRunMetricHistoryParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetRunMetricHistoryParamsWithTimeout creates a new GetRunMetricHistoryParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetRunMetricHistoryParamsWithTimeout(timeout time.Duration) *GetRunMetricHistoryParams {
+	var ()
+	return &GetRunMetricHistoryParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetRunMetricHistoryParamsWithContext creates a new GetRunMetricHistoryParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetRunMetricHistoryParamsWithContext(ctx context.Context) *GetRunMetricHistoryParams {
+	var ()
+	return &GetRunMetricHistoryParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetRunMetricHistoryParamsWithHTTPClient creates a new GetRunMetricHistoryParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetRunMetricHistoryParamsWithHTTPClient(client *http.Client) *GetRunMetricHistoryParams {
+	var ()
+	return &GetRunMetricHistoryParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetRunMetricHistoryParams contains all the parameters to send to the API endpoint
+for the get run metric history operation typically these are written to a http.Request
+*/
+type GetRunMetricHistoryParams struct {
+
+	/*MetricName
+	  The name of the metric.
+
+	*/
+	MetricName string
+	/*NodeID
+	  The ID of the node which reported the metric.
+
+	*/
+	NodeID string
+	/*RunID
+	  The ID of the run.
+
+	*/
+	RunID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get run metric history params
+func (o *GetRunMetricHistoryParams) WithTimeout(timeout time.Duration) *GetRunMetricHistoryParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get run metric history params
+func (o *GetRunMetricHistoryParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get run metric history params
+func (o *GetRunMetricHistoryParams) WithContext(ctx context.Context) *GetRunMetricHistoryParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get run metric history params
+func (o *GetRunMetricHistoryParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get run metric history params
+func (o *GetRunMetricHistoryParams) WithHTTPClient(client *http.Client) *GetRunMetricHistoryParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get run metric history params
+func (o *GetRunMetricHistoryParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithMetricName adds the metricName to the get run metric history params
+func (o *GetRunMetricHistoryParams) WithMetricName(metricName string) *GetRunMetricHistoryParams {
+	o.SetMetricName(metricName)
+	return o
+}
+
+// SetMetricName adds the metricName to the get run metric history params
+func (o *GetRunMetricHistoryParams) SetMetricName(metricName string) {
+	o.MetricName = metricName
+}
+
+// WithNodeID adds the nodeID to the get run metric history params
+func (o *GetRunMetricHistoryParams) WithNodeID(nodeID string) *GetRunMetricHistoryParams {
+	o.SetNodeID(nodeID)
+	return o
+}
+
+// SetNodeID adds the nodeId to the get run metric history params
+func (o *GetRunMetricHistoryParams) SetNodeID(nodeID string) {
+	o.NodeID = nodeID
+}
+
+// WithRunID adds the runID to the get run metric history params
+func (o *GetRunMetricHistoryParams) WithRunID(runID string) *GetRunMetricHistoryParams {
+	o.SetRunID(runID)
+	return o
+}
+
+// SetRunID adds the runId to the get run metric history params
+func (o *GetRunMetricHistoryParams) SetRunID(runID string) {
+	o.RunID = runID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetRunMetricHistoryParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param metric_name
+	if err := r.SetPathParam("metric_name", o.MetricName); err != nil {
+		return err
+	}
+
+	// path param node_id
+	if err := r.SetPathParam("node_id", o.NodeID); err != nil {
+		return err
+	}
+
+	// path param run_id
+	if err := r.SetPathParam("run_id", o.RunID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	run_model "github.com/kubeflow/pipelines/backend/api/go_http_client/run_model"
+)
+
+// GetRunMetricHistoryReader is a Reader for the GetRunMetricHistory structure.
+type GetRunMetricHistoryReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetRunMetricHistoryReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetRunMetricHistoryOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewGetRunMetricHistoryDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetRunMetricHistoryOK creates a GetRunMetricHistoryOK with default headers values
+func NewGetRunMetricHistoryOK() *GetRunMetricHistoryOK {
+	return &GetRunMetricHistoryOK{}
+}
+
+/*
+GetRunMetricHistoryOK handles this case with default header values.
+
+A successful response.
+*/
+type GetRunMetricHistoryOK struct {
+	Payload *run_model.APIGetRunMetricHistoryResponse
+}
+
+func (o *GetRunMetricHistoryOK) Error() string {
+	return fmt.Sprintf("[GET /apis/v1beta1/runs/{run_id}/nodes/{node_id}/metrics/{metric_name}:history][%d] getRunMetricHistoryOK  %+v", 200, o.Payload)
+}
+
+func (o *GetRunMetricHistoryOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.APIGetRunMetricHistoryResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetRunMetricHistoryDefault creates a GetRunMetricHistoryDefault with default headers values
+func NewGetRunMetricHistoryDefault(code int) *GetRunMetricHistoryDefault {
+	return &GetRunMetricHistoryDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetRunMetricHistoryDefault handles this case with default header values.
+
+GetRunMetricHistoryDefault get run metric history default
+*/
+type GetRunMetricHistoryDefault struct {
+	_statusCode int
+
+	Payload *run_model.APIStatus
+}
+
+// Code gets the status code for the get run metric history default response
+func (o *GetRunMetricHistoryDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetRunMetricHistoryDefault) Error() string {
+	return fmt.Sprintf("[GET /apis/v1beta1/runs/{run_id}/nodes/{node_id}/metrics/{metric_name}:history][%d] GetRunMetricHistory default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetRunMetricHistoryDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewListRunArtifactsParams creates a new ListRunArtifactsParams object
+// with the default values initialized.
+func NewListRunArtifactsParams() *ListRunArtifactsParams {
+	var ()
+	return &ListRunArtifactsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListRunArtifactsParamsWithTimeout creates a new ListRunArtifactsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListRunArtifactsParamsWithTimeout(timeout time.Duration) *ListRunArtifactsParams {
+	var ()
+	return &ListRunArtifactsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListRunArtifactsParamsWithContext creates a new ListRunArtifactsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListRunArtifactsParamsWithContext(ctx context.Context) *ListRunArtifactsParams {
+	var ()
+	return &ListRunArtifactsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListRunArtifactsParamsWithHTTPClient creates a new ListRunArtifactsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListRunArtifactsParamsWithHTTPClient(client *http.Client) *ListRunArtifactsParams {
+	var ()
+	return &ListRunArtifactsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListRunArtifactsParams contains all the parameters to send to the API endpoint
+for the list run artifacts operation typically these are written to a http.Request
+*/
+type ListRunArtifactsParams struct {
+
+	/*RunID
+	  The ID of the run.
+
+	*/
+	RunID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list run artifacts params
+func (o *ListRunArtifactsParams) WithTimeout(timeout time.Duration) *ListRunArtifactsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list run artifacts params
+func (o *ListRunArtifactsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list run artifacts params
+func (o *ListRunArtifactsParams) WithContext(ctx context.Context) *ListRunArtifactsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list run artifacts params
+func (o *ListRunArtifactsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list run artifacts params
+func (o *ListRunArtifactsParams) WithHTTPClient(client *http.Client) *ListRunArtifactsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list run artifacts params
+func (o *ListRunArtifactsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithRunID adds the runID to the list run artifacts params
+func (o *ListRunArtifactsParams) WithRunID(runID string) *ListRunArtifactsParams {
+	o.SetRunID(runID)
+	return o
+}
+
+// SetRunID adds the runId to the list run artifacts params
+func (o *ListRunArtifactsParams) SetRunID(runID string) {
+	o.RunID = runID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListRunArtifactsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param run_id
+	if err := r.SetPathParam("run_id", o.RunID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
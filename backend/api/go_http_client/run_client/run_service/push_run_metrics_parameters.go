@@ -0,0 +1,172 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	run_model "github.com/kubeflow/pipelines/backend/api/go_http_client/run_model"
+)
+
+// NewPushRunMetricsParams creates a new PushRunMetricsParams object
+// with the default values initialized.
+func NewPushRunMetricsParams() *PushRunMetricsParams {
+	var ()
+	return &PushRunMetricsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPushRunMetricsParamsWithTimeout creates a new PushRunMetricsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewPushRunMetricsParamsWithTimeout(timeout time.Duration) *PushRunMetricsParams {
+	var ()
+	return &PushRunMetricsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewPushRunMetricsParamsWithContext creates a new PushRunMetricsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewPushRunMetricsParamsWithContext(ctx context.Context) *PushRunMetricsParams {
+	var ()
+	return &PushRunMetricsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewPushRunMetricsParamsWithHTTPClient creates a new PushRunMetricsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewPushRunMetricsParamsWithHTTPClient(client *http.Client) *PushRunMetricsParams {
+	var ()
+	return &PushRunMetricsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PushRunMetricsParams contains all the parameters to send to the API endpoint
+for the push run metrics operation typically these are written to a http.Request
+*/
+type PushRunMetricsParams struct {
+
+	/*Body*/
+	Body *run_model.APIReportRunMetricsRequest
+	/*RunID
+	  Required. The parent run ID of the metric.
+
+	*/
+	RunID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the push run metrics params
+func (o *PushRunMetricsParams) WithTimeout(timeout time.Duration) *PushRunMetricsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the push run metrics params
+func (o *PushRunMetricsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the push run metrics params
+func (o *PushRunMetricsParams) WithContext(ctx context.Context) *PushRunMetricsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the push run metrics params
+func (o *PushRunMetricsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the push run metrics params
+func (o *PushRunMetricsParams) WithHTTPClient(client *http.Client) *PushRunMetricsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the push run metrics params
+func (o *PushRunMetricsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the push run metrics params
+func (o *PushRunMetricsParams) WithBody(body *run_model.APIReportRunMetricsRequest) *PushRunMetricsParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the push run metrics params
+func (o *PushRunMetricsParams) SetBody(body *run_model.APIReportRunMetricsRequest) {
+	o.Body = body
+}
+
+// WithRunID adds the runID to the push run metrics params
+func (o *PushRunMetricsParams) WithRunID(runID string) *PushRunMetricsParams {
+	o.SetRunID(runID)
+	return o
+}
+
+// SetRunID adds the runId to the push run metrics params
+func (o *PushRunMetricsParams) SetRunID(runID string) {
+	o.RunID = runID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PushRunMetricsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param run_id
+	if err := r.SetPathParam("run_id", o.RunID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
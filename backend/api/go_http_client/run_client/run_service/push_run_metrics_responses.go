@@ -0,0 +1,128 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	run_model "github.com/kubeflow/pipelines/backend/api/go_http_client/run_model"
+)
+
+// PushRunMetricsReader is a Reader for the PushRunMetrics structure.
+type PushRunMetricsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PushRunMetricsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewPushRunMetricsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewPushRunMetricsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPushRunMetricsOK creates a PushRunMetricsOK with default headers values
+func NewPushRunMetricsOK() *PushRunMetricsOK {
+	return &PushRunMetricsOK{}
+}
+
+/*
+PushRunMetricsOK handles this case with default header values.
+
+A successful response.
+*/
+type PushRunMetricsOK struct {
+	Payload *run_model.APIReportRunMetricsResponse
+}
+
+func (o *PushRunMetricsOK) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/runs/{run_id}:pushMetrics][%d] pushRunMetricsOK  %+v", 200, o.Payload)
+}
+
+func (o *PushRunMetricsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.APIReportRunMetricsResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPushRunMetricsDefault creates a PushRunMetricsDefault with default headers values
+func NewPushRunMetricsDefault(code int) *PushRunMetricsDefault {
+	return &PushRunMetricsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+PushRunMetricsDefault handles this case with default header values.
+
+PushRunMetricsDefault push run metrics default
+*/
+type PushRunMetricsDefault struct {
+	_statusCode int
+
+	Payload *run_model.APIStatus
+}
+
+// Code gets the status code for the push run metrics default response
+func (o *PushRunMetricsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PushRunMetricsDefault) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/runs/{run_id}:pushMetrics][%d] PushRunMetrics default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *PushRunMetricsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
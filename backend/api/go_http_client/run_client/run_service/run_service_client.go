@@ -67,6 +67,35 @@ func (a *Client) ArchiveRun(params *ArchiveRunParams, authInfo runtime.ClientAut
 
 }
 
+/*
+CompareRuns compares runs by their parameters metrics duration status and pipeline version over HTTP the comparison can be exported as c s v with the format c s v query string
+*/
+func (a *Client) CompareRuns(params *CompareRunsParams, authInfo runtime.ClientAuthInfoWriter) (*CompareRunsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCompareRunsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "CompareRuns",
+		Method:             "GET",
+		PathPattern:        "/apis/v1beta1/runs:compare",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CompareRunsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*CompareRunsOK), nil
+
+}
+
 /*
 CreateRun creates a new run
 */
@@ -154,6 +183,64 @@ func (a *Client) GetRun(params *GetRunParams, authInfo runtime.ClientAuthInfoWri
 
 }
 
+/*
+GetRunMetricHistory gets the values of a time series metric of a run ordered by step
+*/
+func (a *Client) GetRunMetricHistory(params *GetRunMetricHistoryParams, authInfo runtime.ClientAuthInfoWriter) (*GetRunMetricHistoryOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetRunMetricHistoryParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetRunMetricHistory",
+		Method:             "GET",
+		PathPattern:        "/apis/v1beta1/runs/{run_id}/nodes/{node_id}/metrics/{metric_name}:history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetRunMetricHistoryReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetRunMetricHistoryOK), nil
+
+}
+
+/*
+ListRunArtifacts lists the input and output artifacts of all the nodes of a run
+*/
+func (a *Client) ListRunArtifacts(params *ListRunArtifactsParams, authInfo runtime.ClientAuthInfoWriter) (*ListRunArtifactsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListRunArtifactsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListRunArtifacts",
+		Method:             "GET",
+		PathPattern:        "/apis/v1beta1/runs/{run_id}/artifacts",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListRunArtifactsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListRunArtifactsOK), nil
+
+}
+
 /*
 ListRuns finds all runs
 */
@@ -183,6 +270,35 @@ func (a *Client) ListRuns(params *ListRunsParams, authInfo runtime.ClientAuthInf
 
 }
 
+/*
+PushRunMetrics pushes run metrics reports metrics of a run from its running steps so that they re visible before the steps complete unlike report run metrics a metric that has been reported before is updated and the points of a series are added to the series reported before the request must carry the run s metrics push token which the steps of the run get in the k f p m e t r i c s p u s h t o k e n environment variable in an authorization bearer token header
+*/
+func (a *Client) PushRunMetrics(params *PushRunMetricsParams, authInfo runtime.ClientAuthInfoWriter) (*PushRunMetricsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPushRunMetricsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PushRunMetrics",
+		Method:             "POST",
+		PathPattern:        "/apis/v1beta1/runs/{run_id}:pushMetrics",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &PushRunMetricsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PushRunMetricsOK), nil
+
+}
+
 /*
 ReadArtifact finds a run s artifact data
 */
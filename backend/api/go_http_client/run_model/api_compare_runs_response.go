@@ -0,0 +1,105 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APICompareRunsResponse api compare runs response
+// swagger:model apiCompareRunsResponse
+type APICompareRunsResponse struct {
+
+	// The names of all the metrics of the compared runs, sorted.
+	MetricNames []string `json:"metric_names"`
+
+	// The names of all the parameters of the compared runs, sorted.
+	ParameterNames []string `json:"parameter_names"`
+
+	// The compared runs, in the order of run_ids, or by creation time descending
+	// for the runs of an experiment.
+	Runs []*APIRunComparison `json:"runs"`
+
+	// The total number of runs of the experiment that match the filter, which
+	// can exceed the number of compared runs.
+	TotalSize int32 `json:"total_size,omitempty"`
+}
+
+// Validate validates this api compare runs response
+func (m *APICompareRunsResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRuns(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APICompareRunsResponse) validateRuns(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Runs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Runs); i++ {
+		if swag.IsZero(m.Runs[i]) { // not required
+			continue
+		}
+
+		if m.Runs[i] != nil {
+			if err := m.Runs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("runs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APICompareRunsResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APICompareRunsResponse) UnmarshalBinary(b []byte) error {
+	var res APICompareRunsResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
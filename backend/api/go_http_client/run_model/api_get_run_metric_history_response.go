@@ -0,0 +1,95 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIGetRunMetricHistoryResponse api get run metric history response
+// swagger:model apiGetRunMetricHistoryResponse
+type APIGetRunMetricHistoryResponse struct {
+
+	// The values of the metric, ordered by step. Empty if the metric was
+	// reported without a series.
+	Points []*APIRunMetricPoint `json:"points"`
+}
+
+// Validate validates this api get run metric history response
+func (m *APIGetRunMetricHistoryResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePoints(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIGetRunMetricHistoryResponse) validatePoints(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Points) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Points); i++ {
+		if swag.IsZero(m.Points[i]) { // not required
+			continue
+		}
+
+		if m.Points[i] != nil {
+			if err := m.Points[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("points" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIGetRunMetricHistoryResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIGetRunMetricHistoryResponse) UnmarshalBinary(b []byte) error {
+	var res APIGetRunMetricHistoryResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
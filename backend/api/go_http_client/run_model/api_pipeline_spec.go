@@ -47,6 +47,11 @@ type APIPipelineSpec struct {
 	// Not empty if the pipeline id is not empty.
 	PipelineName string `json:"pipeline_name,omitempty"`
 
+	// Output. The content digest of the workflow manifest, in the form of
+	// "sha256:<hex>". For runs, this is the digest of the exact spec the run
+	// executed.
+	WorkflowDigest string `json:"workflow_digest,omitempty"`
+
 	// Optional input field. The marshalled raw argo JSON workflow.
 	// This will be deprecated when pipeline_manifest is in use.
 	WorkflowManifest string `json:"workflow_manifest,omitempty"`
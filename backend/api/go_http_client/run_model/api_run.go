@@ -85,6 +85,10 @@ type APIRun struct {
 
 	// Output. Specify whether this run is in archived or available mode.
 	StorageState RunStorageState `json:"storage_state,omitempty"`
+
+	// Output. Warnings about the run, such as metrics that were dropped because
+	// the run reached its limit of metrics.
+	Warnings []string `json:"warnings"`
 }
 
 // Validate validates this api run
@@ -0,0 +1,101 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIRunArtifact api run artifact
+// swagger:model apiRunArtifact
+type APIRunArtifact struct {
+
+	// The object store key of the artifact. Empty if the artifact isn't stored
+	// in the object store.
+	Key string `json:"key,omitempty"`
+
+	// The name of the artifact.
+	Name string `json:"name,omitempty"`
+
+	// The ID of the node that takes or produces the artifact.
+	NodeID string `json:"node_id,omitempty"`
+
+	// The name of the template that produced the artifact. Empty for an input
+	// artifact that wasn't produced by any node of the run.
+	ProducerTemplate string `json:"producer_template,omitempty"`
+
+	// The size of the artifact in bytes, or -1 if the artifact can't be found in
+	// the object store.
+	Size string `json:"size,omitempty"`
+
+	// Whether the artifact is an input or an output of the node.
+	Type APIRunArtifactType `json:"type,omitempty"`
+}
+
+// Validate validates this api run artifact
+func (m *APIRunArtifact) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIRunArtifact) validateType(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	if err := m.Type.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("type")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIRunArtifact) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIRunArtifact) UnmarshalBinary(b []byte) error {
+	var res APIRunArtifact
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// APIRunArtifactType  - UNSPECIFIED: Default value if not present.
+//   - INPUT: The artifact is an input of the node.
+//   - OUTPUT: The artifact is an output of the node.
+//
+// swagger:model apiRunArtifactType
+type APIRunArtifactType string
+
+const (
+
+	// APIRunArtifactTypeUNSPECIFIED captures enum value "UNSPECIFIED"
+	APIRunArtifactTypeUNSPECIFIED APIRunArtifactType = "UNSPECIFIED"
+
+	// APIRunArtifactTypeINPUT captures enum value "INPUT"
+	APIRunArtifactTypeINPUT APIRunArtifactType = "INPUT"
+
+	// APIRunArtifactTypeOUTPUT captures enum value "OUTPUT"
+	APIRunArtifactTypeOUTPUT APIRunArtifactType = "OUTPUT"
+)
+
+// for schema
+var apiRunArtifactTypeEnum []interface{}
+
+func init() {
+	var res []APIRunArtifactType
+	if err := json.Unmarshal([]byte(`["UNSPECIFIED","INPUT","OUTPUT"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		apiRunArtifactTypeEnum = append(apiRunArtifactTypeEnum, v)
+	}
+}
+
+func (m APIRunArtifactType) validateAPIRunArtifactTypeEnum(path, location string, value APIRunArtifactType) error {
+	if err := validate.Enum(path, location, value, apiRunArtifactTypeEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this api run artifact type
+func (m APIRunArtifactType) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateAPIRunArtifactTypeEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
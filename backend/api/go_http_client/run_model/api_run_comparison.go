@@ -0,0 +1,108 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIRunComparison api run comparison
+// swagger:model apiRunComparison
+type APIRunComparison struct {
+
+	// The time the run was created.
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
+
+	// The duration of the run in seconds, or 0 if it hasn't finished.
+	DurationSeconds string `json:"duration_seconds,omitempty"`
+
+	// The number values of the metrics of the run, by name. If several nodes of
+	// the run report a metric of the same name, the metric of each node is keyed
+	// by its node ID and name instead, as "<node ID>/<name>".
+	Metrics map[string]float64 `json:"metrics,omitempty"`
+
+	// The name of the run.
+	Name string `json:"name,omitempty"`
+
+	// The parameters of the run, by name.
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// The ID of the pipeline version the run was created from, if any.
+	PipelineVersionID string `json:"pipeline_version_id,omitempty"`
+
+	// The name of the pipeline version the run was created from, if any.
+	PipelineVersionName string `json:"pipeline_version_name,omitempty"`
+
+	// The ID of the run.
+	RunID string `json:"run_id,omitempty"`
+
+	// The status of the run.
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this api run comparison
+func (m *APIRunComparison) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIRunComparison) validateCreatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIRunComparison) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIRunComparison) UnmarshalBinary(b []byte) error {
+	var res APIRunComparison
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
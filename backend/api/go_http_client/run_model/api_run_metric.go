@@ -20,6 +20,8 @@ package run_model
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/go-openapi/errors"
@@ -46,6 +48,15 @@ type APIRunMetric struct {
 
 	// The number value of the metric.
 	NumberValue float64 `json:"number_value,omitempty"`
+
+	// Optional. The values of a time-series metric, such as a loss curve, one
+	// per step. When set, number_value must be unset and the value of the
+	// metric, which runs are sorted by, is derived from the series according to
+	// series_summary. Max 1000 points.
+	Series []*APIRunMetricPoint `json:"series"`
+
+	// How the value of a time-series metric is derived from its series.
+	SeriesSummary RunMetricSeriesSummary `json:"series_summary,omitempty"`
 }
 
 // Validate validates this api run metric
@@ -56,6 +67,14 @@ func (m *APIRunMetric) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSeries(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSeriesSummary(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -78,6 +97,47 @@ func (m *APIRunMetric) validateFormat(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *APIRunMetric) validateSeries(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Series) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Series); i++ {
+		if swag.IsZero(m.Series[i]) { // not required
+			continue
+		}
+
+		if m.Series[i] != nil {
+			if err := m.Series[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("series" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *APIRunMetric) validateSeriesSummary(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SeriesSummary) { // not required
+		return nil
+	}
+
+	if err := m.SeriesSummary.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("series_summary")
+		}
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *APIRunMetric) MarshalBinary() ([]byte, error) {
 	if m == nil {
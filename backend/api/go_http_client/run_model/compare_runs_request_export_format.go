@@ -0,0 +1,80 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// CompareRunsRequestExportFormat  - JSON: The comparison is returned as a CompareRunsResponse.
+//   - CSV: The comparison is exported as CSV, with a row per run. Only supported
+//
+// over HTTP.
+// swagger:model CompareRunsRequestExportFormat
+type CompareRunsRequestExportFormat string
+
+const (
+
+	// CompareRunsRequestExportFormatJSON captures enum value "JSON"
+	CompareRunsRequestExportFormatJSON CompareRunsRequestExportFormat = "JSON"
+
+	// CompareRunsRequestExportFormatCSV captures enum value "CSV"
+	CompareRunsRequestExportFormatCSV CompareRunsRequestExportFormat = "CSV"
+)
+
+// for schema
+var compareRunsRequestExportFormatEnum []interface{}
+
+func init() {
+	var res []CompareRunsRequestExportFormat
+	if err := json.Unmarshal([]byte(`["JSON","CSV"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		compareRunsRequestExportFormatEnum = append(compareRunsRequestExportFormatEnum, v)
+	}
+}
+
+func (m CompareRunsRequestExportFormat) validateCompareRunsRequestExportFormatEnum(path, location string, value CompareRunsRequestExportFormat) error {
+	if err := validate.Enum(path, location, value, compareRunsRequestExportFormatEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this compare runs request export format
+func (m CompareRunsRequestExportFormat) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateCompareRunsRequestExportFormatEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
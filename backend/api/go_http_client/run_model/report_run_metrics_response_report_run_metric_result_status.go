@@ -29,10 +29,13 @@ import (
 )
 
 // ReportRunMetricsResponseReportRunMetricResultStatus  - UNSPECIFIED: Default value if not present.
-//  - OK: Indicates successful reporting.
-//  - INVALID_ARGUMENT: Indicates that the payload of the metric is invalid.
-//  - DUPLICATE_REPORTING: Indicates that the metric has been reported before.
-//  - INTERNAL_ERROR: Indicates that something went wrong in the server.
+//   - OK: Indicates successful reporting.
+//   - INVALID_ARGUMENT: Indicates that the payload of the metric is invalid.
+//   - DUPLICATE_REPORTING: Indicates that the metric has been reported before.
+//   - INTERNAL_ERROR: Indicates that something went wrong in the server.
+//   - RESOURCE_EXHAUSTED: Indicates that the metric was dropped because the run reached its limit
+//
+// of metrics.
 // swagger:model ReportRunMetricsResponseReportRunMetricResultStatus
 type ReportRunMetricsResponseReportRunMetricResultStatus string
 
@@ -52,6 +55,9 @@ const (
 
 	// ReportRunMetricsResponseReportRunMetricResultStatusINTERNALERROR captures enum value "INTERNAL_ERROR"
 	ReportRunMetricsResponseReportRunMetricResultStatusINTERNALERROR ReportRunMetricsResponseReportRunMetricResultStatus = "INTERNAL_ERROR"
+
+	// ReportRunMetricsResponseReportRunMetricResultStatusRESOURCEEXHAUSTED captures enum value "RESOURCE_EXHAUSTED"
+	ReportRunMetricsResponseReportRunMetricResultStatusRESOURCEEXHAUSTED ReportRunMetricsResponseReportRunMetricResultStatus = "RESOURCE_EXHAUSTED"
 )
 
 // for schema
@@ -59,7 +65,7 @@ var reportRunMetricsResponseReportRunMetricResultStatusEnum []interface{}
 
 func init() {
 	var res []ReportRunMetricsResponseReportRunMetricResultStatus
-	if err := json.Unmarshal([]byte(`["UNSPECIFIED","OK","INVALID_ARGUMENT","DUPLICATE_REPORTING","INTERNAL_ERROR"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["UNSPECIFIED","OK","INVALID_ARGUMENT","DUPLICATE_REPORTING","INTERNAL_ERROR","RESOURCE_EXHAUSTED"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -0,0 +1,83 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// RunMetricSeriesSummary  - LAST: The value at the last step.
+//   - MIN: The minimum value of the series.
+//   - MAX: The maximum value of the series.
+//
+// swagger:model RunMetricSeriesSummary
+type RunMetricSeriesSummary string
+
+const (
+
+	// RunMetricSeriesSummaryLAST captures enum value "LAST"
+	RunMetricSeriesSummaryLAST RunMetricSeriesSummary = "LAST"
+
+	// RunMetricSeriesSummaryMIN captures enum value "MIN"
+	RunMetricSeriesSummaryMIN RunMetricSeriesSummary = "MIN"
+
+	// RunMetricSeriesSummaryMAX captures enum value "MAX"
+	RunMetricSeriesSummaryMAX RunMetricSeriesSummary = "MAX"
+)
+
+// for schema
+var runMetricSeriesSummaryEnum []interface{}
+
+func init() {
+	var res []RunMetricSeriesSummary
+	if err := json.Unmarshal([]byte(`["LAST","MIN","MAX"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		runMetricSeriesSummaryEnum = append(runMetricSeriesSummaryEnum, v)
+	}
+}
+
+func (m RunMetricSeriesSummary) validateRunMetricSeriesSummaryEnum(path, location string, value RunMetricSeriesSummary) error {
+	if err := validate.Enum(path, location, value, runMetricSeriesSummaryEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this run metric series summary
+func (m RunMetricSeriesSummary) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateRunMetricSeriesSummaryEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -27,6 +27,7 @@ type PipelineClientFake struct {
 	artifacts                 map[string]*api.ReadArtifactResponse
 	readArtifactRequest       *api.ReadArtifactRequest
 	reportedMetricsRequest    *api.ReportRunMetricsRequest
+	reportMetricsCount        int
 	reportMetricsResponseStub *api.ReportRunMetricsResponse
 	reportMetricsErrorStub    error
 }
@@ -64,6 +65,7 @@ func (p *PipelineClientFake) ReadArtifact(request *api.ReadArtifactRequest) (*ap
 
 func (p *PipelineClientFake) ReportRunMetrics(request *api.ReportRunMetricsRequest) (*api.ReportRunMetricsResponse, error) {
 	p.reportedMetricsRequest = request
+	p.reportMetricsCount++
 	return p.reportMetricsResponseStub, p.reportMetricsErrorStub
 }
 
@@ -95,3 +97,7 @@ func (p *PipelineClientFake) StubReportRunMetrics(response *api.ReportRunMetrics
 func (p *PipelineClientFake) GetReportedMetricsRequest() *api.ReportRunMetricsRequest {
 	return p.reportedMetricsRequest
 }
+
+func (p *PipelineClientFake) GetReportMetricsCount() int {
+	return p.reportMetricsCount
+}
@@ -28,8 +28,9 @@ import (
 
 const (
 	metricsArtifactName = "mlpipeline-metrics"
-	// More than 50 metrics is not scalable with current UI design.
-	maxMetricsCountLimit = 50
+	// The number of metrics reported per request, so that large metric sets don't exceed the gRPC
+	// message size limit. The metric limit of a run is enforced by the pipeline server.
+	metricsReportBatchSize = 50
 )
 
 // MetricsReporter reports metrics of a workflow to pipeline server.
@@ -63,28 +64,23 @@ func (r MetricsReporter) ReportMetrics(workflow *util.Workflow) error {
 			continue
 		}
 		if nodeMetrics != nil {
-			if len(runMetrics)+len(nodeMetrics) >= maxMetricsCountLimit {
-				leftQuota := maxMetricsCountLimit - len(runMetrics)
-				runMetrics = append(runMetrics, nodeMetrics[0:leftQuota]...)
-				// TODO(#1426): report the error back to api server to notify user
-				log.Errorf("Reported metrics are more than the limit %v", maxMetricsCountLimit)
-				break
-			}
 			runMetrics = append(runMetrics, nodeMetrics...)
 		}
 	}
-	if len(runMetrics) == 0 {
-		return aggregateErrors(partialFailures)
-	}
-	reportMetricsResponse, err := r.pipelineClient.ReportRunMetrics(&api.ReportRunMetricsRequest{
-		RunId:   runID,
-		Metrics: runMetrics,
-	})
-	if err != nil {
-		return err
+	for start := 0; start < len(runMetrics); start += metricsReportBatchSize {
+		end := start + metricsReportBatchSize
+		if end > len(runMetrics) {
+			end = len(runMetrics)
+		}
+		reportMetricsResponse, err := r.pipelineClient.ReportRunMetrics(&api.ReportRunMetricsRequest{
+			RunId:   runID,
+			Metrics: runMetrics[start:end],
+		})
+		if err != nil {
+			return err
+		}
+		partialFailures = append(partialFailures, processReportMetricResults(reportMetricsResponse)...)
 	}
-
-	partialFailures = append(partialFailures, processReportMetricResults(reportMetricsResponse)...)
 	return aggregateErrors(partialFailures)
 }
 
@@ -101,7 +97,8 @@ func (r MetricsReporter) collectNodeMetricsOrNil(
 
 	// Proto json lib requires a proto message before unmarshal data from JSON. We use
 	// ReportRunMetricsRequest as a workaround to hold user's metrics, which is a superset of what
-	// user can provide.
+	// user can provide. A time-series metric has a series instead of a number value, e.g.
+	// {"name": "loss", "series": [{"step": 1, "numberValue": 0.9}], "seriesSummary": "MIN"}.
 	reportMetricsRequest := new(api.ReportRunMetricsRequest)
 	err = jsonpb.UnmarshalString(metricsJSON, reportMetricsRequest)
 	if err != nil {
@@ -196,6 +193,10 @@ func processReportMetricResult(
 		return util.NewCustomError(
 			errors.New(result.GetMessage()), util.CUSTOM_CODE_TRANSIENT,
 			"failed to report metric because of internal error: %+v", result)
+	case api.ReportRunMetricsResponse_ReportRunMetricResult_RESOURCE_EXHAUSTED:
+		// The pipeline server adds a warning to the run, so retrying wouldn't help.
+		log.Warningf("Metric dropped because the run reached its limit of metrics: %+v", result)
+		return nil
 	default:
 		// Ignore OK, DUP_REPORTING and UNSPECIFIED errors.
 		return nil
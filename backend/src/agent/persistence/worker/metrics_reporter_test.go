@@ -15,9 +15,12 @@
 package worker
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	workflowapi "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
 	"github.com/kubeflow/pipelines/backend/src/agent/persistence/client"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
@@ -192,6 +195,120 @@ func TestReportMetrics_Succeed(t *testing.T) {
 	assert.Equal(t, expectedMetricsRequest, pipelineFake.GetReportedMetricsRequest())
 }
 
+func TestReportMetrics_Series_Succeed(t *testing.T) {
+	pipelineFake := client.NewPipelineClientFake()
+	reporter := NewMetricsReporter(pipelineFake)
+	workflow := util.NewWorkflow(&workflowapi.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "MY_NAMESPACE",
+			Name:      "MY_NAME",
+			UID:       types.UID("run-1"),
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: "run-1"},
+		},
+		Status: workflowapi.WorkflowStatus{
+			Nodes: map[string]workflowapi.NodeStatus{
+				"node-1": workflowapi.NodeStatus{
+					ID:    "node-1",
+					Phase: workflowapi.NodeSucceeded,
+					Outputs: &workflowapi.Outputs{
+						Artifacts: []workflowapi.Artifact{{Name: "mlpipeline-metrics"}},
+					},
+				},
+			},
+		},
+	})
+	metricsJSON := `{"metrics": [{"name": "loss", "seriesSummary": "MIN", "series": [
+		{"step": 1, "numberValue": 0.9, "timestamp": "2020-01-01T00:00:00Z"},
+		{"step": 2, "numberValue": 0.4}]}]}`
+	artifactData, _ := util.ArchiveTgz(map[string]string{"file": metricsJSON})
+	pipelineFake.StubArtifact(
+		&api.ReadArtifactRequest{
+			RunId:        "run-1",
+			NodeId:       "node-1",
+			ArtifactName: "mlpipeline-metrics",
+		},
+		&api.ReadArtifactResponse{
+			Data: []byte(artifactData),
+		})
+	pipelineFake.StubReportRunMetrics(&api.ReportRunMetricsResponse{
+		Results: []*api.ReportRunMetricsResponse_ReportRunMetricResult{},
+	}, nil)
+
+	err := reporter.ReportMetrics(workflow)
+
+	assert.Nil(t, err)
+	expectedMetricsRequest := &api.ReportRunMetricsRequest{
+		RunId: "run-1",
+		Metrics: []*api.RunMetric{
+			{
+				Name:          "loss",
+				NodeId:        "node-1",
+				SeriesSummary: api.RunMetric_MIN,
+				Series: []*api.RunMetricPoint{
+					{Step: 1, NumberValue: 0.9, Timestamp: &timestamp.Timestamp{Seconds: 1577836800}},
+					{Step: 2, NumberValue: 0.4},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expectedMetricsRequest, pipelineFake.GetReportedMetricsRequest())
+}
+
+func TestReportMetrics_Batched(t *testing.T) {
+	pipelineFake := client.NewPipelineClientFake()
+	reporter := NewMetricsReporter(pipelineFake)
+	workflow := util.NewWorkflow(&workflowapi.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "MY_NAMESPACE",
+			Name:      "MY_NAME",
+			UID:       types.UID("run-1"),
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: "run-1"},
+		},
+		Status: workflowapi.WorkflowStatus{
+			Nodes: map[string]workflowapi.NodeStatus{
+				"node-1": workflowapi.NodeStatus{
+					ID:    "node-1",
+					Phase: workflowapi.NodeSucceeded,
+					Outputs: &workflowapi.Outputs{
+						Artifacts: []workflowapi.Artifact{{Name: "mlpipeline-metrics"}},
+					},
+				},
+			},
+		},
+	})
+	var metrics []string
+	for i := 0; i < metricsReportBatchSize+10; i++ {
+		metrics = append(metrics, fmt.Sprintf(`{"name": "metric-%d", "numberValue": %d}`, i, i))
+	}
+	metricsJSON := `{"metrics": [` + strings.Join(metrics, ",") + `]}`
+	artifactData, _ := util.ArchiveTgz(map[string]string{"file": metricsJSON})
+	pipelineFake.StubArtifact(
+		&api.ReadArtifactRequest{
+			RunId:        "run-1",
+			NodeId:       "node-1",
+			ArtifactName: "mlpipeline-metrics",
+		},
+		&api.ReadArtifactResponse{
+			Data: []byte(artifactData),
+		})
+	pipelineFake.StubReportRunMetrics(&api.ReportRunMetricsResponse{
+		Results: []*api.ReportRunMetricsResponse_ReportRunMetricResult{{
+			MetricName:   "metric-59",
+			MetricNodeId: "node-1",
+			Status:       api.ReportRunMetricsResponse_ReportRunMetricResult_RESOURCE_EXHAUSTED,
+		}},
+	}, nil)
+
+	err := reporter.ReportMetrics(workflow)
+
+	// Metrics dropped for the run's limit aren't errors.
+	assert.Nil(t, err)
+	assert.Equal(t, 2, pipelineFake.GetReportMetricsCount())
+	lastRequest := pipelineFake.GetReportedMetricsRequest()
+	assert.Len(t, lastRequest.Metrics, 10)
+	assert.Equal(t, "metric-59", lastRequest.Metrics[9].Name)
+}
+
 func TestReportMetrics_EmptyArchive_Fail(t *testing.T) {
 	pipelineFake := client.NewPipelineClientFake()
 	reporter := NewMetricsReporter(pipelineFake)
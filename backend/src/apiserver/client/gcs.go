@@ -0,0 +1,56 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"time"
+
+	gcs "cloud.google.com/go/storage"
+	"github.com/cenkalti/backoff"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+)
+
+// CreateGCSClient creates a Google Cloud Storage client. Without a credentials file, the client
+// uses the application default credentials, such as the GKE workload identity of the pod.
+func CreateGCSClient(credentialsFile string) (*gcs.Client, error) {
+	var opts []option.ClientOption
+	if credentialsFile != "" {
+		opts = append(opts, option.WithCredentialsFile(credentialsFile))
+	}
+	gcsClient, err := gcs.NewClient(context.Background(), opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error while creating GCS client: %+v", err)
+	}
+	return gcsClient, nil
+}
+
+func CreateGCSClientOrFatal(credentialsFile string, initConnectionTimeout time.Duration) *gcs.Client {
+	var gcsClient *gcs.Client
+	var err error
+	var operation = func() error {
+		gcsClient, err = CreateGCSClient(credentialsFile)
+		return err
+	}
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = initConnectionTimeout
+	err = backoff.Retry(operation, b)
+	if err != nil {
+		glog.Fatalf("Failed to create GCS client. Error: %v", err)
+	}
+	return gcsClient
+}
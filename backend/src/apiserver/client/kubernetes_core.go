@@ -12,6 +12,7 @@ import (
 
 type KubernetesCoreInterface interface {
 	PodClient(namespace string) v1.PodInterface
+	SecretClient(namespace string) v1.SecretInterface
 }
 
 type KubernetesCore struct {
@@ -22,6 +23,10 @@ func (c *KubernetesCore) PodClient(namespace string) v1.PodInterface {
 	return c.coreV1Client.Pods(namespace)
 }
 
+func (c *KubernetesCore) SecretClient(namespace string) v1.SecretInterface {
+	return c.coreV1Client.Secrets(namespace)
+}
+
 func createKubernetesCore() (KubernetesCoreInterface, error) {
 	restConfig, err := rest.InClusterConfig()
 	if err != nil {
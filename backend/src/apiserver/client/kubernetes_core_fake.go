@@ -20,7 +20,8 @@ import (
 )
 
 type FakeKuberneteCoreClient struct {
-	podClientFake *FakePodClient
+	podClientFake    *FakePodClient
+	secretClientFake *FakeSecretClient
 }
 
 func (c *FakeKuberneteCoreClient) PodClient(namespace string) v1.PodInterface {
@@ -30,18 +31,27 @@ func (c *FakeKuberneteCoreClient) PodClient(namespace string) v1.PodInterface {
 	return c.podClientFake
 }
 
+func (c *FakeKuberneteCoreClient) SecretClient(namespace string) v1.SecretInterface {
+	return c.secretClientFake
+}
+
 func NewFakeKuberneteCoresClient() *FakeKuberneteCoreClient {
-	return &FakeKuberneteCoreClient{&FakePodClient{}}
+	return &FakeKuberneteCoreClient{&FakePodClient{}, NewFakeSecretClient()}
 }
 
 type FakeKubernetesCoreClientWithBadPodClient struct {
-	podClientFake *FakeBadPodClient
+	podClientFake    *FakeBadPodClient
+	secretClientFake *FakeSecretClient
 }
 
 func NewFakeKubernetesCoreClientWithBadPodClient() *FakeKubernetesCoreClientWithBadPodClient {
-	return &FakeKubernetesCoreClientWithBadPodClient{&FakeBadPodClient{}}
+	return &FakeKubernetesCoreClientWithBadPodClient{&FakeBadPodClient{}, NewFakeSecretClient()}
 }
 
 func (c *FakeKubernetesCoreClientWithBadPodClient) PodClient(namespace string) v1.PodInterface {
 	return c.podClientFake
 }
+
+func (c *FakeKubernetesCoreClientWithBadPodClient) SecretClient(namespace string) v1.SecretInterface {
+	return c.secretClientFake
+}
@@ -59,9 +59,12 @@ func (c *FakeScheduledWorkflowClient) Get(name string, options v1.GetOptions) (*
 	return nil, errors.New("not found")
 }
 
-func (c *FakeScheduledWorkflowClient) Update(*v1beta1.ScheduledWorkflow) (*v1beta1.ScheduledWorkflow, error) {
-	glog.Error("This fake method is not yet implemented.")
-	return nil, nil
+func (c *FakeScheduledWorkflowClient) Update(scheduledWorkflow *v1beta1.ScheduledWorkflow) (*v1beta1.ScheduledWorkflow, error) {
+	if _, ok := c.scheduledWorkflows[scheduledWorkflow.Name]; !ok {
+		return nil, errors.New("not found")
+	}
+	c.scheduledWorkflows[scheduledWorkflow.Name] = scheduledWorkflow
+	return scheduledWorkflow, nil
 }
 
 func (c *FakeScheduledWorkflowClient) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
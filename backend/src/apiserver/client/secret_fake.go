@@ -0,0 +1,85 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// FakeSecretClient keeps the secrets it creates in memory, regardless of their namespace.
+type FakeSecretClient struct {
+	secrets map[string]*corev1.Secret
+}
+
+func NewFakeSecretClient() *FakeSecretClient {
+	return &FakeSecretClient{secrets: make(map[string]*corev1.Secret)}
+}
+
+func (c *FakeSecretClient) Create(secret *corev1.Secret) (*corev1.Secret, error) {
+	if _, ok := c.secrets[secret.Name]; ok {
+		return nil, apierr.NewAlreadyExists(corev1.Resource("secrets"), secret.Name)
+	}
+	c.secrets[secret.Name] = secret
+	return secret, nil
+}
+
+func (c *FakeSecretClient) Update(secret *corev1.Secret) (*corev1.Secret, error) {
+	if _, ok := c.secrets[secret.Name]; !ok {
+		return nil, apierr.NewNotFound(corev1.Resource("secrets"), secret.Name)
+	}
+	c.secrets[secret.Name] = secret
+	return secret, nil
+}
+
+func (c *FakeSecretClient) Delete(name string, options *v1.DeleteOptions) error {
+	if _, ok := c.secrets[name]; !ok {
+		return apierr.NewNotFound(corev1.Resource("secrets"), name)
+	}
+	delete(c.secrets, name)
+	return nil
+}
+
+func (c *FakeSecretClient) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	glog.Error("This fake method is not yet implemented.")
+	return nil
+}
+
+func (c *FakeSecretClient) Get(name string, options v1.GetOptions) (*corev1.Secret, error) {
+	secret, ok := c.secrets[name]
+	if !ok {
+		return nil, apierr.NewNotFound(corev1.Resource("secrets"), name)
+	}
+	return secret, nil
+}
+
+func (c *FakeSecretClient) List(opts v1.ListOptions) (*corev1.SecretList, error) {
+	glog.Error("This fake method is not yet implemented.")
+	return nil, nil
+}
+
+func (c *FakeSecretClient) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	glog.Error("This fake method is not yet implemented.")
+	return nil, nil
+}
+
+func (c *FakeSecretClient) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *corev1.Secret, err error) {
+	glog.Error("This fake method is not yet implemented.")
+	return nil, nil
+}
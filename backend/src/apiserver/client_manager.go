@@ -15,11 +15,13 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"os"
 	"time"
 
+	gcs "cloud.google.com/go/storage"
 	"github.com/cenkalti/backoff"
 	"github.com/golang/glog"
 	"github.com/jinzhu/gorm"
@@ -30,6 +32,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/minio/minio-go"
+	"github.com/minio/minio-go/pkg/encrypt"
 )
 
 const (
@@ -39,6 +42,7 @@ const (
 	minioServiceSecure     = "MINIO_SERVICE_SECURE"
 	pipelineBucketName     = "MINIO_PIPELINE_BUCKET_NAME"
 	pipelinePath           = "MINIO_PIPELINE_PATH"
+	objectStoreType        = "ObjectStoreConfig.Type"
 	mysqlServiceHost       = "DBConfig.Host"
 	mysqlServicePort       = "DBConfig.Port"
 	mysqlUser              = "DBConfig.User"
@@ -63,6 +67,7 @@ type ClientManager struct {
 	jobStore               storage.JobStoreInterface
 	runStore               storage.RunStoreInterface
 	resourceReferenceStore storage.ResourceReferenceStoreInterface
+	labelStore             storage.LabelStoreInterface
 	dBStatusStore          storage.DBStatusStoreInterface
 	defaultExperimentStore storage.DefaultExperimentStoreInterface
 	objectStore            storage.ObjectStoreInterface
@@ -94,6 +99,10 @@ func (c *ClientManager) ResourceReferenceStore() storage.ResourceReferenceStoreI
 	return c.resourceReferenceStore
 }
 
+func (c *ClientManager) LabelStore() storage.LabelStoreInterface {
+	return c.labelStore
+}
+
 func (c *ClientManager) DBStatusStore() storage.DBStatusStoreInterface {
 	return c.dBStatusStore
 }
@@ -145,9 +154,10 @@ func (c *ClientManager) init() {
 	c.pipelineStore = storage.NewPipelineStore(db, c.time, c.uuid)
 	c.jobStore = storage.NewJobStore(db, c.time)
 	c.resourceReferenceStore = storage.NewResourceReferenceStore(db)
+	c.labelStore = storage.NewLabelStore(db)
 	c.dBStatusStore = storage.NewDBStatusStore(db)
 	c.defaultExperimentStore = storage.NewDefaultExperimentStore(db)
-	c.objectStore = initMinioClient(common.GetDurationConfig(initConnectionTimeout))
+	c.objectStore = initObjectStore(common.GetDurationConfig(initConnectionTimeout))
 
 	c.argoClient = client.NewArgoClientOrFatal(common.GetDurationConfig(initConnectionTimeout))
 
@@ -202,9 +212,11 @@ func initDBClient(initConnectionTimeout time.Duration) *storage.DB {
 		&model.Job{},
 		&model.Pipeline{},
 		&model.PipelineVersion{},
+		&model.Label{},
 		&model.ResourceReference{},
 		&model.RunDetail{},
 		&model.RunMetric{},
+		&model.RunMetricPoint{},
 		&model.DBStatus{},
 		&model.DefaultExperiment{})
 
@@ -237,6 +249,11 @@ func initDBClient(initConnectionTimeout time.Duration) *storage.DB {
 	if response.Error != nil {
 		glog.Fatalf("Failed to create a foreign key for RunID in run_metrics table. Error: %s", response.Error)
 	}
+	response = db.Model(&model.RunMetricPoint{}).
+		AddForeignKey("RunUUID", "run_details(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
+	if response.Error != nil {
+		glog.Fatalf("Failed to create a foreign key for RunID in run_metric_points table. Error: %s", response.Error)
+	}
 	response = db.Model(&model.PipelineVersion{}).
 		AddForeignKey("PipelineId", "pipelines(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
 	if response.Error != nil {
@@ -326,6 +343,23 @@ func initMysql(driverName string, initConnectionTimeout time.Duration) string {
 	return mysqlConfig.FormatDSN()
 }
 
+// initObjectStore creates the object store of the backend selected by ObjectStoreConfig.Type.
+func initObjectStore(initConnectionTimeout time.Duration) storage.ObjectStoreInterface {
+	switch storeType := common.GetStringConfigWithDefault(objectStoreType, "minio"); storeType {
+	case "minio":
+		return initMinioClient(initConnectionTimeout)
+	case "s3":
+		return initS3ObjectStore(initConnectionTimeout)
+	case "gcs":
+		return initGCSObjectStore(initConnectionTimeout)
+	case "local":
+		return initLocalObjectStore()
+	default:
+		glog.Fatalf("Unsupported object store type %v. Supported types are minio, s3, gcs and local.", storeType)
+		return nil
+	}
+}
+
 func initMinioClient(initConnectionTimeout time.Duration) storage.ObjectStoreInterface {
 	// Create minio client.
 	minioServiceHost := common.GetStringConfigWithDefault(
@@ -349,6 +383,66 @@ func initMinioClient(initConnectionTimeout time.Duration) storage.ObjectStoreInt
 	return storage.NewMinioObjectStore(&storage.MinioClient{Client: minioClient}, bucketName, pipelinePath, disableMultipart)
 }
 
+func initS3ObjectStore(initConnectionTimeout time.Duration) storage.ObjectStoreInterface {
+	host := common.GetStringConfigWithDefault("ObjectStoreConfig.Host", "s3.amazonaws.com")
+	port := common.GetStringConfigWithDefault("ObjectStoreConfig.Port", "")
+	region := common.GetStringConfigWithDefault("ObjectStoreConfig.Region", "")
+	secure := common.GetBoolConfigWithDefault("ObjectStoreConfig.Secure", true)
+	// Without static keys, the credentials come from the AWS environment variables or the IAM role.
+	accessKey := common.GetStringConfigWithDefault("ObjectStoreConfig.S3.AccessKey", "")
+	secretKey := common.GetStringConfigWithDefault("ObjectStoreConfig.S3.SecretAccessKey", "")
+	kmsKeyID := common.GetStringConfigWithDefault("ObjectStoreConfig.S3.KMSKeyID", "")
+	bucketName := common.GetStringConfigWithDefault("ObjectStoreConfig.BucketName", os.Getenv(pipelineBucketName))
+	pipelinePath := common.GetStringConfigWithDefault("ObjectStoreConfig.PipelinePath", os.Getenv(pipelinePath))
+
+	var serverSideEncryption encrypt.ServerSide
+	if kmsKeyID != "" {
+		var err error
+		serverSideEncryption, err = encrypt.NewSSEKMS(kmsKeyID, nil)
+		if err != nil {
+			glog.Fatalf("Failed to create SSE-KMS encryption with key %v. Error: %v", kmsKeyID, err)
+		}
+	}
+	s3Client := client.CreateMinioClientOrFatal(host, port, accessKey, secretKey, secure, region, initConnectionTimeout)
+	createMinioBucket(s3Client, bucketName, region)
+
+	return storage.NewS3ObjectStore(&storage.MinioClient{Client: s3Client}, bucketName, pipelinePath, serverSideEncryption)
+}
+
+func initGCSObjectStore(initConnectionTimeout time.Duration) storage.ObjectStoreInterface {
+	// Without a credentials file, the application default credentials are used, e.g. the workload identity.
+	credentialsFile := common.GetStringConfigWithDefault("ObjectStoreConfig.GCS.CredentialsFile", "")
+	bucketName := common.GetStringConfigWithDefault("ObjectStoreConfig.BucketName", os.Getenv(pipelineBucketName))
+	pipelinePath := common.GetStringConfigWithDefault("ObjectStoreConfig.PipelinePath", os.Getenv(pipelinePath))
+
+	gcsClient := client.CreateGCSClientOrFatal(credentialsFile, initConnectionTimeout)
+	checkGCSBucket(gcsClient, bucketName)
+
+	return storage.NewGCSObjectStore(&storage.GCSClient{Client: gcsClient}, bucketName, pipelinePath)
+}
+
+func initLocalObjectStore() storage.ObjectStoreInterface {
+	rootDir := common.GetStringConfigWithDefault("ObjectStoreConfig.Local.RootDir", "")
+	if rootDir == "" {
+		glog.Fatalf("ObjectStoreConfig.Local.RootDir must be set for the local object store.")
+	}
+	pipelinePath := common.GetStringConfigWithDefault("ObjectStoreConfig.PipelinePath", os.Getenv(pipelinePath))
+	if err := os.MkdirAll(rootDir, 0755); err != nil {
+		glog.Fatalf("Failed to create the local object store directory %v. Error: %v", rootDir, err)
+	}
+	return storage.NewLocalObjectStore(rootDir, pipelinePath)
+}
+
+// checkGCSBucket makes sure the GCS bucket exists. Unlike Minio buckets, GCS buckets aren't created
+// by the API server, since that requires a project and broader permissions.
+func checkGCSBucket(gcsClient *gcs.Client, bucketName string) {
+	_, err := gcsClient.Bucket(bucketName).Attrs(context.Background())
+	if err != nil {
+		glog.Fatalf("Failed to get GCS bucket %s. Error: %v", bucketName, err)
+	}
+	glog.Infof("Using GCS bucket %s\n", bucketName)
+}
+
 func createMinioBucket(minioClient *minio.Client, bucketName, region string) {
 	// Check to see if we already own this bucket.
 	exists, err := minioClient.BucketExists(bucketName)
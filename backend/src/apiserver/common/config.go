@@ -30,6 +30,9 @@ const (
 	DefaultPipelineRunnerServiceAccount string = "DefaultPipelineRunnerServiceAccount"
 	KubeflowUserIDHeader                string = "KUBEFLOW_USERID_HEADER"
 	KubeflowUserIDPrefix                string = "KUBEFLOW_USERID_PREFIX"
+	MetricsPushTokenSecret              string = "MetricsPushTokenSecret"
+	RunMetricsLimit                     string = "RunMetricsLimit"
+	RunMetricsLimitPerNamespace         string = "RunMetricsLimitPerNamespace"
 )
 
 func GetStringConfig(configName string) string {
@@ -65,6 +68,17 @@ func GetBoolConfigWithDefault(configName string, value bool) bool {
 	return value
 }
 
+func GetIntConfigWithDefault(configName string, value int) int {
+	if !viper.IsSet(configName) {
+		return value
+	}
+	value, err := strconv.Atoi(viper.GetString(configName))
+	if err != nil {
+		glog.Fatalf("Failed converting string to int %s", viper.GetString(configName))
+	}
+	return value
+}
+
 func GetDurationConfig(configName string) time.Duration {
 	if !viper.IsSet(configName) {
 		glog.Fatalf("Please specify flag %s", configName)
@@ -103,3 +117,25 @@ func GetKubeflowUserIDHeader() string {
 func GetKubeflowUserIDPrefix() string {
 	return GetStringConfigWithDefault(KubeflowUserIDPrefix, GoogleIAPUserIdentityPrefix)
 }
+
+// GetMetricsPushTokenSecret returns the secret run metrics push tokens are signed with. Pushing
+// metrics is disabled when it's empty.
+func GetMetricsPushTokenSecret() string {
+	return GetStringConfigWithDefault(MetricsPushTokenSecret, "")
+}
+
+// GetRunMetricsLimit returns the maximum number of metrics of a run in the namespace. The limit of
+// the namespace in RunMetricsLimitPerNamespace takes precedence over RunMetricsLimit. A limit of 0
+// means no limit.
+func GetRunMetricsLimit(namespace string) int {
+	if namespace != "" && viper.IsSet(RunMetricsLimitPerNamespace) {
+		if limit, ok := viper.GetStringMapString(RunMetricsLimitPerNamespace)[namespace]; ok {
+			value, err := strconv.Atoi(limit)
+			if err == nil {
+				return value
+			}
+			glog.Errorf("Invalid metrics limit %s of namespace %s in %s", limit, namespace, RunMetricsLimitPerNamespace)
+		}
+	}
+	return GetIntConfigWithDefault(RunMetricsLimit, DefaultRunMetricsLimit)
+}
@@ -0,0 +1,34 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRunMetricsLimit(t *testing.T) {
+	assert.Equal(t, DefaultRunMetricsLimit, GetRunMetricsLimit("ns1"))
+
+	viper.Set(RunMetricsLimit, "100")
+	viper.Set(RunMetricsLimitPerNamespace, map[string]string{"ns1": "200", "ns2": "invalid"})
+	defer viper.Set(RunMetricsLimit, nil)
+	defer viper.Set(RunMetricsLimitPerNamespace, nil)
+	assert.Equal(t, 200, GetRunMetricsLimit("ns1"))
+	assert.Equal(t, 100, GetRunMetricsLimit("ns2"))
+	assert.Equal(t, 100, GetRunMetricsLimit(""))
+}
@@ -41,6 +41,14 @@ const (
 	GoogleIAPUserIdentityPrefix string = "accounts.google.com:"
 )
 
+const (
+	// MaxRunMetricSeriesLength is the maximum number of points of a time-series run metric.
+	MaxRunMetricSeriesLength = 1000
+	// DefaultRunMetricsLimit is the maximum number of metrics of a run, unless configured otherwise.
+	// More than 50 metrics is not scalable with current UI design.
+	DefaultRunMetricsLimit = 50
+)
+
 func ToModelResourceType(apiType api.ResourceType) (ResourceType, error) {
 	switch apiType {
 	case api.ResourceType_EXPERIMENT:
@@ -0,0 +1,49 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// MetricsPushTokenSecretKey is the key of the metrics push token in the secret of a run.
+const MetricsPushTokenSecretKey = "token"
+
+// GetMetricsPushTokenSecretName returns the name of the secret the steps of a run read its metrics
+// push token from, so that the token is not part of the workflow of the run.
+func GetMetricsPushTokenSecretName(runID string) string {
+	return "kfp-metrics-push-token-" + runID
+}
+
+// GetMetricsPushToken returns the token that the steps of a run authenticate with to push the
+// run's metrics, or an empty string if pushing metrics is disabled. The token is an HMAC of the
+// run ID, so it doesn't need to be stored.
+func GetMetricsPushToken(runID string) string {
+	secret := GetMetricsPushTokenSecret()
+	if secret == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(runID))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// IsValidMetricsPushToken returns whether token is the metrics push token of the run.
+func IsValidMetricsPushToken(runID string, token string) bool {
+	expected := GetMetricsPushToken(runID)
+	return expected != "" && hmac.Equal([]byte(expected), []byte(token))
+}
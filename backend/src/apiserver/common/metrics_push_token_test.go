@@ -0,0 +1,38 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMetricsPushToken_Disabled(t *testing.T) {
+	assert.Equal(t, "", GetMetricsPushToken("run-1"))
+	assert.False(t, IsValidMetricsPushToken("run-1", ""))
+}
+
+func TestIsValidMetricsPushToken(t *testing.T) {
+	viper.Set(MetricsPushTokenSecret, "secret")
+	defer viper.Set(MetricsPushTokenSecret, "")
+
+	token := GetMetricsPushToken("run-1")
+	assert.NotEmpty(t, token)
+	assert.True(t, IsValidMetricsPushToken("run-1", token))
+	assert.False(t, IsValidMetricsPushToken("run-2", token))
+	assert.False(t, IsValidMetricsPushToken("run-1", "invalid"))
+}
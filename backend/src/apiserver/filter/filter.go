@@ -19,6 +19,8 @@ package filter
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/golang/protobuf/jsonpb"
@@ -43,8 +45,24 @@ type Filter struct {
 	in map[string]interface{}
 
 	substring map[string]interface{}
+
+	// Predicates on resource labels, keyed by the label key. These are only
+	// populated for filters created with NewWithLabels.
+	labelEq  map[string]interface{}
+	labelNeq map[string]interface{}
+	labelIn  map[string]interface{}
+
+	// The column holding the ID of the resource being filtered, and the type
+	// under which its labels are stored in the labels table.
+	labelResourceKey  string
+	labelResourceType string
 }
 
+// LabelKeyPrefix is the prefix of predicate keys that filter on resource labels
+// rather than on model fields. For example, "label.team" matches the value of
+// label "team".
+const LabelKeyPrefix = "label."
+
 // filterForMarshaling is a helper struct for marshaling Filter into JSON. This
 // is needed as we don't want to export the fields in Filter.
 type filterForMarshaling struct {
@@ -60,6 +78,12 @@ type filterForMarshaling struct {
 	IN map[string]interface{}
 
 	SUBSTRING map[string]interface{}
+
+	LabelEQ           map[string]interface{}
+	LabelNEQ          map[string]interface{}
+	LabelIN           map[string]interface{}
+	LabelResourceKey  string
+	LabelResourceType string
 }
 
 // MarshalJSON implements JSON Marshaler for Filter.
@@ -79,6 +103,12 @@ func (f *Filter) MarshalJSON() ([]byte, error) {
 		LTE:         f.lte,
 		IN:          f.in,
 		SUBSTRING:   f.substring,
+
+		LabelEQ:           f.labelEq,
+		LabelNEQ:          f.labelNeq,
+		LabelIN:           f.labelIn,
+		LabelResourceKey:  f.labelResourceKey,
+		LabelResourceType: f.labelResourceType,
 	})
 }
 
@@ -104,12 +134,21 @@ func (f *Filter) UnmarshalJSON(b []byte) error {
 	f.lte = ffm.LTE
 	f.in = ffm.IN
 	f.substring = ffm.SUBSTRING
+	f.labelEq = ffm.LabelEQ
+	f.labelNeq = ffm.LabelNEQ
+	f.labelIn = ffm.LabelIN
+	f.labelResourceKey = ffm.LabelResourceKey
+	f.labelResourceType = ffm.LabelResourceType
 
 	return nil
 }
 
 // New creates a new Filter from parsing the API filter protocol buffer.
 func New(filterProto *api.Filter) (*Filter, error) {
+	return newFilter(filterProto, "", "")
+}
+
+func newFilter(filterProto *api.Filter, labelResourceKey string, labelResourceType string) (*Filter, error) {
 	f := &Filter{
 		filterProto: filterProto,
 		eq:          make(map[string]interface{}),
@@ -120,6 +159,12 @@ func New(filterProto *api.Filter) (*Filter, error) {
 		lte:         make(map[string]interface{}),
 		in:          make(map[string]interface{}),
 		substring:   make(map[string]interface{}),
+
+		labelEq:           make(map[string]interface{}),
+		labelNeq:          make(map[string]interface{}),
+		labelIn:           make(map[string]interface{}),
+		labelResourceKey:  labelResourceKey,
+		labelResourceType: labelResourceType,
 	}
 
 	if err := f.parseFilterProto(); err != nil {
@@ -134,6 +179,25 @@ func New(filterProto *api.Filter) (*Filter, error) {
 // the equivalent column name is "Name", then filterProto with predicates against key "name"
 // will be parsed as if the key value was "pipelines.Name".
 func NewWithKeyMap(filterProto *api.Filter, keyMap map[string]string, modelName string) (*Filter, error) {
+	return newWithKeyMap(filterProto, keyMap, modelName, "", "")
+}
+
+// NewWithLabels is like NewWithKeyMap, but additionally accepts predicates on
+// resource labels, using keys of the form "label.<key>". A label predicate
+// matches the rows whose primaryKey column identifies a resource of type
+// resourceType carrying the label. Only EQUALS, NOT_EQUALS and IN on string
+// values are supported for labels.
+func NewWithLabels(filterProto *api.Filter, keyMap map[string]string, modelName string,
+	primaryKey string, resourceType string) (*Filter, error) {
+	resourceKey := primaryKey
+	if modelName != "" {
+		resourceKey = modelName + "." + primaryKey
+	}
+	return newWithKeyMap(filterProto, keyMap, modelName, resourceKey, resourceType)
+}
+
+func newWithKeyMap(filterProto *api.Filter, keyMap map[string]string, modelName string,
+	labelResourceKey string, labelResourceType string) (*Filter, error) {
 	// Fully qualify column name to avoid "ambiguous column name" error.
 	var modelNamePrefix string
 	if modelName != "" {
@@ -141,13 +205,17 @@ func NewWithKeyMap(filterProto *api.Filter, keyMap map[string]string, modelName
 	}
 
 	for _, pred := range filterProto.Predicates {
+		if labelResourceType != "" && strings.HasPrefix(pred.Key, LabelKeyPrefix) {
+			// Label predicates are resolved against the labels table instead.
+			continue
+		}
 		k, ok := keyMap[pred.Key]
 		if !ok {
 			return nil, util.NewInvalidInputError("no support for filtering on unrecognized field %q", pred.Key)
 		}
 		pred.Key = modelNamePrefix + k
 	}
-	return New(filterProto)
+	return newFilter(filterProto, labelResourceKey, labelResourceType)
 }
 
 // AddToSelect builds a WHERE clause from the Filter f, adds it to the supplied
@@ -192,9 +260,65 @@ func (f *Filter) AddToSelect(sb squirrel.SelectBuilder) squirrel.SelectBuilder {
 		sb = sb.Where(like)
 	}
 
+	for _, k := range sortedKeys(f.labelEq) {
+		sb = sb.Where(f.labelPredicate(k, f.labelEq[k], false))
+	}
+	for _, k := range sortedKeys(f.labelNeq) {
+		sb = sb.Where(f.labelPredicate(k, f.labelNeq[k], true))
+	}
+	for _, k := range sortedKeys(f.labelIn) {
+		sb = sb.Where(f.labelPredicate(k, f.labelIn[k], false))
+	}
+
 	return sb
 }
 
+func (f *Filter) labelPredicate(key string, value interface{}, negate bool) labelPredicate {
+	return labelPredicate{
+		resourceKey:  f.labelResourceKey,
+		resourceType: f.labelResourceType,
+		key:          key,
+		value:        value,
+		negate:       negate,
+	}
+}
+
+// labelPredicate is a squirrel.Sqlizer matching the resources whose label with
+// the given key has (or, if negated, does not have) the given value. The value
+// can be a slice, in which case any of its elements matches.
+type labelPredicate struct {
+	resourceKey  string
+	resourceType string
+	key          string
+	value        interface{}
+	negate       bool
+}
+
+func (p labelPredicate) ToSql() (string, []interface{}, error) {
+	subquery, args, err := squirrel.
+		Select("ResourceUUID").
+		From("labels").
+		Where(squirrel.Eq{"ResourceType": p.resourceType, "LabelKey": p.key, "LabelValue": p.value}).
+		ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+	op := "IN"
+	if p.negate {
+		op = "NOT IN"
+	}
+	return fmt.Sprintf("%s %s (%s)", p.resourceKey, op, subquery), args, nil
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func checkPredicate(p *api.Predicate) error {
 	switch p.Op {
 	case api.Predicate_IN:
@@ -230,6 +354,13 @@ func (f *Filter) parseFilterProto() error {
 			return err
 		}
 
+		if f.labelResourceType != "" && strings.HasPrefix(pred.Key, LabelKeyPrefix) {
+			if err := f.addLabelPredicate(pred); err != nil {
+				return err
+			}
+			continue
+		}
+
 		var m map[string]interface{}
 		switch pred.Op {
 		case api.Predicate_EQUALS:
@@ -260,6 +391,39 @@ func (f *Filter) parseFilterProto() error {
 	return nil
 }
 
+func (f *Filter) addLabelPredicate(p *api.Predicate) error {
+	key := strings.TrimPrefix(p.Key, LabelKeyPrefix)
+	if key == "" {
+		return util.NewInvalidInputError("no label key specified in predicate key %q", p.Key)
+	}
+
+	var m map[string]interface{}
+	switch p.Op {
+	case api.Predicate_EQUALS:
+		m = f.labelEq
+	case api.Predicate_NOT_EQUALS:
+		m = f.labelNeq
+	case api.Predicate_IN:
+		m = f.labelIn
+	default:
+		return util.NewInvalidInputError("cannot use operator %v on label %q. Supported operators are EQUALS, NOT_EQUALS and IN", p.Op, key)
+	}
+
+	switch p.Value.(type) {
+	case *api.Predicate_StringValue:
+		m[key] = p.GetStringValue()
+	case *api.Predicate_StringValues:
+		var v []string
+		for _, i := range p.GetStringValues().GetValues() {
+			v = append(v, i)
+		}
+		m[key] = v
+	default:
+		return util.NewInvalidInputError("label %q can only be compared with string values, got %T", key, p.Value)
+	}
+	return nil
+}
+
 func addPredicateValue(m map[string]interface{}, p *api.Predicate) error {
 	switch t := p.Value.(type) {
 	case *api.Predicate_IntValue:
@@ -292,6 +292,120 @@ func TestAddToSelect(t *testing.T) {
 	}
 }
 
+func TestAddToSelectWithLabels(t *testing.T) {
+	tests := []struct {
+		protoStr string
+		wantSQL  string
+		wantArgs []interface{}
+	}{
+		{
+			`predicates { key: "label.team" op: EQUALS string_value: "ranking" }`,
+			"SELECT mycolumn WHERE pipelines.UUID IN (SELECT ResourceUUID FROM labels WHERE LabelKey = ? AND LabelValue = ? AND ResourceType = ?)",
+			[]interface{}{"team", "ranking", "pipeline"},
+		},
+		{
+			`predicates { key: "name" op: EQUALS string_value: "p1" }
+			 predicates { key: "label.stage" op: NOT_EQUALS string_value: "prod" }`,
+			"SELECT mycolumn WHERE pipelines.Name = ? AND pipelines.UUID NOT IN (SELECT ResourceUUID FROM labels WHERE LabelKey = ? AND LabelValue = ? AND ResourceType = ?)",
+			[]interface{}{"p1", "stage", "prod", "pipeline"},
+		},
+		{
+			`predicates { key: "label.team" op: IN string_values { values: "ranking" values: "search" } }`,
+			"SELECT mycolumn WHERE pipelines.UUID IN (SELECT ResourceUUID FROM labels WHERE LabelKey = ? AND LabelValue IN (?,?) AND ResourceType = ?)",
+			[]interface{}{"team", "ranking", "search", "pipeline"},
+		},
+	}
+
+	for _, test := range tests {
+		filterProto := &api.Filter{}
+		if err := proto.UnmarshalText(test.protoStr, filterProto); err != nil {
+			t.Errorf("Failed to unmarshal Filter text proto\n%q\nError: %v", test.protoStr, err)
+			continue
+		}
+
+		filter, err := NewWithLabels(filterProto, map[string]string{"name": "Name"}, "pipelines", "UUID", "pipeline")
+		if err != nil {
+			t.Errorf("NewWithLabels(%+v) = %+v, %v\nWant nil error", *filterProto, filter, err)
+			continue
+		}
+
+		sb := squirrel.Select("mycolumn")
+		gotSQL, gotArgs, err := filter.AddToSelect(sb).ToSql()
+		if !cmp.Equal(gotSQL, test.wantSQL) || !cmp.Equal(gotArgs, test.wantArgs) || err != nil {
+			t.Errorf("Filter.AddToSelect(%+v).ToSql() =\nGot: %+v, %v, %v\nWant: %+v, %+v, <nil>", filter, gotSQL, gotArgs, err, test.wantSQL, test.wantArgs)
+		}
+	}
+}
+
+func TestInvalidLabelFilters(t *testing.T) {
+	tests := []struct {
+		protoStr string
+	}{
+		{
+			`predicates { key: "label." op: EQUALS string_value: "ranking" }`,
+		},
+		{
+			`predicates { key: "label.team" op: IS_SUBSTRING string_value: "rank" }`,
+		},
+		{
+			`predicates { key: "label.team" op: GREATER_THAN string_value: "ranking" }`,
+		},
+		{
+			`predicates { key: "label.team" op: EQUALS long_value: 10 }`,
+		},
+	}
+
+	for _, test := range tests {
+		filterProto := &api.Filter{}
+		if err := proto.UnmarshalText(test.protoStr, filterProto); err != nil {
+			t.Errorf("Failed to unmarshal Filter text proto\n%q\nError: %v", test.protoStr, err)
+			continue
+		}
+
+		got, err := NewWithLabels(filterProto, map[string]string{"name": "Name"}, "pipelines", "UUID", "pipeline")
+		if err == nil {
+			t.Errorf("NewWithLabels(%+v) = %+v, <nil>\nWant non-nil error ", *filterProto, got)
+		}
+	}
+}
+
+func TestNewWithKeyMap_RejectsLabels(t *testing.T) {
+	filterProto := &api.Filter{}
+	if err := proto.UnmarshalText(`predicates { key: "label.team" op: EQUALS string_value: "ranking" }`, filterProto); err != nil {
+		t.Fatalf("Failed to unmarshal Filter text proto: %v", err)
+	}
+
+	got, err := NewWithKeyMap(filterProto, map[string]string{"name": "Name"}, "runs")
+	if err == nil {
+		t.Errorf("NewWithKeyMap(%+v) = %+v, <nil>\nWant non-nil error ", *filterProto, got)
+	}
+}
+
+func TestMarshalJSON_Labels(t *testing.T) {
+	filterProto := &api.Filter{}
+	if err := proto.UnmarshalText(`predicates { key: "label.team" op: EQUALS string_value: "ranking" }`, filterProto); err != nil {
+		t.Fatalf("Failed to unmarshal Filter text proto: %v", err)
+	}
+	f, err := NewWithLabels(filterProto, map[string]string{}, "experiments", "UUID", "Experiment")
+	if err != nil {
+		t.Fatalf("NewWithLabels() = %v", err)
+	}
+
+	b, err := json.Marshal(f)
+	if err != nil {
+		t.Fatalf("json.Marshal(%+v) = %v", f, err)
+	}
+	got := &Filter{}
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatalf("json.Unmarshal(%s) = %v", b, err)
+	}
+
+	opts := []cmp.Option{cmp.AllowUnexported(Filter{}), cmpopts.EquateEmpty()}
+	if !cmp.Equal(got, f, opts...) {
+		t.Errorf("Filter did not survive a JSON round trip. Diff:%s", cmp.Diff(f, got, opts...))
+	}
+}
+
 func TestMarshalJSON(t *testing.T) {
 	f := &Filter{
 		filterProto: &api.Filter{
@@ -305,7 +419,7 @@ func TestMarshalJSON(t *testing.T) {
 		eq: map[string]interface{}{"name": "SomeName"},
 	}
 
-	want := `{"FilterProto":"{\"predicates\":[{\"op\":\"EQUALS\",\"key\":\"Name\",\"stringValue\":\"SomeName\"}]}","EQ":{"name":"SomeName"},"NEQ":null,"GT":null,"GTE":null,"LT":null,"LTE":null,"IN":null,"SUBSTRING":null}`
+	want := `{"FilterProto":"{\"predicates\":[{\"op\":\"EQUALS\",\"key\":\"Name\",\"stringValue\":\"SomeName\"}]}","EQ":{"name":"SomeName"},"NEQ":null,"GT":null,"GTE":null,"LT":null,"LTE":null,"IN":null,"SUBSTRING":null,"LabelEQ":null,"LabelNEQ":null,"LabelIN":null,"LabelResourceKey":"","LabelResourceType":""}`
 
 	got, err := json.Marshal(f)
 	if err != nil || string(got) != want {
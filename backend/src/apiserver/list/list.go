@@ -163,7 +163,13 @@ func NewOptions(listable Listable, pageSize int, sortBy string, filterProto *api
 
 	// Filtering.
 	if filterProto != nil {
-		f, err := filter.NewWithKeyMap(filterProto, listable.APIToModelFieldMap(), listable.GetModelName())
+		var f *filter.Filter
+		if labeled, ok := listable.(LabeledListable); ok {
+			f, err = filter.NewWithLabels(filterProto, listable.APIToModelFieldMap(), listable.GetModelName(),
+				listable.PrimaryKeyColumnName(), string(labeled.GetLabelResourceType()))
+		} else {
+			f, err = filter.NewWithKeyMap(filterProto, listable.APIToModelFieldMap(), listable.GetModelName())
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -320,6 +326,15 @@ type Listable interface {
 	GetFieldValue(name string) interface{}
 }
 
+// LabeledListable is implemented by the Listable models whose resources can
+// carry labels. Filters on such models accept predicates on "label.<key>".
+type LabeledListable interface {
+	Listable
+	// GetLabelResourceType returns the resource type under which the labels of
+	// the model are stored.
+	GetLabelResourceType() common.ResourceType
+}
+
 // NextPageToken returns a string that can be used to fetch the subsequent set
 // of results using the same listing options in o, starting with listable as the
 // first record.
@@ -139,10 +139,19 @@ func startHttpProxy(resourceManager *resource.ResourceManager) {
 	pipelineUploadServer := server.NewPipelineUploadServer(resourceManager, &server.PipelineUploadServerOptions{CollectMetrics: *collectMetricsFlag})
 	topMux.HandleFunc("/apis/v1beta1/pipelines/upload", pipelineUploadServer.UploadPipeline)
 	topMux.HandleFunc("/apis/v1beta1/pipelines/upload_version", pipelineUploadServer.UploadPipelineVersion)
+	// Exported tables aren't JSON, so exports are only supported in HTTP.
+	exportServer := server.NewExportServer(resourceManager, &server.ExportServerOptions{CollectMetrics: *collectMetricsFlag})
+	topMux.HandleFunc("/apis/v1beta1/runs:export", exportServer.ExportRuns)
+	topMux.HandleFunc("/apis/v1beta1/experiments:export", exportServer.ExportExperiments)
 	topMux.HandleFunc("/apis/v1beta1/healthz", func(w http.ResponseWriter, r *http.Request) {
 		io.WriteString(w, `{"commit_sha":"`+common.GetStringConfigWithDefault("COMMIT_SHA", "unknown")+`", "tag_name":"`+common.GetStringConfigWithDefault("TAG_NAME", "unknown")+`"}`)
 	})
 
+	// Artifacts are streamed with byte-range support, which the gRPC gateway doesn't support.
+	runServer := server.NewRunServer(resourceManager, &server.RunServerOptions{CollectMetrics: *collectMetricsFlag})
+	topMux.Handle("/apis/v1beta1/runs/", server.ArtifactDownloadHandler(runServer, mux))
+	// The CSV comparison of runs isn't JSON, so it's written outside of the gRPC gateway too.
+	topMux.Handle("/apis/v1beta1/runs:compare", server.CompareRunsHandler(runServer, mux))
 	topMux.Handle("/apis/", mux)
 
 	// Register a handler for Prometheus to poll.
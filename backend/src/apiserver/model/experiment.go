@@ -1,5 +1,7 @@
 package model
 
+import "github.com/kubeflow/pipelines/backend/src/apiserver/common"
+
 type Experiment struct {
 	UUID           string `gorm:"column:UUID; not null; primary_key"`
 	Name           string `gorm:"column:Name; not null; unique_index:idx_name_namespace"`
@@ -7,6 +9,8 @@ type Experiment struct {
 	CreatedAtInSec int64  `gorm:"column:CreatedAtInSec; not null"`
 	Namespace      string `gorm:"column:Namespace; not null; unique_index:idx_name_namespace"`
 	StorageState   string `gorm:"column:StorageState; not null;"`
+	// User provided labels. Stored in the labels table.
+	Labels map[string]string `gorm:"-"`
 }
 
 func (e Experiment) GetValueOfPrimaryKey() string {
@@ -80,3 +84,7 @@ func (e *Experiment) GetSortByFieldPrefix(name string) string {
 func (e *Experiment) GetKeyFieldPrefix() string {
 	return "experiments."
 }
+
+func (e *Experiment) GetLabelResourceType() common.ResourceType {
+	return common.Experiment
+}
@@ -14,7 +14,14 @@
 
 package model
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+)
 
 type Job struct {
 	UUID               string `gorm:"column:UUID; not null; primary_key"`
@@ -32,6 +39,17 @@ type Job struct {
 	Trigger
 	PipelineSpec
 	Conditions string `gorm:"column:Conditions; not null"`
+	// The next time the job is scheduled to run, as reported by the controller.
+	NextTriggeredTimeInSec *int64 `gorm:"column:NextTriggeredTimeInSec;"`
+	// The progress of the latest backfill of the job.
+	Backfill
+	// Number of consecutive failed runs after which the job is disabled.
+	// 0 means that failed runs never disable the job.
+	MaxConsecutiveFailures int64 `gorm:"column:MaxConsecutiveFailures; not null"`
+	// JSON list of the time windows during which the job creates no run.
+	BlackoutWindows *string `gorm:"column:BlackoutWindows;"`
+	// User provided labels. Stored in the labels table.
+	Labels map[string]string `gorm:"-"`
 }
 
 // Trigger specifies when to create a new workflow.
@@ -40,6 +58,33 @@ type Trigger struct {
 	CronSchedule
 	// Create workflows periodically.
 	PeriodicSchedule
+	// Create workflows for new objects of an object store bucket.
+	ObjectStoreEvent
+	// Create workflows when upstream runs complete.
+	RunCompletion
+}
+
+type Backfill struct {
+	// ID of the backfill.
+	BackfillId *string `gorm:"column:BackfillId;"`
+
+	// Time of the first run of the backfill, inclusive.
+	BackfillStartTimeInSec *int64 `gorm:"column:BackfillStartTimeInSec;"`
+
+	// Time after the last run of the backfill, exclusive.
+	BackfillEndTimeInSec *int64 `gorm:"column:BackfillEndTimeInSec;"`
+
+	// Phase of the backfill, one of Running, Completed and Cancelled.
+	BackfillState *string `gorm:"column:BackfillState;"`
+
+	// Number of runs the backfill creates.
+	BackfillTotalRuns *int64 `gorm:"column:BackfillTotalRuns;"`
+
+	// Number of runs the backfill created so far.
+	BackfillCreatedRuns *int64 `gorm:"column:BackfillCreatedRuns;"`
+
+	// Scheduled time of the next run of the backfill, if any.
+	BackfillNextScheduledTimeInSec *int64 `gorm:"column:BackfillNextScheduledTimeInSec;"`
 }
 
 type CronSchedule struct {
@@ -54,6 +99,10 @@ type CronSchedule struct {
 	// Cron string describing when a workflow should be created within the
 	// time interval defined by StartTime and EndTime.
 	Cron *string `gorm:"column:Schedule;"`
+
+	// IANA time zone in which the Cron string is evaluated.
+	// If no time zone is specified, UTC is used.
+	CronScheduleTimeZone *string `gorm:"column:CronScheduleTimeZone;"`
 }
 
 type PeriodicSchedule struct {
@@ -70,6 +119,79 @@ type PeriodicSchedule struct {
 	IntervalSecond *int64 `gorm:"column:IntervalSecond;"`
 }
 
+type ObjectStoreEvent struct {
+	// Bucket in which new objects create workflows.
+	ObjectStoreEventBucket *string `gorm:"column:ObjectStoreEventBucket;"`
+
+	// Only objects whose key starts with the prefix create workflows.
+	ObjectStoreEventPrefix *string `gorm:"column:ObjectStoreEventPrefix;"`
+
+	// Name of the parameter set to the key of the new object.
+	ObjectStoreEventKeyParameter *string `gorm:"column:ObjectStoreEventKeyParameter;"`
+}
+
+type RunCompletion struct {
+	// ID of the job whose runs create workflows.
+	RunCompletionJobId *string `gorm:"column:RunCompletionJobId;"`
+
+	// ID of the experiment whose runs create workflows.
+	RunCompletionExperimentId *string `gorm:"column:RunCompletionExperimentId;"`
+
+	// Comma separated final states of the upstream run that create a workflow.
+	RunCompletionStates *string `gorm:"column:RunCompletionStates;"`
+
+	// Name of the parameter set to the ID of the upstream run.
+	RunCompletionRunIdParameter *string `gorm:"column:RunCompletionRunIdParameter;"`
+
+	// JSON map from names of parameters to names of output parameters of the
+	// upstream run.
+	RunCompletionOutputParameters *string `gorm:"column:RunCompletionOutputParameters;"`
+}
+
+// RunCompletionStateList returns the final states of the upstream run that
+// create a workflow.
+func (r RunCompletion) RunCompletionStateList() []string {
+	if r.RunCompletionStates == nil || *r.RunCompletionStates == "" {
+		return nil
+	}
+	return strings.Split(*r.RunCompletionStates, ",")
+}
+
+// RunCompletionOutputParameterMap returns the map from names of parameters to
+// names of output parameters of the upstream run.
+func (r RunCompletion) RunCompletionOutputParameterMap() (map[string]string, error) {
+	if r.RunCompletionOutputParameters == nil || *r.RunCompletionOutputParameters == "" {
+		return nil, nil
+	}
+	var outputParameters map[string]string
+	if err := json.Unmarshal([]byte(*r.RunCompletionOutputParameters), &outputParameters); err != nil {
+		return nil, err
+	}
+	return outputParameters, nil
+}
+
+// TimeWindow is a time window during which a job creates no run.
+type TimeWindow struct {
+	// Start of the window, inclusive.
+	StartTime time.Time `json:"startTime"`
+
+	// End of the window, exclusive.
+	EndTime time.Time `json:"endTime"`
+}
+
+// BlackoutWindowList returns the time windows during which the job creates no
+// run.
+func (j Job) BlackoutWindowList() ([]TimeWindow, error) {
+	if j.BlackoutWindows == nil || *j.BlackoutWindows == "" {
+		return nil, nil
+	}
+	var windows []TimeWindow
+	if err := json.Unmarshal([]byte(*j.BlackoutWindows), &windows); err != nil {
+		return nil, err
+	}
+	return windows, nil
+}
+
 func (j Job) GetValueOfPrimaryKey() string {
 	return fmt.Sprint(j.UUID)
 }
@@ -134,3 +256,7 @@ func (j *Job) GetSortByFieldPrefix(name string) string {
 func (j *Job) GetKeyFieldPrefix() string {
 	return "jobs."
 }
+
+func (j *Job) GetLabelResourceType() common.ResourceType {
+	return common.Job
+}
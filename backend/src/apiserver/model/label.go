@@ -0,0 +1,34 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+)
+
+// Label table stores the user provided key/value labels attached to resources.
+type Label struct {
+	// ID of the labeled resource
+	ResourceUUID string `gorm:"column:ResourceUUID; not null; primary_key"`
+
+	// The type of the labeled resource
+	ResourceType common.ResourceType `gorm:"column:ResourceType; not null; primary_key; index:labelfilter"`
+
+	// The key of the label
+	LabelKey string `gorm:"column:LabelKey; not null; primary_key; index:labelfilter"`
+
+	// The value of the label
+	LabelValue string `gorm:"column:LabelValue; not null; index:labelfilter"`
+}
@@ -14,7 +14,11 @@
 
 package model
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+)
 
 // PipelineStatus a label for the status of the Pipeline.
 // This is intend to make pipeline creation and deletion atomic.
@@ -39,6 +43,8 @@ type Pipeline struct {
 	// Default version of this pipeline. It could be null.
 	DefaultVersionId string           `gorm:"column:DefaultVersionId;"`
 	DefaultVersion   *PipelineVersion `gorm:"-"`
+	// User provided labels. Stored in the labels table.
+	Labels map[string]string `gorm:"-"`
 }
 
 func (p Pipeline) GetValueOfPrimaryKey() string {
@@ -108,3 +114,7 @@ func (p *Pipeline) GetSortByFieldPrefix(name string) string {
 func (p *Pipeline) GetKeyFieldPrefix() string {
 	return "pipelines."
 }
+
+func (p *Pipeline) GetLabelResourceType() common.ResourceType {
+	return common.Pipeline
+}
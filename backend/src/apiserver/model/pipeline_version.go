@@ -16,6 +16,8 @@ package model
 
 import (
 	"fmt"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 )
 
 // PipelineVersionStatus a label for the status of the Pipeline.
@@ -42,6 +44,14 @@ type PipelineVersion struct {
 	Status     PipelineVersionStatus `gorm:"column:Status; not null"`
 	// Code source url links to the pipeline version's definition in repo.
 	CodeSourceUrl string `gorm:"column:CodeSourceUrl;"`
+	// Content digest of the stored pipeline template, e.g. "sha256:<hex>".
+	// Versions created before digests were recorded have an empty digest.
+	Digest string `gorm:"column:Digest; index"`
+	// Typed parameter metadata declared in the template, as a JSON list of
+	// util.ParameterSpec. Empty when the template declares no schema.
+	ParameterSchema string `gorm:"column:ParameterSchema; size:65535"`
+	// User provided labels. Stored in the labels table.
+	Labels map[string]string `gorm:"-"`
 }
 
 func (p PipelineVersion) GetValueOfPrimaryKey() string {
@@ -66,6 +76,7 @@ func (p *PipelineVersion) APIToModelFieldMap() map[string]string {
 		"name":       "Name",
 		"created_at": "CreatedAtInSec",
 		"status":     "Status",
+		"digest":     "Digest",
 	}
 }
 
@@ -91,6 +102,8 @@ func (p *PipelineVersion) GetFieldValue(name string) interface{} {
 		return p.CreatedAtInSec
 	case "Status":
 		return p.Status
+	case "Digest":
+		return p.Digest
 	default:
 		return nil
 	}
@@ -103,3 +116,7 @@ func (p *PipelineVersion) GetSortByFieldPrefix(name string) string {
 func (p *PipelineVersion) GetKeyFieldPrefix() string {
 	return "pipeline_versions."
 }
+
+func (p *PipelineVersion) GetLabelResourceType() common.ResourceType {
+	return common.PipelineVersion
+}
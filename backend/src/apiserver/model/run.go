@@ -31,6 +31,7 @@ type Run struct {
 	ScheduledAtInSec   int64  `gorm:"column:ScheduledAtInSec; default:0;"`
 	FinishedAtInSec    int64  `gorm:"column:FinishedAtInSec; default:0;"`
 	Conditions         string `gorm:"column:Conditions; not null"`
+	Warnings           string `gorm:"column:Warnings; size:65535"` /* JSON-encoded list of warnings about the run. */
 	Metrics            []*RunMetric
 	ResourceReferences []*ResourceReference
 	PipelineSpec
@@ -54,6 +55,32 @@ type RunMetric struct {
 	NumberValue float64 `gorm:"column:NumberValue"`
 	Format      string  `gorm:"column:Format"`
 	Payload     string  `gorm:"column:Payload; not null; size:65535"`
+	// Series holds the values of a time-series metric. It's stored in the run_metric_points table,
+	// and NumberValue holds the value derived from it.
+	Series []*RunMetricPoint `gorm:"-" json:"-"`
+	// SeriesSummary is how NumberValue is derived from the series: LAST, MIN or MAX.
+	SeriesSummary string `gorm:"-" json:"-"`
+}
+
+// RunMetricPoint is the value of a time-series metric at a step.
+type RunMetricPoint struct {
+	RunUUID        string  `gorm:"column:RunUUID; not null; primary_key"`
+	NodeID         string  `gorm:"column:NodeID; not null; primary_key"`
+	Name           string  `gorm:"column:Name; not null; primary_key"`
+	Step           int64   `gorm:"column:Step; not null; primary_key"`
+	TimestampInSec int64   `gorm:"column:TimestampInSec; not null"`
+	NumberValue    float64 `gorm:"column:NumberValue; not null"`
+}
+
+// RunArtifact is an input or output artifact of a node of a run.
+type RunArtifact struct {
+	NodeID               string
+	Name                 string
+	Input                bool
+	ObjectStoreKey       string
+	ProducerTemplateName string
+	// The size of the artifact in bytes, or -1 if it isn't in the object store.
+	Size int64
 }
 
 func (r Run) GetValueOfPrimaryKey() string {
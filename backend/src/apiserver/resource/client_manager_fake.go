@@ -33,6 +33,7 @@ type FakeClientManager struct {
 	jobStore               storage.JobStoreInterface
 	runStore               storage.RunStoreInterface
 	resourceReferenceStore storage.ResourceReferenceStoreInterface
+	labelStore             storage.LabelStoreInterface
 	dBStatusStore          storage.DBStatusStoreInterface
 	defaultExperimentStore storage.DefaultExperimentStoreInterface
 	objectStore            storage.ObjectStoreInterface
@@ -70,6 +71,7 @@ func NewFakeClientManager(time util.TimeInterface, uuid util.UUIDGeneratorInterf
 		runStore:               storage.NewRunStore(db, time),
 		ArgoClientFake:         client.NewFakeArgoClient(),
 		resourceReferenceStore: storage.NewResourceReferenceStore(db),
+		labelStore:             storage.NewLabelStore(db),
 		dBStatusStore:          storage.NewDBStatusStore(db),
 		defaultExperimentStore: storage.NewDefaultExperimentStore(db),
 		objectStore:            storage.NewFakeObjectStore(),
@@ -130,6 +132,10 @@ func (f *FakeClientManager) ResourceReferenceStore() storage.ResourceReferenceSt
 	return f.resourceReferenceStore
 }
 
+func (f *FakeClientManager) LabelStore() storage.LabelStoreInterface {
+	return f.labelStore
+}
+
 func (f *FakeClientManager) DBStatusStore() storage.DBStatusStoreInterface {
 	return f.dBStatusStore
 }
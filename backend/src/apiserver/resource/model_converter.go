@@ -16,6 +16,7 @@ package resource
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
@@ -40,17 +41,52 @@ func (r *ResourceManager) ToModelExperiment(apiExperiment *api.Experiment) (*mod
 		Name:        apiExperiment.Name,
 		Description: apiExperiment.Description,
 		Namespace:   namespace,
+		Labels:      apiExperiment.Labels,
 	}, nil
 }
 
 func (r *ResourceManager) ToModelRunMetric(metric *api.RunMetric, runUUID string) *model.RunMetric {
-	return &model.RunMetric{
+	modelMetric := &model.RunMetric{
 		RunUUID:     runUUID,
 		Name:        metric.GetName(),
 		NodeID:      metric.GetNodeId(),
 		NumberValue: metric.GetNumberValue(),
 		Format:      metric.GetFormat().String(),
 	}
+	if len(metric.GetSeries()) == 0 {
+		return modelMetric
+	}
+	modelMetric.SeriesSummary = metric.GetSeriesSummary().String()
+	// The metric value of a time series is the summary of the series, so that runs can still be
+	// sorted by it.
+	var last *api.RunMetricPoint
+	for i, point := range metric.GetSeries() {
+		modelMetric.Series = append(modelMetric.Series, &model.RunMetricPoint{
+			RunUUID:        runUUID,
+			NodeID:         metric.GetNodeId(),
+			Name:           metric.GetName(),
+			Step:           point.GetStep(),
+			TimestampInSec: point.GetTimestamp().GetSeconds(),
+			NumberValue:    point.GetNumberValue(),
+		})
+		value := point.GetNumberValue()
+		switch metric.GetSeriesSummary() {
+		case api.RunMetric_MIN:
+			if i == 0 || value < modelMetric.NumberValue {
+				modelMetric.NumberValue = value
+			}
+		case api.RunMetric_MAX:
+			if i == 0 || value > modelMetric.NumberValue {
+				modelMetric.NumberValue = value
+			}
+		default:
+			if last == nil || point.GetStep() > last.GetStep() {
+				last = point
+				modelMetric.NumberValue = value
+			}
+		}
+	}
+	return modelMetric
 }
 
 // The input run might not contain workflowSpecManifest, but instead a pipeline ID.
@@ -92,6 +128,7 @@ func (r *ResourceManager) ToModelRunDetail(run *api.Run, runId string, workflow
 				PipelineId:           run.GetPipelineSpec().GetPipelineId(),
 				PipelineName:         pipelineName,
 				WorkflowSpecManifest: workflowSpecManifest,
+				WorkflowSpecDigest:   util.ComputeDigest([]byte(workflowSpecManifest)),
 				Parameters:           params,
 			},
 		},
@@ -122,22 +159,26 @@ func (r *ResourceManager) ToModelJob(job *api.Job, swf *util.ScheduledWorkflow,
 		serviceAccount = swf.Spec.Workflow.Spec.ServiceAccountName
 	}
 	return &model.Job{
-		UUID:               string(swf.UID),
-		DisplayName:        job.Name,
-		Name:               swf.Name,
-		Namespace:          swf.Namespace,
-		ServiceAccount:     serviceAccount,
-		Description:        job.Description,
-		Conditions:         swf.ConditionSummary(),
-		Enabled:            job.Enabled,
-		Trigger:            toModelTrigger(job.Trigger),
-		MaxConcurrency:     job.MaxConcurrency,
-		NoCatchup:          job.NoCatchup,
-		ResourceReferences: resourceReferences,
+		UUID:                   string(swf.UID),
+		DisplayName:            job.Name,
+		Name:                   swf.Name,
+		Namespace:              swf.Namespace,
+		ServiceAccount:         serviceAccount,
+		Description:            job.Description,
+		Conditions:             swf.ConditionSummary(),
+		Enabled:                job.Enabled,
+		Trigger:                toModelTrigger(job.Trigger),
+		MaxConcurrency:         job.MaxConcurrency,
+		NoCatchup:              job.NoCatchup,
+		ResourceReferences:     resourceReferences,
+		Labels:                 job.Labels,
+		MaxConsecutiveFailures: swf.MaxConsecutiveFailuresOr0(),
+		BlackoutWindows:        swf.BlackoutsOrNull(),
 		PipelineSpec: model.PipelineSpec{
 			PipelineId:           job.GetPipelineSpec().GetPipelineId(),
 			PipelineName:         pipelineName,
 			WorkflowSpecManifest: workflowSpecManifest,
+			WorkflowSpecDigest:   util.ComputeDigest([]byte(workflowSpecManifest)),
 			Parameters:           params,
 		},
 	}, nil
@@ -163,6 +204,7 @@ func (r *ResourceManager) ToModelPipelineVersion(version *api.PipelineVersion) (
 		Parameters:     paramStr,
 		PipelineId:     pipelineId,
 		CodeSourceUrl:  version.CodeSourceUrl,
+		Labels:         version.Labels,
 	}, nil
 }
 
@@ -180,6 +222,9 @@ func toModelTrigger(trigger *api.Trigger) model.Trigger {
 		if cronSchedule.EndTime != nil {
 			modelTrigger.CronScheduleEndTimeInSec = &cronSchedule.EndTime.Seconds
 		}
+		if cronSchedule.TimeZone != "" {
+			modelTrigger.CronScheduleTimeZone = &cronSchedule.TimeZone
+		}
 	}
 
 	if trigger.GetPeriodicSchedule() != nil {
@@ -193,6 +238,31 @@ func toModelTrigger(trigger *api.Trigger) model.Trigger {
 			modelTrigger.PeriodicScheduleEndTimeInSec = &periodicSchedule.EndTime.Seconds
 		}
 	}
+
+	if trigger.GetObjectStoreEvent() != nil {
+		event := trigger.GetObjectStoreEvent()
+		modelTrigger.ObjectStoreEvent = model.ObjectStoreEvent{
+			ObjectStoreEventBucket:       &event.Bucket,
+			ObjectStoreEventPrefix:       &event.Prefix,
+			ObjectStoreEventKeyParameter: &event.KeyParameter,
+		}
+	}
+
+	if trigger.GetRunCompletion() != nil {
+		runCompletion := trigger.GetRunCompletion()
+		states := strings.Join(runCompletion.States, ",")
+		modelTrigger.RunCompletion = model.RunCompletion{
+			RunCompletionJobId:          &runCompletion.JobId,
+			RunCompletionExperimentId:   &runCompletion.ExperimentId,
+			RunCompletionStates:         &states,
+			RunCompletionRunIdParameter: &runCompletion.RunIdParameter,
+		}
+		if len(runCompletion.OutputParameters) > 0 {
+			// Marshalling a map of strings cannot fail.
+			outputParameters, _ := json.Marshal(runCompletion.OutputParameters)
+			modelTrigger.RunCompletionOutputParameters = util.StringPointer(string(outputParameters))
+		}
+	}
 	return modelTrigger
 }
 
@@ -146,6 +146,42 @@ func TestToModelRunMetric(t *testing.T) {
 	assert.Equal(t, expectedModelRunMetric, actualModelRunMetric)
 }
 
+func TestToModelRunMetric_Series(t *testing.T) {
+	store, manager := initResourceManager()
+	defer store.Close()
+	series := []*api.RunMetricPoint{
+		{Step: 2, NumberValue: 0.5, Timestamp: &timestamp.Timestamp{Seconds: 20}},
+		{Step: 3, NumberValue: 0.6},
+		{Step: 1, NumberValue: 0.9},
+	}
+	tests := []struct {
+		summary api.RunMetric_SeriesSummary
+		value   float64
+	}{
+		{api.RunMetric_LAST, 0.6},
+		{api.RunMetric_MIN, 0.5},
+		{api.RunMetric_MAX, 0.9},
+	}
+	for _, test := range tests {
+		apiRunMetric := &api.RunMetric{
+			Name:          "loss",
+			NodeId:        "node-1",
+			Series:        series,
+			SeriesSummary: test.summary,
+		}
+
+		actualModelRunMetric := manager.ToModelRunMetric(apiRunMetric, "run-1")
+
+		assert.Equal(t, test.value, actualModelRunMetric.NumberValue, test.summary.String())
+		assert.Equal(t, test.summary.String(), actualModelRunMetric.SeriesSummary)
+		assert.Equal(t, []*model.RunMetricPoint{
+			{RunUUID: "run-1", NodeID: "node-1", Name: "loss", Step: 2, TimestampInSec: 20, NumberValue: 0.5},
+			{RunUUID: "run-1", NodeID: "node-1", Name: "loss", Step: 3, NumberValue: 0.6},
+			{RunUUID: "run-1", NodeID: "node-1", Name: "loss", Step: 1, NumberValue: 0.9},
+		}, actualModelRunMetric.Series)
+	}
+}
+
 func TestToModelRunDetail(t *testing.T) {
 	store, manager, experiment := initWithExperiment(t)
 	defer store.Close()
@@ -178,6 +214,7 @@ func TestToModelRunDetail(t *testing.T) {
 			Description:    "this is a run",
 			PipelineSpec: model.PipelineSpec{
 				WorkflowSpecManifest: "workflow spec",
+				WorkflowSpecDigest:   util.ComputeDigest([]byte("workflow spec")),
 				Parameters:           `[{"name":"param2","value":"world"}]`,
 			},
 			ResourceReferences: []*model.ResourceReference{
@@ -246,6 +283,7 @@ func TestToModelJob(t *testing.T) {
 			PipelineId:           pipeline.UUID,
 			PipelineName:         pipeline.Name,
 			WorkflowSpecManifest: "workflow spec",
+			WorkflowSpecDigest:   util.ComputeDigest([]byte("workflow spec")),
 			Parameters:           `[{"name":"param2","value":"world"}]`,
 		},
 		ResourceReferences: []*model.ResourceReference{
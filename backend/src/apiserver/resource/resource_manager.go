@@ -17,7 +17,10 @@ package resource
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
+	"time"
 
 	workflowapi "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	workflowclient "github.com/argoproj/argo/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
@@ -30,12 +33,17 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	swfutil "github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/util"
 	scheduledworkflow "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	scheduledworkflowclient "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/clientset/versioned/typed/scheduledworkflow/v1beta1"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
 
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -54,6 +62,12 @@ var (
 		Name: "resource_manager_workflow_gc",
 		Help: "The number of gabarage-collected workflows",
 	})
+
+	// Count the upstream runs queued by run completion triggers.
+	runCompletionTriggerCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "resource_manager_run_completion_triggered_runs",
+		Help: "The number of upstream runs queued to trigger runs of jobs",
+	})
 )
 
 type ClientManagerInterface interface {
@@ -62,6 +76,7 @@ type ClientManagerInterface interface {
 	JobStore() storage.JobStoreInterface
 	RunStore() storage.RunStoreInterface
 	ResourceReferenceStore() storage.ResourceReferenceStoreInterface
+	LabelStore() storage.LabelStoreInterface
 	DBStatusStore() storage.DBStatusStoreInterface
 	DefaultExperimentStore() storage.DefaultExperimentStoreInterface
 	ObjectStore() storage.ObjectStoreInterface
@@ -79,6 +94,7 @@ type ResourceManager struct {
 	jobStore               storage.JobStoreInterface
 	runStore               storage.RunStoreInterface
 	resourceReferenceStore storage.ResourceReferenceStoreInterface
+	labelStore             storage.LabelStoreInterface
 	dBStatusStore          storage.DBStatusStoreInterface
 	defaultExperimentStore storage.DefaultExperimentStoreInterface
 	objectStore            storage.ObjectStoreInterface
@@ -97,6 +113,7 @@ func NewResourceManager(clientManager ClientManagerInterface) *ResourceManager {
 		jobStore:               clientManager.JobStore(),
 		runStore:               clientManager.RunStore(),
 		resourceReferenceStore: clientManager.ResourceReferenceStore(),
+		labelStore:             clientManager.LabelStore(),
 		dBStatusStore:          clientManager.DBStatusStore(),
 		defaultExperimentStore: clientManager.DefaultExperimentStore(),
 		objectStore:            clientManager.ObjectStore(),
@@ -138,6 +155,18 @@ func (r *ResourceManager) ListExperiments(filterContext *common.FilterContext, o
 	return r.experimentStore.ListExperiments(filterContext, opts)
 }
 
+func (r *ResourceManager) UpdateExperimentLabels(experimentId string, labels map[string]string) (*model.Experiment, error) {
+	_, err := r.experimentStore.GetExperiment(experimentId)
+	if err != nil {
+		return nil, util.Wrap(err, "Update experiment labels failed")
+	}
+	err = r.labelStore.UpdateLabels(experimentId, common.Experiment, labels)
+	if err != nil {
+		return nil, util.Wrap(err, "Update experiment labels failed")
+	}
+	return r.experimentStore.GetExperiment(experimentId)
+}
+
 func (r *ResourceManager) DeleteExperiment(experimentID string) error {
 	_, err := r.experimentStore.GetExperiment(experimentID)
 	if err != nil {
@@ -227,6 +256,7 @@ func (r *ResourceManager) DeletePipeline(pipelineId string) error {
 		glog.Errorf("%v", errors.Wrapf(err, "Failed to delete pipeline file for pipeline %v", pipelineId))
 		return nil
 	}
+	r.deletePipelineVersionPackage(pipelineId)
 	err = r.pipelineStore.DeletePipeline(pipelineId)
 	if err != nil {
 		glog.Errorf("%v", errors.Wrapf(err, "Failed to delete pipeline DB entry for pipeline %v", pipelineId))
@@ -235,11 +265,22 @@ func (r *ResourceManager) DeletePipeline(pipelineId string) error {
 }
 
 func (r *ResourceManager) CreatePipeline(name string, description string, pipelineFile []byte) (*model.Pipeline, error) {
+	return r.CreatePipelineWithLabels(name, description, nil, pipelineFile, nil)
+}
+
+// CreatePipelineWithLabels creates a pipeline and its default version. The pipeline package, if
+// any, is the archive the pipeline file was extracted from, and is kept with the version.
+func (r *ResourceManager) CreatePipelineWithLabels(name string, description string, labels map[string]string,
+	pipelineFile []byte, pipelinePackage []byte) (*model.Pipeline, error) {
 	// Extract the parameter from the pipeline
 	params, err := util.GetParameters(pipelineFile)
 	if err != nil {
 		return nil, util.Wrap(err, "Create pipeline failed")
 	}
+	parameterSchema, err := util.GetParameterSchema(pipelineFile)
+	if err != nil {
+		return nil, util.Wrap(err, "Create pipeline failed")
+	}
 
 	// Create an entry with status of creating the pipeline
 	pipeline := &model.Pipeline{
@@ -247,18 +288,20 @@ func (r *ResourceManager) CreatePipeline(name string, description string, pipeli
 		Description: description,
 		Parameters:  params,
 		Status:      model.PipelineCreating,
+		Labels:      labels,
 		DefaultVersion: &model.PipelineVersion{
-			Name:       name,
-			Parameters: params,
-			Status:     model.PipelineVersionCreating}}
+			Name:            name,
+			Parameters:      params,
+			ParameterSchema: parameterSchema,
+			Digest:          util.ComputeDigest(pipelineFile),
+			Status:          model.PipelineVersionCreating}}
 	newPipeline, err := r.pipelineStore.CreatePipeline(pipeline)
 	if err != nil {
 		return nil, util.Wrap(err, "Create pipeline failed")
 	}
 
 	// Store the pipeline file to a path dependent on pipeline version
-	err = r.objectStore.AddFile(pipelineFile,
-		r.objectStore.GetPipelineKey(fmt.Sprint(newPipeline.DefaultVersion.UUID)))
+	err = r.storePipelineVersionFiles(newPipeline.DefaultVersion.UUID, pipelineFile, pipelinePackage)
 	if err != nil {
 		return nil, util.Wrap(err, "Create pipeline failed")
 	}
@@ -276,6 +319,49 @@ func (r *ResourceManager) CreatePipeline(name string, description string, pipeli
 	return newPipeline, nil
 }
 
+// storePipelineVersionFiles stores the pipeline file of a version, and its package if any.
+func (r *ResourceManager) storePipelineVersionFiles(versionId string, pipelineFile []byte, pipelinePackage []byte) error {
+	err := r.objectStore.AddFile(pipelineFile, r.objectStore.GetPipelineKey(fmt.Sprint(versionId)))
+	if err != nil {
+		return err
+	}
+	if pipelinePackage != nil {
+		return r.objectStore.AddFile(pipelinePackage, r.objectStore.GetPipelinePackageKey(versionId))
+	}
+	return nil
+}
+
+func (r *ResourceManager) UpdatePipelineLabels(pipelineId string, labels map[string]string) (*model.Pipeline, error) {
+	_, err := r.pipelineStore.GetPipeline(pipelineId)
+	if err != nil {
+		return nil, util.Wrap(err, "Update pipeline labels failed")
+	}
+	err = r.labelStore.UpdateLabels(pipelineId, common.Pipeline, labels)
+	if err != nil {
+		return nil, util.Wrap(err, "Update pipeline labels failed")
+	}
+	return r.pipelineStore.GetPipeline(pipelineId)
+}
+
+func (r *ResourceManager) UpdatePipelineDefaultVersion(pipelineId string, versionId string) error {
+	_, err := r.pipelineStore.GetPipeline(pipelineId)
+	if err != nil {
+		return util.Wrap(err, "Update pipeline default version failed")
+	}
+	version, err := r.pipelineStore.GetPipelineVersion(versionId)
+	if err != nil {
+		return util.Wrap(err, "Update pipeline default version failed")
+	}
+	if version.PipelineId != pipelineId {
+		return util.NewInvalidInputError("Pipeline version %v doesn't belong to pipeline %v.", versionId, pipelineId)
+	}
+	err = r.pipelineStore.UpdatePipelineDefaultVersion(pipelineId, versionId)
+	if err != nil {
+		return util.Wrap(err, "Update pipeline default version failed")
+	}
+	return nil
+}
+
 func (r *ResourceManager) UpdatePipelineStatus(pipelineId string, status model.PipelineStatus) error {
 	return r.pipelineStore.UpdatePipelineStatus(pipelineId, status)
 }
@@ -296,6 +382,10 @@ func (r *ResourceManager) GetPipelineTemplate(pipelineId string) ([]byte, error)
 			"Get pipeline template failed since no default version is defined")
 	}
 	template, err := r.objectStore.GetFile(r.objectStore.GetPipelineKey(fmt.Sprint(pipeline.DefaultVersion.UUID)))
+	if util.IsUserErrorCodeMatch(err, codes.NotFound) {
+		// Every pipeline version has a template, so a missing one is a server error.
+		return nil, util.NewInternalServerError(err, "Get pipeline template failed")
+	}
 	if err != nil {
 		return nil, util.Wrap(err, "Get pipeline template failed")
 	}
@@ -337,6 +427,9 @@ func (r *ResourceManager) CreateRun(apiRun *api.Run) (*model.RunDetail, error) {
 	if err = workflow.VerifyParameters(parameters); err != nil {
 		return nil, util.Wrap(err, "Failed to verify parameters.")
 	}
+	if err = workflow.VerifyParameterValues(parameters); err != nil {
+		return nil, util.Wrap(err, "Failed to verify parameters.")
+	}
 
 	r.setDefaultServiceAccount(&workflow, apiRun.GetServiceAccount())
 
@@ -359,6 +452,11 @@ func (r *ResourceManager) CreateRun(apiRun *api.Run) (*model.RunDetail, error) {
 	workflow.SetLabels(util.LabelKeyWorkflowRunId, runId)
 	// Add run name annotation to the workflow so that it can be logged by the Metadata Writer.
 	workflow.SetAnnotations(util.AnnotationKeyRunName, apiRun.Name)
+	// Pass the run ID and the metrics push token to the steps, so they can push metrics while running.
+	metricsPushToken := common.GetMetricsPushToken(runId)
+	if metricsPushToken != "" {
+		setMetricsPushTokenEnv(&workflow, runId)
+	}
 	// Replace {{workflow.uid}} with runId
 	err = workflow.ReplaceUID(runId)
 	if err != nil {
@@ -394,6 +492,15 @@ func (r *ResourceManager) CreateRun(apiRun *api.Run) (*model.RunDetail, error) {
 	if err != nil {
 		return nil, util.NewInternalServerError(err, "Failed to create a workflow for (%s)", workflow.Name)
 	}
+	if metricsPushToken != "" {
+		if err := r.createMetricsPushTokenSecret(util.NewWorkflow(newWorkflow), runId, metricsPushToken); err != nil {
+			// The steps of the workflow can't start without the secret.
+			if deleteErr := r.getWorkflowClient(namespace).Delete(newWorkflow.Name, &v1.DeleteOptions{}); deleteErr != nil {
+				glog.Errorf("Failed to delete the workflow %v of run %v: %v", newWorkflow.Name, runId, deleteErr)
+			}
+			return nil, err
+		}
+	}
 
 	// Store run metadata into database
 	runDetail, err := r.ToModelRunDetail(apiRun, runId, util.NewWorkflow(newWorkflow), string(workflowSpecManifestBytes))
@@ -559,6 +666,66 @@ func (r *ResourceManager) GetJob(id string) (*model.Job, error) {
 }
 
 func (r *ResourceManager) CreateJob(apiJob *api.Job) (*model.Job, error) {
+	spec, workflowSpecManifestBytes, err := r.toScheduledWorkflowSpec(apiJob)
+	if err != nil {
+		return nil, util.Wrap(err, "Create job failed")
+	}
+	swfGeneratedName, err := toSWFCRDResourceGeneratedName(apiJob.Name)
+	if err != nil {
+		return nil, util.Wrap(err, "Create job failed")
+	}
+	scheduledWorkflow := &scheduledworkflow.ScheduledWorkflow{
+		ObjectMeta: v1.ObjectMeta{
+			GenerateName: swfGeneratedName,
+			Annotations:  map[string]string{util.AnnotationKeyJobName: apiJob.Name},
+		},
+		Spec: *spec,
+	}
+
+	// Add a reference to the default experiment if run does not already have a containing experiment
+	ref, err := r.getDefaultExperimentIfNoExperiment(apiJob.GetResourceReferences())
+	if err != nil {
+		return nil, err
+	}
+	if ref != nil {
+		apiJob.ResourceReferences = append(apiJob.GetResourceReferences(), ref)
+	}
+
+	namespace, err := r.getNamespaceFromExperiment(apiJob.GetResourceReferences())
+	if err != nil {
+		return nil, err
+	}
+
+	if runCompletion := apiJob.GetTrigger().GetRunCompletion(); runCompletion != nil {
+		if err := r.checkRunCompletionUpstream(runCompletion, namespace); err != nil {
+			return nil, util.Wrap(err, "Create job failed")
+		}
+		experimentId := common.GetExperimentIDFromAPIResourceReferences(apiJob.GetResourceReferences())
+		if err := r.checkRunCompletionCycle(runCompletion, "", experimentId); err != nil {
+			return nil, util.Wrap(err, "Create job failed")
+		}
+	}
+
+	newScheduledWorkflow, err := r.getScheduledWorkflowClient(namespace).Create(scheduledWorkflow)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create a scheduled workflow for (%s)", scheduledWorkflow.Name)
+	}
+
+	job, err := r.ToModelJob(apiJob, util.NewScheduledWorkflow(newScheduledWorkflow), string(workflowSpecManifestBytes))
+	if err != nil {
+		return nil, util.Wrap(err, "Create job failed")
+	}
+
+	now := r.time.Now().Unix()
+	job.CreatedAtInSec = now
+	job.UpdatedAtInSec = now
+	return r.jobStore.CreateJob(job)
+}
+
+// toScheduledWorkflowSpec returns the spec of the scheduled workflow of the
+// job, and the workflow manifest it is created from.
+func (r *ResourceManager) toScheduledWorkflowSpec(apiJob *api.Job) (
+	*scheduledworkflow.ScheduledWorkflowSpec, []byte, error) {
 	// Get workflow from either of the two places:
 	// (1) raw pipeline manifest in pipeline_spec
 	// (2) pipeline version in resource_references
@@ -566,117 +733,366 @@ func (r *ResourceManager) CreateJob(apiJob *api.Job) (*model.Job, error) {
 	var workflowSpecManifestBytes []byte
 	err := ConvertPipelineIdToDefaultPipelineVersion(apiJob.PipelineSpec, &apiJob.ResourceReferences, r)
 	if err != nil {
-		return nil, util.Wrap(err, "Failed to find default version to create job with pipeline id.")
+		return nil, nil, util.Wrap(err, "Failed to find default version to create job with pipeline id.")
 	}
 	workflowSpecManifestBytes, err = r.getWorkflowSpecBytesFromPipelineVersion(apiJob.GetResourceReferences())
 	if err != nil {
 		workflowSpecManifestBytes, err = r.getWorkflowSpecBytesFromPipelineSpec(apiJob.GetPipelineSpec())
 		if err != nil {
-			return nil, util.Wrap(err, "Failed to fetch workflow spec.")
+			return nil, nil, util.Wrap(err, "Failed to fetch workflow spec.")
 		}
 	}
 
 	var workflow util.Workflow
 	err = json.Unmarshal(workflowSpecManifestBytes, &workflow)
 	if err != nil {
-		return nil, util.NewInternalServerError(err,
+		return nil, nil, util.NewInternalServerError(err,
 			"Failed to unmarshal workflow spec manifest. Workflow bytes: %s", string(workflowSpecManifestBytes))
 	}
 
 	// Verify no additional parameter provided
-	err = workflow.VerifyParameters(toParametersMap(apiJob.GetPipelineSpec().GetParameters()))
+	parameters := toParametersMap(apiJob.GetPipelineSpec().GetParameters())
+	err = workflow.VerifyParameters(parameters)
 	if err != nil {
-		return nil, util.Wrap(err, "Create job failed")
+		return nil, nil, err
+	}
+	for _, param := range apiJob.GetPipelineSpec().GetParameters() {
+		if err := swfutil.ValidateParameterMacros(param.GetValue()); err != nil {
+			return nil, nil, util.Wrapf(err, "Invalid value of the parameter %q", param.GetName())
+		}
+	}
+	for _, name := range getTriggerParameters(apiJob.GetTrigger()) {
+		if err := workflow.VerifyParameters(map[string]string{name: ""}); err != nil {
+			return nil, nil, util.Wrapf(err, "the parameter %q set by the trigger is not a parameter of the pipeline", name)
+		}
+		// The value is only known when the job triggers, so it is checked like
+		// the values substituted by the controller.
+		parameters[name] = "[[TriggerValue]]"
+	}
+	err = workflow.VerifyParameterValues(parameters)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	r.setDefaultServiceAccount(&workflow, apiJob.GetServiceAccount())
 
 	// Disable istio sidecar injection
 	workflow.SetAnnotationsToAllTemplates(util.AnnotationKeyIstioSidecarInject, util.AnnotationValueIstioSidecarInjectDisabled)
-
-	swfGeneratedName, err := toSWFCRDResourceGeneratedName(apiJob.Name)
-	if err != nil {
-		return nil, util.Wrap(err, "Create job failed")
-	}
-	scheduledWorkflow := &scheduledworkflow.ScheduledWorkflow{
-		ObjectMeta: v1.ObjectMeta{GenerateName: swfGeneratedName},
-		Spec: scheduledworkflow.ScheduledWorkflowSpec{
-			Enabled:        apiJob.Enabled,
-			MaxConcurrency: &apiJob.MaxConcurrency,
-			Trigger:        *toCRDTrigger(apiJob.Trigger),
-			Workflow: &scheduledworkflow.WorkflowResource{
-				Parameters: toCRDParameter(apiJob.GetPipelineSpec().GetParameters()),
-				Spec:       workflow.Spec,
-			},
-			NoCatchup: util.BoolPointer(apiJob.NoCatchup),
+	// The controller replaces {{workflow.uid}} with the ID of each run. The secret holding the
+	// metrics push token of the run is created when the run is first reported, and its steps wait
+	// for it until then.
+	if common.GetMetricsPushTokenSecret() != "" {
+		setMetricsPushTokenEnv(&workflow, "{{workflow.uid}}")
+	}
+
+	spec := &scheduledworkflow.ScheduledWorkflowSpec{
+		Enabled:        apiJob.Enabled,
+		MaxConcurrency: &apiJob.MaxConcurrency,
+		Trigger:        *toCRDTrigger(apiJob.Trigger),
+		Workflow: &scheduledworkflow.WorkflowResource{
+			Parameters: toCRDParameter(apiJob.GetPipelineSpec().GetParameters()),
+			Spec:       workflow.Spec,
 		},
+		NoCatchup:     util.BoolPointer(apiJob.NoCatchup),
+		FailurePolicy: toCRDFailurePolicy(apiJob.MaxConsecutiveFailures),
+		Blackouts:     toCRDTimeWindows(apiJob.BlackoutWindows),
 	}
 
 	// Marking auto-added artifacts as optional. Otherwise most older workflows will start failing after upgrade to Argo 2.3.
 	// TODO: Fix the components to explicitly declare the artifacts they really output.
-	for templateIdx, template := range scheduledWorkflow.Spec.Workflow.Spec.Templates {
+	for templateIdx, template := range spec.Workflow.Spec.Templates {
 		for artIdx, artifact := range template.Outputs.Artifacts {
 			if artifact.Name == "mlpipeline-ui-metadata" || artifact.Name == "mlpipeline-metrics" {
-				scheduledWorkflow.Spec.Workflow.Spec.Templates[templateIdx].Outputs.Artifacts[artIdx].Optional = true
+				spec.Workflow.Spec.Templates[templateIdx].Outputs.Artifacts[artIdx].Optional = true
 			}
 		}
 	}
+	return spec, workflowSpecManifestBytes, nil
+}
 
-	// Add a reference to the default experiment if run does not already have a containing experiment
-	ref, err := r.getDefaultExperimentIfNoExperiment(apiJob.GetResourceReferences())
+func (r *ResourceManager) EnableJob(jobID string, enabled bool) error {
+	job, err := r.checkJobExist(jobID)
 	if err != nil {
-		return nil, err
+		return util.Wrap(err, "Enable/Disable job failed")
 	}
-	if ref != nil {
-		apiJob.ResourceReferences = append(apiJob.GetResourceReferences(), ref)
+
+	_, err = r.getScheduledWorkflowClient(job.Namespace).Patch(
+		job.Name,
+		types.MergePatchType,
+		[]byte(fmt.Sprintf(`{"spec":{"enabled":%s}}`, strconv.FormatBool(enabled))))
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to enable/disable job CRD. Enabled: %v, jobID: %v",
+			enabled, jobID)
 	}
 
-	namespace, err := r.getNamespaceFromExperiment(apiJob.GetResourceReferences())
+	err = r.jobStore.EnableJob(jobID, enabled)
 	if err != nil {
-		return nil, err
+		return util.Wrapf(err, "Failed to enable/disable job. Enabled: %v, jobID: %v",
+			enabled, jobID)
 	}
 
-	newScheduledWorkflow, err := r.getScheduledWorkflowClient(namespace).Create(scheduledWorkflow)
+	return nil
+}
+
+func (r *ResourceManager) UpdateJobLabels(jobId string, labels map[string]string) (*model.Job, error) {
+	_, err := r.checkJobExist(jobId)
 	if err != nil {
-		return nil, util.NewInternalServerError(err, "Failed to create a scheduled workflow for (%s)", scheduledWorkflow.Name)
+		return nil, util.Wrap(err, "Update job labels failed")
+	}
+	err = r.labelStore.UpdateLabels(jobId, common.Job, labels)
+	if err != nil {
+		return nil, util.Wrap(err, "Update job labels failed")
 	}
+	return r.jobStore.GetJob(jobId)
+}
 
-	job, err := r.ToModelJob(apiJob, util.NewScheduledWorkflow(newScheduledWorkflow), string(workflowSpecManifestBytes))
+// UpdateJob replaces the trigger, pipeline, parameters, max concurrency and
+// catchup of the job. The name, labels, enabled state and experiment of the
+// job are kept, and so are its runs and the progress of its schedule.
+func (r *ResourceManager) UpdateJob(jobID string, apiJob *api.Job) (*model.Job, error) {
+	job, err := r.checkJobExist(jobID)
 	if err != nil {
-		return nil, util.Wrap(err, "Create job failed")
+		return nil, util.Wrap(err, "Update job failed")
 	}
 
-	now := r.time.Now().Unix()
-	job.CreatedAtInSec = now
-	job.UpdatedAtInSec = now
-	return r.jobStore.CreateJob(job)
+	// The job stays in its experiment.
+	var references []*api.ResourceReference
+	for _, ref := range apiJob.GetResourceReferences() {
+		if ref.GetKey().GetType() == api.ResourceType_EXPERIMENT || ref.GetKey().GetType() == api.ResourceType_NAMESPACE {
+			continue
+		}
+		references = append(references, ref)
+	}
+	for _, ref := range job.ResourceReferences {
+		if ref.ReferenceType != common.Experiment {
+			continue
+		}
+		for _, apiRef := range apiJob.GetResourceReferences() {
+			if apiRef.GetKey().GetType() == api.ResourceType_EXPERIMENT && apiRef.GetKey().GetId() != ref.ReferenceUUID {
+				return nil, util.NewInvalidInputError("Update job failed: the experiment of a job can't be changed")
+			}
+		}
+		references = append(references, &api.ResourceReference{
+			Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: ref.ReferenceUUID},
+			Relationship: api.Relationship_OWNER,
+		})
+	}
+	apiJob.ResourceReferences = references
+	apiJob.Name = job.DisplayName
+	apiJob.Enabled = job.Enabled
+	apiJob.Labels = nil
+
+	spec, workflowSpecManifestBytes, err := r.toScheduledWorkflowSpec(apiJob)
+	if err != nil {
+		return nil, util.Wrap(err, "Update job failed")
+	}
+	if runCompletion := apiJob.GetTrigger().GetRunCompletion(); runCompletion != nil {
+		if err := r.checkRunCompletionUpstream(runCompletion, job.Namespace); err != nil {
+			return nil, util.Wrap(err, "Update job failed")
+		}
+		if err := r.checkRunCompletionCycle(runCompletion, job.UUID, jobExperimentId(job)); err != nil {
+			return nil, util.Wrap(err, "Update job failed")
+		}
+	}
+
+	swfClient := r.getScheduledWorkflowClient(job.Namespace)
+	scheduledWorkflow, err := swfClient.Get(job.Name, v1.GetOptions{})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Update job failed: failed to get the job CRD")
+	}
+	// The status of the scheduled workflow, which holds the index and the last
+	// trigger time of the schedule, is kept as is.
+	scheduledWorkflow = scheduledWorkflow.DeepCopy()
+	spec.Enabled = scheduledWorkflow.Spec.Enabled
+	spec.MaxHistory = scheduledWorkflow.Spec.MaxHistory
+	spec.Backfill = scheduledWorkflow.Spec.Backfill
+	spec.ManualTrigger = scheduledWorkflow.Spec.ManualTrigger
+	if spec.Trigger.RunCompletion != nil && scheduledWorkflow.Spec.Trigger.RunCompletion != nil {
+		spec.Trigger.RunCompletion.PendingRuns = scheduledWorkflow.Spec.Trigger.RunCompletion.PendingRuns
+	}
+	scheduledWorkflow.Spec = *spec
+	updatedScheduledWorkflow, err := swfClient.Update(scheduledWorkflow)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to update job CRD. jobID: %v", jobID)
+	}
+
+	newJob, err := r.ToModelJob(apiJob, util.NewScheduledWorkflow(updatedScheduledWorkflow), string(workflowSpecManifestBytes))
+	if err != nil {
+		return nil, util.Wrap(err, "Update job failed")
+	}
+	newJob.UpdatedAtInSec = r.time.Now().Unix()
+	err = r.jobStore.UpdateJobSpec(newJob)
+	if err != nil {
+		return nil, util.Wrap(err, "Update job failed")
+	}
+	return r.jobStore.GetJob(jobID)
 }
 
-func (r *ResourceManager) EnableJob(jobID string, enabled bool) error {
+// TriggerJob requests one run of the job right away. The scheduled workflow
+// controller creates the run once the max concurrency of the job allows it.
+func (r *ResourceManager) TriggerJob(jobID string) error {
 	job, err := r.checkJobExist(jobID)
 	if err != nil {
-		return util.Wrap(err, "Enable/Disable job failed")
+		return util.Wrap(err, "Trigger job failed")
+	}
+	swf, err := r.getScheduledWorkflowClient(job.Namespace).Get(job.Name, v1.GetOptions{})
+	if err != nil {
+		return util.NewInternalServerError(err, "Trigger job failed: failed to get the job CRD")
+	}
+	if swfutil.NewScheduledWorkflow(swf).HasPendingManualTrigger() {
+		return util.NewInvalidInputError(
+			"Trigger job failed: the run of the previous trigger %v of the job is not created yet", swf.Spec.ManualTrigger.ID)
 	}
 
+	id, err := r.uuid.NewRandom()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to generate manual trigger ID")
+	}
 	_, err = r.getScheduledWorkflowClient(job.Namespace).Patch(
 		job.Name,
 		types.MergePatchType,
-		[]byte(fmt.Sprintf(`{"spec":{"enabled":%s}}`, strconv.FormatBool(enabled))))
+		[]byte(fmt.Sprintf(`{"spec":{"manualTrigger":{"id":%q}}}`, id.String())))
 	if err != nil {
-		return util.NewInternalServerError(err,
-			"Failed to enable/disable job CRD. Enabled: %v, jobID: %v",
-			enabled, jobID)
+		return util.NewInternalServerError(err, "Failed to trigger job CRD. jobID: %v", jobID)
 	}
+	return nil
+}
 
-	err = r.jobStore.EnableJob(jobID, enabled)
+// BackfillJob starts a backfill of the job over [startEpoch, endEpoch). The
+// scheduled workflow controller creates the runs of the backfill.
+func (r *ResourceManager) BackfillJob(jobID string, startEpoch int64, endEpoch int64) (*model.Job, error) {
+	job, err := r.checkJobExist(jobID)
 	if err != nil {
-		return util.Wrapf(err, "Failed to enable/disable job. Enabled: %v, jobID: %v",
-			enabled, jobID)
+		return nil, util.Wrap(err, "Backfill job failed")
+	}
+	if job.BackfillState != nil && *job.BackfillState == string(scheduledworkflow.BackfillRunning) {
+		return nil, util.NewInvalidInputError(
+			"Backfill job failed: the backfill %v of the job is still running. Cancel it first", *job.BackfillId)
+	}
+	swf, err := r.getScheduledWorkflowClient(job.Namespace).Get(job.Name, v1.GetOptions{})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Backfill job failed: failed to get the job CRD")
+	}
+	if swf.Spec.CronSchedule == nil && swf.Spec.PeriodicSchedule == nil {
+		return nil, util.NewInvalidInputError("Backfill job failed: only jobs with a cron or periodic schedule can be backfilled")
+	}
+
+	id, err := r.uuid.NewRandom()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to generate backfill ID")
+	}
+	backfill := &scheduledworkflow.Backfill{
+		ID:        id.String(),
+		StartTime: v1.NewTime(time.Unix(startEpoch, 0).UTC()),
+		EndTime:   v1.NewTime(time.Unix(endEpoch, 0).UTC()),
+	}
+	totalRuns := swfutil.CountBackfillWorkflows(&swf.Spec.Trigger, backfill, swfutil.MaxBackfillWorkflows)
+	if totalRuns == 0 {
+		return nil, util.NewInvalidInputError("Backfill job failed: the schedule of the job has no time within the range")
+	}
+	if totalRuns > swfutil.MaxBackfillWorkflows {
+		return nil, util.NewInvalidInputError(
+			"Backfill job failed: the range has more than %v times of the schedule of the job", swfutil.MaxBackfillWorkflows)
 	}
 
+	// Cancelled is set explicitly, since a merge patch keeps the fields it does not set.
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"backfill": map[string]interface{}{
+				"id":        backfill.ID,
+				"startTime": backfill.StartTime,
+				"endTime":   backfill.EndTime,
+				"cancelled": false,
+			},
+		},
+	})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to marshal the backfill of job %v", jobID)
+	}
+	_, err = r.getScheduledWorkflowClient(job.Namespace).Patch(job.Name, types.MergePatchType, patch)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to backfill job CRD. jobID: %v", jobID)
+	}
+
+	firstEpoch := swfutil.GetNextBackfillEpoch(&swf.Spec.Trigger, backfill, nil)
+	err = r.jobStore.UpdateJobBackfill(jobID, model.Backfill{
+		BackfillId:                     util.StringPointer(backfill.ID),
+		BackfillStartTimeInSec:         util.Int64Pointer(startEpoch),
+		BackfillEndTimeInSec:           util.Int64Pointer(endEpoch),
+		BackfillState:                  util.StringPointer(string(scheduledworkflow.BackfillRunning)),
+		BackfillTotalRuns:              util.Int64Pointer(totalRuns),
+		BackfillCreatedRuns:            util.Int64Pointer(0),
+		BackfillNextScheduledTimeInSec: util.Int64Pointer(firstEpoch),
+	})
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to store the backfill of job %v", jobID)
+	}
+	return r.jobStore.GetJob(jobID)
+}
+
+// CancelJobBackfill stops the running backfill of the job. The runs it
+// already created are not affected.
+func (r *ResourceManager) CancelJobBackfill(jobID string) error {
+	job, err := r.checkJobExist(jobID)
+	if err != nil {
+		return util.Wrap(err, "Cancel job backfill failed")
+	}
+	if job.BackfillState == nil || *job.BackfillState != string(scheduledworkflow.BackfillRunning) {
+		return util.NewInvalidInputError("Cancel job backfill failed: the job has no running backfill")
+	}
+
+	_, err = r.getScheduledWorkflowClient(job.Namespace).Patch(
+		job.Name,
+		types.MergePatchType,
+		[]byte(`{"spec":{"backfill":{"cancelled":true}}}`))
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to cancel the backfill of job CRD. jobID: %v", jobID)
+	}
 	return nil
 }
 
+// PreviewSchedule returns the next trigger times, in epoch seconds, of a job
+// with the trigger if it was created now. At most count times are returned, as
+// computed by the scheduled workflow controller.
+func (r *ResourceManager) PreviewSchedule(apiTrigger *api.Trigger, noCatchup bool, count int) []int64 {
+	nowEpoch := r.time.Now().Unix()
+	crdTrigger := toCRDTrigger(apiTrigger)
+
+	var getNextScheduledEpoch func(lastJobEpoch *int64) int64
+	if crdTrigger.CronSchedule != nil {
+		schedule := swfutil.NewCronSchedule(crdTrigger.CronSchedule)
+		getNextScheduledEpoch = func(lastJobEpoch *int64) int64 {
+			if noCatchup {
+				return schedule.GetNextScheduledEpochNoCatchup(lastJobEpoch, nowEpoch, nowEpoch)
+			}
+			return schedule.GetNextScheduledEpoch(lastJobEpoch, nowEpoch)
+		}
+	} else if crdTrigger.PeriodicSchedule != nil {
+		schedule := swfutil.NewPeriodicSchedule(crdTrigger.PeriodicSchedule)
+		getNextScheduledEpoch = func(lastJobEpoch *int64) int64 {
+			if noCatchup {
+				return schedule.GetNextScheduledEpochNoCatchup(lastJobEpoch, nowEpoch, nowEpoch)
+			}
+			return schedule.GetNextScheduledEpoch(lastJobEpoch, nowEpoch)
+		}
+	} else {
+		return nil
+	}
+
+	epochs := make([]int64, 0, count)
+	var lastJobEpoch *int64
+	for len(epochs) < count {
+		nextEpoch := getNextScheduledEpoch(lastJobEpoch)
+		if nextEpoch == math.MaxInt64 {
+			break
+		}
+		epochs = append(epochs, nextEpoch)
+		lastJobEpoch = &nextEpoch
+	}
+	return epochs
+}
+
 func (r *ResourceManager) DeleteJob(jobID string) error {
 	job, err := r.checkJobExist(jobID)
 	if err != nil {
@@ -705,6 +1121,13 @@ func (r *ResourceManager) ReportWorkflowResource(workflow *util.Workflow) error
 		return util.NewInvalidInputError("Workflow missing namespace")
 	}
 
+	// A run reported in a final state before already triggered the downstream jobs.
+	if workflow.IsInFinalState() && !r.isRunReportedInFinalState(runId) {
+		if err := r.triggerRunCompletionJobs(runId, jobId, workflow); err != nil {
+			return err
+		}
+	}
+
 	if workflow.PersistedFinalState() {
 		// If workflow's final state has being persisted, the workflow should be garbage collected.
 		err := r.getWorkflowClient(workflow.Namespace).Delete(workflow.Name, &v1.DeleteOptions{})
@@ -733,6 +1156,20 @@ func (r *ResourceManager) ReportWorkflowResource(workflow *util.Workflow) error
 		if err != nil {
 			return util.Wrap(err, "Failed to retrieve the job name for the job that created the run.")
 		}
+		// The steps of a run created by a job wait for the secret holding its metrics push token, so
+		// it is created when the run is first reported, before the run is stored. Later reports of the
+		// run find it stored and skip the secret.
+		if token := common.GetMetricsPushToken(runId); token != "" && !workflow.IsInFinalState() {
+			_, err := r.runStore.GetRun(runId)
+			if util.IsUserErrorCodeMatch(err, codes.NotFound) {
+				if err := r.createMetricsPushTokenSecret(workflow, runId, token); err != nil {
+					return err
+				}
+			} else if err != nil {
+				return util.Wrap(err, "Failed to get the run.")
+			}
+		}
+		workflowSpecManifest := workflow.GetWorkflowSpec().ToStringForStore()
 		runDetail := &model.RunDetail{
 			Run: model.Run{
 				UUID:             runId,
@@ -745,7 +1182,8 @@ func (r *ResourceManager) ReportWorkflowResource(workflow *util.Workflow) error
 				FinishedAtInSec:  workflow.FinishedAt(),
 				Conditions:       workflow.Condition(),
 				PipelineSpec: model.PipelineSpec{
-					WorkflowSpecManifest: workflow.GetWorkflowSpec().ToStringForStore(),
+					WorkflowSpecManifest: workflowSpecManifest,
+					WorkflowSpecDigest:   util.ComputeDigest([]byte(workflowSpecManifest)),
 				},
 				ResourceReferences: []*model.ResourceReference{
 					{
@@ -786,6 +1224,234 @@ func (r *ResourceManager) ReportWorkflowResource(workflow *util.Workflow) error
 	return nil
 }
 
+// isRunReportedInFinalState returns whether the run is stored in a final state.
+func (r *ResourceManager) isRunReportedInFinalState(runId string) bool {
+	run, err := r.runStore.GetRun(runId)
+	if err != nil {
+		return false
+	}
+	switch workflowapi.NodePhase(run.Conditions) {
+	case workflowapi.NodeSucceeded, workflowapi.NodeFailed, workflowapi.NodeError, workflowapi.NodeSkipped:
+		return true
+	}
+	return false
+}
+
+// checkRunCompletionUpstream checks that the upstream job or experiment of a
+// run completion trigger exists in the namespace of the downstream job.
+func (r *ResourceManager) checkRunCompletionUpstream(runCompletion *api.RunCompletion, namespace string) error {
+	var upstreamNamespace string
+	if runCompletion.JobId != "" {
+		job, err := r.jobStore.GetJob(runCompletion.JobId)
+		if err != nil {
+			return util.Wrap(err, "Failed to get the upstream job of the run completion trigger")
+		}
+		upstreamNamespace = job.Namespace
+	} else {
+		var err error
+		upstreamNamespace, err = r.getNamespaceFromExperiment([]*api.ResourceReference{{
+			Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: runCompletion.ExperimentId},
+			Relationship: api.Relationship_OWNER,
+		}})
+		if err != nil {
+			return util.Wrap(err, "Failed to get the upstream experiment of the run completion trigger")
+		}
+	}
+	if upstreamNamespace != namespace {
+		return util.NewInvalidInputError(
+			"The upstream job or experiment of the run completion trigger must be in the namespace of the job (%v).", namespace)
+	}
+	return nil
+}
+
+// checkRunCompletionCycle checks that the runs of a job don't trigger the
+// upstream job or experiment of its run completion trigger again, either
+// directly or through the jobs they trigger. The runs of a job are created in
+// its experiment. The job ID is empty for a job being created.
+func (r *ResourceManager) checkRunCompletionCycle(runCompletion *api.RunCompletion, jobId string, experimentId string) error {
+	if (runCompletion.JobId != "" && runCompletion.JobId == jobId) ||
+		(runCompletion.ExperimentId != "" && runCompletion.ExperimentId == experimentId) {
+		return util.NewInvalidInputError(
+			"The run completion trigger can't watch the job itself or its experiment, since the runs of the job would trigger it again.")
+	}
+
+	// Walk the jobs triggered by the runs of the job, and by their runs in turn.
+	visited := map[string]bool{jobId: true}
+	pendingJobIds := []string{jobId}
+	pendingExperimentIds := []string{experimentId}
+	for len(pendingJobIds) > 0 {
+		downstreamJobs, err := r.jobStore.ListRunCompletionJobs(pendingJobIds[0], pendingExperimentIds[0])
+		if err != nil {
+			return util.Wrap(err, "Failed to list the jobs triggered by the runs of the job")
+		}
+		pendingJobIds, pendingExperimentIds = pendingJobIds[1:], pendingExperimentIds[1:]
+		for _, downstreamJob := range downstreamJobs {
+			if visited[downstreamJob.UUID] {
+				continue
+			}
+			visited[downstreamJob.UUID] = true
+			downstreamExperimentId := jobExperimentId(downstreamJob)
+			if downstreamJob.UUID == runCompletion.JobId ||
+				(runCompletion.ExperimentId != "" && downstreamExperimentId == runCompletion.ExperimentId) {
+				return util.NewInvalidInputError(
+					"The run completion trigger would form a cycle: the runs of the job trigger job %v, whose runs trigger the job again.",
+					downstreamJob.UUID)
+			}
+			pendingJobIds = append(pendingJobIds, downstreamJob.UUID)
+			pendingExperimentIds = append(pendingExperimentIds, downstreamExperimentId)
+		}
+	}
+	return nil
+}
+
+// setMetricsPushTokenEnv passes the run ID and the metrics push token to the steps of the workflow,
+// so they can push metrics while running. The token is read from the secret of the run, so that it
+// is not part of the workflow, nor of the manifests stored with the run.
+func setMetricsPushTokenEnv(workflow *util.Workflow, runId string) {
+	workflow.SetEnvToAllContainers(util.EnvKeyRunID, runId)
+	workflow.SetEnvFromSecretToAllContainers(util.EnvKeyMetricsPushToken,
+		common.GetMetricsPushTokenSecretName(runId), common.MetricsPushTokenSecretKey)
+}
+
+// createMetricsPushTokenSecret creates the secret holding the metrics push token of the run, unless
+// it exists. The secret is owned by the workflow of the run, so that it is deleted along with it.
+func (r *ResourceManager) createMetricsPushTokenSecret(workflow *util.Workflow, runId string, token string) error {
+	secret := &corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{
+			Name:   common.GetMetricsPushTokenSecretName(runId),
+			Labels: map[string]string{util.LabelKeyWorkflowRunId: runId},
+			OwnerReferences: []v1.OwnerReference{*v1.NewControllerRef(workflow.Get(),
+				workflowapi.SchemeGroupVersion.WithKind("Workflow"))},
+		},
+		Data: map[string][]byte{common.MetricsPushTokenSecretKey: []byte(token)},
+	}
+	_, err := r.k8sCoreClient.SecretClient(workflow.Namespace).Create(secret)
+	if err != nil && !apierr.IsAlreadyExists(err) {
+		return util.NewInternalServerError(err, "Failed to create the metrics push token secret of run %v", runId)
+	}
+	return nil
+}
+
+// jobExperimentId returns the ID of the experiment the runs of the job are created in.
+func jobExperimentId(job *model.Job) string {
+	for _, ref := range job.ResourceReferences {
+		if ref.ReferenceType == common.Experiment {
+			return ref.ReferenceUUID
+		}
+	}
+	return ""
+}
+
+// triggerRunCompletionJobs queues the run in the run completion trigger of each
+// enabled job triggered by its completion, and the scheduled workflow controller
+// creates the runs of the jobs. It is called before the run is stored in a final
+// state, so that the run is queued again if reporting it fails.
+func (r *ResourceManager) triggerRunCompletionJobs(runId string, jobId string, workflow *util.Workflow) error {
+	// The runs of a job are in the experiment of the job.
+	experimentRef, err := r.resourceReferenceStore.GetResourceReference(runId, common.Run, common.Experiment)
+	if jobId != "" {
+		experimentRef, err = r.resourceReferenceStore.GetResourceReference(jobId, common.Job, common.Experiment)
+	}
+	var experimentId string
+	if err == nil {
+		experimentId = experimentRef.ReferenceUUID
+	} else if !util.IsUserErrorCodeMatch(err, codes.NotFound) {
+		return util.Wrap(err, "Failed to get the experiment of the run to trigger the jobs watching it")
+	}
+
+	jobs, err := r.jobStore.ListRunCompletionJobs(jobId, experimentId)
+	if err != nil {
+		return util.Wrap(err, "Failed to list the jobs triggered by the completion of the run")
+	}
+	for _, job := range jobs {
+		// A job is never triggered by its own runs, which could loop forever.
+		if !job.Enabled || job.UUID == jobId || job.Namespace != workflow.Namespace ||
+			!isRunCompletionState(job.RunCompletionStateList(), workflow.Condition()) {
+			continue
+		}
+		params, err := runCompletionParameters(job, runId, workflow)
+		if err != nil {
+			// Reporting the run again wouldn't help, so the job is skipped.
+			glog.Errorf("Failed to trigger job %v on the completion of run %v: %v", job.UUID, runId, err)
+			continue
+		}
+		queued, err := r.queueRunCompletionRun(job, scheduledworkflow.PendingRun{RunID: runId, Parameters: params})
+		if err != nil {
+			return util.NewInternalServerError(err, "Failed to trigger job %v on the completion of run %v", job.UUID, runId)
+		}
+		if queued {
+			runCompletionTriggerCounter.Inc()
+			glog.Infof("Run %v queued to trigger job %v on its completion.", runId, job.UUID)
+		}
+	}
+	return nil
+}
+
+// queueRunCompletionRun adds the upstream run to the run completion trigger of
+// the job's scheduled workflow. It returns false if the run is already queued or
+// already created a run of the job.
+func (r *ResourceManager) queueRunCompletionRun(job *model.Job, run scheduledworkflow.PendingRun) (bool, error) {
+	swfClient := r.getScheduledWorkflowClient(job.Namespace)
+	queued := false
+	// The controller updates the scheduled workflow too, whenever it syncs it.
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		swf, err := swfClient.Get(job.Name, v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		swf = swf.DeepCopy()
+		queued = swfutil.NewScheduledWorkflow(swf).AddPendingRun(run)
+		if !queued {
+			return nil
+		}
+		_, err = swfClient.Update(swf)
+		return err
+	})
+	return queued, err
+}
+
+func isRunCompletionState(states []string, condition string) bool {
+	if len(states) == 0 {
+		return condition == string(workflowapi.NodeSucceeded)
+	}
+	for _, state := range states {
+		if state == condition {
+			return true
+		}
+	}
+	return false
+}
+
+// runCompletionParameters returns the parameters of the run of the job
+// triggered by the upstream run: the ID and the output parameters of the
+// upstream run. They override the parameters of the job.
+func runCompletionParameters(job *model.Job, upstreamRunId string,
+	upstreamWorkflow *util.Workflow) ([]scheduledworkflow.Parameter, error) {
+	var params []scheduledworkflow.Parameter
+	if job.RunCompletionRunIdParameter != nil && *job.RunCompletionRunIdParameter != "" {
+		params = append(params, scheduledworkflow.Parameter{Name: *job.RunCompletionRunIdParameter, Value: upstreamRunId})
+	}
+	outputParameters, err := job.RunCompletionOutputParameterMap()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Run completion trigger with wrong format is stored")
+	}
+	names := make([]string, 0, len(outputParameters))
+	for name := range outputParameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	upstreamOutputs := upstreamWorkflow.OutputParameters()
+	for _, name := range names {
+		value, ok := upstreamOutputs[outputParameters[name]]
+		if !ok {
+			return nil, util.NewInvalidInputError(
+				"The upstream run %v has no output parameter %q.", upstreamRunId, outputParameters[name])
+		}
+		params = append(params, scheduledworkflow.Parameter{Name: name, Value: value})
+	}
+	return params, nil
+}
+
 // AddWorkflowLabel add label for a workflow
 func AddWorkflowLabel(wfClient workflowclient.WorkflowInterface, name string, labelKey string, labelValue string) error {
 	patchObj := map[string]interface{}{
@@ -946,27 +1612,116 @@ func (r *ResourceManager) ReportMetric(metric *api.RunMetric, runUUID string) er
 	return r.runStore.ReportMetric(r.ToModelRunMetric(metric, runUUID))
 }
 
+// AddRunWarning adds a warning to a run, unless the run already has it.
+func (r *ResourceManager) AddRunWarning(runUUID string, warning string) error {
+	return r.runStore.AddRunWarning(runUUID, warning)
+}
+
+// PushMetric reports a metric of a running run, updating the metric if it has been reported before.
+func (r *ResourceManager) PushMetric(metric *api.RunMetric, runUUID string) error {
+	return r.runStore.PushMetric(r.ToModelRunMetric(metric, runUUID))
+}
+
+// GetRunMetricHistory returns the series of a time-series metric of a run, ordered by step.
+func (r *ResourceManager) GetRunMetricHistory(runID string, nodeID string, name string) ([]*model.RunMetricPoint, error) {
+	return r.runStore.GetMetricHistory(runID, nodeID, name)
+}
+
 // ReadArtifact parses run's workflow to find artifact file path and reads the content of the file
 // from object store.
 func (r *ResourceManager) ReadArtifact(runID string, nodeID string, artifactName string) ([]byte, error) {
+	artifactPath, err := r.getArtifactPath(runID, nodeID, artifactName)
+	if err != nil {
+		return nil, err
+	}
+	return r.objectStore.GetFile(artifactPath)
+}
+
+// OpenArtifact parses run's workflow to find artifact file path and opens the file for streaming,
+// starting at the given byte offset. When untar is set, the artifact is read as a tarball and the
+// content of the first regular file inside it is streamed instead.
+func (r *ResourceManager) OpenArtifact(runID string, nodeID string, artifactName string, untar bool, offset int64) (*storage.FileReader, error) {
+	artifactPath, err := r.getArtifactPath(runID, nodeID, artifactName)
+	if err != nil {
+		return nil, err
+	}
+	if !untar {
+		return r.objectStore.OpenFile(artifactPath, offset)
+	}
+	if offset < 0 {
+		return nil, util.NewInvalidInputError("Offset %v is out of range", offset)
+	}
+	reader, err := r.objectStore.OpenFile(artifactPath, 0)
+	if err != nil {
+		return nil, err
+	}
+	fileReader, err := untarFirstFile(reader, offset)
+	if err != nil {
+		reader.Close()
+		return nil, util.Wrapf(err, "Failed to untar artifact %v", artifactPath)
+	}
+	return fileReader, nil
+}
+
+// ListRunArtifacts parses run's workflow to find the input and output artifacts of all its nodes,
+// and looks up their sizes in the object store.
+func (r *ResourceManager) ListRunArtifacts(runID string) ([]*model.RunArtifact, error) {
+	workflow, err := r.getRunWorkflow(runID)
+	if err != nil {
+		return nil, err
+	}
+	artifacts := []*model.RunArtifact{}
+	for _, artifact := range workflow.NodeArtifacts() {
+		size := int64(-1)
+		if artifact.ObjectStoreKey != "" {
+			if size, err = r.objectStore.GetFileSize(artifact.ObjectStoreKey); err != nil {
+				// The artifact may have been garbage collected, which shouldn't fail the listing.
+				glog.Warningf("Failed to get the size of artifact %v of run %v: %v", artifact.ObjectStoreKey, runID, err)
+				size = -1
+			}
+		}
+		artifacts = append(artifacts, &model.RunArtifact{
+			NodeID:               artifact.NodeID,
+			Name:                 artifact.Name,
+			Input:                artifact.Input,
+			ObjectStoreKey:       artifact.ObjectStoreKey,
+			ProducerTemplateName: artifact.ProducerTemplateName,
+			Size:                 size,
+		})
+	}
+	return artifacts, nil
+}
+
+func (r *ResourceManager) getArtifactPath(runID string, nodeID string, artifactName string) (string, error) {
+	workflow, err := r.getRunWorkflow(runID)
+	if err != nil {
+		return "", err
+	}
+	artifactPath := workflow.FindObjectStoreArtifactKeyOrEmpty(nodeID, artifactName)
+	if artifactPath == "" {
+		return "", util.NewResourceNotFoundError(
+			"artifact", common.CreateArtifactPath(runID, nodeID, artifactName))
+	}
+	return artifactPath, nil
+}
+
+func (r *ResourceManager) getRunWorkflow(runID string) (*util.Workflow, error) {
 	run, err := r.runStore.GetRun(runID)
 	if err != nil {
 		return nil, err
 	}
 	var storageWorkflow workflowapi.Workflow
+	if run.WorkflowRuntimeManifest == "" {
+		// The workflow hasn't been reported yet, so no node has run.
+		return util.NewWorkflow(&storageWorkflow), nil
+	}
 	err = json.Unmarshal([]byte(run.WorkflowRuntimeManifest), &storageWorkflow)
 	if err != nil {
 		// This should never happen.
 		return nil, util.NewInternalServerError(
 			err, "failed to unmarshal workflow '%s'", run.WorkflowRuntimeManifest)
 	}
-	workflow := util.NewWorkflow(&storageWorkflow)
-	artifactPath := workflow.FindObjectStoreArtifactKeyOrEmpty(nodeID, artifactName)
-	if artifactPath == "" {
-		return nil, util.NewResourceNotFoundError(
-			"artifact", common.CreateArtifactPath(runID, nodeID, artifactName))
-	}
-	return r.objectStore.GetFile(artifactPath)
+	return util.NewWorkflow(&storageWorkflow), nil
 }
 
 func (r *ResourceManager) GetDefaultExperimentId() (string, error) {
@@ -989,11 +1744,30 @@ func (r *ResourceManager) getDefaultSA() string {
 	return common.GetStringConfigWithDefault(common.DefaultPipelineRunnerServiceAccount, defaultPipelineRunnerServiceAccount)
 }
 
-func (r *ResourceManager) CreatePipelineVersion(apiVersion *api.PipelineVersion, pipelineFile []byte) (*model.PipelineVersion, error) {
+// CreatePipelineVersion creates a version of a pipeline. The pipeline package, if any, is the
+// archive the pipeline file was extracted from, and is kept with the version.
+func (r *ResourceManager) CreatePipelineVersion(apiVersion *api.PipelineVersion, pipelineFile []byte, pipelinePackage []byte) (*model.PipelineVersion, error) {
+	version, _, err := r.createPipelineVersion(apiVersion, pipelineFile, pipelinePackage, false)
+	return version, err
+}
+
+// CreateOrGetPipelineVersion returns the existing ready version of the pipeline
+// whose template has the same content digest as pipelineFile, or creates a new
+// version if there is none. The returned bool reports whether a new version was
+// created. An existing version keeps the package it was created with.
+func (r *ResourceManager) CreateOrGetPipelineVersion(apiVersion *api.PipelineVersion, pipelineFile []byte, pipelinePackage []byte) (*model.PipelineVersion, bool, error) {
+	return r.createPipelineVersion(apiVersion, pipelineFile, pipelinePackage, true)
+}
+
+func (r *ResourceManager) createPipelineVersion(apiVersion *api.PipelineVersion, pipelineFile []byte, pipelinePackage []byte, deduplicate bool) (*model.PipelineVersion, bool, error) {
 	// Extract the parameters from the pipeline
 	params, err := util.GetParameters(pipelineFile)
 	if err != nil {
-		return nil, util.Wrap(err, "Create pipeline version failed")
+		return nil, false, util.Wrap(err, "Create pipeline version failed")
+	}
+	parameterSchema, err := util.GetParameterSchema(pipelineFile)
+	if err != nil {
+		return nil, false, util.Wrap(err, "Create pipeline version failed")
 	}
 
 	// Extract pipeline id
@@ -1004,26 +1778,42 @@ func (r *ResourceManager) CreatePipelineVersion(apiVersion *api.PipelineVersion,
 		}
 	}
 	if len(pipelineId) == 0 {
-		return nil, util.Wrap(err, "Create pipeline version failed due to missing pipeline id")
+		return nil, false, util.Wrap(err, "Create pipeline version failed due to missing pipeline id")
 	}
 
 	// Construct model.PipelineVersion
 	version := &model.PipelineVersion{
-		Name:          apiVersion.Name,
-		PipelineId:    pipelineId,
-		Status:        model.PipelineVersionCreating,
-		Parameters:    params,
-		CodeSourceUrl: apiVersion.CodeSourceUrl,
-	}
-	version, err = r.pipelineStore.CreatePipelineVersion(version)
-	if err != nil {
-		return nil, util.Wrap(err, "Create pipeline version failed")
+		Name:            apiVersion.Name,
+		PipelineId:      pipelineId,
+		Status:          model.PipelineVersionCreating,
+		Parameters:      params,
+		ParameterSchema: parameterSchema,
+		CodeSourceUrl:   apiVersion.CodeSourceUrl,
+		Digest:          util.ComputeDigest(pipelineFile),
+		Labels:          apiVersion.Labels,
+	}
+	if deduplicate {
+		// The lookup and the creation happen in one transaction, so concurrent uploads of the same
+		// content end up with a single version.
+		var created bool
+		version, created, err = r.pipelineStore.CreateOrGetPipelineVersion(version)
+		if err != nil {
+			return nil, false, util.Wrap(err, "Create pipeline version failed")
+		}
+		if !created {
+			return version, false, nil
+		}
+	} else {
+		version, err = r.pipelineStore.CreatePipelineVersion(version)
+		if err != nil {
+			return nil, false, util.Wrap(err, "Create pipeline version failed")
+		}
 	}
 
 	// Store the pipeline file
-	err = r.objectStore.AddFile(pipelineFile, r.objectStore.GetPipelineKey(fmt.Sprint(version.UUID)))
+	err = r.storePipelineVersionFiles(version.UUID, pipelineFile, pipelinePackage)
 	if err != nil {
-		return nil, util.Wrap(err, "Create pipeline version failed")
+		return nil, false, util.Wrap(err, "Create pipeline version failed")
 	}
 
 	// After pipeline version being created in DB and pipeline file being
@@ -1031,10 +1821,10 @@ func (r *ResourceManager) CreatePipelineVersion(apiVersion *api.PipelineVersion,
 	version.Status = model.PipelineVersionReady
 	err = r.pipelineStore.UpdatePipelineVersionStatus(version.UUID, version.Status)
 	if err != nil {
-		return nil, util.Wrap(err, "Create pipeline version failed")
+		return nil, false, util.Wrap(err, "Create pipeline version failed")
 	}
 
-	return version, nil
+	return version, true, nil
 }
 
 func (r *ResourceManager) GetPipelineVersion(versionId string) (*model.PipelineVersion, error) {
@@ -1045,6 +1835,18 @@ func (r *ResourceManager) ListPipelineVersions(pipelineId string, opts *list.Opt
 	return r.pipelineStore.ListPipelineVersions(pipelineId, opts)
 }
 
+func (r *ResourceManager) UpdatePipelineVersionLabels(versionId string, labels map[string]string) (*model.PipelineVersion, error) {
+	_, err := r.pipelineStore.GetPipelineVersion(versionId)
+	if err != nil {
+		return nil, util.Wrap(err, "Update pipeline version labels failed")
+	}
+	err = r.labelStore.UpdateLabels(versionId, common.PipelineVersion, labels)
+	if err != nil {
+		return nil, util.Wrap(err, "Update pipeline version labels failed")
+	}
+	return r.pipelineStore.GetPipelineVersion(versionId)
+}
+
 func (r *ResourceManager) DeletePipelineVersion(pipelineVersionId string) error {
 	_, err := r.pipelineStore.GetPipelineVersion(pipelineVersionId)
 	if err != nil {
@@ -1062,6 +1864,7 @@ func (r *ResourceManager) DeletePipelineVersion(pipelineVersionId string) error
 		glog.Errorf("%v", errors.Wrapf(err, "Failed to delete pipeline file for pipeline version %v", pipelineVersionId))
 		return util.Wrap(err, "Delete pipeline version failed")
 	}
+	r.deletePipelineVersionPackage(pipelineVersionId)
 	err = r.pipelineStore.DeletePipelineVersion(pipelineVersionId)
 	if err != nil {
 		glog.Errorf("%v", errors.Wrapf(err, "Failed to delete pipeline DB entry for pipeline %v", pipelineVersionId))
@@ -1079,6 +1882,10 @@ func (r *ResourceManager) GetPipelineVersionTemplate(versionId string) ([]byte,
 	}
 
 	template, err := r.objectStore.GetFile(r.objectStore.GetPipelineKey(fmt.Sprint(versionId)))
+	if util.IsUserErrorCodeMatch(err, codes.NotFound) {
+		// Every pipeline version has a template, so a missing one is a server error.
+		return nil, util.NewInternalServerError(err, "Get pipeline version template failed")
+	}
 	if err != nil {
 		return nil, util.Wrap(err, "Get pipeline version template failed")
 	}
@@ -1086,6 +1893,41 @@ func (r *ResourceManager) GetPipelineVersionTemplate(versionId string) ([]byte,
 	return template, nil
 }
 
+// GetPipelineVersionAsset reads a single file from the archive a pipeline version was uploaded in.
+func (r *ResourceManager) GetPipelineVersionAsset(versionId string, assetPath string) ([]byte, error) {
+	// Verify pipeline version exist
+	_, err := r.pipelineStore.GetPipelineVersion(versionId)
+	if err != nil {
+		return nil, util.Wrap(err, "Get pipeline version asset failed")
+	}
+
+	pipelinePackage, err := r.objectStore.GetFile(r.objectStore.GetPipelinePackageKey(versionId))
+	if util.IsUserErrorCodeMatch(err, codes.NotFound) || (err == nil && len(pipelinePackage) == 0) {
+		// Pipeline versions uploaded as YAML files, or before packages were kept, have no package.
+		return nil, util.NewResourceNotFoundError("Pipeline version package", versionId)
+	}
+	if err != nil {
+		return nil, util.Wrap(err, "Get pipeline version asset failed")
+	}
+	asset, err := extractPipelinePackageFile(pipelinePackage, assetPath)
+	if err != nil {
+		return nil, util.Wrap(err, "Get pipeline version asset failed")
+	}
+	if asset == nil {
+		return nil, util.NewResourceNotFoundError("Pipeline version asset", assetPath)
+	}
+	return asset, nil
+}
+
+// deletePipelineVersionPackage removes the package of a pipeline version, if any. Failures are only
+// logged since most pipeline versions don't have a package.
+func (r *ResourceManager) deletePipelineVersionPackage(versionId string) {
+	err := r.objectStore.DeleteFile(r.objectStore.GetPipelinePackageKey(versionId))
+	if err != nil {
+		glog.Infof("No pipeline package deleted for pipeline version %v: %v", versionId, err)
+	}
+}
+
 func (r *ResourceManager) IsRequestAuthorized(userIdentity string, namespace string) (bool, error) {
 	return r.kfamClient.IsAuthorized(userIdentity, namespace)
 }
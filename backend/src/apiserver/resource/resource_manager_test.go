@@ -15,23 +15,29 @@
 package resource
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	swfutil "github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/util"
 	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -46,6 +52,10 @@ func (m *FakeBadObjectStore) GetPipelineKey(pipelineID string) string {
 	return pipelineID
 }
 
+func (m *FakeBadObjectStore) GetPipelinePackageKey(pipelineVersionID string) string {
+	return pipelineVersionID
+}
+
 func (m *FakeBadObjectStore) AddFile(template []byte, filePath string) error {
 	return util.NewInternalServerError(errors.New("Error"), "bad object store")
 }
@@ -58,6 +68,14 @@ func (m *FakeBadObjectStore) GetFile(filePath string) ([]byte, error) {
 	return []byte(""), nil
 }
 
+func (m *FakeBadObjectStore) OpenFile(filePath string, offset int64) (*storage.FileReader, error) {
+	return nil, util.NewInternalServerError(errors.New("Error"), "bad object store")
+}
+
+func (m *FakeBadObjectStore) GetFileSize(filePath string) (int64, error) {
+	return 0, util.NewInternalServerError(errors.New("Error"), "bad object store")
+}
+
 func (m *FakeBadObjectStore) AddAsYamlFile(o interface{}, filePath string) error {
 	return util.NewInternalServerError(errors.New("Error"), "bad object store")
 }
@@ -66,6 +84,15 @@ func (m *FakeBadObjectStore) GetFromYamlFile(o interface{}, filePath string) err
 	return util.NewInternalServerError(errors.New("Error"), "bad object store")
 }
 
+// fakeGetFileErrorObjectStore fails every GetFile call with an internal error.
+type fakeGetFileErrorObjectStore struct {
+	storage.ObjectStoreInterface
+}
+
+func (m *fakeGetFileErrorObjectStore) GetFile(filePath string) ([]byte, error) {
+	return nil, util.NewInternalServerError(errors.New("Error"), "bad object store")
+}
+
 var testWorkflow = util.NewWorkflow(&v1alpha1.Workflow{
 	TypeMeta:   v1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Workflow"},
 	ObjectMeta: v1.ObjectMeta{Name: "workflow-name", UID: "workflow1", Namespace: "ns1"},
@@ -73,6 +100,14 @@ var testWorkflow = util.NewWorkflow(&v1alpha1.Workflow{
 	Status:     v1alpha1.WorkflowStatus{Phase: v1alpha1.NodeRunning},
 })
 
+var testWorkflowWithParameterSchema = util.NewWorkflow(&v1alpha1.Workflow{
+	TypeMeta: v1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Workflow"},
+	ObjectMeta: v1.ObjectMeta{Name: "workflow-name", UID: "workflow1", Namespace: "ns1",
+		Annotations: map[string]string{util.AnnotationKeyParameterSchema: `[{"name": "param1", "type": "int", "min": 1}]`}},
+	Spec: v1alpha1.WorkflowSpec{Arguments: v1alpha1.Arguments{Parameters: []v1alpha1.Parameter{
+		{Name: "param1", Value: util.StringPointer("1")}}}},
+})
+
 // Util function to create an initial state with pipeline uploaded
 func initWithPipeline(t *testing.T) (*FakeClientManager, *ResourceManager, *model.Pipeline) {
 	initEnvVars()
@@ -224,6 +259,7 @@ func TestCreatePipeline(t *testing.T) {
 			Parameters:     "[{\"name\":\"param1\"}]",
 			Status:         model.PipelineVersionReady,
 			PipelineId:     DefaultFakeUUID,
+			Digest:         util.ComputeDigest([]byte(testWorkflow.ToStringForStore())),
 		}}
 	assert.Equal(t, pipelineExpected, pipeline)
 }
@@ -303,6 +339,66 @@ func TestGetPipelineTemplate_PipelineFileNotFound(t *testing.T) {
 	assert.Contains(t, err.Error(), "object not found")
 }
 
+// Util function to create an initial state with a pipeline created from a package.
+func initWithPipelinePackage(t *testing.T, pipelinePackage []byte) (*FakeClientManager, *ResourceManager, *model.Pipeline) {
+	initEnvVars()
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	manager := NewResourceManager(store)
+	p, err := manager.CreatePipelineWithLabels("p1", "", nil, []byte(testWorkflow.ToStringForStore()), pipelinePackage)
+	assert.Nil(t, err)
+	return store, manager, p
+}
+
+func TestGetPipelineVersionAsset(t *testing.T) {
+	pipelinePackage, err := util.ArchiveTgz(map[string]string{
+		"pipeline.yaml":       testWorkflow.ToStringForStore(),
+		"README.md":           "# Hello",
+		"components/a/c.yaml": "name: a",
+	})
+	assert.Nil(t, err)
+	store, manager, p := initWithPipelinePackage(t, []byte(pipelinePackage))
+	defer store.Close()
+
+	asset, err := manager.GetPipelineVersionAsset(p.DefaultVersionId, "README.md")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("# Hello"), asset)
+	asset, err = manager.GetPipelineVersionAsset(p.DefaultVersionId, "./components/a/c.yaml")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("name: a"), asset)
+
+	_, err = manager.GetPipelineVersionAsset(p.DefaultVersionId, "missing.txt")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestGetPipelineVersionAsset_NoPackage(t *testing.T) {
+	store, manager, p := initWithPipeline(t)
+	defer store.Close()
+	_, err := manager.GetPipelineVersionAsset(p.DefaultVersionId, "README.md")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "Pipeline version package")
+}
+
+func TestGetPipelineVersionAsset_GetFileError(t *testing.T) {
+	store, manager, p := initWithPipelinePackage(t, []byte("package"))
+	defer store.Close()
+	manager.objectStore = &fakeGetFileErrorObjectStore{store.ObjectStore()}
+	_, err := manager.GetPipelineVersionAsset(p.DefaultVersionId, "README.md")
+	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "bad object store")
+}
+
+func TestDeletePipeline_DeletesPackage(t *testing.T) {
+	store, manager, p := initWithPipelinePackage(t, []byte("package"))
+	defer store.Close()
+	_, err := store.ObjectStore().GetFile(store.ObjectStore().GetPipelinePackageKey(p.DefaultVersionId))
+	assert.Nil(t, err)
+
+	err = manager.DeletePipeline(p.UUID)
+	assert.Nil(t, err)
+	_, err = store.ObjectStore().GetFile(store.ObjectStore().GetPipelinePackageKey(p.DefaultVersionId))
+	assert.NotNil(t, err)
+}
+
 func TestCreateRun_ThroughPipelineID(t *testing.T) {
 	store, manager, p := initWithPipeline(t)
 	defer store.Close()
@@ -325,7 +421,7 @@ func TestCreateRun_ThroughPipelineID(t *testing.T) {
 				Relationship: api.Relationship_OWNER,
 			},
 		},
-	}, []byte(testWorkflow.ToStringForStore()))
+	}, []byte(testWorkflow.ToStringForStore()), nil)
 	assert.Nil(t, err)
 
 	// The pipeline specified via pipeline id will be converted to this
@@ -370,6 +466,7 @@ func TestCreateRun_ThroughPipelineID(t *testing.T) {
 				PipelineId:           p.UUID,
 				PipelineName:         "p1",
 				WorkflowSpecManifest: testWorkflow.ToStringForStore(),
+				WorkflowSpecDigest:   util.ComputeDigest([]byte(testWorkflow.ToStringForStore())),
 				Parameters:           "[{\"name\":\"param1\",\"value\":\"world\"}]",
 			},
 			ResourceReferences: []*model.ResourceReference{
@@ -424,6 +521,7 @@ func TestCreateRun_ThroughWorkflowSpec(t *testing.T) {
 			Conditions:     "Running",
 			PipelineSpec: model.PipelineSpec{
 				WorkflowSpecManifest: testWorkflow.ToStringForStore(),
+				WorkflowSpecDigest:   util.ComputeDigest([]byte(testWorkflow.ToStringForStore())),
 				Parameters:           "[{\"name\":\"param1\",\"value\":\"world\"}]",
 			},
 			ResourceReferences: []*model.ResourceReference{
@@ -448,6 +546,111 @@ func TestCreateRun_ThroughWorkflowSpec(t *testing.T) {
 	assert.Equal(t, expectedRunDetail, runDetail, "CreateRun stored invalid data in database")
 }
 
+func TestCreateRun_MetricsPushToken(t *testing.T) {
+	viper.Set(common.MetricsPushTokenSecret, "secret")
+	defer viper.Set(common.MetricsPushTokenSecret, "")
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	workflow := testWorkflow.DeepCopy()
+	workflow.Spec.Templates = []v1alpha1.Template{{Name: "step", Container: &corev1.Container{Image: "image"}}}
+	apiRun := &api.Run{
+		Name: "run1",
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: util.NewWorkflow(workflow).ToStringForStore(),
+			Parameters:       []*api.Parameter{{Name: "param1", Value: "world"}},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}
+
+	runDetail, err := manager.CreateRun(apiRun)
+	assert.Nil(t, err)
+
+	// The token is only in the secret of the run.
+	var runtimeWorkflow v1alpha1.Workflow
+	err = json.Unmarshal([]byte(runDetail.WorkflowRuntimeManifest), &runtimeWorkflow)
+	assert.Nil(t, err)
+	secretName := "kfp-metrics-push-token-" + runDetail.UUID
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: util.EnvKeyRunID, Value: runDetail.UUID},
+		{Name: util.EnvKeyMetricsPushToken, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+			Key:                  "token",
+		}}},
+	}, runtimeWorkflow.Spec.Templates[0].Container.Env)
+	assert.NotContains(t, runDetail.WorkflowRuntimeManifest, common.GetMetricsPushToken(runDetail.UUID))
+
+	secret, err := store.KubernetesCoreClient().SecretClient("ns1").Get(secretName, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, common.GetMetricsPushToken(runDetail.UUID), string(secret.Data["token"]))
+	assert.Equal(t, runDetail.Name, secret.OwnerReferences[0].Name)
+	assert.Equal(t, "Workflow", secret.OwnerReferences[0].Kind)
+}
+
+func TestCreateJob_MetricsPushToken(t *testing.T) {
+	viper.Set(common.MetricsPushTokenSecret, "secret")
+	defer viper.Set(common.MetricsPushTokenSecret, "")
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	workflow := testWorkflow.DeepCopy()
+	workflow.Spec.Templates = []v1alpha1.Template{{Name: "step", Container: &corev1.Container{Image: "image"}}}
+	job, err := manager.CreateJob(&api.Job{
+		Name:         "job1",
+		Enabled:      true,
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: util.NewWorkflow(workflow).ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	// The controller replaces {{workflow.uid}} with the ID of each run.
+	swf, err := store.SwfClient().ScheduledWorkflow("ns1").Get(job.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: util.EnvKeyRunID, Value: "{{workflow.uid}}"},
+		{Name: util.EnvKeyMetricsPushToken, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "kfp-metrics-push-token-{{workflow.uid}}"},
+			Key:                  "token",
+		}}},
+	}, swf.Spec.Workflow.Spec.Templates[0].Container.Env)
+
+	// The secret of a run of the job is created when the run is first reported.
+	runWorkflow := util.NewWorkflow(&v1alpha1.Workflow{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "run1",
+			Namespace: "ns1",
+			UID:       "RUN_1",
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: "RUN_1"},
+			OwnerReferences: []v1.OwnerReference{{
+				APIVersion: "kubeflow.org/v1beta1",
+				Kind:       "ScheduledWorkflow",
+				Name:       job.Name,
+				UID:        types.UID(job.UUID),
+			}},
+		},
+	})
+	assert.Nil(t, manager.ReportWorkflowResource(runWorkflow))
+	secretClient := store.KubernetesCoreClient().SecretClient("ns1")
+	secret, err := secretClient.Get("kfp-metrics-push-token-RUN_1", v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, common.GetMetricsPushToken("RUN_1"), string(secret.Data["token"]))
+	assert.Equal(t, types.UID("RUN_1"), secret.OwnerReferences[0].UID)
+
+	// Later reports of the run don't write the secret again.
+	assert.Nil(t, secretClient.Delete("kfp-metrics-push-token-RUN_1", &v1.DeleteOptions{}))
+	assert.Nil(t, manager.ReportWorkflowResource(runWorkflow))
+	_, err = secretClient.Get("kfp-metrics-push-token-RUN_1", v1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+}
+
 func TestCreateRun_ThroughWorkflowSpecWithPatch(t *testing.T) {
 	viper.Set(HasDefaultBucketEnvVar, "true")
 	viper.Set(ProjectIDEnvVar, "test-project-id")
@@ -473,6 +676,7 @@ func TestCreateRun_ThroughWorkflowSpecWithPatch(t *testing.T) {
 			Conditions:     "Running",
 			PipelineSpec: model.PipelineSpec{
 				WorkflowSpecManifest: testWorkflow.ToStringForStore(),
+				WorkflowSpecDigest:   util.ComputeDigest([]byte(testWorkflow.ToStringForStore())),
 				Parameters:           "[{\"name\":\"param1\",\"value\":\"test-default-bucket\"}]",
 			},
 			ResourceReferences: []*model.ResourceReference{
@@ -515,7 +719,7 @@ func TestCreateRun_ThroughPipelineVersion(t *testing.T) {
 				Relationship: api.Relationship_OWNER,
 			},
 		},
-	}, []byte(testWorkflow.ToStringForStore()))
+	}, []byte(testWorkflow.ToStringForStore()), nil)
 	assert.Nil(t, err)
 
 	apiRun := &api.Run{
@@ -560,6 +764,7 @@ func TestCreateRun_ThroughPipelineVersion(t *testing.T) {
 			Conditions:     "Running",
 			PipelineSpec: model.PipelineSpec{
 				WorkflowSpecManifest: testWorkflow.ToStringForStore(),
+				WorkflowSpecDigest:   util.ComputeDigest([]byte(testWorkflow.ToStringForStore())),
 				Parameters:           "[{\"name\":\"param1\",\"value\":\"world\"}]",
 			},
 			ResourceReferences: []*model.ResourceReference{
@@ -681,6 +886,24 @@ func TestCreateRun_OverrideParametersError(t *testing.T) {
 	assert.Contains(t, err.Error(), "Unrecognized input parameter")
 }
 
+func TestCreateRun_InvalidParameterValue(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+	apiRun := &api.Run{
+		Name: "run1",
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflowWithParameterSchema.ToStringForStore(),
+			Parameters: []*api.Parameter{
+				{Name: "param1", Value: "many"},
+			},
+		},
+	}
+	_, err := manager.CreateRun(apiRun)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "Invalid value \"many\" for parameter \"param1\": expected an integer")
+}
+
 func TestCreateRun_CreateWorkflowError(t *testing.T) {
 	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
 	defer store.Close()
@@ -919,6 +1142,7 @@ func TestCreateJob_ThroughWorkflowSpec(t *testing.T) {
 		Conditions:     "NO_STATUS",
 		PipelineSpec: model.PipelineSpec{
 			WorkflowSpecManifest: testWorkflow.ToStringForStore(),
+			WorkflowSpecDigest:   util.ComputeDigest([]byte(testWorkflow.ToStringForStore())),
 		},
 		ResourceReferences: []*model.ResourceReference{
 			{
@@ -971,7 +1195,7 @@ func TestCreateJob_ThroughPipelineID(t *testing.T) {
 				Relationship: api.Relationship_OWNER,
 			},
 		},
-	}, []byte(testWorkflow.ToStringForStore()))
+	}, []byte(testWorkflow.ToStringForStore()), nil)
 	assert.Nil(t, err)
 
 	// The pipeline specified via pipeline id will be converted to this
@@ -991,6 +1215,7 @@ func TestCreateJob_ThroughPipelineID(t *testing.T) {
 			PipelineId:           pipeline.UUID,
 			PipelineName:         "p1",
 			WorkflowSpecManifest: testWorkflow.ToStringForStore(),
+			WorkflowSpecDigest:   util.ComputeDigest([]byte(testWorkflow.ToStringForStore())),
 			Parameters:           "[{\"name\":\"param1\",\"value\":\"world\"}]",
 		},
 		ResourceReferences: []*model.ResourceReference{
@@ -1034,7 +1259,7 @@ func TestCreateJob_ThroughPipelineVersion(t *testing.T) {
 				Relationship: api.Relationship_OWNER,
 			},
 		},
-	}, []byte(testWorkflow.ToStringForStore()))
+	}, []byte(testWorkflow.ToStringForStore()), nil)
 	assert.Nil(t, err)
 
 	job := &api.Job{
@@ -1069,6 +1294,7 @@ func TestCreateJob_ThroughPipelineVersion(t *testing.T) {
 		Conditions:     "NO_STATUS",
 		PipelineSpec: model.PipelineSpec{
 			WorkflowSpecManifest: testWorkflow.ToStringForStore(),
+			WorkflowSpecDigest:   util.ComputeDigest([]byte(testWorkflow.ToStringForStore())),
 			Parameters:           "[{\"name\":\"param1\",\"value\":\"world\"}]",
 		},
 		ResourceReferences: []*model.ResourceReference{
@@ -1149,6 +1375,215 @@ func TestCreateJob_ExtraInputParameterError(t *testing.T) {
 	assert.Contains(t, err.Error(), "Unrecognized input parameter: param2")
 }
 
+func TestCreateJob_InvalidParameterValue(t *testing.T) {
+	store, manager, _ := initWithExperiment(t)
+	defer store.Close()
+	job := &api.Job{
+		Name:    "pp 1",
+		Enabled: true,
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflowWithParameterSchema.ToStringForStore(),
+			Parameters: []*api.Parameter{
+				{Name: "param1", Value: "0"},
+			},
+		},
+	}
+	_, err := manager.CreateJob(job)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "must be at least 1")
+}
+
+func TestCreateJob_TemplatedParameterValue(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	// Values substituted when the job triggers are not checked against the schema.
+	job := &api.Job{
+		Name:    "pp 1",
+		Enabled: true,
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflowWithParameterSchema.ToStringForStore(),
+			Parameters: []*api.Parameter{
+				{Name: "param1", Value: "[[Index]]"},
+			},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}
+	_, err := manager.CreateJob(job)
+	assert.Nil(t, err)
+}
+
+func TestCreateJob_InvalidParameterMacro(t *testing.T) {
+	store, manager, _ := initWithExperiment(t)
+	defer store.Close()
+	job := &api.Job{
+		Name:    "pp 1",
+		Enabled: true,
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+			Parameters: []*api.Parameter{
+				{Name: "param1", Value: "[[ScheduledTime-1d.2006-01-02]]"},
+			},
+		},
+	}
+	_, err := manager.CreateJob(job)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "Unknown macro [[ScheduledTime-1d.2006-01-02]]")
+}
+
+func TestCreateJob_ObjectStoreEvent(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	job := &api.Job{
+		Name:    "pp 1",
+		Enabled: true,
+		Trigger: &api.Trigger{
+			Trigger: &api.Trigger_ObjectStoreEvent{ObjectStoreEvent: &api.ObjectStoreEvent{
+				Bucket:       "mlpipeline",
+				Prefix:       "data/",
+				KeyParameter: "param2",
+			}}},
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflowWithParameterSchema.ToStringForStore(),
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}
+	_, err := manager.CreateJob(job)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "the parameter \"param2\" set by the trigger is not a parameter of the pipeline")
+
+	// The key parameter is required to be an int, but its value is only known
+	// when an object triggers a run.
+	job.Trigger.GetObjectStoreEvent().KeyParameter = "param1"
+	job.PipelineSpec.Parameters = []*api.Parameter{{Name: "param1", Value: ""}}
+	createdJob, err := manager.CreateJob(job)
+	assert.Nil(t, err)
+	assert.Equal(t, model.ObjectStoreEvent{
+		ObjectStoreEventBucket:       util.StringPointer("mlpipeline"),
+		ObjectStoreEventPrefix:       util.StringPointer("data/"),
+		ObjectStoreEventKeyParameter: util.StringPointer("param1"),
+	}, createdJob.ObjectStoreEvent)
+	swf, err := store.SwfClient().ScheduledWorkflow("ns1").Get(createdJob.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "param1", swf.Spec.Trigger.ObjectStoreEvent.KeyParameter)
+}
+
+func TestCreateJob_RunCompletion_WatchesOwnExperiment(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	_, err := manager.CreateJob(&api.Job{
+		Name:    "pp 1",
+		Enabled: true,
+		Trigger: &api.Trigger{Trigger: &api.Trigger_RunCompletion{RunCompletion: &api.RunCompletion{
+			ExperimentId:   exp.UUID,
+			RunIdParameter: "param1",
+		}}},
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "can't watch the job itself or its experiment")
+}
+
+func TestCreateJob_RunCompletion_Cycle(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	store.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+	manager = NewResourceManager(store)
+	otherExp, err := manager.CreateExperiment(&api.Experiment{Name: "e2"})
+	assert.Nil(t, err)
+
+	// The runs of the upstream job, created in e1, are triggered by the runs in e2.
+	upstreamJob, err := manager.CreateJob(&api.Job{
+		Name:    "upstream",
+		Enabled: true,
+		Trigger: &api.Trigger{Trigger: &api.Trigger_RunCompletion{RunCompletion: &api.RunCompletion{
+			ExperimentId:   otherExp.UUID,
+			RunIdParameter: "param1",
+		}}},
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	// A job in e2 triggered by the upstream job would trigger it again.
+	_, err = manager.CreateJob(&api.Job{
+		Name:    "downstream",
+		Enabled: true,
+		Trigger: &api.Trigger{Trigger: &api.Trigger_RunCompletion{RunCompletion: &api.RunCompletion{
+			JobId:          upstreamJob.UUID,
+			RunIdParameter: "param1",
+		}}},
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: otherExp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "would form a cycle")
+}
+
+func TestCreateJob_FailurePolicyAndBlackouts(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	job := &api.Job{
+		Name:                   "pp 1",
+		Enabled:                true,
+		MaxConsecutiveFailures: 3,
+		BlackoutWindows: []*api.TimeWindow{
+			{StartTime: &timestamp.Timestamp{Seconds: 100}, EndTime: &timestamp.Timestamp{Seconds: 200}},
+		},
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+			Parameters:       []*api.Parameter{{Name: "param1", Value: "world"}},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}
+	createdJob, err := manager.CreateJob(job)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), createdJob.MaxConsecutiveFailures)
+	windows, err := createdJob.BlackoutWindowList()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(windows))
+	assert.Equal(t, int64(100), windows[0].StartTime.Unix())
+	assert.Equal(t, int64(200), windows[0].EndTime.Unix())
+
+	swf, err := store.SwfClient().ScheduledWorkflow("ns1").Get(createdJob.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, &swfapi.FailurePolicy{MaxConsecutiveFailures: 3}, swf.Spec.FailurePolicy)
+	assert.Equal(t, []swfapi.TimeWindow{{
+		StartTime: v1.NewTime(time.Unix(100, 0)),
+		EndTime:   v1.NewTime(time.Unix(200, 0)),
+	}}, swf.Spec.Blackouts)
+}
+
 func TestCreateJob_FailedToCreateScheduleWorkflow(t *testing.T) {
 	store, manager, p := initWithPipeline(t)
 	defer store.Close()
@@ -1180,6 +1615,7 @@ func TestEnableJob(t *testing.T) {
 		Conditions:     "NO_STATUS",
 		PipelineSpec: model.PipelineSpec{
 			WorkflowSpecManifest: testWorkflow.ToStringForStore(),
+			WorkflowSpecDigest:   util.ComputeDigest([]byte(testWorkflow.ToStringForStore())),
 		},
 		ResourceReferences: []*model.ResourceReference{
 			{
@@ -1223,12 +1659,221 @@ func TestEnableJob_DbFailure(t *testing.T) {
 	assert.Contains(t, err.Error(), "database is closed")
 }
 
-func TestDeleteJob(t *testing.T) {
-	store, manager, job := initWithJob(t)
-	defer store.Close()
-	err := manager.DeleteJob(job.UUID)
+func initWithPeriodicJob(t *testing.T) (*FakeClientManager, *ResourceManager, *model.Job) {
+	store, manager, exp := initWithExperiment(t)
+	job := &api.Job{
+		Name:    "j1",
+		Enabled: true,
+		Trigger: &api.Trigger{Trigger: &api.Trigger_PeriodicSchedule{PeriodicSchedule: &api.PeriodicSchedule{
+			IntervalSecond: 3600,
+		}}},
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}
+	j, err := manager.CreateJob(job)
 	assert.Nil(t, err)
-
+	return store, manager, j
+}
+
+func TestBackfillJob(t *testing.T) {
+	store, manager, job := initWithPeriodicJob(t)
+	defer store.Close()
+
+	job, err := manager.BackfillJob(job.UUID, 3600, 4*3600)
+	assert.Nil(t, err)
+	assert.Equal(t, model.Backfill{
+		BackfillId:                     util.StringPointer(DefaultFakeUUID),
+		BackfillStartTimeInSec:         util.Int64Pointer(3600),
+		BackfillEndTimeInSec:           util.Int64Pointer(4 * 3600),
+		BackfillState:                  util.StringPointer("Running"),
+		BackfillTotalRuns:              util.Int64Pointer(3),
+		BackfillCreatedRuns:            util.Int64Pointer(0),
+		BackfillNextScheduledTimeInSec: util.Int64Pointer(3600),
+	}, job.Backfill)
+
+	// Only one backfill runs at a time.
+	_, err = manager.BackfillJob(job.UUID, 3600, 4*3600)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "is still running")
+
+	assert.Nil(t, manager.CancelJobBackfill(job.UUID))
+}
+
+func TestBackfillJob_InvalidRange(t *testing.T) {
+	store, manager, job := initWithPeriodicJob(t)
+	defer store.Close()
+
+	_, err := manager.BackfillJob(job.UUID, 3600, 3601)
+	assert.Nil(t, err)
+	store.DB().Exec("UPDATE jobs SET BackfillState = 'Completed'")
+
+	_, err = manager.BackfillJob(job.UUID, 0, 0)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "has no time within the range")
+
+	_, err = manager.BackfillJob(job.UUID, 0, 2000*3600)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "more than 1000 times")
+}
+
+func TestBackfillJob_NoSchedule(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+
+	_, err := manager.BackfillJob(job.UUID, 0, 3600)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "only jobs with a cron or periodic schedule")
+}
+
+func TestCancelJobBackfill_NotRunning(t *testing.T) {
+	store, manager, job := initWithPeriodicJob(t)
+	defer store.Close()
+
+	err := manager.CancelJobBackfill(job.UUID)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "has no running backfill")
+}
+
+func TestUpdateJob(t *testing.T) {
+	store, manager, job := initWithPeriodicJob(t)
+	defer store.Close()
+	swfClient := store.SwfClient().ScheduledWorkflow("ns1")
+	swf, err := swfClient.Get(job.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	swf.Status.Trigger.LastIndex = util.Int64Pointer(5)
+
+	apiJob := &api.Job{
+		Name:           "new name",
+		Enabled:        false,
+		MaxConcurrency: 3,
+		NoCatchup:      true,
+		Trigger: &api.Trigger{Trigger: &api.Trigger_CronSchedule{CronSchedule: &api.CronSchedule{
+			Cron: "0 0 * * * *",
+		}}},
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+			Parameters:       []*api.Parameter{{Name: "param1", Value: "world"}},
+		},
+	}
+	updatedJob, err := manager.UpdateJob(job.UUID, apiJob)
+	assert.Nil(t, err)
+	assert.Equal(t, "j1", updatedJob.DisplayName)
+	assert.True(t, updatedJob.Enabled)
+	assert.Equal(t, int64(3), updatedJob.MaxConcurrency)
+	assert.True(t, updatedJob.NoCatchup)
+	assert.Equal(t, "0 0 * * * *", *updatedJob.Cron)
+	assert.Equal(t, "[{\"name\":\"param1\",\"value\":\"world\"}]", updatedJob.Parameters)
+	// The job stays in its experiment.
+	assert.Equal(t, job.ResourceReferences, updatedJob.ResourceReferences)
+
+	swf, err = swfClient.Get(job.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.True(t, swf.Spec.Enabled)
+	assert.Equal(t, int64(3), *swf.Spec.MaxConcurrency)
+	assert.True(t, *swf.Spec.NoCatchup)
+	assert.Equal(t, "0 0 * * * *", swf.Spec.CronSchedule.Cron)
+	assert.Nil(t, swf.Spec.PeriodicSchedule)
+	assert.Equal(t, []swfapi.Parameter{{Name: "param1", Value: "world"}}, swf.Spec.Workflow.Parameters)
+	assert.Equal(t, int64(5), *swf.Status.Trigger.LastIndex)
+}
+
+func TestUpdateJob_ChangeExperiment(t *testing.T) {
+	store, manager, job := initWithPeriodicJob(t)
+	defer store.Close()
+
+	apiJob := &api.Job{
+		MaxConcurrency: 1,
+		PipelineSpec:   &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: "another experiment"},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}
+	_, err := manager.UpdateJob(job.UUID, apiJob)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "experiment of a job can't be changed")
+}
+
+func TestUpdateJob_ExtraInputParameterError(t *testing.T) {
+	store, manager, job := initWithPeriodicJob(t)
+	defer store.Close()
+
+	apiJob := &api.Job{
+		MaxConcurrency: 1,
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+			Parameters:       []*api.Parameter{{Name: "param2", Value: "world"}},
+		},
+	}
+	_, err := manager.UpdateJob(job.UUID, apiJob)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "Unrecognized input parameter: param2")
+
+	// The job is left unchanged.
+	unchangedJob, err := manager.GetJob(job.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, job.Parameters, unchangedJob.Parameters)
+}
+
+func TestTriggerJob_PreviousTriggerPending(t *testing.T) {
+	store, manager, job := initWithPeriodicJob(t)
+	defer store.Close()
+	swf, err := store.SwfClient().ScheduledWorkflow("ns1").Get(job.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+
+	err = manager.TriggerJob(job.UUID)
+	assert.Nil(t, err)
+
+	// The fake client does not apply patches.
+	swf.Spec.ManualTrigger = &swfapi.ManualTrigger{ID: "m1"}
+	err = manager.TriggerJob(job.UUID)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "the run of the previous trigger m1 of the job is not created yet")
+
+	swf.Status.Trigger.LastManualTriggerID = "m1"
+	err = manager.TriggerJob(job.UUID)
+	assert.Nil(t, err)
+}
+
+func TestPreviewSchedule(t *testing.T) {
+	const hour = int64(3600)
+	// Now is 10:30.
+	store := NewFakeClientManagerOrFatal(util.NewFakeTime(time.Unix(10*hour+30*60-1, 0)))
+	defer store.Close()
+	manager := NewResourceManager(store)
+
+	cronTrigger := &api.Trigger{Trigger: &api.Trigger_CronSchedule{CronSchedule: &api.CronSchedule{
+		StartTime: &timestamp.Timestamp{Seconds: 5 * hour},
+		EndTime:   &timestamp.Timestamp{Seconds: 12 * hour},
+		Cron:      "0 0 * * * *",
+	}}}
+	// Catching up runs every missed hour since the start time.
+	assert.Equal(t, []int64{6 * hour, 7 * hour, 8 * hour}, manager.PreviewSchedule(cronTrigger, false, 3))
+	// Otherwise only the last missed hour runs.
+	assert.Equal(t, []int64{10 * hour, 11 * hour, 12 * hour}, manager.PreviewSchedule(cronTrigger, true, 5))
+
+	periodicTrigger := &api.Trigger{Trigger: &api.Trigger_PeriodicSchedule{PeriodicSchedule: &api.PeriodicSchedule{
+		StartTime:      &timestamp.Timestamp{Seconds: 20 * hour},
+		IntervalSecond: hour,
+	}}}
+	assert.Equal(t, []int64{21 * hour, 22 * hour}, manager.PreviewSchedule(periodicTrigger, false, 2))
+
+	assert.Nil(t, manager.PreviewSchedule(&api.Trigger{}, false, 2))
+}
+
+func TestDeleteJob(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+	err := manager.DeleteJob(job.UUID)
+	assert.Nil(t, err)
+
 	_, err = manager.GetJob(job.UUID)
 	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
 	assert.Contains(t, err.Error(), fmt.Sprintf("Job %v not found", job.UUID))
@@ -1292,6 +1937,7 @@ func TestReportWorkflowResource_ScheduledWorkflowIDEmpty_Success(t *testing.T) {
 		Conditions:     "Running",
 		PipelineSpec: model.PipelineSpec{
 			WorkflowSpecManifest: testWorkflow.ToStringForStore(),
+			WorkflowSpecDigest:   util.ComputeDigest([]byte(testWorkflow.ToStringForStore())),
 			Parameters:           "[{\"name\":\"param1\",\"value\":\"world\"}]",
 		},
 		ResourceReferences: []*model.ResourceReference{
@@ -1346,6 +1992,7 @@ func TestReportWorkflowResource_ScheduledWorkflowIDNotEmpty_Success(t *testing.T
 			FinishedAtInSec:  0,
 			PipelineSpec: model.PipelineSpec{
 				WorkflowSpecManifest: workflow.GetWorkflowSpec().ToStringForStore(),
+				WorkflowSpecDigest:   util.ComputeDigest([]byte(workflow.GetWorkflowSpec().ToStringForStore())),
 			},
 			ResourceReferences: []*model.ResourceReference{
 				{
@@ -1419,6 +2066,7 @@ func TestReportWorkflowResource_ScheduledWorkflowIDNotEmpty_NoExperiment_Success
 			FinishedAtInSec:  0,
 			PipelineSpec: model.PipelineSpec{
 				WorkflowSpecManifest: workflow.GetWorkflowSpec().ToStringForStore(),
+				WorkflowSpecDigest:   util.ComputeDigest([]byte(workflow.GetWorkflowSpec().ToStringForStore())),
 			},
 			ResourceReferences: []*model.ResourceReference{
 				{
@@ -1467,6 +2115,136 @@ func TestReportWorkflowResource_WorkflowCompleted(t *testing.T) {
 	assert.Equal(t, wf.Labels[util.LabelKeyWorkflowPersistedFinalState], "true")
 }
 
+func TestReportWorkflowResource_WorkflowCompleted_TriggersRunCompletionJob(t *testing.T) {
+	store, manager, run := initWithOneTimeRun(t)
+	defer store.Close()
+	store.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal("123e4567-e89b-12d3-a456-426655440003", nil))
+	manager = NewResourceManager(store)
+	downstreamExperiment, err := manager.CreateExperiment(&api.Experiment{Name: "downstream"})
+	assert.Nil(t, err)
+	job, err := manager.CreateJob(&api.Job{
+		Name:    "downstream",
+		Enabled: true,
+		Trigger: &api.Trigger{Trigger: &api.Trigger_RunCompletion{RunCompletion: &api.RunCompletion{
+			ExperimentId:   DefaultFakeUUID,
+			RunIdParameter: "param1",
+		}}},
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: downstreamExperiment.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	})
+	assert.Nil(t, err)
+	store.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+	manager = NewResourceManager(store)
+
+	workflow := util.NewWorkflow(&v1alpha1.Workflow{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      run.Name,
+			Namespace: "ns1",
+			UID:       types.UID(run.UUID),
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: run.UUID},
+		},
+		Status: v1alpha1.WorkflowStatus{Phase: v1alpha1.NodeSucceeded},
+	})
+	err = manager.ReportWorkflowResource(workflow)
+	assert.Nil(t, err)
+
+	// The run is queued for the scheduled workflow controller, which creates the downstream run.
+	_, err = manager.GetRun(FakeUUIDOne)
+	assert.NotNil(t, err)
+	swfClient := store.SwfClient().ScheduledWorkflow("ns1")
+	swf, err := swfClient.Get(job.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, []swfapi.PendingRun{{
+		RunID:      run.UUID,
+		Parameters: []swfapi.Parameter{{Name: "param1", Value: run.UUID}},
+	}}, swf.Spec.Trigger.RunCompletion.PendingRuns)
+
+	// Reporting the final state again does not queue the run again, even once its downstream run is created.
+	swfutil.NewScheduledWorkflow(swf).UpdateRunCompletionStatus(&swf.Spec.Trigger.RunCompletion.PendingRuns[0])
+	_, err = swfClient.Update(swf)
+	assert.Nil(t, err)
+	err = manager.ReportWorkflowResource(workflow)
+	assert.Nil(t, err)
+	err = manager.triggerRunCompletionJobs(run.UUID, "", workflow)
+	assert.Nil(t, err)
+	swf, err = swfClient.Get(job.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Empty(t, swf.Spec.Trigger.RunCompletion.PendingRuns)
+
+	// The completion of the downstream run does not trigger its own job.
+	downstreamWorkflow := util.NewWorkflow(&v1alpha1.Workflow{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "downstream-1",
+			Namespace: "ns1",
+			UID:       types.UID(FakeUUIDOne),
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: FakeUUIDOne},
+			OwnerReferences: []v1.OwnerReference{{
+				APIVersion: "kubeflow.org/v1beta1",
+				Kind:       "ScheduledWorkflow",
+				Name:       job.Name,
+				UID:        types.UID(job.UUID),
+			}},
+		},
+		Status: v1alpha1.WorkflowStatus{Phase: v1alpha1.NodeSucceeded},
+	})
+	_, err = store.ArgoClientFake.Workflow("ns1").Create(downstreamWorkflow.Get())
+	assert.Nil(t, err)
+	err = manager.ReportWorkflowResource(downstreamWorkflow)
+	assert.Nil(t, err)
+	swf, err = swfClient.Get(job.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Empty(t, swf.Spec.Trigger.RunCompletion.PendingRuns)
+}
+
+func TestReportWorkflowResource_WorkflowCompleted_RunCompletionJobNotFound(t *testing.T) {
+	store, manager, run := initWithOneTimeRun(t)
+	defer store.Close()
+	store.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal("123e4567-e89b-12d3-a456-426655440003", nil))
+	manager = NewResourceManager(store)
+	downstreamExperiment, err := manager.CreateExperiment(&api.Experiment{Name: "downstream"})
+	assert.Nil(t, err)
+	job, err := manager.CreateJob(&api.Job{
+		Name:    "downstream",
+		Enabled: true,
+		Trigger: &api.Trigger{Trigger: &api.Trigger_RunCompletion{RunCompletion: &api.RunCompletion{
+			ExperimentId: DefaultFakeUUID,
+		}}},
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: downstreamExperiment.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	})
+	assert.Nil(t, err)
+	// The scheduled workflow of the job is missing, so the run can't be queued.
+	err = store.SwfClient().ScheduledWorkflow("ns1").Delete(job.Name, &v1.DeleteOptions{})
+	assert.Nil(t, err)
+
+	workflow := util.NewWorkflow(&v1alpha1.Workflow{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      run.Name,
+			Namespace: "ns1",
+			UID:       types.UID(run.UUID),
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: run.UUID},
+		},
+		Status: v1alpha1.WorkflowStatus{Phase: v1alpha1.NodeSucceeded},
+	})
+	err = manager.ReportWorkflowResource(workflow)
+	assert.NotNil(t, err)
+
+	// The run isn't stored in its final state, so that reporting it again triggers the job.
+	storedRun, err := manager.GetRun(run.UUID)
+	assert.Nil(t, err)
+	assert.NotEqual(t, string(v1alpha1.NodeSucceeded), storedRun.Conditions)
+}
+
 func TestReportWorkflowResource_WorkflowCompleted_FinalStatePersisted(t *testing.T) {
 	store, manager, run := initWithOneTimeRun(t)
 	defer store.Close()
@@ -1538,6 +2316,7 @@ func TestReportScheduledWorkflowResource_Success(t *testing.T) {
 		},
 		PipelineSpec: model.PipelineSpec{
 			WorkflowSpecManifest: testWorkflow.ToStringForStore(),
+			WorkflowSpecDigest:   util.ComputeDigest([]byte(testWorkflow.ToStringForStore())),
 			Parameters:           "[]",
 		},
 		ResourceReferences: []*model.ResourceReference{
@@ -1679,6 +2458,141 @@ func TestReadArtifact_Succeed(t *testing.T) {
 	assert.Equal(t, expectedContent, string(artifactContent))
 }
 
+func reportWorkflowWithArtifact(t *testing.T, manager *ResourceManager, jobUUID string, filePath string) {
+	workflow := util.NewWorkflow(&v1alpha1.Workflow{
+		ObjectMeta: v1.ObjectMeta{
+			Name:              "MY_NAME",
+			Namespace:         "MY_NAMESPACE",
+			UID:               "run-1",
+			Labels:            map[string]string{util.LabelKeyWorkflowRunId: "run-1"},
+			CreationTimestamp: v1.NewTime(time.Unix(11, 0).UTC()),
+			OwnerReferences: []v1.OwnerReference{{
+				APIVersion: "kubeflow.org/v1beta1",
+				Kind:       "ScheduledWorkflow",
+				Name:       "SCHEDULE_NAME",
+				UID:        types.UID(jobUUID),
+			}},
+		},
+		Status: v1alpha1.WorkflowStatus{
+			Nodes: map[string]v1alpha1.NodeStatus{
+				"node-1": {
+					Outputs: &v1alpha1.Outputs{
+						Artifacts: []v1alpha1.Artifact{
+							{
+								Name: "artifact-1",
+								ArtifactLocation: v1alpha1.ArtifactLocation{
+									S3: &v1alpha1.S3Artifact{
+										Key: filePath,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	err := manager.ReportWorkflowResource(workflow)
+	assert.Nil(t, err)
+}
+
+func TestOpenArtifact_Offset(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+	store.ObjectStore().AddFile([]byte("0123456789"), "test/file.tgz")
+	reportWorkflowWithArtifact(t, manager, job.UUID, "test/file.tgz")
+
+	reader, err := manager.OpenArtifact("run-1", "node-1", "artifact-1", false, 4)
+	assert.Nil(t, err)
+	defer reader.Close()
+	content, err := ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, "456789", string(content))
+	assert.Equal(t, int64(10), reader.Size)
+}
+
+func TestOpenArtifact_Untar(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+	tarball, err := util.ArchiveTgz(map[string]string{"metrics.json": "{\"metrics\": []}"})
+	assert.Nil(t, err)
+	store.ObjectStore().AddFile([]byte(tarball), "test/file.tgz")
+	reportWorkflowWithArtifact(t, manager, job.UUID, "test/file.tgz")
+
+	reader, err := manager.OpenArtifact("run-1", "node-1", "artifact-1", true, 1)
+	assert.Nil(t, err)
+	defer reader.Close()
+	content, err := ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, "\"metrics\": []}", string(content))
+	assert.Equal(t, int64(15), reader.Size)
+	assert.Equal(t, "application/json", reader.ContentType)
+}
+
+func TestOpenArtifact_UntarNotTarball(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+	store.ObjectStore().AddFile([]byte("not a tarball"), "test/file.tgz")
+	reportWorkflowWithArtifact(t, manager, job.UUID, "test/file.tgz")
+
+	_, err := manager.OpenArtifact("run-1", "node-1", "artifact-1", true, 0)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.InvalidArgument))
+}
+
+func TestOpenArtifact_NoRun_NotFound(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+
+	_, err := manager.OpenArtifact("run-1", "node-1", "artifact-1", false, 0)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.NotFound))
+}
+
+func TestListRunArtifacts(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+	store.ObjectStore().AddFile([]byte("0123456789"), "test/file.tgz")
+	reportWorkflowWithArtifact(t, manager, job.UUID, "test/file.tgz")
+
+	artifacts, err := manager.ListRunArtifacts("run-1")
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.RunArtifact{{
+		NodeID:         "node-1",
+		Name:           "artifact-1",
+		ObjectStoreKey: "test/file.tgz",
+		Size:           10,
+	}}, artifacts)
+}
+
+func TestListRunArtifacts_ArtifactNotInObjectStore(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+	reportWorkflowWithArtifact(t, manager, job.UUID, "test/file.tgz")
+
+	artifacts, err := manager.ListRunArtifacts("run-1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(artifacts))
+	assert.Equal(t, int64(-1), artifacts[0].Size)
+}
+
+func TestListRunArtifacts_WorkflowNotReported(t *testing.T) {
+	store, manager, runDetail := initWithOneTimeRun(t)
+	defer store.Close()
+
+	artifacts, err := manager.ListRunArtifacts(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Empty(t, artifacts)
+}
+
+func TestListRunArtifacts_NoRun_NotFound(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+
+	_, err := manager.ListRunArtifacts("run-1")
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.NotFound))
+}
+
 func TestReadArtifact_WorkflowNoStatus_NotFound(t *testing.T) {
 	store, manager, job := initWithJob(t)
 	defer store.Close()
@@ -2069,6 +2983,45 @@ spec:
           path: /output.txt`
 )
 
+func TestCreatePipelineVersion_WithParameterSchema(t *testing.T) {
+	store, manager, p := initWithPipeline(t)
+	defer store.Close()
+	pipelineStore, ok := store.pipelineStore.(*storage.PipelineStore)
+	assert.True(t, ok)
+	pipelineStore.SetUUIDGenerator(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+
+	version, err := manager.CreatePipelineVersion(
+		&api.PipelineVersion{
+			Name: "p_v",
+			ResourceReferences: []*api.ResourceReference{
+				{
+					Key:          &api.ResourceKey{Id: p.UUID, Type: api.ResourceType_PIPELINE},
+					Relationship: api.Relationship_OWNER,
+				},
+			},
+		},
+		[]byte(testWorkflowWithParameterSchema.ToStringForStore()), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, `[{"name":"param1","type":"int","default":"1","min":1}]`, version.ParameterSchema)
+
+	// An invalid schema is rejected when the version is uploaded.
+	invalid := testWorkflowWithParameterSchema.DeepCopy()
+	invalid.Annotations[util.AnnotationKeyParameterSchema] = `[{"name": "param2"}]`
+	_, err = manager.CreatePipelineVersion(
+		&api.PipelineVersion{
+			Name: "p_v2",
+			ResourceReferences: []*api.ResourceReference{
+				{
+					Key:          &api.ResourceKey{Id: p.UUID, Type: api.ResourceType_PIPELINE},
+					Relationship: api.Relationship_OWNER,
+				},
+			},
+		},
+		[]byte(util.NewWorkflow(invalid).ToStringForStore()), nil)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "\"param2\" is not a parameter of the workflow")
+}
+
 func TestCreatePipelineVersion(t *testing.T) {
 	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
 	manager := NewResourceManager(store)
@@ -2094,7 +3047,7 @@ func TestCreatePipelineVersion(t *testing.T) {
 				},
 			},
 		},
-		[]byte(testWorkflow.ToStringForStore()))
+		[]byte(testWorkflow.ToStringForStore()), nil)
 	assert.Nil(t, err)
 
 	defer store.Close()
@@ -2105,10 +3058,89 @@ func TestCreatePipelineVersion(t *testing.T) {
 		Parameters:     "[{\"name\":\"param1\"}]",
 		Status:         model.PipelineVersionReady,
 		PipelineId:     DefaultFakeUUID,
+		Digest:         util.ComputeDigest([]byte(testWorkflow.ToStringForStore())),
 	}
 	assert.Equal(t, pipelineVersionExpected, version)
 }
 
+func TestUpdatePipelineDefaultVersion(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+
+	_, err := manager.CreatePipeline("p1", "", []byte(testWorkflow.ToStringForStore()))
+	assert.Nil(t, err)
+	pipelineStore, ok := store.pipelineStore.(*storage.PipelineStore)
+	assert.True(t, ok)
+	pipelineStore.SetUUIDGenerator(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+	_, err = manager.CreatePipeline("p2", "", []byte(testWorkflow.ToStringForStore()))
+	assert.Nil(t, err)
+
+	// Creating a version makes it the default version.
+	const versionUUID = "123e4567-e89b-12d3-a456-426655440002"
+	pipelineStore.SetUUIDGenerator(util.NewFakeUUIDGeneratorOrFatal(versionUUID, nil))
+	_, err = manager.CreatePipelineVersion(
+		&api.PipelineVersion{
+			Name: "p1_v2",
+			ResourceReferences: []*api.ResourceReference{
+				&api.ResourceReference{
+					Key:          &api.ResourceKey{Id: DefaultFakeUUID, Type: api.ResourceType_PIPELINE},
+					Relationship: api.Relationship_OWNER,
+				},
+			},
+		},
+		[]byte(testWorkflow.ToStringForStore()), nil)
+	assert.Nil(t, err)
+	pipeline, err := manager.GetPipeline(DefaultFakeUUID)
+	assert.Nil(t, err)
+	assert.Equal(t, versionUUID, pipeline.DefaultVersionId)
+
+	err = manager.UpdatePipelineDefaultVersion(DefaultFakeUUID, DefaultFakeUUID)
+	assert.Nil(t, err)
+	pipeline, err = manager.GetPipeline(DefaultFakeUUID)
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultFakeUUID, pipeline.DefaultVersionId)
+
+	// The version of another pipeline can't be the default version.
+	err = manager.UpdatePipelineDefaultVersion(DefaultFakeUUID, FakeUUIDOne)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestCreateOrGetPipelineVersion(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+
+	_, err := manager.CreatePipeline("p", "", []byte(testWorkflow.ToStringForStore()))
+	assert.Nil(t, err)
+	pipelineStore, ok := store.pipelineStore.(*storage.PipelineStore)
+	assert.True(t, ok)
+	pipelineStore.SetUUIDGenerator(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+	apiVersion := &api.PipelineVersion{
+		Name: "p_v",
+		ResourceReferences: []*api.ResourceReference{
+			&api.ResourceReference{
+				Key:          &api.ResourceKey{Id: DefaultFakeUUID, Type: api.ResourceType_PIPELINE},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}
+
+	// The default version has the same template, so it is reused.
+	version, created, err := manager.CreateOrGetPipelineVersion(apiVersion, []byte(testWorkflow.ToStringForStore()), nil)
+	assert.Nil(t, err)
+	assert.False(t, created)
+	assert.Equal(t, DefaultFakeUUID, version.UUID)
+
+	// A different template creates a new version.
+	version, created, err = manager.CreateOrGetPipelineVersion(apiVersion, []byte(complexPipeline), nil)
+	assert.Nil(t, err)
+	assert.True(t, created)
+	assert.Equal(t, FakeUUIDOne, version.UUID)
+	assert.Equal(t, util.ComputeDigest([]byte(complexPipeline)), version.Digest)
+}
+
 func TestCreatePipelineVersion_ComplexPipelineVersion(t *testing.T) {
 	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
 	defer store.Close()
@@ -2135,7 +3167,7 @@ func TestCreatePipelineVersion_ComplexPipelineVersion(t *testing.T) {
 				},
 			},
 		},
-		[]byte(strings.TrimSpace(complexPipeline)))
+		[]byte(strings.TrimSpace(complexPipeline)), nil)
 	assert.Nil(t, err)
 
 	_, err = manager.GetPipeline(createdPipeline.UUID)
@@ -2174,7 +3206,7 @@ func TestCreatePipelineVersion_CreatePipelineVersionFileError(t *testing.T) {
 				},
 			},
 		},
-		[]byte("apiVersion: argoproj.io/v1alpha1\nkind: Workflow"))
+		[]byte("apiVersion: argoproj.io/v1alpha1\nkind: Workflow"), nil)
 	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode())
 	assert.Contains(t, err.Error(), "bad object store")
 
@@ -2210,7 +3242,7 @@ func TestCreatePipelineVersion_GetParametersError(t *testing.T) {
 				},
 			},
 		},
-		[]byte("I am invalid yaml"))
+		[]byte("I am invalid yaml"), nil)
 	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
 	assert.Contains(t, err.Error(), "Failed to parse the parameter")
 }
@@ -2249,7 +3281,7 @@ func TestCreatePipelineVersion_StorePipelineVersionMetadataError(t *testing.T) {
 				},
 			},
 		},
-		[]byte("apiVersion: argoproj.io/v1alpha1\nkind: Workflow"))
+		[]byte("apiVersion: argoproj.io/v1alpha1\nkind: Workflow"), nil)
 	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode())
 	assert.Contains(t, err.Error(), "database is closed")
 }
@@ -2280,7 +3312,7 @@ func TestDeletePipelineVersion(t *testing.T) {
 				},
 			},
 		},
-		[]byte("apiVersion: argoproj.io/v1alpha1\nkind: Workflow"))
+		[]byte("apiVersion: argoproj.io/v1alpha1\nkind: Workflow"), nil)
 
 	// Delete the above pipeline_version.
 	err = manager.DeletePipelineVersion(FakeUUIDOne)
@@ -2317,7 +3349,7 @@ func TestDeletePipelineVersion_FileError(t *testing.T) {
 				},
 			},
 		},
-		[]byte("apiVersion: argoproj.io/v1alpha1\nkind: Workflow"))
+		[]byte("apiVersion: argoproj.io/v1alpha1\nkind: Workflow"), nil)
 
 	// Switch to a bad object store
 	manager.objectStore = &FakeBadObjectStore{}
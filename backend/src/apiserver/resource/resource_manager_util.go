@@ -15,8 +15,16 @@
 package resource
 
 import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"path"
 	"regexp"
 	"strings"
 	"time"
@@ -27,6 +35,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	servercommon "github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	scheduledworkflow "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
@@ -42,15 +51,58 @@ func toCRDTrigger(apiTrigger *api.Trigger) *scheduledworkflow.Trigger {
 	if apiTrigger.GetPeriodicSchedule() != nil {
 		crdTrigger.PeriodicSchedule = toCRDPeriodicSchedule(apiTrigger.GetPeriodicSchedule())
 	}
+	if apiTrigger.GetObjectStoreEvent() != nil {
+		crdTrigger.ObjectStoreEvent = toCRDObjectStoreEvent(apiTrigger.GetObjectStoreEvent())
+	}
+	if apiTrigger.GetRunCompletion() != nil {
+		crdTrigger.RunCompletion = toCRDRunCompletion(apiTrigger.GetRunCompletion())
+	}
 	return &crdTrigger
 }
 
+func toCRDRunCompletion(runCompletion *api.RunCompletion) *scheduledworkflow.RunCompletionTrigger {
+	return &scheduledworkflow.RunCompletionTrigger{
+		JobID:            runCompletion.JobId,
+		ExperimentID:     runCompletion.ExperimentId,
+		States:           runCompletion.States,
+		RunIDParameter:   runCompletion.RunIdParameter,
+		OutputParameters: runCompletion.OutputParameters,
+	}
+}
+
+// getTriggerParameters returns the names of the parameters that the trigger
+// sets when the job triggers.
+func getTriggerParameters(trigger *api.Trigger) []string {
+	var names []string
+	if event := trigger.GetObjectStoreEvent(); event != nil {
+		names = append(names, event.KeyParameter)
+	}
+	if runCompletion := trigger.GetRunCompletion(); runCompletion != nil {
+		if runCompletion.RunIdParameter != "" {
+			names = append(names, runCompletion.RunIdParameter)
+		}
+		for name := range runCompletion.OutputParameters {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func toCRDObjectStoreEvent(event *api.ObjectStoreEvent) *scheduledworkflow.ObjectStoreEventTrigger {
+	return &scheduledworkflow.ObjectStoreEventTrigger{
+		Bucket:       event.Bucket,
+		Prefix:       event.Prefix,
+		KeyParameter: event.KeyParameter,
+	}
+}
+
 func toCRDCronSchedule(cronSchedule *api.CronSchedule) *scheduledworkflow.CronSchedule {
 	if cronSchedule == nil || cronSchedule.Cron == "" {
 		return nil
 	}
 	crdCronSchedule := scheduledworkflow.CronSchedule{}
 	crdCronSchedule.Cron = cronSchedule.Cron
+	crdCronSchedule.TimeZone = cronSchedule.TimeZone
 
 	if cronSchedule.StartTime != nil {
 		startTime := v1.NewTime(time.Unix(cronSchedule.StartTime.Seconds, 0))
@@ -80,6 +132,24 @@ func toCRDPeriodicSchedule(periodicSchedule *api.PeriodicSchedule) *scheduledwor
 	return &crdPeriodicSchedule
 }
 
+func toCRDFailurePolicy(maxConsecutiveFailures int64) *scheduledworkflow.FailurePolicy {
+	if maxConsecutiveFailures == 0 {
+		return nil
+	}
+	return &scheduledworkflow.FailurePolicy{MaxConsecutiveFailures: maxConsecutiveFailures}
+}
+
+func toCRDTimeWindows(windows []*api.TimeWindow) []scheduledworkflow.TimeWindow {
+	var crdWindows []scheduledworkflow.TimeWindow
+	for _, window := range windows {
+		crdWindows = append(crdWindows, scheduledworkflow.TimeWindow{
+			StartTime: v1.NewTime(time.Unix(window.GetStartTime().GetSeconds(), 0)),
+			EndTime:   v1.NewTime(time.Unix(window.GetEndTime().GetSeconds(), 0)),
+		})
+	}
+	return crdWindows
+}
+
 func toCRDParameter(apiParams []*api.Parameter) []scheduledworkflow.Parameter {
 	var swParams []scheduledworkflow.Parameter
 	for _, apiParam := range apiParams {
@@ -265,3 +335,104 @@ func ConvertPipelineIdToDefaultPipelineVersion(pipelineSpec *api.PipelineSpec, r
 	})
 	return nil
 }
+
+// extractPipelinePackageFile reads a single file from a pipeline package, which is either a zip
+// file or a compressed tarball. Returns nil if the package doesn't contain the file.
+func extractPipelinePackageFile(pipelinePackage []byte, filePath string) ([]byte, error) {
+	filePath = normalizePipelinePackagePath(filePath)
+	if len(pipelinePackage) > 2 && pipelinePackage[0] == '\x50' && pipelinePackage[1] == '\x4B' {
+		zipReader, err := zip.NewReader(bytes.NewReader(pipelinePackage), int64(len(pipelinePackage)))
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to read the pipeline package as a zip file")
+		}
+		for _, file := range zipReader.File {
+			if file.FileInfo().IsDir() || normalizePipelinePackagePath(file.Name) != filePath {
+				continue
+			}
+			rc, err := file.Open()
+			if err != nil {
+				return nil, util.NewInternalServerError(err, "Failed to open %v in the pipeline package", filePath)
+			}
+			defer rc.Close()
+			content, err := ioutil.ReadAll(rc)
+			if err != nil {
+				return nil, util.NewInternalServerError(err, "Failed to read %v in the pipeline package", filePath)
+			}
+			return content, nil
+		}
+		return nil, nil
+	}
+
+	gzipReader, err := gzip.NewReader(bytes.NewReader(pipelinePackage))
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to read the pipeline package as a tarball file")
+	}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to read the pipeline package as a tarball file")
+		}
+		if !header.FileInfo().Mode().IsRegular() || normalizePipelinePackagePath(header.Name) != filePath {
+			continue
+		}
+		content, err := ioutil.ReadAll(tarReader)
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to read %v in the pipeline package", filePath)
+		}
+		return content, nil
+	}
+}
+
+// normalizePipelinePackagePath makes "./README.md", "/README.md" and "README.md" refer to the
+// same file in a pipeline package.
+func normalizePipelinePackagePath(filePath string) string {
+	return strings.TrimPrefix(path.Clean("/"+filePath), "/")
+}
+
+// tarFileReader reads a file in a tarball and closes the underlying tarball when done.
+type tarFileReader struct {
+	io.Reader
+	io.Closer
+}
+
+// untarFirstFile wraps a gzipped tarball so that reading it yields the content of the first
+// regular file in the tarball, skipping its first offset bytes. Closing the returned reader closes
+// the tarball reader.
+func untarFirstFile(tarball *storage.FileReader, offset int64) (*storage.FileReader, error) {
+	gzipReader, err := gzip.NewReader(tarball)
+	if err != nil {
+		return nil, util.NewInvalidInputError("Failed to read the artifact as a tarball file: %v", err.Error())
+	}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil, util.NewInvalidInputError("The tarball has no regular file")
+		}
+		if err != nil {
+			return nil, util.NewInvalidInputError("Failed to read the artifact as a tarball file: %v", err.Error())
+		}
+		if !header.FileInfo().Mode().IsRegular() {
+			continue
+		}
+		if offset > header.Size {
+			return nil, util.NewInvalidInputError("Offset %v is out of range for %v of size %v", offset, header.Name, header.Size)
+		}
+		if _, err := io.CopyN(ioutil.Discard, tarReader, offset); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to skip to offset %v of %v", offset, header.Name)
+		}
+		contentType := mime.TypeByExtension(path.Ext(header.Name))
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		return &storage.FileReader{
+			ReadCloser:  tarFileReader{Reader: tarReader, Closer: tarball},
+			Size:        header.Size,
+			ContentType: contentType,
+		}, nil
+	}
+}
@@ -68,6 +68,32 @@ func TestToCrdCronSchedule(t *testing.T) {
 	})
 }
 
+func TestToCrdCronSchedule_TimeZone(t *testing.T) {
+	actualCronSchedule := toCRDCronSchedule(&api.CronSchedule{
+		Cron:     "0 0 9 * * *",
+		TimeZone: "America/New_York",
+	})
+	assert.Equal(t, actualCronSchedule, &scheduledworkflow.CronSchedule{
+		Cron:     "0 0 9 * * *",
+		TimeZone: "America/New_York",
+	})
+}
+
+func TestToCrdTrigger_ObjectStoreEvent(t *testing.T) {
+	actualTrigger := toCRDTrigger(&api.Trigger{
+		Trigger: &api.Trigger_ObjectStoreEvent{ObjectStoreEvent: &api.ObjectStoreEvent{
+			Bucket:       "mlpipeline",
+			Prefix:       "data/",
+			KeyParameter: "input",
+		}}})
+	assert.Equal(t, &scheduledworkflow.Trigger{
+		ObjectStoreEvent: &scheduledworkflow.ObjectStoreEventTrigger{
+			Bucket:       "mlpipeline",
+			Prefix:       "data/",
+			KeyParameter: "input",
+		}}, actualTrigger)
+}
+
 func TestToCrdCronSchedule_NilCron(t *testing.T) {
 	actualCronSchedule := toCRDCronSchedule(&api.CronSchedule{
 		StartTime: &timestamp.Timestamp{Seconds: 123},
@@ -342,7 +368,7 @@ func TestConvertPipelineIdToDefaultPipelineVersion(t *testing.T) {
 				Relationship: api.Relationship_OWNER,
 			},
 		},
-	}, []byte(testWorkflow.ToStringForStore()))
+	}, []byte(testWorkflow.ToStringForStore()), nil)
 	assert.Nil(t, err)
 
 	// Create a run of the latest pipeline version, but by specifying the pipeline id.
@@ -400,7 +426,7 @@ func TestConvertPipelineIdToDefaultPipelineVersion_NoOp(t *testing.T) {
 				Relationship: api.Relationship_OWNER,
 			},
 		},
-	}, []byte(testWorkflow.ToStringForStore()))
+	}, []byte(testWorkflow.ToStringForStore()), nil)
 	assert.Nil(t, err)
 	// FakeUUID is the new default version's id.
 	assert.NotEqual(t, oldVersionId, FakeUUIDOne)
@@ -16,13 +16,18 @@ package server
 
 import (
 	"encoding/json"
+	"sort"
+	"strings"
 
 	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/golang/glog"
 	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	scheduledworkflow "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 )
 
 func ToApiExperiment(experiment *model.Experiment) *api.Experiment {
@@ -45,6 +50,7 @@ func ToApiExperiment(experiment *model.Experiment) *api.Experiment {
 		CreatedAt:          &timestamp.Timestamp{Seconds: experiment.CreatedAtInSec},
 		ResourceReferences: resourceReferences,
 		StorageState:       api.Experiment_StorageState(api.Experiment_StorageState_value[experiment.StorageState]),
+		Labels:             experiment.Labels,
 	}
 }
 
@@ -80,6 +86,7 @@ func ToApiPipeline(pipeline *model.Pipeline) *api.Pipeline {
 		Description:    pipeline.Description,
 		Parameters:     params,
 		DefaultVersion: defaultVersion,
+		Labels:         pipeline.Labels,
 	}
 }
 
@@ -91,6 +98,10 @@ func ToApiPipelineVersion(version *model.PipelineVersion) (*api.PipelineVersion,
 	if err != nil {
 		return nil, err
 	}
+	parameterSchema, err := toApiParameterSchema(version.ParameterSchema)
+	if err != nil {
+		return nil, err
+	}
 
 	return &api.PipelineVersion{
 		Id:            version.UUID,
@@ -107,6 +118,9 @@ func ToApiPipelineVersion(version *model.PipelineVersion) (*api.PipelineVersion,
 				Relationship: api.Relationship_OWNER,
 			},
 		},
+		Labels:          version.Labels,
+		Digest:          version.Digest,
+		ParameterSchema: parameterSchema,
 	}, nil
 }
 
@@ -151,6 +165,38 @@ func toApiParameters(paramsString string) ([]*api.Parameter, error) {
 	return apiParams, nil
 }
 
+func toApiParameterSchema(schemaString string) ([]*api.ParameterSpec, error) {
+	if schemaString == "" {
+		return nil, nil
+	}
+	var specs []*util.ParameterSpec
+	if err := json.Unmarshal([]byte(schemaString), &specs); err != nil {
+		return nil, util.NewInternalServerError(err, "Parameter schema with wrong format is stored")
+	}
+	apiSpecs := make([]*api.ParameterSpec, 0, len(specs))
+	for _, spec := range specs {
+		apiSpec := &api.ParameterSpec{
+			Name:        spec.Name,
+			Type:        api.ParameterSpec_Type(api.ParameterSpec_Type_value[strings.ToUpper(string(spec.Type))]),
+			Description: spec.Description,
+			Required:    spec.Required,
+			Pattern:     spec.Pattern,
+			EnumValues:  spec.Enum,
+		}
+		if spec.Default != nil {
+			apiSpec.DefaultValue = &wrappers.StringValue{Value: *spec.Default}
+		}
+		if spec.Min != nil {
+			apiSpec.Min = &wrappers.DoubleValue{Value: *spec.Min}
+		}
+		if spec.Max != nil {
+			apiSpec.Max = &wrappers.DoubleValue{Value: *spec.Max}
+		}
+		apiSpecs = append(apiSpecs, apiSpec)
+	}
+	return apiSpecs, nil
+}
+
 func toApiRun(run *model.Run) *api.Run {
 	params, err := toApiParameters(run.Parameters)
 	if err != nil {
@@ -165,10 +211,17 @@ func toApiRun(run *model.Run) *api.Run {
 			metrics = append(metrics, ToApiRunMetric(metric))
 		}
 	}
+	var warnings []string
+	if run.Warnings != "" {
+		if err := json.Unmarshal([]byte(run.Warnings), &warnings); err != nil {
+			glog.Errorf("Failed to parse warnings (%v) of run %s: %v", run.Warnings, run.UUID, err)
+		}
+	}
 	return &api.Run{
 		CreatedAt:      &timestamp.Timestamp{Seconds: run.CreatedAtInSec},
 		Id:             run.UUID,
 		Metrics:        metrics,
+		Warnings:       warnings,
 		Name:           run.DisplayName,
 		ServiceAccount: run.ServiceAccount,
 		StorageState:   api.Run_StorageState(api.Run_StorageState_value[run.StorageState]),
@@ -180,6 +233,7 @@ func toApiRun(run *model.Run) *api.Run {
 			PipelineId:       run.PipelineId,
 			PipelineName:     run.PipelineName,
 			WorkflowManifest: run.WorkflowSpecManifest,
+			WorkflowDigest:   run.WorkflowSpecDigest,
 			PipelineManifest: run.PipelineSpecManifest,
 			Parameters:       params,
 		},
@@ -213,6 +267,24 @@ func ToApiJob(job *model.Job) *api.Job {
 			Error: err.Error(),
 		}
 	}
+	trigger, err := toApiTrigger(job.Trigger)
+	if err != nil {
+		return &api.Job{
+			Id:    job.UUID,
+			Error: err.Error(),
+		}
+	}
+	blackoutWindows, err := toApiTimeWindows(job)
+	if err != nil {
+		return &api.Job{
+			Id:    job.UUID,
+			Error: err.Error(),
+		}
+	}
+	var nextTriggeredTime *timestamp.Timestamp
+	if job.NextTriggeredTimeInSec != nil {
+		nextTriggeredTime = &timestamp.Timestamp{Seconds: *job.NextTriggeredTimeInSec}
+	}
 	return &api.Job{
 		Id:             job.UUID,
 		Name:           job.DisplayName,
@@ -224,16 +296,70 @@ func ToApiJob(job *model.Job) *api.Job {
 		Status:         job.Conditions,
 		MaxConcurrency: job.MaxConcurrency,
 		NoCatchup:      job.NoCatchup,
-		Trigger:        toApiTrigger(job.Trigger),
+		Trigger:        trigger,
 		PipelineSpec: &api.PipelineSpec{
 			PipelineId:       job.PipelineId,
 			PipelineName:     job.PipelineName,
 			WorkflowManifest: job.WorkflowSpecManifest,
+			WorkflowDigest:   job.WorkflowSpecDigest,
 			PipelineManifest: job.PipelineSpecManifest,
 			Parameters:       params,
 		},
-		ResourceReferences: toApiResourceReferences(job.ResourceReferences),
+		ResourceReferences:     toApiResourceReferences(job.ResourceReferences),
+		Labels:                 job.Labels,
+		NextTriggeredTime:      nextTriggeredTime,
+		Backfill:               toApiBackfill(job.Backfill),
+		MaxConsecutiveFailures: job.MaxConsecutiveFailures,
+		BlackoutWindows:        blackoutWindows,
+	}
+}
+
+func toApiTimeWindows(job *model.Job) ([]*api.TimeWindow, error) {
+	windows, err := job.BlackoutWindowList()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to parse the blackout windows of job %v", job.UUID)
+	}
+	var apiWindows []*api.TimeWindow
+	for _, window := range windows {
+		apiWindows = append(apiWindows, &api.TimeWindow{
+			StartTime: &timestamp.Timestamp{Seconds: window.StartTime.Unix()},
+			EndTime:   &timestamp.Timestamp{Seconds: window.EndTime.Unix()},
+		})
+	}
+	return apiWindows, nil
+}
+
+func toApiBackfill(backfill model.Backfill) *api.Backfill {
+	if backfill.BackfillId == nil {
+		return nil
+	}
+	apiBackfill := &api.Backfill{Id: *backfill.BackfillId}
+	if backfill.BackfillStartTimeInSec != nil {
+		apiBackfill.StartTime = &timestamp.Timestamp{Seconds: *backfill.BackfillStartTimeInSec}
+	}
+	if backfill.BackfillEndTimeInSec != nil {
+		apiBackfill.EndTime = &timestamp.Timestamp{Seconds: *backfill.BackfillEndTimeInSec}
+	}
+	if backfill.BackfillState != nil {
+		switch scheduledworkflow.BackfillPhase(*backfill.BackfillState) {
+		case scheduledworkflow.BackfillRunning:
+			apiBackfill.State = api.Backfill_RUNNING
+		case scheduledworkflow.BackfillCompleted:
+			apiBackfill.State = api.Backfill_COMPLETED
+		case scheduledworkflow.BackfillCancelled:
+			apiBackfill.State = api.Backfill_CANCELLED
+		}
+	}
+	if backfill.BackfillTotalRuns != nil {
+		apiBackfill.TotalRuns = *backfill.BackfillTotalRuns
 	}
+	if backfill.BackfillCreatedRuns != nil {
+		apiBackfill.CreatedRuns = *backfill.BackfillCreatedRuns
+	}
+	if backfill.BackfillNextScheduledTimeInSec != nil {
+		apiBackfill.NextScheduledTime = &timestamp.Timestamp{Seconds: *backfill.BackfillNextScheduledTimeInSec}
+	}
+	return apiBackfill
 }
 
 func ToApiJobs(jobs []*model.Job) []*api.Job {
@@ -255,6 +381,114 @@ func ToApiRunMetric(metric *model.RunMetric) *api.RunMetric {
 	}
 }
 
+func ToApiRunArtifacts(artifacts []*model.RunArtifact) []*api.RunArtifact {
+	apiArtifacts := make([]*api.RunArtifact, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		artifactType := api.RunArtifact_OUTPUT
+		if artifact.Input {
+			artifactType = api.RunArtifact_INPUT
+		}
+		apiArtifacts = append(apiArtifacts, &api.RunArtifact{
+			Name:             artifact.Name,
+			NodeId:           artifact.NodeID,
+			Type:             artifactType,
+			Key:              artifact.ObjectStoreKey,
+			Size:             artifact.Size,
+			ProducerTemplate: artifact.ProducerTemplateName,
+		})
+	}
+	return apiArtifacts
+}
+
+// ToApiRunComparisons builds the comparison of runs, a row per run, with the parameters and metrics
+// of all the runs as columns.
+func ToApiRunComparisons(runs []*model.Run) (*api.CompareRunsResponse, error) {
+	parameterNames := make(map[string]bool)
+	metricNames := make(map[string]bool)
+	comparisons := make([]*api.RunComparison, 0, len(runs))
+	for _, run := range runs {
+		params, err := toApiParameters(run.Parameters)
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to compare run %s", run.UUID)
+		}
+		comparison := &api.RunComparison{
+			RunId:      run.UUID,
+			Name:       run.DisplayName,
+			Status:     run.Conditions,
+			CreatedAt:  &timestamp.Timestamp{Seconds: run.CreatedAtInSec},
+			Parameters: make(map[string]string),
+		}
+		if run.FinishedAtInSec > 0 {
+			comparison.DurationSeconds = run.FinishedAtInSec - run.CreatedAtInSec
+		}
+		for _, ref := range run.ResourceReferences {
+			if ref.ReferenceType == common.PipelineVersion {
+				comparison.PipelineVersionId = ref.ReferenceUUID
+				comparison.PipelineVersionName = ref.ReferenceName
+			}
+		}
+		for _, param := range params {
+			comparison.Parameters[param.Name] = param.Value
+			parameterNames[param.Name] = true
+		}
+		comparison.Metrics = toRunMetricValues(run.Metrics)
+		for name := range comparison.Metrics {
+			metricNames[name] = true
+		}
+		comparisons = append(comparisons, comparison)
+	}
+	return &api.CompareRunsResponse{
+		ParameterNames: sortedKeys(parameterNames),
+		MetricNames:    sortedKeys(metricNames),
+		Runs:           comparisons,
+		TotalSize:      int32(len(comparisons)),
+	}, nil
+}
+
+// toRunMetricValues flattens the metrics of a run by name. When several nodes report a metric with
+// the same name, the metric of each node is keyed by its node ID and name instead, so that no value
+// is dropped.
+func toRunMetricValues(metrics []*model.RunMetric) map[string]float64 {
+	nodeCounts := make(map[string]int)
+	for _, metric := range metrics {
+		nodeCounts[metric.Name]++
+	}
+	values := make(map[string]float64)
+	for _, metric := range metrics {
+		name := metric.Name
+		if nodeCounts[metric.Name] > 1 {
+			name = metric.NodeID + "/" + metric.Name
+		}
+		values[name] = metric.NumberValue
+	}
+	return values
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ToApiRunMetricPoints converts the series of a metric. A point without a timestamp gets none.
+func ToApiRunMetricPoints(points []*model.RunMetricPoint) []*api.RunMetricPoint {
+	apiPoints := make([]*api.RunMetricPoint, 0, len(points))
+	for _, point := range points {
+		apiPoint := &api.RunMetricPoint{
+			Step:        point.Step,
+			NumberValue: point.NumberValue,
+		}
+		if point.TimestampInSec != 0 {
+			apiPoint.Timestamp = &timestamp.Timestamp{Seconds: point.TimestampInSec}
+		}
+		apiPoints = append(apiPoints, apiPoint)
+	}
+	return apiPoints
+}
+
 func toApiResourceReferences(references []*model.ResourceReference) []*api.ResourceReference {
 	var apiReferences []*api.ResourceReference
 	for _, ref := range references {
@@ -296,7 +530,7 @@ func toApiRelationship(r common.Relationship) api.Relationship {
 	}
 }
 
-func toApiTrigger(trigger model.Trigger) *api.Trigger {
+func toApiTrigger(trigger model.Trigger) (*api.Trigger, error) {
 	if trigger.Cron != nil && *trigger.Cron != "" {
 		var cronSchedule api.CronSchedule
 		cronSchedule.Cron = *trigger.Cron
@@ -308,7 +542,10 @@ func toApiTrigger(trigger model.Trigger) *api.Trigger {
 			cronSchedule.EndTime = &timestamp.Timestamp{
 				Seconds: *trigger.CronScheduleEndTimeInSec}
 		}
-		return &api.Trigger{Trigger: &api.Trigger_CronSchedule{CronSchedule: &cronSchedule}}
+		if trigger.CronScheduleTimeZone != nil {
+			cronSchedule.TimeZone = *trigger.CronScheduleTimeZone
+		}
+		return &api.Trigger{Trigger: &api.Trigger_CronSchedule{CronSchedule: &cronSchedule}}, nil
 	}
 
 	if trigger.IntervalSecond != nil && *trigger.IntervalSecond != 0 {
@@ -322,7 +559,38 @@ func toApiTrigger(trigger model.Trigger) *api.Trigger {
 			periodicSchedule.EndTime = &timestamp.Timestamp{
 				Seconds: *trigger.PeriodicScheduleEndTimeInSec}
 		}
-		return &api.Trigger{Trigger: &api.Trigger_PeriodicSchedule{PeriodicSchedule: &periodicSchedule}}
+		return &api.Trigger{Trigger: &api.Trigger_PeriodicSchedule{PeriodicSchedule: &periodicSchedule}}, nil
+	}
+
+	if trigger.ObjectStoreEventBucket != nil && *trigger.ObjectStoreEventBucket != "" {
+		event := api.ObjectStoreEvent{Bucket: *trigger.ObjectStoreEventBucket}
+		if trigger.ObjectStoreEventPrefix != nil {
+			event.Prefix = *trigger.ObjectStoreEventPrefix
+		}
+		if trigger.ObjectStoreEventKeyParameter != nil {
+			event.KeyParameter = *trigger.ObjectStoreEventKeyParameter
+		}
+		return &api.Trigger{Trigger: &api.Trigger_ObjectStoreEvent{ObjectStoreEvent: &event}}, nil
+	}
+
+	if trigger.RunCompletionJobId != nil || trigger.RunCompletionExperimentId != nil {
+		var runCompletion api.RunCompletion
+		if trigger.RunCompletionJobId != nil {
+			runCompletion.JobId = *trigger.RunCompletionJobId
+		}
+		if trigger.RunCompletionExperimentId != nil {
+			runCompletion.ExperimentId = *trigger.RunCompletionExperimentId
+		}
+		runCompletion.States = trigger.RunCompletionStateList()
+		if trigger.RunCompletionRunIdParameter != nil {
+			runCompletion.RunIdParameter = *trigger.RunCompletionRunIdParameter
+		}
+		outputParameters, err := trigger.RunCompletionOutputParameterMap()
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Run completion trigger with wrong format is stored")
+		}
+		runCompletion.OutputParameters = outputParameters
+		return &api.Trigger{Trigger: &api.Trigger_RunCompletion{RunCompletion: &runCompletion}}, nil
 	}
-	return &api.Trigger{}
+	return &api.Trigger{}, nil
 }
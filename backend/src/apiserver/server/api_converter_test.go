@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
@@ -77,6 +78,28 @@ func TestToApiPipeline_ErrorParsingField(t *testing.T) {
 	assert.Equal(t, expectedApiPipeline, apiPipeline)
 }
 
+func TestToApiPipelineVersion_ParameterSchema(t *testing.T) {
+	modelVersion := &model.PipelineVersion{
+		UUID:            "version1",
+		Name:            "v1",
+		PipelineId:      "pipeline1",
+		ParameterSchema: `[{"name":"epochs","type":"int","required":true,"default":"10","min":1},{"name":"data","type":"artifact_uri","pattern":"gs://.*"},{"name":"optimizer","type":"enum","enum":["adam","sgd"]}]`,
+	}
+	apiVersion, err := ToApiPipelineVersion(modelVersion)
+	assert.Nil(t, err)
+	assert.Equal(t, []*api.ParameterSpec{
+		{
+			Name:         "epochs",
+			Type:         api.ParameterSpec_INT,
+			Required:     true,
+			DefaultValue: &wrappers.StringValue{Value: "10"},
+			Min:          &wrappers.DoubleValue{Value: 1},
+		},
+		{Name: "data", Type: api.ParameterSpec_ARTIFACT_URI, Pattern: "gs://.*"},
+		{Name: "optimizer", Type: api.ParameterSpec_ENUM, EnumValues: []string{"adam", "sgd"}},
+	}, apiVersion.ParameterSchema)
+}
+
 func TestToApiRunDetail(t *testing.T) {
 	modelRun := &model.RunDetail{
 		Run: model.Run{
@@ -352,6 +375,25 @@ func TestNonScheduledJobToApiJob(t *testing.T) {
 	assert.Equal(t, expectedJob, apiJob)
 }
 
+func TestToApiJob_FailurePolicyAndBlackouts(t *testing.T) {
+	modelJob := &model.Job{
+		UUID:                   "job1",
+		DisplayName:            "name1",
+		Enabled:                true,
+		MaxConcurrency:         1,
+		MaxConsecutiveFailures: 3,
+		BlackoutWindows:        util.StringPointer(`[{"startTime":"1970-01-01T00:01:40Z","endTime":"1970-01-01T00:03:20Z"}]`),
+		CreatedAtInSec:         1,
+		UpdatedAtInSec:         1,
+	}
+
+	apiJob := ToApiJob(modelJob)
+	assert.Equal(t, int64(3), apiJob.MaxConsecutiveFailures)
+	assert.Equal(t, []*api.TimeWindow{
+		{StartTime: &timestamp.Timestamp{Seconds: 100}, EndTime: &timestamp.Timestamp{Seconds: 200}},
+	}, apiJob.BlackoutWindows)
+}
+
 func TestToApiJob_ErrorParsingField(t *testing.T) {
 	modelJob := &model.Job{
 		UUID:           "job1",
@@ -376,6 +418,70 @@ func TestToApiJob_ErrorParsingField(t *testing.T) {
 	assert.Equal(t, expectedApiJob, apiJob)
 }
 
+func TestToApiTrigger_ObjectStoreEvent(t *testing.T) {
+	trigger, err := toApiTrigger(model.Trigger{
+		ObjectStoreEvent: model.ObjectStoreEvent{
+			ObjectStoreEventBucket:       util.StringPointer("mlpipeline"),
+			ObjectStoreEventPrefix:       util.StringPointer("data/"),
+			ObjectStoreEventKeyParameter: util.StringPointer("input"),
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, &api.Trigger{Trigger: &api.Trigger_ObjectStoreEvent{ObjectStoreEvent: &api.ObjectStoreEvent{
+		Bucket:       "mlpipeline",
+		Prefix:       "data/",
+		KeyParameter: "input",
+	}}}, trigger)
+}
+
+func TestToApiTrigger_RunCompletion(t *testing.T) {
+	trigger, err := toApiTrigger(model.Trigger{
+		RunCompletion: model.RunCompletion{
+			RunCompletionJobId:            util.StringPointer("job1"),
+			RunCompletionExperimentId:     util.StringPointer(""),
+			RunCompletionStates:           util.StringPointer("Succeeded,Failed"),
+			RunCompletionRunIdParameter:   util.StringPointer("upstream"),
+			RunCompletionOutputParameters: util.StringPointer(`{"model":"train-model"}`),
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, &api.Trigger{Trigger: &api.Trigger_RunCompletion{RunCompletion: &api.RunCompletion{
+		JobId:            "job1",
+		States:           []string{"Succeeded", "Failed"},
+		RunIdParameter:   "upstream",
+		OutputParameters: map[string]string{"model": "train-model"},
+	}}}, trigger)
+
+	_, err = toApiTrigger(model.Trigger{
+		RunCompletion: model.RunCompletion{
+			RunCompletionJobId:            util.StringPointer("job1"),
+			RunCompletionOutputParameters: util.StringPointer("not json"),
+		},
+	})
+	assert.NotNil(t, err)
+}
+
+func TestToApiBackfill(t *testing.T) {
+	assert.Nil(t, toApiBackfill(model.Backfill{}))
+
+	backfill := toApiBackfill(model.Backfill{
+		BackfillId:             util.StringPointer("b1"),
+		BackfillStartTimeInSec: util.Int64Pointer(10),
+		BackfillEndTimeInSec:   util.Int64Pointer(40),
+		BackfillState:          util.StringPointer("Completed"),
+		BackfillTotalRuns:      util.Int64Pointer(3),
+		BackfillCreatedRuns:    util.Int64Pointer(3),
+	})
+	assert.Equal(t, &api.Backfill{
+		Id:          "b1",
+		StartTime:   &timestamp.Timestamp{Seconds: 10},
+		EndTime:     &timestamp.Timestamp{Seconds: 40},
+		State:       api.Backfill_COMPLETED,
+		TotalRuns:   3,
+		CreatedRuns: 3,
+	}, backfill)
+}
+
 func TestToApiJobs(t *testing.T) {
 	modelJob1 := model.Job{
 		UUID:        "job1",
@@ -525,6 +631,68 @@ func TestToApiResourceReferences(t *testing.T) {
 	assert.Equal(t, expectedApiResourceReferences, toApiResourceReferences(resourceReferences))
 }
 
+func TestToApiRunComparisons(t *testing.T) {
+	runs := []*model.Run{
+		{
+			UUID:            "run1",
+			DisplayName:     "name1",
+			Conditions:      "Succeeded",
+			CreatedAtInSec:  10,
+			FinishedAtInSec: 25,
+			PipelineSpec:    model.PipelineSpec{Parameters: `[{"name":"lr","value":"0.1"}]`},
+			ResourceReferences: []*model.ResourceReference{
+				{ResourceUUID: "run1", ResourceType: common.Run, ReferenceUUID: "pipelineversion1",
+					ReferenceName: "k1", ReferenceType: common.PipelineVersion, Relationship: common.Creator},
+			},
+			Metrics: []*model.RunMetric{
+				{RunUUID: "run1", NodeID: "node-2", Name: "accuracy", NumberValue: 0.9},
+				{RunUUID: "run1", NodeID: "node-1", Name: "accuracy", NumberValue: 0.8},
+				{RunUUID: "run1", NodeID: "node-1", Name: "loss", NumberValue: 0.2},
+			},
+		},
+		{
+			UUID:           "run2",
+			DisplayName:    "name2",
+			Conditions:     "Running",
+			CreatedAtInSec: 20,
+			PipelineSpec:   model.PipelineSpec{Parameters: `[{"name":"epochs","value":"3"}]`},
+			Metrics: []*model.RunMetric{
+				{RunUUID: "run2", NodeID: "node-1", Name: "loss", NumberValue: 0.3},
+			},
+		},
+	}
+	expected := &api.CompareRunsResponse{
+		ParameterNames: []string{"epochs", "lr"},
+		MetricNames:    []string{"loss", "node-1/accuracy", "node-2/accuracy"},
+		Runs: []*api.RunComparison{
+			{
+				RunId:               "run1",
+				Name:                "name1",
+				Status:              "Succeeded",
+				CreatedAt:           &timestamp.Timestamp{Seconds: 10},
+				DurationSeconds:     15,
+				PipelineVersionId:   "pipelineversion1",
+				PipelineVersionName: "k1",
+				Parameters:          map[string]string{"lr": "0.1"},
+				// The metrics of the nodes reporting the same name are keyed by node ID.
+				Metrics: map[string]float64{"node-1/accuracy": 0.8, "node-2/accuracy": 0.9, "loss": 0.2},
+			},
+			{
+				RunId:      "run2",
+				Name:       "name2",
+				Status:     "Running",
+				CreatedAt:  &timestamp.Timestamp{Seconds: 20},
+				Parameters: map[string]string{"epochs": "3"},
+				Metrics:    map[string]float64{"loss": 0.3},
+			},
+		},
+		TotalSize: 2,
+	}
+	actual, err := ToApiRunComparisons(runs)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+}
+
 func TestToApiExperiments(t *testing.T) {
 	exp1 := &model.Experiment{
 		UUID:           "exp1",
@@ -559,3 +727,20 @@ func TestToApiExperiments(t *testing.T) {
 	}
 	assert.Equal(t, expectedApiExps, apiExps)
 }
+
+func TestToApiRunArtifacts(t *testing.T) {
+	modelArtifacts := []*model.RunArtifact{
+		{NodeID: "node-1", Name: "data", ObjectStoreKey: "run/data.tgz", ProducerTemplateName: "preprocess", Size: 10},
+		{NodeID: "node-2", Name: "data", Input: true, ObjectStoreKey: "run/data.tgz", ProducerTemplateName: "preprocess", Size: 10},
+		{NodeID: "node-2", Name: "log", Size: -1},
+	}
+
+	actualAPIArtifacts := ToApiRunArtifacts(modelArtifacts)
+
+	expectedAPIArtifacts := []*api.RunArtifact{
+		{Name: "data", NodeId: "node-1", Type: api.RunArtifact_OUTPUT, Key: "run/data.tgz", Size: 10, ProducerTemplate: "preprocess"},
+		{Name: "data", NodeId: "node-2", Type: api.RunArtifact_INPUT, Key: "run/data.tgz", Size: 10, ProducerTemplate: "preprocess"},
+		{Name: "log", NodeId: "node-2", Type: api.RunArtifact_OUTPUT, Size: -1},
+	}
+	assert.Equal(t, expectedAPIArtifacts, actualAPIArtifacts)
+}
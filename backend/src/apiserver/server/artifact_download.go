@@ -0,0 +1,194 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// The size of the chunks artifacts are streamed in.
+	artifactChunkSize = 1 << 20
+	// Query string key to extract the single file in an artifact tarball.
+	UntarQueryStringKey = "untar"
+)
+
+var artifactDownloadPathRegexp = regexp.MustCompile(`^/apis/v1beta1/runs/([^/]+)/nodes/([^/]+)/artifacts/([^/]+):download$`)
+
+// byteRange is the single range of a Range header. A negative start means the last -start bytes,
+// and a negative length means until the end of the file.
+type byteRange struct {
+	start  int64
+	length int64
+}
+
+// ArtifactDownloadHandler serves GET requests on
+// /apis/v1beta1/runs/{run_id}/nodes/{node_id}/artifacts/{artifact_name}:download by streaming
+// the artifact, and passes any other request to next.
+func ArtifactDownloadHandler(runServer *RunServer, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || !artifactDownloadPathRegexp.MatchString(r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		runServer.DownloadArtifact(w, r)
+	})
+}
+
+// DownloadArtifact streams a run's artifact over HTTP. A single byte range can be requested with
+// the Range header, and the untar query string extracts the single file of an artifact tarball.
+func (s *RunServer) DownloadArtifact(w http.ResponseWriter, r *http.Request) {
+	if s.options.CollectMetrics {
+		downloadArtifactRequests.Inc()
+	}
+
+	matches := artifactDownloadPathRegexp.FindStringSubmatch(r.URL.Path)
+	if matches == nil {
+		writeArtifactErrorToResponse(w, util.NewResourceNotFoundError("artifact", r.URL.Path))
+		return
+	}
+	runID, nodeID, artifactName := matches[1], matches[2], matches[3]
+	untar := false
+	if untarQueryString := r.URL.Query().Get(UntarQueryStringKey); untarQueryString != "" {
+		var err error
+		untar, err = strconv.ParseBool(untarQueryString)
+		if err != nil {
+			writeArtifactErrorToResponse(w, util.NewInvalidInputError("Invalid value for %s: %s", UntarQueryStringKey, untarQueryString))
+			return
+		}
+	}
+
+	err := s.canAccessRun(httpRequestContext(r), runID)
+	if err != nil {
+		writeArtifactErrorToResponse(w, util.Wrap(err, "Failed to authorize the request."))
+		return
+	}
+
+	rng := parseByteRange(r.Header.Get("Range"))
+	offset := int64(0)
+	if rng != nil && rng.start > 0 {
+		offset = rng.start
+	}
+	reader, err := s.resourceManager.OpenArtifact(runID, nodeID, artifactName, untar, offset)
+	if err != nil && offset > 0 && util.IsUserErrorCodeMatch(err, codes.InvalidArgument) {
+		// The range starts past the end of the artifact.
+		w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+		return
+	}
+	if err == nil && rng != nil && rng.start < 0 {
+		// The size is only known once the artifact is open, so reopen it at the suffix.
+		offset = reader.Size + rng.start
+		if offset < 0 {
+			offset = 0
+		}
+		reader.Close()
+		reader, err = s.resourceManager.OpenArtifact(runID, nodeID, artifactName, untar, offset)
+	}
+	if err != nil {
+		writeArtifactErrorToResponse(w, util.Wrapf(err, "Failed to download artifact %s", r.URL.Path))
+		return
+	}
+	defer reader.Close()
+
+	if rng != nil && offset >= reader.Size {
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", reader.Size))
+		w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+		return
+	}
+	length := reader.Size - offset
+	if rng != nil && rng.length >= 0 && rng.length < length {
+		length = rng.length
+	}
+	w.Header().Set("Accept-Ranges", "bytes")
+	w.Header().Set("Content-Type", reader.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(length, 10))
+	if rng != nil {
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, offset+length-1, reader.Size))
+		w.WriteHeader(http.StatusPartialContent)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+	if _, err := io.CopyN(w, reader, length); err != nil {
+		// The headers are already sent, so the client can only tell from the short content.
+		glog.Errorf("Failed to download artifact %s. Error: %+v", r.URL.Path, err)
+	}
+}
+
+// parseByteRange parses a Range header with a single byte range, such as "bytes=0-99",
+// "bytes=100-" or "bytes=-100". It returns nil for a missing, malformed or multi-range header, in
+// which case the whole artifact is served.
+func parseByteRange(header string) *byteRange {
+	if !strings.HasPrefix(header, "bytes=") {
+		return nil
+	}
+	spec := strings.TrimPrefix(header, "bytes=")
+	if strings.Contains(spec, ",") {
+		return nil
+	}
+	dash := strings.Index(spec, "-")
+	if dash < 0 {
+		return nil
+	}
+	startString, endString := strings.TrimSpace(spec[:dash]), strings.TrimSpace(spec[dash+1:])
+	if startString == "" {
+		suffix, err := strconv.ParseInt(endString, 10, 64)
+		if err != nil || suffix <= 0 {
+			return nil
+		}
+		return &byteRange{start: -suffix, length: -1}
+	}
+	start, err := strconv.ParseInt(startString, 10, 64)
+	if err != nil || start < 0 {
+		return nil
+	}
+	if endString == "" {
+		return &byteRange{start: start, length: -1}
+	}
+	end, err := strconv.ParseInt(endString, 10, 64)
+	if err != nil || end < start {
+		return nil
+	}
+	return &byteRange{start: start, length: end - start + 1}
+}
+
+func writeArtifactErrorToResponse(w http.ResponseWriter, err error) {
+	writeHTTPErrorToResponse(w, "download artifact", err)
+}
+
+// writeHTTPErrorToResponse writes err as a JSON api.Error with the HTTP status of its gRPC code.
+func writeHTTPErrorToResponse(w http.ResponseWriter, action string, err error) {
+	glog.Errorf("Failed to %s. Error: %+v", action, err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(runtime.HTTPStatusFromCode(status.Code(util.ToGRPCError(err))))
+	errorResponse := api.Error{ErrorMessage: err.Error(), ErrorDetails: fmt.Sprintf("%+v", err)}
+	errBytes, err := json.Marshal(errorResponse)
+	if err != nil {
+		w.Write([]byte("Failed to " + action))
+	}
+	w.Write(errBytes)
+}
@@ -0,0 +1,178 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Util function to create an initial state with a run whose node-1 outputs artifact-1.
+func initWithArtifact(t *testing.T, content string) (*resource.FakeClientManager, *resource.ResourceManager, *model.RunDetail) {
+	clientManager, manager, runDetail := initWithOneTimeRun(t)
+	clientManager.ObjectStore().AddFile([]byte(content), "test/artifact.tgz")
+	workflow := util.NewWorkflow(&v1alpha1.Workflow{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "workflow-name",
+			Namespace: "ns1",
+			UID:       "workflow1",
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: runDetail.UUID},
+		},
+		Status: v1alpha1.WorkflowStatus{
+			Nodes: map[string]v1alpha1.NodeStatus{
+				"node-1": {
+					Outputs: &v1alpha1.Outputs{
+						Artifacts: []v1alpha1.Artifact{{
+							Name: "artifact-1",
+							ArtifactLocation: v1alpha1.ArtifactLocation{
+								S3: &v1alpha1.S3Artifact{Key: "test/artifact.tgz"},
+							},
+						}},
+					},
+				},
+			},
+		},
+	})
+	err := manager.ReportWorkflowResource(workflow)
+	assert.Nil(t, err)
+	return clientManager, manager, runDetail
+}
+
+func downloadArtifact(runServer *RunServer, runID string, query string, header http.Header) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("GET", "/apis/v1beta1/runs/"+runID+"/nodes/node-1/artifacts/artifact-1:download"+query, nil)
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	rr := httptest.NewRecorder()
+	notFound := http.NotFoundHandler()
+	ArtifactDownloadHandler(runServer, notFound).ServeHTTP(rr, req)
+	return rr
+}
+
+func TestDownloadArtifact(t *testing.T) {
+	clients, manager, runDetail := initWithArtifact(t, "0123456789")
+	defer clients.Close()
+	runServer := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+
+	rr := downloadArtifact(runServer, runDetail.UUID, "", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "0123456789", rr.Body.String())
+	assert.Equal(t, "10", rr.Header().Get("Content-Length"))
+	assert.Equal(t, "application/octet-stream", rr.Header().Get("Content-Type"))
+	assert.Equal(t, "bytes", rr.Header().Get("Accept-Ranges"))
+}
+
+func TestDownloadArtifact_Range(t *testing.T) {
+	clients, manager, runDetail := initWithArtifact(t, "0123456789")
+	defer clients.Close()
+	runServer := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+
+	tests := []struct {
+		rangeHeader  string
+		content      string
+		contentRange string
+	}{
+		{"bytes=2-4", "234", "bytes 2-4/10"},
+		{"bytes=7-", "789", "bytes 7-9/10"},
+		{"bytes=-2", "89", "bytes 8-9/10"},
+		{"bytes=8-100", "89", "bytes 8-9/10"},
+	}
+	for _, test := range tests {
+		rr := downloadArtifact(runServer, runDetail.UUID, "", http.Header{"Range": {test.rangeHeader}})
+		assert.Equal(t, http.StatusPartialContent, rr.Code, test.rangeHeader)
+		assert.Equal(t, test.content, rr.Body.String(), test.rangeHeader)
+		assert.Equal(t, test.contentRange, rr.Header().Get("Content-Range"), test.rangeHeader)
+	}
+}
+
+func TestDownloadArtifact_RangeNotSatisfiable(t *testing.T) {
+	clients, manager, runDetail := initWithArtifact(t, "0123456789")
+	defer clients.Close()
+	runServer := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+
+	rr := downloadArtifact(runServer, runDetail.UUID, "", http.Header{"Range": {"bytes=20-"}})
+	assert.Equal(t, http.StatusRequestedRangeNotSatisfiable, rr.Code)
+}
+
+func TestDownloadArtifact_Untar(t *testing.T) {
+	tarball, err := util.ArchiveTgz(map[string]string{"index.html": "<html></html>"})
+	assert.Nil(t, err)
+	clients, manager, runDetail := initWithArtifact(t, tarball)
+	defer clients.Close()
+	runServer := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+
+	rr := downloadArtifact(runServer, runDetail.UUID, "?untar=true", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "<html></html>", rr.Body.String())
+	assert.Equal(t, "13", rr.Header().Get("Content-Length"))
+	assert.Equal(t, "text/html; charset=utf-8", rr.Header().Get("Content-Type"))
+}
+
+func TestDownloadArtifact_NotFound(t *testing.T) {
+	clients, manager, _ := initWithArtifact(t, "0123456789")
+	defer clients.Close()
+	runServer := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+
+	rr := downloadArtifact(runServer, "unknown-run", "", nil)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestDownloadArtifact_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+
+	clients, manager, runDetail := initWithArtifact(t, "0123456789")
+	defer clients.Close()
+	runServer := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+
+	rr := downloadArtifact(runServer, runDetail.UUID, "", nil)
+	// Same status as the gRPC gateway returns for a BadRequestError.
+	assert.Equal(t, http.StatusConflict, rr.Code)
+	assert.Contains(t, rr.Body.String(), "there is no user identity header")
+}
+
+func TestDownloadArtifact_Authorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+
+	clients, manager, runDetail := initWithArtifact(t, "0123456789")
+	defer clients.Close()
+	runServer := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+
+	header := http.Header{}
+	header.Set(common.GoogleIAPUserIdentityHeader, common.GoogleIAPUserIdentityPrefix+"user@google.com")
+	rr := downloadArtifact(runServer, runDetail.UUID, "", header)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "0123456789", rr.Body.String())
+}
+
+func TestArtifactDownloadHandler_PassesOtherRequests(t *testing.T) {
+	clients, manager, _ := initWithArtifact(t, "0123456789")
+	defer clients.Close()
+	runServer := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+
+	req, _ := http.NewRequest("GET", "/apis/v1beta1/runs/run-1/nodes/node-1/artifacts/artifact-1:read", nil)
+	rr := httptest.NewRecorder()
+	passed := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { passed = true })
+	ArtifactDownloadHandler(runServer, next).ServeHTTP(rr, req)
+	assert.True(t, passed)
+}
+
+func TestParseByteRange(t *testing.T) {
+	assert.Equal(t, &byteRange{start: 0, length: 100}, parseByteRange("bytes=0-99"))
+	assert.Equal(t, &byteRange{start: 100, length: -1}, parseByteRange("bytes=100-"))
+	assert.Equal(t, &byteRange{start: -100, length: -1}, parseByteRange("bytes=-100"))
+	assert.Nil(t, parseByteRange(""))
+	assert.Nil(t, parseByteRange("bytes=0-1,5-6"))
+	assert.Nil(t, parseByteRange("bytes=5-3"))
+	assert.Nil(t, parseByteRange("items=0-1"))
+}
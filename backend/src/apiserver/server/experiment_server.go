@@ -47,6 +47,11 @@ var (
 		Help: "The total number of UnarchiveExperiment requests",
 	})
 
+	updateExperimentLabelsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "experiment_server_update_labels_requests",
+		Help: "The total number of UpdateExperimentLabels requests",
+	})
+
 	// TODO(jingzhang36): error count and success count.
 
 	experimentCount = promauto.NewGauge(prometheus.GaugeOpts{
@@ -123,7 +128,26 @@ func (s *ExperimentServer) ListExperiment(ctx context.Context, request *api.List
 		return nil, util.Wrap(err, "Failed to create list options")
 	}
 
-	filterContext, err := ValidateFilter(request.ResourceReferenceKey)
+	filterContext, err := authorizedListExperimentsFilterContext(s.resourceManager, ctx, request.ResourceReferenceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	experiments, total_size, nextPageToken, err := s.resourceManager.ListExperiments(filterContext, opts)
+	if err != nil {
+		return nil, util.Wrap(err, "List experiments failed.")
+	}
+	return &api.ListExperimentsResponse{
+			Experiments:   ToApiExperiments(experiments),
+			TotalSize:     int32(total_size),
+			NextPageToken: nextPageToken},
+		nil
+}
+
+// authorizedListExperimentsFilterContext validates the namespace experiments are listed in, and
+// checks that the caller can access it in multi-user mode.
+func authorizedListExperimentsFilterContext(resourceManager *resource.ResourceManager, ctx context.Context, referenceKey *api.ResourceKey) (*common.FilterContext, error) {
+	filterContext, err := ValidateFilter(referenceKey)
 	if err != nil {
 		return nil, util.Wrap(err, "Validating filter failed.")
 	}
@@ -137,7 +161,7 @@ func (s *ExperimentServer) ListExperiment(ctx context.Context, request *api.List
 		if len(namespace) == 0 {
 			return nil, util.NewInvalidInputError("Invalid resource references for experiment. Namespace is empty.")
 		}
-		err = isAuthorized(s.resourceManager, ctx, namespace)
+		err = isAuthorized(resourceManager, ctx, namespace)
 		if err != nil {
 			return nil, util.Wrap(err, "Failed to authorize with API resource references")
 		}
@@ -150,16 +174,7 @@ func (s *ExperimentServer) ListExperiment(ctx context.Context, request *api.List
 			ReferenceKey: &common.ReferenceKey{Type: common.Namespace, ID: ""},
 		}
 	}
-
-	experiments, total_size, nextPageToken, err := s.resourceManager.ListExperiments(filterContext, opts)
-	if err != nil {
-		return nil, util.Wrap(err, "List experiments failed.")
-	}
-	return &api.ListExperimentsResponse{
-			Experiments:   ToApiExperiments(experiments),
-			TotalSize:     int32(total_size),
-			NextPageToken: nextPageToken},
-		nil
+	return filterContext, nil
 }
 
 func (s *ExperimentServer) DeleteExperiment(ctx context.Context, request *api.DeleteExperimentRequest) (*empty.Empty, error) {
@@ -203,7 +218,7 @@ func ValidateCreateExperimentRequest(request *api.CreateExperimentRequest) error
 	} else if len(resourceReferences) > 0 {
 		return util.NewInvalidInputError("In single-user mode, CreateExperimentRequest shouldn't contain resource references.")
 	}
-	return nil
+	return ValidateLabels(request.Experiment.Labels)
 }
 
 // TODO(chensun): consider refactoring the code to get rid of double-query of experiment.
@@ -259,6 +274,26 @@ func (s *ExperimentServer) UnarchiveExperiment(ctx context.Context, request *api
 	return &empty.Empty{}, nil
 }
 
+func (s *ExperimentServer) UpdateExperimentLabels(ctx context.Context, request *api.UpdateExperimentLabelsRequest) (*api.Experiment, error) {
+	if s.options.CollectMetrics {
+		updateExperimentLabelsRequests.Inc()
+	}
+
+	err := ValidateLabels(request.Labels)
+	if err != nil {
+		return nil, err
+	}
+	err = s.canAccessExperiment(ctx, request.Id)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the requests.")
+	}
+	experiment, err := s.resourceManager.UpdateExperimentLabels(request.Id, request.Labels)
+	if err != nil {
+		return nil, util.Wrap(err, "Update experiment labels failed.")
+	}
+	return ToApiExperiment(experiment), nil
+}
+
 func NewExperimentServer(resourceManager *resource.ResourceManager, options *ExperimentServerOptions) *ExperimentServer {
 	return &ExperimentServer{resourceManager: resourceManager, options: options}
 }
@@ -606,3 +606,36 @@ func TestArchiveAndUnarchiveExperiment(t *testing.T) {
 	assert.Equal(t, 1, len(jobs.Jobs))
 	assert.Equal(t, false, jobs.Jobs[0].Enabled)
 }
+
+func TestUpdateExperimentLabels(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	resourceManager := resource.NewResourceManager(clientManager)
+	server := ExperimentServer{resourceManager: resourceManager, options: &ExperimentServerOptions{CollectMetrics: false}}
+	experiment := &api.Experiment{Name: "ex1", Labels: map[string]string{"team": "a"}}
+	result, err := server.CreateExperiment(nil, &api.CreateExperimentRequest{Experiment: experiment})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "a"}, result.Labels)
+
+	result, err = server.UpdateExperimentLabels(nil, &api.UpdateExperimentLabelsRequest{
+		Id:     result.Id,
+		Labels: map[string]string{"team": "b", "stage": "prod"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "b", "stage": "prod"}, result.Labels)
+
+	result, err = server.GetExperiment(nil, &api.GetExperimentRequest{Id: result.Id})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "b", "stage": "prod"}, result.Labels)
+}
+
+func TestUpdateExperimentLabels_InvalidLabel(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	resourceManager := resource.NewResourceManager(clientManager)
+	server := ExperimentServer{resourceManager: resourceManager, options: &ExperimentServerOptions{CollectMetrics: false}}
+	_, err := server.UpdateExperimentLabels(nil, &api.UpdateExperimentLabelsRequest{
+		Id:     resource.DefaultFakeUUID,
+		Labels: map[string]string{"": "b"},
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Label key is empty")
+}
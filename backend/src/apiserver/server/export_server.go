@@ -0,0 +1,396 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/golang/glog"
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	// The query string keys of an export request. Except for the format, they match the query string
+	// of the ListRuns and ListExperiment requests.
+	ExportFormatQueryStringKey     = "format"
+	sortByQueryStringKey           = "sort_by"
+	filterQueryStringKey           = "filter"
+	referenceKeyTypeQueryStringKey = "resource_reference_key.type"
+	referenceKeyIDQueryStringKey   = "resource_reference_key.id"
+
+	exportFormatCSV     = "csv"
+	exportFormatParquet = "parquet"
+
+	// The page size resources are listed with while they are exported.
+	exportPageSize = maxPageSize
+
+	// The prefixes of the columns runs' parameters and metrics are flattened into.
+	parameterColumnPrefix = "parameters."
+	metricColumnPrefix    = "metrics."
+)
+
+// Metric variables. Please prefix the metric names with export_server_.
+var (
+	exportRunsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "export_server_export_runs_requests",
+		Help: "The number of run export requests",
+	})
+
+	exportExperimentsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "export_server_export_experiments_requests",
+		Help: "The number of experiment export requests",
+	})
+)
+
+// The columns of an exported run, before the columns of its parameters and metrics.
+var runExportColumns = []tableColumn{
+	{"id", stringColumn},
+	{"name", stringColumn},
+	{"description", stringColumn},
+	{"status", stringColumn},
+	{"storage_state", stringColumn},
+	{"namespace", stringColumn},
+	{"created_at", timestampColumn},
+	{"scheduled_at", timestampColumn},
+	{"finished_at", timestampColumn},
+	{"experiment_id", stringColumn},
+	{"pipeline_id", stringColumn},
+	{"pipeline_name", stringColumn},
+	{"pipeline_version_id", stringColumn},
+	{"pipeline_version_name", stringColumn},
+}
+
+var experimentExportColumns = []tableColumn{
+	{"id", stringColumn},
+	{"name", stringColumn},
+	{"description", stringColumn},
+	{"storage_state", stringColumn},
+	{"namespace", stringColumn},
+	{"created_at", timestampColumn},
+}
+
+type ExportServerOptions struct {
+	CollectMetrics bool
+}
+
+// ExportServer streams the runs or experiments a ListRuns or ListExperiment request would list as
+// a table, for analysis in other tools. This endpoint is HTTP only, since its responses aren't JSON.
+type ExportServer struct {
+	resourceManager *resource.ResourceManager
+	options         *ExportServerOptions
+}
+
+// ExportRuns exports the runs matching the ListRuns query string as CSV or Parquet, with a row per
+// run and a column per parameter and metric. The runs are listed a page at a time twice: first to
+// find the parameter and metric columns, then to write the rows. Parameters and metrics first seen
+// in the second pass, like those of runs created in between, aren't exported. The metrics are keyed
+// like in the comparison of runs, see toRunMetricValues.
+func (s *ExportServer) ExportRuns(w http.ResponseWriter, r *http.Request) {
+	if s.options.CollectMetrics {
+		exportRunsRequests.Inc()
+	}
+
+	query := r.URL.Query()
+	format, err := parseExportFormat(query)
+	if err != nil {
+		writeHTTPErrorToResponse(w, "export runs", err)
+		return
+	}
+	referenceKey, err := parseExportReferenceKey(query)
+	if err != nil {
+		writeHTTPErrorToResponse(w, "export runs", err)
+		return
+	}
+	filterContext, err := authorizedListRunsFilterContext(s.resourceManager, httpRequestContext(r), referenceKey)
+	if err != nil {
+		writeHTTPErrorToResponse(w, "export runs", err)
+		return
+	}
+
+	parameterNames := make(map[string]bool)
+	metricNames := make(map[string]bool)
+	err = s.listRunPages(filterContext, query, func(runs []*model.Run) error {
+		for _, run := range runs {
+			params, err := toApiParameters(run.Parameters)
+			if err != nil {
+				return util.Wrapf(err, "Failed to export run %s", run.UUID)
+			}
+			for _, param := range params {
+				parameterNames[param.Name] = true
+			}
+			for name := range toRunMetricValues(run.Metrics) {
+				metricNames[name] = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		writeHTTPErrorToResponse(w, "export runs", err)
+		return
+	}
+
+	columns := append([]tableColumn{}, runExportColumns...)
+	sortedParameterNames := sortedKeys(parameterNames)
+	for _, name := range sortedParameterNames {
+		columns = append(columns, tableColumn{parameterColumnPrefix + name, stringColumn})
+	}
+	sortedMetricNames := sortedKeys(metricNames)
+	for _, name := range sortedMetricNames {
+		columns = append(columns, tableColumn{metricColumnPrefix + name, doubleColumn})
+	}
+	writer, err := newExportTableWriter(w, format, "runs", columns)
+	if err != nil {
+		writeHTTPErrorToResponse(w, "export runs", err)
+		return
+	}
+	err = s.listRunPages(filterContext, query, func(runs []*model.Run) error {
+		rows := make([]tableRow, 0, len(runs))
+		for _, run := range runs {
+			row, err := toRunExportRow(run, sortedParameterNames, sortedMetricNames)
+			if err != nil {
+				return util.Wrapf(err, "Failed to export run %s", run.UUID)
+			}
+			rows = append(rows, row)
+		}
+		return writer.WriteRows(rows)
+	})
+	if err == nil {
+		err = writer.Close()
+	}
+	if err != nil {
+		// The response is already partially written, so the error can only be logged. A Parquet file
+		// without its footer is invalid, and a CSV file is missing rows.
+		glog.Errorf("Failed to export runs. Error: %+v", err)
+	}
+}
+
+// ExportExperiments exports the experiments matching the ListExperiment query string as CSV or Parquet.
+func (s *ExportServer) ExportExperiments(w http.ResponseWriter, r *http.Request) {
+	if s.options.CollectMetrics {
+		exportExperimentsRequests.Inc()
+	}
+
+	query := r.URL.Query()
+	format, err := parseExportFormat(query)
+	if err != nil {
+		writeHTTPErrorToResponse(w, "export experiments", err)
+		return
+	}
+	referenceKey, err := parseExportReferenceKey(query)
+	if err != nil {
+		writeHTTPErrorToResponse(w, "export experiments", err)
+		return
+	}
+	filterContext, err := authorizedListExperimentsFilterContext(s.resourceManager, httpRequestContext(r), referenceKey)
+	if err != nil {
+		writeHTTPErrorToResponse(w, "export experiments", err)
+		return
+	}
+	opts, err := validatedListOptions(&model.Experiment{}, "", exportPageSize, query.Get(sortByQueryStringKey), query.Get(filterQueryStringKey))
+	if err != nil {
+		writeHTTPErrorToResponse(w, "export experiments", util.Wrap(err, "Failed to create list options"))
+		return
+	}
+	experiments, _, nextPageToken, err := s.resourceManager.ListExperiments(filterContext, opts)
+	if err != nil {
+		writeHTTPErrorToResponse(w, "export experiments", util.Wrap(err, "List experiments failed."))
+		return
+	}
+
+	writer, err := newExportTableWriter(w, format, "experiments", experimentExportColumns)
+	if err != nil {
+		writeHTTPErrorToResponse(w, "export experiments", err)
+		return
+	}
+	for {
+		rows := make([]tableRow, 0, len(experiments))
+		for _, experiment := range experiments {
+			rows = append(rows, toExperimentExportRow(experiment))
+		}
+		if err = writer.WriteRows(rows); err != nil || nextPageToken == "" {
+			break
+		}
+		opts, err = validatedListOptions(&model.Experiment{}, nextPageToken, exportPageSize, "", "")
+		if err != nil {
+			break
+		}
+		experiments, _, nextPageToken, err = s.resourceManager.ListExperiments(filterContext, opts)
+		if err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = writer.Close()
+	}
+	if err != nil {
+		glog.Errorf("Failed to export experiments. Error: %+v", err)
+	}
+}
+
+// listRunPages lists the runs matching the sort_by and filter of the query a page at a time.
+func (s *ExportServer) listRunPages(filterContext *common.FilterContext, query url.Values, handle func([]*model.Run) error) error {
+	opts, err := validatedListOptions(&model.Run{}, "", exportPageSize, query.Get(sortByQueryStringKey), query.Get(filterQueryStringKey))
+	if err != nil {
+		return util.Wrap(err, "Failed to create list options")
+	}
+	for {
+		runs, _, nextPageToken, err := s.resourceManager.ListRuns(filterContext, opts)
+		if err != nil {
+			return util.Wrap(err, "Failed to list runs.")
+		}
+		if err := handle(runs); err != nil {
+			return err
+		}
+		if nextPageToken == "" {
+			return nil
+		}
+		opts, err = validatedListOptions(&model.Run{}, nextPageToken, exportPageSize, "", "")
+		if err != nil {
+			return util.Wrap(err, "Failed to create list options")
+		}
+	}
+}
+
+func parseExportFormat(query url.Values) (string, error) {
+	format := strings.ToLower(query.Get(ExportFormatQueryStringKey))
+	switch format {
+	case "", exportFormatCSV:
+		return exportFormatCSV, nil
+	case exportFormatParquet:
+		return exportFormatParquet, nil
+	default:
+		return "", util.NewInvalidInputError("Unsupported export format %q. Supported formats are %s and %s.",
+			format, exportFormatCSV, exportFormatParquet)
+	}
+}
+
+// parseExportReferenceKey parses the resource reference key of the query string, which the type can
+// be the name or the number of.
+func parseExportReferenceKey(query url.Values) (*api.ResourceKey, error) {
+	keyType := query.Get(referenceKeyTypeQueryStringKey)
+	keyID := query.Get(referenceKeyIDQueryStringKey)
+	if keyType == "" && keyID == "" {
+		return nil, nil
+	}
+	resourceType, ok := api.ResourceType_value[strings.ToUpper(keyType)]
+	if !ok {
+		number, err := strconv.Atoi(keyType)
+		if err != nil {
+			return nil, util.NewInvalidInputError("Unrecognized resource reference type %q.", keyType)
+		}
+		resourceType = int32(number)
+	}
+	return &api.ResourceKey{Type: api.ResourceType(resourceType), Id: keyID}, nil
+}
+
+// newExportTableWriter sets the headers of the response for the format, and returns the writer of
+// its table.
+func newExportTableWriter(w http.ResponseWriter, format string, name string, columns []tableColumn) (tableWriter, error) {
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, name, format))
+	if format == exportFormatParquet {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		return newParquetTableWriter(w, columns)
+	}
+	w.Header().Set("Content-Type", "text/csv")
+	return newCSVTableWriter(w, columns)
+}
+
+func toRunExportRow(run *model.Run, parameterNames []string, metricNames []string) (tableRow, error) {
+	params, err := toApiParameters(run.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	var pipelineVersionID, pipelineVersionName string
+	for _, ref := range run.ResourceReferences {
+		if ref.ReferenceType == common.PipelineVersion {
+			pipelineVersionID, pipelineVersionName = ref.ReferenceUUID, ref.ReferenceName
+		}
+	}
+	row := tableRow{
+		run.UUID,
+		nullableString(run.DisplayName),
+		nullableString(run.Description),
+		nullableString(run.Conditions),
+		nullableString(run.StorageState),
+		nullableString(run.Namespace),
+		nullableTimestamp(run.CreatedAtInSec),
+		nullableTimestamp(run.ScheduledAtInSec),
+		nullableTimestamp(run.FinishedAtInSec),
+		nullableString(run.ExperimentUUID),
+		nullableString(run.PipelineId),
+		nullableString(run.PipelineName),
+		nullableString(pipelineVersionID),
+		nullableString(pipelineVersionName),
+	}
+	paramValues := make(map[string]string)
+	for _, param := range params {
+		paramValues[param.Name] = param.Value
+	}
+	for _, name := range parameterNames {
+		if value, ok := paramValues[name]; ok {
+			row = append(row, value)
+		} else {
+			row = append(row, nil)
+		}
+	}
+	metricValues := toRunMetricValues(run.Metrics)
+	for _, name := range metricNames {
+		if value, ok := metricValues[name]; ok {
+			row = append(row, value)
+		} else {
+			row = append(row, nil)
+		}
+	}
+	return row, nil
+}
+
+func toExperimentExportRow(experiment *model.Experiment) tableRow {
+	return tableRow{
+		experiment.UUID,
+		nullableString(experiment.Name),
+		nullableString(experiment.Description),
+		nullableString(experiment.StorageState),
+		nullableString(experiment.Namespace),
+		nullableTimestamp(experiment.CreatedAtInSec),
+	}
+}
+
+func nullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+func nullableTimestamp(seconds int64) interface{} {
+	if seconds == 0 {
+		return nil
+	}
+	return seconds
+}
+
+func NewExportServer(resourceManager *resource.ResourceManager, options *ExportServerOptions) *ExportServer {
+	return &ExportServer{resourceManager: resourceManager, options: options}
+}
@@ -0,0 +1,161 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func exportRuns(exportServer *ExportServer, query url.Values) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("GET", "/apis/v1beta1/runs:export?"+query.Encode(), nil)
+	rr := httptest.NewRecorder()
+	exportServer.ExportRuns(rr, req)
+	return rr
+}
+
+func exportExperiments(exportServer *ExportServer, query url.Values) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("GET", "/apis/v1beta1/experiments:export?"+query.Encode(), nil)
+	rr := httptest.NewRecorder()
+	exportServer.ExportExperiments(rr, req)
+	return rr
+}
+
+func TestExportRuns_CSV(t *testing.T) {
+	clientManager, runServer, runDetail, otherRunDetail := initWithComparedRuns(t)
+	defer clientManager.Close()
+	exportServer := NewExportServer(runServer.resourceManager, &ExportServerOptions{CollectMetrics: false})
+
+	rr := exportRuns(exportServer, url.Values{
+		"resource_reference_key.type": {"EXPERIMENT"},
+		"resource_reference_key.id":   {runDetail.ExperimentUUID},
+	})
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "text/csv", rr.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="runs.csv"`, rr.Header().Get("Content-Disposition"))
+	records, err := csv.NewReader(rr.Body).ReadAll()
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{
+		{"id", "name", "description", "status", "storage_state", "namespace", "created_at", "scheduled_at",
+			"finished_at", "experiment_id", "pipeline_id", "pipeline_name", "pipeline_version_id",
+			"pipeline_version_name", "parameters.param1", "metrics.loss", "metrics.node-1/accuracy", "metrics.node-2/accuracy"},
+		{runDetail.UUID, "run1", "", runDetail.Conditions, runDetail.StorageState, runDetail.Namespace,
+			time.Unix(runDetail.CreatedAtInSec, 0).UTC().Format(time.RFC3339), "", "", runDetail.ExperimentUUID, "", "", "", "", "world", "", "0.7", "0.8"},
+		{otherRunDetail.UUID, "run2", "", otherRunDetail.Conditions, otherRunDetail.StorageState, otherRunDetail.Namespace,
+			time.Unix(otherRunDetail.CreatedAtInSec, 0).UTC().Format(time.RFC3339), "", "", otherRunDetail.ExperimentUUID, "", "", "", "", "hello", "0.25", "", ""},
+	}, records)
+}
+
+func TestExportRuns_Filter(t *testing.T) {
+	clientManager, runServer, _, otherRunDetail := initWithComparedRuns(t)
+	defer clientManager.Close()
+	exportServer := NewExportServer(runServer.resourceManager, &ExportServerOptions{CollectMetrics: false})
+
+	rr := exportRuns(exportServer, url.Values{
+		"filter": {`{"predicates": [{"key": "name", "op": "EQUALS", "string_value": "run2"}]}`},
+	})
+	assert.Equal(t, http.StatusOK, rr.Code)
+	records, err := csv.NewReader(rr.Body).ReadAll()
+	assert.Nil(t, err)
+	assert.Len(t, records, 2)
+	assert.Equal(t, otherRunDetail.UUID, records[1][0])
+	// Only the parameters and metrics of the exported runs are columns.
+	assert.Equal(t, []string{"parameters.param1", "metrics.loss"}, records[0][len(runExportColumns):])
+}
+
+func TestExportRuns_Parquet(t *testing.T) {
+	clientManager, runServer, _, _ := initWithComparedRuns(t)
+	defer clientManager.Close()
+	exportServer := NewExportServer(runServer.resourceManager, &ExportServerOptions{CollectMetrics: false})
+
+	rr := exportRuns(exportServer, url.Values{"format": {"parquet"}})
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/octet-stream", rr.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="runs.parquet"`, rr.Header().Get("Content-Disposition"))
+	data := rr.Body.Bytes()
+	assert.Equal(t, parquetMagic, string(data[:4]))
+	assert.Equal(t, parquetMagic, string(data[len(data)-4:]))
+}
+
+func TestExportRuns_InvalidFormat(t *testing.T) {
+	clientManager, runServer, _, _ := initWithComparedRuns(t)
+	defer clientManager.Close()
+	exportServer := NewExportServer(runServer.resourceManager, &ExportServerOptions{CollectMetrics: false})
+
+	rr := exportRuns(exportServer, url.Values{"format": {"xlsx"}})
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "Unsupported export format")
+}
+
+func TestExportRuns_MultiUserRequiresReference(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clientManager, runServer, _, _ := initWithComparedRuns(t)
+	defer clientManager.Close()
+	exportServer := NewExportServer(runServer.resourceManager, &ExportServerOptions{CollectMetrics: false})
+
+	rr := exportRuns(exportServer, url.Values{})
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "ListRuns must filter by resource reference in multi-user mode.")
+}
+
+func TestExportExperiments_CSV(t *testing.T) {
+	clientManager, resourceManager, experiment := initWithExperiment(t)
+	defer clientManager.Close()
+	exportServer := NewExportServer(resourceManager, &ExportServerOptions{CollectMetrics: false})
+
+	rr := exportExperiments(exportServer, url.Values{})
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, `attachment; filename="experiments.csv"`, rr.Header().Get("Content-Disposition"))
+	records, err := csv.NewReader(rr.Body).ReadAll()
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{
+		{"id", "name", "description", "storage_state", "namespace", "created_at"},
+		{experiment.UUID, experiment.Name, experiment.Description, experiment.StorageState, "",
+			time.Unix(experiment.CreatedAtInSec, 0).UTC().Format(time.RFC3339)},
+	}, records)
+}
+
+func TestParseExportReferenceKey(t *testing.T) {
+	key, err := parseExportReferenceKey(url.Values{})
+	assert.Nil(t, err)
+	assert.Nil(t, key)
+
+	key, err = parseExportReferenceKey(url.Values{
+		"resource_reference_key.type": {"namespace"},
+		"resource_reference_key.id":   {"ns1"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "NAMESPACE", key.Type.String())
+	assert.Equal(t, "ns1", key.Id)
+
+	key, err = parseExportReferenceKey(url.Values{
+		"resource_reference_key.type": {"1"},
+		"resource_reference_key.id":   {"e1"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "EXPERIMENT", key.Type.String())
+
+	_, err = parseExportReferenceKey(url.Values{"resource_reference_key.type": {"PIPELINE_SPEC"}})
+	AssertUserError(t, err, 3)
+}
@@ -16,8 +16,11 @@ package server
 
 import (
 	"context"
+	"time"
 
+	workflowapi "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
@@ -62,6 +65,36 @@ var (
 		Help: "The total number of EnableJob requests",
 	})
 
+	updateJobLabelsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "job_server_update_labels_requests",
+		Help: "The total number of UpdateJobLabels requests",
+	})
+
+	updateJobRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "job_server_update_requests",
+		Help: "The total number of UpdateJob requests",
+	})
+
+	triggerJobRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "job_server_trigger_requests",
+		Help: "The total number of TriggerJob requests",
+	})
+
+	previewScheduleRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "job_server_preview_schedule_requests",
+		Help: "The total number of PreviewSchedule requests",
+	})
+
+	backfillJobRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "job_server_backfill_requests",
+		Help: "The total number of BackfillJob requests",
+	})
+
+	cancelJobBackfillRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "job_server_cancel_backfill_requests",
+		Help: "The total number of CancelJobBackfill requests",
+	})
+
 	// TODO(jingzhang36): error count and success count.
 
 	jobCount = promauto.NewGauge(prometheus.GaugeOpts{
@@ -70,6 +103,11 @@ var (
 	})
 )
 
+const (
+	defaultPreviewScheduleCount = 10
+	maxPreviewScheduleCount     = 100
+)
+
 type JobServerOptions struct {
 	CollectMetrics bool
 }
@@ -221,9 +259,145 @@ func (s *JobServer) DeleteJob(ctx context.Context, request *api.DeleteJobRequest
 	return &empty.Empty{}, nil
 }
 
+func (s *JobServer) UpdateJob(ctx context.Context, request *api.UpdateJobRequest) (*api.Job, error) {
+	if s.options.CollectMetrics {
+		updateJobRequests.Inc()
+	}
+
+	if request.Job == nil {
+		return nil, util.NewInvalidInputError("The job to update must be set.")
+	}
+	err := s.validateJob(request.Job)
+	if err != nil {
+		return nil, util.Wrap(err, "Validate update job request failed.")
+	}
+	err = s.canAccessJob(ctx, request.Id)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request.")
+	}
+
+	job, err := s.resourceManager.UpdateJob(request.Id, request.Job)
+	if err != nil {
+		return nil, util.Wrap(err, "Update job failed.")
+	}
+	return ToApiJob(job), nil
+}
+
+func (s *JobServer) UpdateJobLabels(ctx context.Context, request *api.UpdateJobLabelsRequest) (*api.Job, error) {
+	if s.options.CollectMetrics {
+		updateJobLabelsRequests.Inc()
+	}
+
+	err := ValidateLabels(request.Labels)
+	if err != nil {
+		return nil, err
+	}
+	err = s.canAccessJob(ctx, request.Id)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request.")
+	}
+	job, err := s.resourceManager.UpdateJobLabels(request.Id, request.Labels)
+	if err != nil {
+		return nil, util.Wrap(err, "Update job labels failed.")
+	}
+	return ToApiJob(job), nil
+}
+
+func (s *JobServer) PreviewSchedule(ctx context.Context, request *api.PreviewScheduleRequest) (*api.PreviewScheduleResponse, error) {
+	if s.options.CollectMetrics {
+		previewScheduleRequests.Inc()
+	}
+
+	if request.Trigger.GetCronSchedule() == nil && request.Trigger.GetPeriodicSchedule() == nil {
+		return nil, util.NewInvalidInputError("The trigger must have a cron schedule or a periodic schedule.")
+	}
+	if err := validateTrigger(request.Trigger); err != nil {
+		return nil, util.Wrap(err, "Validate preview schedule request failed.")
+	}
+	count := int(request.Count)
+	if count == 0 {
+		count = defaultPreviewScheduleCount
+	}
+	if count < 0 || count > maxPreviewScheduleCount {
+		return nil, util.NewInvalidInputError(
+			"The count of trigger times is out of range. Support 1-%v. Received %v.", maxPreviewScheduleCount, request.Count)
+	}
+
+	epochs := s.resourceManager.PreviewSchedule(request.Trigger, request.NoCatchup, count)
+	triggerTimes := make([]*timestamp.Timestamp, 0, len(epochs))
+	for _, epoch := range epochs {
+		triggerTimes = append(triggerTimes, &timestamp.Timestamp{Seconds: epoch})
+	}
+	return &api.PreviewScheduleResponse{TriggerTimes: triggerTimes}, nil
+}
+
+func (s *JobServer) TriggerJob(ctx context.Context, request *api.TriggerJobRequest) (*empty.Empty, error) {
+	if s.options.CollectMetrics {
+		triggerJobRequests.Inc()
+	}
+
+	err := s.canAccessJob(ctx, request.Id)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request.")
+	}
+
+	err = s.resourceManager.TriggerJob(request.Id)
+	if err != nil {
+		return nil, util.Wrap(err, "Trigger job failed.")
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *JobServer) BackfillJob(ctx context.Context, request *api.BackfillJobRequest) (*api.Backfill, error) {
+	if s.options.CollectMetrics {
+		backfillJobRequests.Inc()
+	}
+
+	if request.StartTime == nil || request.EndTime == nil {
+		return nil, util.NewInvalidInputError("The start time and the end time of the backfill must be set.")
+	}
+	if request.StartTime.Seconds >= request.EndTime.Seconds {
+		return nil, util.NewInvalidInputError("The start time of the backfill must be before its end time.")
+	}
+	err := s.canAccessJob(ctx, request.Id)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request.")
+	}
+
+	job, err := s.resourceManager.BackfillJob(request.Id, request.StartTime.Seconds, request.EndTime.Seconds)
+	if err != nil {
+		return nil, util.Wrap(err, "Backfill job failed.")
+	}
+	return toApiBackfill(job.Backfill), nil
+}
+
+func (s *JobServer) CancelJobBackfill(ctx context.Context, request *api.CancelJobBackfillRequest) (*empty.Empty, error) {
+	if s.options.CollectMetrics {
+		cancelJobBackfillRequests.Inc()
+	}
+
+	err := s.canAccessJob(ctx, request.Id)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request.")
+	}
+
+	err = s.resourceManager.CancelJobBackfill(request.Id)
+	if err != nil {
+		return nil, util.Wrap(err, "Cancel job backfill failed.")
+	}
+	return &empty.Empty{}, nil
+}
+
 func (s *JobServer) validateCreateJobRequest(request *api.CreateJobRequest) error {
-	job := request.Job
+	if err := s.validateJob(request.Job); err != nil {
+		return err
+	}
+	return ValidateLabels(request.Job.Labels)
+}
 
+// validateJob validates the pipeline, max concurrency, failure policy,
+// blackout windows and trigger of a job.
+func (s *JobServer) validateJob(job *api.Job) error {
 	if err := ValidatePipelineSpec(s.resourceManager, job.PipelineSpec); err != nil {
 		if _, errResourceReference := CheckPipelineVersionReference(s.resourceManager, job.ResourceReferences); errResourceReference != nil {
 			return util.Wrap(err, "Neither pipeline spec nor pipeline version is valid."+errResourceReference.Error())
@@ -233,19 +407,74 @@ func (s *JobServer) validateCreateJobRequest(request *api.CreateJobRequest) erro
 	if job.MaxConcurrency > 10 || job.MaxConcurrency < 1 {
 		return util.NewInvalidInputError("The max concurrency of the job is out of range. Support 1-10. Received %v.", job.MaxConcurrency)
 	}
-	if job.Trigger != nil && job.Trigger.GetCronSchedule() != nil {
-		if _, err := cron.Parse(job.Trigger.GetCronSchedule().Cron); err != nil {
+	// Only the failed runs of the history of the job count, which keeps the
+	// last 10 runs.
+	if job.MaxConsecutiveFailures > 10 || job.MaxConsecutiveFailures < 0 {
+		return util.NewInvalidInputError("The max consecutive failures of the job is out of range. Support 0-10. Received %v.", job.MaxConsecutiveFailures)
+	}
+	if err := validateTimeWindows(job.BlackoutWindows); err != nil {
+		return err
+	}
+	return validateTrigger(job.Trigger)
+}
+
+func validateTimeWindows(windows []*api.TimeWindow) error {
+	for _, window := range windows {
+		if window.GetStartTime() == nil || window.GetEndTime() == nil {
+			return util.NewInvalidInputError("A blackout window must have a start time and an end time.")
+		}
+		if window.GetStartTime().GetSeconds() >= window.GetEndTime().GetSeconds() {
+			return util.NewInvalidInputError(
+				"The start time of a blackout window must be before its end time. Received %v and %v.",
+				window.GetStartTime().GetSeconds(), window.GetEndTime().GetSeconds())
+		}
+	}
+	return nil
+}
+
+func validateTrigger(trigger *api.Trigger) error {
+	if trigger != nil && trigger.GetCronSchedule() != nil {
+		if _, err := cron.Parse(trigger.GetCronSchedule().Cron); err != nil {
 			return util.NewInvalidInputError(
 				"Schedule cron is not a supported format(https://godoc.org/github.com/robfig/cron). Error: %v", err)
 		}
+		if timeZone := trigger.GetCronSchedule().TimeZone; timeZone != "" {
+			// Local would depend on the time zone of the controller.
+			if _, err := time.LoadLocation(timeZone); err != nil || timeZone == "Local" {
+				return util.NewInvalidInputError(
+					"Schedule time zone %q is not a valid IANA time zone, such as America/New_York.", timeZone)
+			}
+		}
 	}
-	if job.Trigger != nil && job.Trigger.GetPeriodicSchedule() != nil {
-		periodicScheduleInterval := job.Trigger.GetPeriodicSchedule().IntervalSecond
+	if trigger != nil && trigger.GetPeriodicSchedule() != nil {
+		periodicScheduleInterval := trigger.GetPeriodicSchedule().IntervalSecond
 		if periodicScheduleInterval < 1 {
 			return util.NewInvalidInputError(
 				"Found invalid period schedule interval %v. Set at interval to least 1 second.", periodicScheduleInterval)
 		}
 	}
+	if trigger != nil && trigger.GetObjectStoreEvent() != nil {
+		event := trigger.GetObjectStoreEvent()
+		if event.Bucket == "" {
+			return util.NewInvalidInputError("The object store event trigger must have a bucket.")
+		}
+		if event.KeyParameter == "" {
+			return util.NewInvalidInputError("The object store event trigger must have a key parameter.")
+		}
+	}
+	if trigger != nil && trigger.GetRunCompletion() != nil {
+		runCompletion := trigger.GetRunCompletion()
+		if (runCompletion.JobId == "") == (runCompletion.ExperimentId == "") {
+			return util.NewInvalidInputError("The run completion trigger must have exactly one of a job ID and an experiment ID.")
+		}
+		for _, state := range runCompletion.States {
+			if state != string(workflowapi.NodeSucceeded) && state != string(workflowapi.NodeFailed) &&
+				state != string(workflowapi.NodeError) {
+				return util.NewInvalidInputError(
+					"Found invalid run completion state %q. Support Succeeded, Failed and Error.", state)
+			}
+		}
+	}
 	return nil
 }
 
@@ -56,6 +56,7 @@ var (
 		Status:    "NO_STATUS",
 		PipelineSpec: &api.PipelineSpec{
 			WorkflowManifest: testWorkflow.ToStringForStore(),
+			WorkflowDigest:   util.ComputeDigest([]byte(testWorkflow.ToStringForStore())),
 			Parameters:       []*api.Parameter{{Name: "param1", Value: "world"}},
 		},
 		ResourceReferences: []*api.ResourceReference{
@@ -189,6 +190,104 @@ func TestValidateApiJob_InvalidCron(t *testing.T) {
 	assert.Contains(t, err.Error(), "Schedule cron is not a supported format")
 }
 
+func TestValidateApiJob_InvalidTimeZone(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	apiJob := &api.Job{
+		Name:           "job1",
+		Enabled:        true,
+		MaxConcurrency: 1,
+		Trigger: &api.Trigger{
+			Trigger: &api.Trigger_CronSchedule{CronSchedule: &api.CronSchedule{
+				Cron:     "0 0 9 * * *",
+				TimeZone: "Mars/Olympus_Mons",
+			}}},
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+			Parameters:       []*api.Parameter{{Name: "param1", Value: "world"}},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{Key: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID}, Relationship: api.Relationship_OWNER},
+		},
+	}
+	err := server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "not a valid IANA time zone")
+
+	apiJob.Trigger.GetCronSchedule().TimeZone = "America/New_York"
+	assert.Nil(t, server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob}))
+}
+
+func TestValidateApiJob_InvalidObjectStoreEvent(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	event := &api.ObjectStoreEvent{KeyParameter: "param1"}
+	apiJob := &api.Job{
+		Name:           "job1",
+		Enabled:        true,
+		MaxConcurrency: 1,
+		Trigger:        &api.Trigger{Trigger: &api.Trigger_ObjectStoreEvent{ObjectStoreEvent: event}},
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+			Parameters:       []*api.Parameter{{Name: "param1", Value: "world"}},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{Key: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID}, Relationship: api.Relationship_OWNER},
+		},
+	}
+	err := server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "must have a bucket")
+
+	event.Bucket = "mlpipeline"
+	event.KeyParameter = ""
+	err = server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "must have a key parameter")
+
+	event.KeyParameter = "param1"
+	assert.Nil(t, server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob}))
+}
+
+func TestValidateApiJob_InvalidRunCompletion(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	runCompletion := &api.RunCompletion{}
+	apiJob := &api.Job{
+		Name:           "job1",
+		Enabled:        true,
+		MaxConcurrency: 1,
+		Trigger:        &api.Trigger{Trigger: &api.Trigger_RunCompletion{RunCompletion: runCompletion}},
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+			Parameters:       []*api.Parameter{{Name: "param1", Value: "world"}},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{Key: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID}, Relationship: api.Relationship_OWNER},
+		},
+	}
+	err := server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "exactly one of a job ID and an experiment ID")
+
+	runCompletion.JobId = "job1"
+	runCompletion.ExperimentId = experiment.UUID
+	err = server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob})
+	assert.Contains(t, err.Error(), "exactly one of a job ID and an experiment ID")
+
+	runCompletion.JobId = ""
+	runCompletion.States = []string{"Succeeded", "Running"}
+	err = server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), `invalid run completion state "Running"`)
+
+	runCompletion.States = []string{"Succeeded", "Failed"}
+	assert.Nil(t, server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob}))
+}
+
 func TestValidateApiJob_MaxConcurrencyOutOfRange(t *testing.T) {
 	clients, manager, experiment := initWithExperiment(t)
 	defer clients.Close()
@@ -215,6 +314,71 @@ func TestValidateApiJob_MaxConcurrencyOutOfRange(t *testing.T) {
 	assert.Contains(t, err.Error(), "max concurrency of the job is out of range")
 }
 
+func TestValidateApiJob_MaxConsecutiveFailuresOutOfRange(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	apiJob := &api.Job{
+		Name:                   "job1",
+		Enabled:                true,
+		MaxConcurrency:         1,
+		MaxConsecutiveFailures: 11,
+		Trigger: &api.Trigger{
+			Trigger: &api.Trigger_CronSchedule{CronSchedule: &api.CronSchedule{
+				StartTime: &timestamp.Timestamp{Seconds: 1},
+				Cron:      "1 * * * *",
+			}}},
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+			Parameters:       []*api.Parameter{{Name: "param1", Value: "world"}},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{Key: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID}, Relationship: api.Relationship_OWNER},
+		},
+	}
+	err := server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "max consecutive failures of the job is out of range")
+
+	apiJob.MaxConsecutiveFailures = 3
+	assert.Nil(t, server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob}))
+}
+
+func TestValidateApiJob_InvalidBlackoutWindow(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	apiJob := &api.Job{
+		Name:           "job1",
+		Enabled:        true,
+		MaxConcurrency: 1,
+		Trigger: &api.Trigger{
+			Trigger: &api.Trigger_CronSchedule{CronSchedule: &api.CronSchedule{
+				StartTime: &timestamp.Timestamp{Seconds: 1},
+				Cron:      "1 * * * *",
+			}}},
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+			Parameters:       []*api.Parameter{{Name: "param1", Value: "world"}},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{Key: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID}, Relationship: api.Relationship_OWNER},
+		},
+		BlackoutWindows: []*api.TimeWindow{{StartTime: &timestamp.Timestamp{Seconds: 10}}},
+	}
+	err := server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "must have a start time and an end time")
+
+	apiJob.BlackoutWindows[0].EndTime = &timestamp.Timestamp{Seconds: 10}
+	err = server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "must be before its end time")
+
+	apiJob.BlackoutWindows[0].EndTime = &timestamp.Timestamp{Seconds: 20}
+	assert.Nil(t, server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob}))
+}
+
 func TestValidateApiJob_NegativeIntervalSecond(t *testing.T) {
 	clients, manager, experiment := initWithExperiment(t)
 	defer clients.Close()
@@ -512,3 +676,209 @@ func TestDisableJob_Multiuser(t *testing.T) {
 	_, err = server.DisableJob(ctx, &api.DisableJobRequest{Id: job.Id})
 	assert.Nil(t, err)
 }
+
+func TestUpdateJobLabels(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	job, err := server.CreateJob(nil, &api.CreateJobRequest{Job: commonApiJob})
+	assert.Nil(t, err)
+	assert.Nil(t, job.Labels)
+
+	job, err = server.UpdateJobLabels(nil, &api.UpdateJobLabelsRequest{
+		Id:     job.Id,
+		Labels: map[string]string{"owner": "data-team"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"owner": "data-team"}, job.Labels)
+}
+
+func TestPreviewSchedule(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	response, err := server.PreviewSchedule(nil, &api.PreviewScheduleRequest{
+		Trigger: &api.Trigger{Trigger: &api.Trigger_CronSchedule{CronSchedule: &api.CronSchedule{
+			StartTime: &timestamp.Timestamp{Seconds: 36000},
+			EndTime:   &timestamp.Timestamp{Seconds: 43200},
+			Cron:      "0 0 * * * *",
+		}}},
+		Count: 3,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, &api.PreviewScheduleResponse{
+		TriggerTimes: []*timestamp.Timestamp{{Seconds: 39600}, {Seconds: 43200}},
+	}, response)
+
+	response, err = server.PreviewSchedule(nil, &api.PreviewScheduleRequest{
+		Trigger: &api.Trigger{Trigger: &api.Trigger_PeriodicSchedule{PeriodicSchedule: &api.PeriodicSchedule{
+			StartTime:      &timestamp.Timestamp{Seconds: 36000},
+			IntervalSecond: 60,
+		}}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, defaultPreviewScheduleCount, len(response.TriggerTimes))
+	assert.Equal(t, &timestamp.Timestamp{Seconds: 36060}, response.TriggerTimes[0])
+}
+
+func TestPreviewSchedule_InvalidRequest(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	cronTrigger := func(cron string) *api.Trigger {
+		return &api.Trigger{Trigger: &api.Trigger_CronSchedule{CronSchedule: &api.CronSchedule{Cron: cron}}}
+	}
+
+	tests := []struct {
+		request *api.PreviewScheduleRequest
+		errMsg  string
+	}{
+		{&api.PreviewScheduleRequest{}, "must have a cron schedule or a periodic schedule"},
+		{&api.PreviewScheduleRequest{Trigger: cronTrigger("1 * * ")}, "Schedule cron is not a supported format"},
+		{&api.PreviewScheduleRequest{Trigger: &api.Trigger{Trigger: &api.Trigger_PeriodicSchedule{
+			PeriodicSchedule: &api.PeriodicSchedule{IntervalSecond: 0}}}}, "invalid period schedule interval"},
+		{&api.PreviewScheduleRequest{Trigger: cronTrigger("0 0 * * * *"), Count: 101}, "out of range"},
+		{&api.PreviewScheduleRequest{Trigger: cronTrigger("0 0 * * * *"), Count: -1}, "out of range"},
+	}
+	for _, test := range tests {
+		_, err := server.PreviewSchedule(nil, test.request)
+		assert.NotNil(t, err, test.errMsg)
+		assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode(), test.errMsg)
+		assert.Contains(t, err.Error(), test.errMsg)
+	}
+}
+
+func TestBackfillJob(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	job, err := server.CreateJob(nil, &api.CreateJobRequest{Job: commonApiJob})
+	assert.Nil(t, err)
+
+	backfill, err := server.BackfillJob(nil, &api.BackfillJobRequest{
+		Id:        job.Id,
+		StartTime: &timestamp.Timestamp{Seconds: 0},
+		EndTime:   &timestamp.Timestamp{Seconds: 7200},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, &api.Backfill{
+		Id:                "123e4567-e89b-12d3-a456-426655440000",
+		StartTime:         &timestamp.Timestamp{Seconds: 0},
+		EndTime:           &timestamp.Timestamp{Seconds: 7200},
+		State:             api.Backfill_RUNNING,
+		TotalRuns:         120,
+		NextScheduledTime: &timestamp.Timestamp{Seconds: 1},
+	}, backfill)
+
+	job, err = server.GetJob(nil, &api.GetJobRequest{Id: job.Id})
+	assert.Nil(t, err)
+	assert.Equal(t, backfill, job.Backfill)
+
+	_, err = server.CancelJobBackfill(nil, &api.CancelJobBackfillRequest{Id: job.Id})
+	assert.Nil(t, err)
+}
+
+func TestBackfillJob_InvalidRequest(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+
+	tests := []struct {
+		request *api.BackfillJobRequest
+		errMsg  string
+	}{
+		{&api.BackfillJobRequest{Id: "job1", StartTime: &timestamp.Timestamp{Seconds: 0}},
+			"start time and the end time of the backfill must be set"},
+		{&api.BackfillJobRequest{Id: "job1", StartTime: &timestamp.Timestamp{Seconds: 60}, EndTime: &timestamp.Timestamp{Seconds: 60}},
+			"must be before its end time"},
+	}
+	for _, test := range tests {
+		_, err := server.BackfillJob(nil, test.request)
+		assert.NotNil(t, err, test.errMsg)
+		assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode(), test.errMsg)
+		assert.Contains(t, err.Error(), test.errMsg)
+	}
+}
+
+func TestUpdateJob(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	job, err := server.CreateJob(nil, &api.CreateJobRequest{Job: commonApiJob})
+	assert.Nil(t, err)
+
+	updatedJob, err := server.UpdateJob(nil, &api.UpdateJobRequest{
+		Id: job.Id,
+		Job: &api.Job{
+			MaxConcurrency: 2,
+			Trigger: &api.Trigger{
+				Trigger: &api.Trigger_PeriodicSchedule{PeriodicSchedule: &api.PeriodicSchedule{
+					IntervalSecond: 3600,
+				}}},
+			PipelineSpec: &api.PipelineSpec{
+				WorkflowManifest: testWorkflow.ToStringForStore(),
+				Parameters:       []*api.Parameter{{Name: "param1", Value: "hello"}},
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, job.Id, updatedJob.Id)
+	assert.Equal(t, "job1", updatedJob.Name)
+	assert.Equal(t, int64(2), updatedJob.MaxConcurrency)
+	assert.Equal(t, int64(3600), updatedJob.Trigger.GetPeriodicSchedule().IntervalSecond)
+	assert.Nil(t, updatedJob.Trigger.GetCronSchedule())
+	assert.Equal(t, []*api.Parameter{{Name: "param1", Value: "hello"}}, updatedJob.PipelineSpec.Parameters)
+	assert.Equal(t, job.ResourceReferences, updatedJob.ResourceReferences)
+}
+
+func TestUpdateJob_InvalidRequest(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	job, err := server.CreateJob(nil, &api.CreateJobRequest{Job: commonApiJob})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		request *api.UpdateJobRequest
+		errMsg  string
+	}{
+		{&api.UpdateJobRequest{Id: job.Id}, "The job to update must be set"},
+		{&api.UpdateJobRequest{Id: job.Id, Job: &api.Job{
+			MaxConcurrency: 20,
+			PipelineSpec:   &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		}}, "max concurrency of the job is out of range"},
+	}
+	for _, test := range tests {
+		_, err := server.UpdateJob(nil, test.request)
+		assert.NotNil(t, err, test.errMsg)
+		assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode(), test.errMsg)
+		assert.Contains(t, err.Error(), test.errMsg)
+	}
+}
+
+func TestTriggerJob(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	job, err := server.CreateJob(nil, &api.CreateJobRequest{Job: commonApiJob})
+	assert.Nil(t, err)
+
+	_, err = server.TriggerJob(nil, &api.TriggerJobRequest{Id: job.Id})
+	assert.Nil(t, err)
+
+	_, err = server.TriggerJob(nil, &api.TriggerJobRequest{Id: "not-exist"})
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestUpdateJobLabels_JobNotExist(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	_, err := server.UpdateJobLabels(nil, &api.UpdateJobLabelsRequest{
+		Id:     "not-exist",
+		Labels: map[string]string{"owner": "data-team"},
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
@@ -0,0 +1,341 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// Parquet and Thrift compact protocol values, as defined in
+// https://github.com/apache/parquet-format/blob/master/src/main/thrift/parquet.thrift and
+// https://github.com/apache/thrift/blob/master/doc/specs/thrift-compact-protocol.md
+const (
+	parquetMagic     = "PAR1"
+	parquetVersion   = 1
+	parquetCreatedBy = "kubeflow-pipelines-api-server"
+
+	parquetTypeInt64     = 2
+	parquetTypeDouble    = 5
+	parquetTypeByteArray = 6
+
+	parquetConvertedTypeUTF8            = 0
+	parquetConvertedTypeTimestampMillis = 9
+
+	parquetRepetitionOptional = 1
+
+	parquetEncodingPlain = 0
+	parquetEncodingRLE   = 3
+
+	parquetCodecUncompressed = 0
+	parquetPageTypeDataPage  = 0
+
+	// The number of 8-value groups in a bit-packed run of definition levels.
+	parquetMaxBitPackedGroups = 63
+
+	thriftTypeI32    = 5
+	thriftTypeI64    = 6
+	thriftTypeBinary = 8
+	thriftTypeList   = 9
+	thriftTypeStruct = 12
+)
+
+type parquetColumnChunk struct {
+	offset    int64
+	size      int64
+	numValues int64
+}
+
+type parquetRowGroup struct {
+	chunks  []parquetColumnChunk
+	numRows int64
+}
+
+// parquetTableWriter writes a flat Parquet file of optional columns. Every batch of rows is a row
+// group with a single PLAIN encoded, uncompressed data page per column, so only the metadata of the
+// row groups is held in memory until the footer is written.
+type parquetTableWriter struct {
+	writer    io.Writer
+	offset    int64
+	columns   []tableColumn
+	rowGroups []*parquetRowGroup
+	numRows   int64
+}
+
+func newParquetTableWriter(w io.Writer, columns []tableColumn) (*parquetTableWriter, error) {
+	writer := &parquetTableWriter{writer: w, columns: columns}
+	if err := writer.write([]byte(parquetMagic)); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+func (w *parquetTableWriter) write(data []byte) error {
+	n, err := w.writer.Write(data)
+	w.offset += int64(n)
+	if err != nil {
+		return util.Wrap(err, "Failed to write Parquet data")
+	}
+	return nil
+}
+
+func (w *parquetTableWriter) WriteRows(rows []tableRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	rowGroup := &parquetRowGroup{numRows: int64(len(rows))}
+	for i, column := range w.columns {
+		page := encodeParquetDataPage(column.columnType, rows, i)
+		header := encodeParquetPageHeader(len(rows), len(page))
+		rowGroup.chunks = append(rowGroup.chunks, parquetColumnChunk{
+			offset:    w.offset,
+			size:      int64(len(header) + len(page)),
+			numValues: int64(len(rows)),
+		})
+		if err := w.write(header); err != nil {
+			return err
+		}
+		if err := w.write(page); err != nil {
+			return err
+		}
+	}
+	w.rowGroups = append(w.rowGroups, rowGroup)
+	w.numRows += rowGroup.numRows
+	return nil
+}
+
+// Close writes the footer: the file metadata, its length and the magic number.
+func (w *parquetTableWriter) Close() error {
+	metadata := w.encodeFileMetadata()
+	footer := make([]byte, 4)
+	binary.LittleEndian.PutUint32(footer, uint32(len(metadata)))
+	footer = append(footer, parquetMagic...)
+	if err := w.write(metadata); err != nil {
+		return err
+	}
+	return w.write(footer)
+}
+
+func (w *parquetTableWriter) encodeFileMetadata() []byte {
+	e := &thriftCompactEncoder{}
+	e.beginStruct()
+	e.i32Field(1, parquetVersion)
+	e.listField(2, thriftTypeStruct, len(w.columns)+1)
+	e.beginStruct()
+	e.stringField(4, "schema")
+	e.i32Field(5, int32(len(w.columns)))
+	e.endStruct()
+	for _, column := range w.columns {
+		physicalType, convertedType := parquetColumnTypes(column.columnType)
+		e.beginStruct()
+		e.i32Field(1, physicalType)
+		e.i32Field(3, parquetRepetitionOptional)
+		e.stringField(4, column.name)
+		if convertedType >= 0 {
+			e.i32Field(6, convertedType)
+		}
+		e.endStruct()
+	}
+	e.i64Field(3, w.numRows)
+	e.listField(4, thriftTypeStruct, len(w.rowGroups))
+	for _, rowGroup := range w.rowGroups {
+		var totalSize int64
+		e.beginStruct()
+		e.listField(1, thriftTypeStruct, len(rowGroup.chunks))
+		for i, chunk := range rowGroup.chunks {
+			physicalType, _ := parquetColumnTypes(w.columns[i].columnType)
+			totalSize += chunk.size
+			e.beginStruct()
+			e.i64Field(2, chunk.offset)
+			e.structField(3)
+			e.i32Field(1, physicalType)
+			e.listField(2, thriftTypeI32, 2)
+			e.i32(parquetEncodingPlain)
+			e.i32(parquetEncodingRLE)
+			e.listField(3, thriftTypeBinary, 1)
+			e.binary(w.columns[i].name)
+			e.i32Field(4, parquetCodecUncompressed)
+			e.i64Field(5, chunk.numValues)
+			e.i64Field(6, chunk.size)
+			e.i64Field(7, chunk.size)
+			e.i64Field(9, chunk.offset)
+			e.endStruct()
+			e.endStruct()
+		}
+		e.i64Field(2, totalSize)
+		e.i64Field(3, rowGroup.numRows)
+		e.endStruct()
+	}
+	e.stringField(6, parquetCreatedBy)
+	e.endStruct()
+	return e.buf.Bytes()
+}
+
+// parquetColumnTypes returns the physical type of a column, and its converted type or -1 if none.
+func parquetColumnTypes(columnType tableColumnType) (int32, int32) {
+	switch columnType {
+	case int64Column:
+		return parquetTypeInt64, -1
+	case doubleColumn:
+		return parquetTypeDouble, -1
+	case timestampColumn:
+		return parquetTypeInt64, parquetConvertedTypeTimestampMillis
+	default:
+		return parquetTypeByteArray, parquetConvertedTypeUTF8
+	}
+}
+
+func encodeParquetPageHeader(numValues int, pageSize int) []byte {
+	e := &thriftCompactEncoder{}
+	e.beginStruct()
+	e.i32Field(1, parquetPageTypeDataPage)
+	e.i32Field(2, int32(pageSize))
+	e.i32Field(3, int32(pageSize))
+	e.structField(5)
+	e.i32Field(1, int32(numValues))
+	e.i32Field(2, parquetEncodingPlain)
+	e.i32Field(3, parquetEncodingRLE)
+	e.i32Field(4, parquetEncodingRLE)
+	e.endStruct()
+	e.endStruct()
+	return e.buf.Bytes()
+}
+
+// encodeParquetDataPage encodes the values of a column as the definition levels of the rows,
+// prefixed with their length, followed by the PLAIN encoded values that are present.
+func encodeParquetDataPage(columnType tableColumnType, rows []tableRow, column int) []byte {
+	levels := &bytes.Buffer{}
+	values := &bytes.Buffer{}
+	for start := 0; start < len(rows); start += 8 * parquetMaxBitPackedGroups {
+		end := start + 8*parquetMaxBitPackedGroups
+		if end > len(rows) {
+			end = len(rows)
+		}
+		groups := (end - start + 7) / 8
+		writeUvarint(levels, uint64(groups)<<1|1)
+		packed := make([]byte, groups)
+		for i := start; i < end; i++ {
+			if rows[i][column] != nil {
+				packed[(i-start)/8] |= 1 << uint((i-start)%8)
+			}
+		}
+		levels.Write(packed)
+	}
+	for _, row := range rows {
+		value := row[column]
+		if value == nil {
+			continue
+		}
+		switch columnType {
+		case int64Column:
+			binary.Write(values, binary.LittleEndian, value.(int64))
+		case doubleColumn:
+			binary.Write(values, binary.LittleEndian, math.Float64bits(value.(float64)))
+		case timestampColumn:
+			binary.Write(values, binary.LittleEndian, value.(int64)*1000)
+		default:
+			binary.Write(values, binary.LittleEndian, uint32(len(value.(string))))
+			values.WriteString(value.(string))
+		}
+	}
+	page := make([]byte, 4, 4+levels.Len()+values.Len())
+	binary.LittleEndian.PutUint32(page, uint32(levels.Len()))
+	page = append(page, levels.Bytes()...)
+	return append(page, values.Bytes()...)
+}
+
+// thriftCompactEncoder encodes the structs of the Parquet metadata with the Thrift compact protocol.
+type thriftCompactEncoder struct {
+	buf bytes.Buffer
+	// The ID of the last field of the current struct, and of the structs it's nested in.
+	lastFieldID  int16
+	lastFieldIDs []int16
+}
+
+func (e *thriftCompactEncoder) beginStruct() {
+	e.lastFieldIDs = append(e.lastFieldIDs, e.lastFieldID)
+	e.lastFieldID = 0
+}
+
+func (e *thriftCompactEncoder) endStruct() {
+	e.buf.WriteByte(0)
+	e.lastFieldID = e.lastFieldIDs[len(e.lastFieldIDs)-1]
+	e.lastFieldIDs = e.lastFieldIDs[:len(e.lastFieldIDs)-1]
+}
+
+func (e *thriftCompactEncoder) fieldHeader(id int16, fieldType byte) {
+	if delta := id - e.lastFieldID; delta > 0 && delta <= 15 {
+		e.buf.WriteByte(byte(delta)<<4 | fieldType)
+	} else {
+		e.buf.WriteByte(fieldType)
+		writeUvarint(&e.buf, zigzag(int64(id)))
+	}
+	e.lastFieldID = id
+}
+
+func (e *thriftCompactEncoder) i32Field(id int16, value int32) {
+	e.fieldHeader(id, thriftTypeI32)
+	e.i32(value)
+}
+
+func (e *thriftCompactEncoder) i64Field(id int16, value int64) {
+	e.fieldHeader(id, thriftTypeI64)
+	writeUvarint(&e.buf, zigzag(value))
+}
+
+func (e *thriftCompactEncoder) stringField(id int16, value string) {
+	e.fieldHeader(id, thriftTypeBinary)
+	e.binary(value)
+}
+
+// structField begins a struct field, which is ended with endStruct.
+func (e *thriftCompactEncoder) structField(id int16) {
+	e.fieldHeader(id, thriftTypeStruct)
+	e.beginStruct()
+}
+
+// listField begins a list field. Its elements are written right after it.
+func (e *thriftCompactEncoder) listField(id int16, elementType byte, size int) {
+	e.fieldHeader(id, thriftTypeList)
+	if size < 15 {
+		e.buf.WriteByte(byte(size)<<4 | elementType)
+	} else {
+		e.buf.WriteByte(0xf0 | elementType)
+		writeUvarint(&e.buf, uint64(size))
+	}
+}
+
+func (e *thriftCompactEncoder) i32(value int32) {
+	writeUvarint(&e.buf, zigzag(int64(value)))
+}
+
+func (e *thriftCompactEncoder) binary(value string) {
+	writeUvarint(&e.buf, uint64(len(value)))
+	e.buf.WriteString(value)
+}
+
+func zigzag(value int64) uint64 {
+	return uint64(value<<1) ^ uint64(value>>63)
+}
+
+func writeUvarint(buf *bytes.Buffer, value uint64) {
+	var encoded [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(encoded[:], value)
+	buf.Write(encoded[:n])
+}
@@ -0,0 +1,115 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThriftCompactEncoder(t *testing.T) {
+	e := &thriftCompactEncoder{}
+	e.beginStruct()
+	e.i32Field(1, -2)
+	e.structField(3)
+	e.i64Field(1, 300)
+	e.endStruct()
+	// A field ID more than 15 above the previous one is written in full.
+	e.stringField(20, "ab")
+	e.listField(21, thriftTypeI32, 2)
+	e.i32(1)
+	e.i32(2)
+	e.endStruct()
+	assert.Equal(t, []byte{
+		0x15, 0x03, // field 1, i32 -2
+		0x2c,             // field 3, struct
+		0x16, 0xd8, 0x04, // field 1, i64 300
+		0x00,                       // end of field 3
+		0x08, 0x28, 0x02, 'a', 'b', // field 20, binary "ab"
+		0x19, 0x25, 0x02, 0x04, // field 21, list of 2 i32
+		0x00, // end of struct
+	}, e.buf.Bytes())
+}
+
+func TestEncodeParquetDataPage(t *testing.T) {
+	rows := []tableRow{{"a"}, {nil}, {"bc"}}
+	assert.Equal(t, []byte{
+		0x02, 0x00, 0x00, 0x00, // length of the definition levels
+		0x03, 0x05, // a bit-packed run of a group: 1, 0, 1
+		0x01, 0x00, 0x00, 0x00, 'a',
+		0x02, 0x00, 0x00, 0x00, 'b', 'c',
+	}, encodeParquetDataPage(stringColumn, rows, 0))
+
+	rows = []tableRow{{1.5}, {nil}}
+	assert.Equal(t, []byte{
+		0x02, 0x00, 0x00, 0x00,
+		0x03, 0x01,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf8, 0x3f,
+	}, encodeParquetDataPage(doubleColumn, rows, 0))
+
+	// Timestamps are written in milliseconds.
+	rows = []tableRow{{int64(2)}}
+	assert.Equal(t, []byte{
+		0x02, 0x00, 0x00, 0x00,
+		0x03, 0x01,
+		0xd0, 0x07, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}, encodeParquetDataPage(timestampColumn, rows, 0))
+}
+
+func TestEncodeParquetDataPage_ManyRows(t *testing.T) {
+	rows := make([]tableRow, 8*parquetMaxBitPackedGroups+1)
+	for i := range rows {
+		rows[i] = tableRow{nil}
+	}
+	rows[len(rows)-1] = tableRow{int64(7)}
+	page := encodeParquetDataPage(int64Column, rows, 0)
+	// The definition levels are split into a run of the maximum number of groups and a run of 1.
+	levelsLength := int(binary.LittleEndian.Uint32(page))
+	assert.Equal(t, 1+parquetMaxBitPackedGroups+2, levelsLength)
+	assert.Equal(t, byte(parquetMaxBitPackedGroups<<1|1), page[4])
+	assert.Equal(t, []byte{0x03, 0x01}, page[4+levelsLength-2:4+levelsLength])
+	assert.Equal(t, []byte{0x07, 0, 0, 0, 0, 0, 0, 0}, page[4+levelsLength:])
+}
+
+func TestParquetTableWriter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	writer, err := newParquetTableWriter(buf, []tableColumn{{"id", stringColumn}, {"value", int64Column}})
+	assert.Nil(t, err)
+	assert.Nil(t, writer.WriteRows([]tableRow{{"a", int64(1)}, {"b", nil}}))
+	assert.Nil(t, writer.WriteRows([]tableRow{{"c", int64(3)}}))
+	assert.Nil(t, writer.Close())
+
+	data := buf.Bytes()
+	assert.Equal(t, parquetMagic, string(data[:4]))
+	assert.Equal(t, parquetMagic, string(data[len(data)-4:]))
+	assert.Equal(t, int64(3), writer.numRows)
+	assert.Len(t, writer.rowGroups, 2)
+
+	// The column chunks follow each other, and the footer follows the last of them.
+	offset := int64(len(parquetMagic))
+	for _, rowGroup := range writer.rowGroups {
+		assert.Len(t, rowGroup.chunks, 2)
+		for _, chunk := range rowGroup.chunks {
+			assert.Equal(t, offset, chunk.offset)
+			offset += chunk.size
+		}
+	}
+	metadataLength := int64(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	assert.Equal(t, int64(len(data)), offset+metadataLength+8)
+	assert.Equal(t, writer.encodeFileMetadata(), data[offset:offset+metadataLength])
+}
@@ -73,6 +73,21 @@ var (
 		Help: "The total number of DeletePipelineVersion requests",
 	})
 
+	updatePipelineLabelsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pipeline_server_update_labels_requests",
+		Help: "The total number of UpdatePipelineLabels requests",
+	})
+
+	updatePipelineVersionLabelsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pipeline_server_update_version_labels_requests",
+		Help: "The total number of UpdatePipelineVersionLabels requests",
+	})
+
+	updatePipelineDefaultVersionRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pipeline_server_update_default_version_requests",
+		Help: "The total number of UpdatePipelineDefaultVersion requests",
+	})
+
 	// TODO(jingzhang36): error count and success count.
 
 	pipelineCount = promauto.NewGauge(prometheus.GaugeOpts{
@@ -107,7 +122,7 @@ func (s *PipelineServer) CreatePipeline(ctx context.Context, request *api.Create
 			"Please double check the URL is valid and can be accessed by the pipeline system.", pipelineUrl)
 	}
 	pipelineFileName := path.Base(pipelineUrl)
-	pipelineFile, err := ReadPipelineFile(pipelineFileName, resp.Body, MaxFileLength)
+	pipelineFile, pipelinePackage, err := ReadPipelinePackage(pipelineFileName, resp.Body, MaxFileLength)
 	if err != nil {
 		return nil, util.Wrap(err, "The URL is valid but pipeline system failed to read the file.")
 	}
@@ -117,7 +132,8 @@ func (s *PipelineServer) CreatePipeline(ctx context.Context, request *api.Create
 		return nil, util.Wrap(err, "Invalid pipeline name.")
 	}
 
-	pipeline, err := s.resourceManager.CreatePipeline(pipelineName, request.Pipeline.Description, pipelineFile)
+	pipeline, err := s.resourceManager.CreatePipelineWithLabels(
+		pipelineName, request.Pipeline.Description, request.Pipeline.Labels, pipelineFile, pipelinePackage)
 	if err != nil {
 		return nil, util.Wrap(err, "Create pipeline failed.")
 	}
@@ -193,7 +209,7 @@ func ValidateCreatePipelineRequest(request *api.CreatePipelineRequest) error {
 		return util.NewInvalidInputError(
 			"Invalid Pipeline URL %v. Please specify a valid URL", request.Pipeline.Url.PipelineUrl)
 	}
-	return nil
+	return ValidateLabels(request.Pipeline.Labels)
 }
 
 func NewPipelineServer(resourceManager *resource.ResourceManager, options *PipelineServerOptions) *PipelineServer {
@@ -214,17 +230,25 @@ func (s *PipelineServer) CreatePipelineVersion(ctx context.Context, request *api
 	if _, err := url.ParseRequestURI(request.Version.PackageUrl.PipelineUrl); err != nil {
 		return nil, util.NewInvalidInputError("Invalid Pipeline URL %v. Please specify a valid URL", request.Version.PackageUrl.PipelineUrl)
 	}
+	if err := ValidateLabels(request.Version.Labels); err != nil {
+		return nil, err
+	}
 	resp, err := s.httpClient.Get(pipelineUrl)
 	if err != nil || resp.StatusCode != http.StatusOK {
 		return nil, util.NewInternalServerError(err, "Failed to download the pipeline from %v. Please double check the URL is valid and can be accessed by the pipeline system.", pipelineUrl)
 	}
 	pipelineFileName := path.Base(pipelineUrl)
-	pipelineFile, err := ReadPipelineFile(pipelineFileName, resp.Body, MaxFileLength)
+	pipelineFile, pipelinePackage, err := ReadPipelinePackage(pipelineFileName, resp.Body, MaxFileLength)
 	if err != nil {
 		return nil, util.Wrap(err, "The URL is valid but pipeline system failed to read the file.")
 	}
 
-	version, err := s.resourceManager.CreatePipelineVersion(request.Version, pipelineFile)
+	var version *model.PipelineVersion
+	if request.Deduplicate {
+		version, _, err = s.resourceManager.CreateOrGetPipelineVersion(request.Version, pipelineFile, pipelinePackage)
+	} else {
+		version, err = s.resourceManager.CreatePipelineVersion(request.Version, pipelineFile, pipelinePackage)
+	}
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to create a version.")
 	}
@@ -298,3 +322,57 @@ func (s *PipelineServer) GetPipelineVersionTemplate(ctx context.Context, request
 
 	return &api.GetTemplateResponse{Template: string(template)}, nil
 }
+
+func (s *PipelineServer) GetPipelineVersionAsset(ctx context.Context, request *api.GetPipelineVersionAssetRequest) (*api.GetPipelineVersionAssetResponse, error) {
+	if len(request.Path) == 0 {
+		return nil, util.NewInvalidInputError("Asset path is empty. Please specify the path of a file in the pipeline package.")
+	}
+	asset, err := s.resourceManager.GetPipelineVersionAsset(request.VersionId, request.Path)
+	if err != nil {
+		return nil, util.Wrap(err, "Get pipeline version asset failed.")
+	}
+
+	return &api.GetPipelineVersionAssetResponse{Data: asset}, nil
+}
+
+func (s *PipelineServer) UpdatePipelineLabels(ctx context.Context, request *api.UpdatePipelineLabelsRequest) (*api.Pipeline, error) {
+	if s.options.CollectMetrics {
+		updatePipelineLabelsRequests.Inc()
+	}
+
+	if err := ValidateLabels(request.Labels); err != nil {
+		return nil, err
+	}
+	pipeline, err := s.resourceManager.UpdatePipelineLabels(request.Id, request.Labels)
+	if err != nil {
+		return nil, util.Wrap(err, "Update pipeline labels failed.")
+	}
+	return ToApiPipeline(pipeline), nil
+}
+
+func (s *PipelineServer) UpdatePipelineVersionLabels(ctx context.Context, request *api.UpdatePipelineVersionLabelsRequest) (*api.PipelineVersion, error) {
+	if s.options.CollectMetrics {
+		updatePipelineVersionLabelsRequests.Inc()
+	}
+
+	if err := ValidateLabels(request.Labels); err != nil {
+		return nil, err
+	}
+	version, err := s.resourceManager.UpdatePipelineVersionLabels(request.VersionId, request.Labels)
+	if err != nil {
+		return nil, util.Wrap(err, "Update pipeline version labels failed.")
+	}
+	return ToApiPipelineVersion(version)
+}
+
+func (s *PipelineServer) UpdatePipelineDefaultVersion(ctx context.Context, request *api.UpdatePipelineDefaultVersionRequest) (*empty.Empty, error) {
+	if s.options.CollectMetrics {
+		updatePipelineDefaultVersionRequests.Inc()
+	}
+
+	err := s.resourceManager.UpdatePipelineDefaultVersion(request.PipelineId, request.VersionId)
+	if err != nil {
+		return nil, util.Wrap(err, "Update pipeline default version failed.")
+	}
+	return &empty.Empty{}, nil
+}
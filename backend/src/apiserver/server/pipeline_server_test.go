@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
@@ -45,6 +46,55 @@ func TestCreatePipeline_YAML(t *testing.T) {
 	assert.Equal(t, "pipeline description", newPipeline.Description)
 }
 
+func TestCreatePipeline_WithLabels(t *testing.T) {
+	httpServer := getMockServer(t)
+	// Close the server when test finishes
+	defer httpServer.Close()
+
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	resourceManager := resource.NewResourceManager(clientManager)
+
+	pipelineServer := PipelineServer{resourceManager: resourceManager, httpClient: httpServer.Client(), options: &PipelineServerOptions{CollectMetrics: false}}
+	pipeline, err := pipelineServer.CreatePipeline(context.Background(), &api.CreatePipelineRequest{
+		Pipeline: &api.Pipeline{
+			Url:    &api.Url{PipelineUrl: httpServer.URL + "/arguments-parameters.yaml"},
+			Name:   "argument-parameters",
+			Labels: map[string]string{"team": "a"},
+		}})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "a"}, pipeline.Labels)
+
+	pipeline, err = pipelineServer.UpdatePipelineLabels(context.Background(), &api.UpdatePipelineLabelsRequest{
+		Id:     pipeline.Id,
+		Labels: map[string]string{"team": "b"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "b"}, pipeline.Labels)
+
+	version, err := pipelineServer.UpdatePipelineVersionLabels(context.Background(), &api.UpdatePipelineVersionLabelsRequest{
+		VersionId: pipeline.DefaultVersion.Id,
+		Labels:    map[string]string{"stage": "prod"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"stage": "prod"}, version.Labels)
+}
+
+func TestCreatePipeline_InvalidLabels(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	resourceManager := resource.NewResourceManager(clientManager)
+
+	pipelineServer := PipelineServer{resourceManager: resourceManager, httpClient: http.DefaultClient, options: &PipelineServerOptions{CollectMetrics: false}}
+	_, err := pipelineServer.CreatePipeline(context.Background(), &api.CreatePipelineRequest{
+		Pipeline: &api.Pipeline{
+			Url:    &api.Url{PipelineUrl: "https://foo.bar/pipeline.yaml"},
+			Name:   "argument-parameters",
+			Labels: map[string]string{"team": strings.Repeat("a", 256)},
+		}})
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "too long")
+}
+
 func TestCreatePipeline_Tarball(t *testing.T) {
 	httpServer := getMockServer(t)
 	// Close the server when test finishes
@@ -151,6 +201,55 @@ func TestCreatePipelineVersion_YAML(t *testing.T) {
 		{Name: "param1", Value: "hello"}, {Name: "param2"}}, params)
 }
 
+func TestCreatePipelineVersion_Deduplicate(t *testing.T) {
+	httpServer := getMockServer(t)
+	// Close the server when test finishes
+	defer httpServer.Close()
+
+	clientManager := resource.NewFakeClientManagerOrFatal(
+		util.NewFakeTimeForEpoch())
+	resourceManager := resource.NewResourceManager(clientManager)
+
+	pipelineServer := PipelineServer{
+		resourceManager: resourceManager, httpClient: httpServer.Client(), options: &PipelineServerOptions{CollectMetrics: false}}
+	pipeline, err := pipelineServer.CreatePipeline(context.Background(), &api.CreatePipelineRequest{
+		Pipeline: &api.Pipeline{
+			Url:  &api.Url{PipelineUrl: httpServer.URL + "/arguments-parameters.yaml"},
+			Name: "argument-parameters",
+		}})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, pipeline.DefaultVersion.Digest)
+
+	clientManager.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(resource.FakeUUIDOne, nil))
+	pipelineServer.resourceManager = resource.NewResourceManager(clientManager)
+	request := &api.CreatePipelineVersionRequest{
+		Version: &api.PipelineVersion{
+			PackageUrl: &api.Url{
+				PipelineUrl: httpServer.URL + "/arguments-parameters.yaml"},
+			Name: "argument-parameters-v2",
+			ResourceReferences: []*api.ResourceReference{
+				&api.ResourceReference{
+					Key: &api.ResourceKey{
+						Id:   pipeline.Id,
+						Type: api.ResourceType_PIPELINE,
+					},
+					Relationship: api.Relationship_OWNER,
+				}}},
+		Deduplicate: true,
+	}
+	pipelineVersion, err := pipelineServer.CreatePipelineVersion(context.Background(), request)
+	assert.Nil(t, err)
+	assert.Equal(t, pipeline.DefaultVersion.Id, pipelineVersion.Id)
+	assert.Equal(t, pipeline.DefaultVersion.Digest, pipelineVersion.Digest)
+
+	// Without deduplication a new version is created with the same digest.
+	request.Deduplicate = false
+	pipelineVersion, err = pipelineServer.CreatePipelineVersion(context.Background(), request)
+	assert.Nil(t, err)
+	assert.NotEqual(t, pipeline.DefaultVersion.Id, pipelineVersion.Id)
+	assert.Equal(t, pipeline.DefaultVersion.Digest, pipelineVersion.Digest)
+}
+
 func TestCreatePipelineVersion_InvalidYAML(t *testing.T) {
 	httpServer := getMockServer(t)
 	// Close the server when test finishes
@@ -261,6 +360,50 @@ func TestListPipelineVersion_NoResourceKey(t *testing.T) {
 	assert.Equal(t, "Invalid input error: ResourceKey must be set in the input", err.Error())
 }
 
+func TestGetPipelineVersionAsset(t *testing.T) {
+	httpServer := getMockServer(t)
+	// Close the server when test finishes
+	defer httpServer.Close()
+
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	resourceManager := resource.NewResourceManager(clientManager)
+
+	pipelineServer := PipelineServer{resourceManager: resourceManager, httpClient: httpServer.Client(), options: &PipelineServerOptions{CollectMetrics: false}}
+	pipeline, err := pipelineServer.CreatePipeline(context.Background(), &api.CreatePipelineRequest{
+		Pipeline: &api.Pipeline{
+			Url:  &api.Url{PipelineUrl: httpServer.URL + "/pipeline_plus_component/pipeline_plus_component.tar.gz"},
+			Name: "pipeline-plus-component",
+		}})
+	assert.Nil(t, err)
+
+	asset, err := pipelineServer.GetPipelineVersionAsset(context.Background(), &api.GetPipelineVersionAssetRequest{
+		VersionId: pipeline.DefaultVersion.Id,
+		Path:      "component.yaml",
+	})
+	assert.Nil(t, err)
+	expectedAsset, _ := ioutil.ReadFile("test/pipeline_plus_component/component.yaml")
+	assert.Equal(t, expectedAsset, asset.Data)
+
+	// The template is still served on its own.
+	template, err := pipelineServer.GetPipelineVersionTemplate(context.Background(), &api.GetPipelineVersionTemplateRequest{
+		VersionId: pipeline.DefaultVersion.Id,
+	})
+	assert.Nil(t, err)
+	expectedTemplate, _ := ioutil.ReadFile("test/pipeline_plus_component/pipeline.yaml")
+	assert.Equal(t, string(expectedTemplate), template.Template)
+}
+
+func TestGetPipelineVersionAsset_EmptyPath(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	resourceManager := resource.NewResourceManager(clientManager)
+
+	pipelineServer := PipelineServer{resourceManager: resourceManager, httpClient: http.DefaultClient, options: &PipelineServerOptions{CollectMetrics: false}}
+	_, err := pipelineServer.GetPipelineVersionAsset(context.Background(), &api.GetPipelineVersionAssetRequest{
+		VersionId: resource.DefaultFakeUUID,
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+}
+
 func getMockServer(t *testing.T) *httptest.Server {
 	httpServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		// Send response to be tested
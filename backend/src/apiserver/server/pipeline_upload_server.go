@@ -19,10 +19,12 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/jsonpb"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/pkg/errors"
@@ -37,6 +39,9 @@ const (
 	DescriptionQueryStringKey = "description"
 	// Pipeline Id in the query string specifies a pipeline when creating versions.
 	PipelineKey = "pipelineid"
+	// If true, uploading a version whose content digest matches an existing
+	// version of the pipeline returns the existing version.
+	DeduplicateQueryStringKey = "deduplicate"
 )
 
 // Metric variables. Please prefix the metric names with pipeline_upload_ or pipeline_version_upload_.
@@ -82,7 +87,7 @@ func (s *PipelineUploadServer) UploadPipeline(w http.ResponseWriter, r *http.Req
 	}
 	defer file.Close()
 
-	pipelineFile, err := ReadPipelineFile(header.Filename, file, MaxFileLength)
+	pipelineFile, pipelinePackage, err := ReadPipelinePackage(header.Filename, file, MaxFileLength)
 	if err != nil {
 		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Error read pipeline file."))
 		return
@@ -100,7 +105,7 @@ func (s *PipelineUploadServer) UploadPipeline(w http.ResponseWriter, r *http.Req
 		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Error read pipeline description."))
 		return
 	}
-	newPipeline, err := s.resourceManager.CreatePipeline(pipelineName, pipelineDescription, pipelineFile)
+	newPipeline, err := s.resourceManager.CreatePipelineWithLabels(pipelineName, pipelineDescription, nil, pipelineFile, pipelinePackage)
 	if err != nil {
 		s.writeErrorToResponse(w, http.StatusInternalServerError, util.Wrap(err, "Error creating pipeline"))
 		return
@@ -134,7 +139,7 @@ func (s *PipelineUploadServer) UploadPipelineVersion(w http.ResponseWriter, r *h
 	}
 	defer file.Close()
 
-	pipelineFile, err := ReadPipelineFile(header.Filename, file, MaxFileLength)
+	pipelineFile, pipelinePackage, err := ReadPipelinePackage(header.Filename, file, MaxFileLength)
 	if err != nil {
 		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Error read pipeline version file."))
 		return
@@ -154,19 +159,33 @@ func (s *PipelineUploadServer) UploadPipelineVersion(w http.ResponseWriter, r *h
 		return
 	}
 
-	newPipelineVersion, err := s.resourceManager.CreatePipelineVersion(
-		&api.PipelineVersion{
-			Name: pipelineVersionName,
-			ResourceReferences: []*api.ResourceReference{
-				&api.ResourceReference{
-					Key: &api.ResourceKey{
-						Id:   pipelineId,
-						Type: api.ResourceType_PIPELINE,
-					},
-					Relationship: api.Relationship_OWNER,
+	deduplicate := false
+	if deduplicateQueryString := r.URL.Query().Get(DeduplicateQueryStringKey); deduplicateQueryString != "" {
+		deduplicate, err = strconv.ParseBool(deduplicateQueryString)
+		if err != nil {
+			s.writeErrorToResponse(w, http.StatusBadRequest, util.NewInvalidInputError("Invalid value for %s: %s", DeduplicateQueryStringKey, deduplicateQueryString))
+			return
+		}
+	}
+
+	apiVersion := &api.PipelineVersion{
+		Name: pipelineVersionName,
+		ResourceReferences: []*api.ResourceReference{
+			&api.ResourceReference{
+				Key: &api.ResourceKey{
+					Id:   pipelineId,
+					Type: api.ResourceType_PIPELINE,
 				},
+				Relationship: api.Relationship_OWNER,
 			},
-		}, pipelineFile)
+		},
+	}
+	var newPipelineVersion *model.PipelineVersion
+	if deduplicate {
+		newPipelineVersion, _, err = s.resourceManager.CreateOrGetPipelineVersion(apiVersion, pipelineFile, pipelinePackage)
+	} else {
+		newPipelineVersion, err = s.resourceManager.CreatePipelineVersion(apiVersion, pipelineFile, pipelinePackage)
+	}
 	if err != nil {
 		s.writeErrorToResponse(w, http.StatusInternalServerError, util.Wrap(err, "Error creating pipeline version"))
 		return
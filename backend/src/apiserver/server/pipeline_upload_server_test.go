@@ -90,6 +90,7 @@ func TestUploadPipeline_YAML(t *testing.T) {
 				Parameters:     "[]",
 				Status:         model.PipelineVersionReady,
 				PipelineId:     resource.DefaultFakeUUID,
+				Digest:         util.ComputeDigest(template),
 			}}}
 	pkg, total_size, str, err := clientManager.PipelineStore().ListPipelines(opts)
 	assert.Nil(t, err)
@@ -128,6 +129,7 @@ func TestUploadPipeline_YAML(t *testing.T) {
 			Parameters:     "[]",
 			Status:         model.PipelineVersionReady,
 			PipelineId:     resource.DefaultFakeUUID,
+			Digest:         util.ComputeDigest(template),
 		},
 		{
 			UUID:           fakeVersionUUID,
@@ -136,6 +138,7 @@ func TestUploadPipeline_YAML(t *testing.T) {
 			Parameters:     "[]",
 			Status:         model.PipelineVersionReady,
 			PipelineId:     resource.DefaultFakeUUID,
+			Digest:         util.ComputeDigest(template),
 		},
 	}
 	// Expect 2 versions, one is created by default when creating pipeline and the other is what we manually created
@@ -190,6 +193,7 @@ func TestUploadPipeline_Tarball(t *testing.T) {
 				Parameters:     "[{\"name\":\"param1\",\"value\":\"hello\"},{\"name\":\"param2\"}]",
 				Status:         model.PipelineVersionReady,
 				PipelineId:     resource.DefaultFakeUUID,
+				Digest:         util.ComputeDigest(template),
 			}}}
 	pkg, total_size, str, err := clientManager.PipelineStore().ListPipelines(opts)
 	assert.Nil(t, err)
@@ -234,6 +238,7 @@ func TestUploadPipeline_Tarball(t *testing.T) {
 			Parameters:     "[{\"name\":\"param1\",\"value\":\"hello\"},{\"name\":\"param2\"}]",
 			Status:         model.PipelineVersionReady,
 			PipelineId:     resource.DefaultFakeUUID,
+			Digest:         util.ComputeDigest(template),
 		},
 		{
 			UUID:           fakeVersionUUID,
@@ -242,6 +247,7 @@ func TestUploadPipeline_Tarball(t *testing.T) {
 			Parameters:     "[{\"name\":\"param1\",\"value\":\"hello\"},{\"name\":\"param2\"}]",
 			Status:         model.PipelineVersionReady,
 			PipelineId:     resource.DefaultFakeUUID,
+			Digest:         util.ComputeDigest(template),
 		},
 	}
 	// Expect 2 versions, one is created by default when creating pipeline and the other is what we manually created
@@ -313,6 +319,7 @@ func TestUploadPipeline_SpecifyFileName(t *testing.T) {
 				Parameters:     "[]",
 				Status:         model.PipelineVersionReady,
 				PipelineId:     resource.DefaultFakeUUID,
+				Digest:         util.ComputeDigest(template),
 			}}}
 	pkg, total_size, str, err := clientManager.PipelineStore().ListPipelines(opts)
 	assert.Nil(t, err)
@@ -383,6 +390,7 @@ func TestUploadPipeline_SpecifyFileDescription(t *testing.T) {
 				Parameters:     "[]",
 				Status:         model.PipelineVersionReady,
 				PipelineId:     resource.DefaultFakeUUID,
+				Digest:         util.ComputeDigest(template),
 			},
 			Description: "description of foo bar",
 		}}
@@ -459,3 +467,47 @@ func TestUploadPipelineVersion_FileNameTooLong(t *testing.T) {
 	assert.Equal(t, 400, rr.Code)
 	assert.Contains(t, string(rr.Body.Bytes()), "Pipeline name too long")
 }
+
+func TestUploadPipelineVersion_Deduplicate(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	resourceManager := resource.NewResourceManager(clientManager)
+	server := PipelineUploadServer{resourceManager: resourceManager, options: &PipelineUploadServerOptions{CollectMetrics: false}}
+	b := &bytes.Buffer{}
+	w := multipart.NewWriter(b)
+	part, _ := w.CreateFormFile("uploadfile", "hello-world.yaml")
+	io.Copy(part, bytes.NewBufferString("apiVersion: argoproj.io/v1alpha1\nkind: Workflow"))
+	w.Close()
+	req, _ := http.NewRequest("POST", "/apis/v1beta1/pipelines/upload", bytes.NewReader(b.Bytes()))
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	rr := httptest.NewRecorder()
+	handler := http.HandlerFunc(server.UploadPipeline)
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, 200, rr.Code)
+
+	// Upload the same file as a new version with deduplication.
+	clientManager.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(fakeVersionUUID, nil))
+	resourceManager = resource.NewResourceManager(clientManager)
+	server = PipelineUploadServer{resourceManager: resourceManager, options: &PipelineUploadServerOptions{CollectMetrics: false}}
+	req, _ = http.NewRequest("POST", "/apis/v1beta1/pipelines/upload_version?name="+fakeVersionName+"&pipelineid="+resource.DefaultFakeUUID+"&deduplicate=true", bytes.NewReader(b.Bytes()))
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	rr = httptest.NewRecorder()
+	handler = http.HandlerFunc(server.UploadPipelineVersion)
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, 200, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"id":"`+resource.DefaultFakeUUID+`"`)
+
+	// Verify no new version is stored.
+	opts, err := list.NewOptions(&model.PipelineVersion{}, 2, "", nil)
+	assert.Nil(t, err)
+	_, total_size, _, err := clientManager.PipelineStore().ListPipelineVersions(resource.DefaultFakeUUID, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, total_size)
+
+	// An invalid deduplicate value is rejected.
+	req, _ = http.NewRequest("POST", "/apis/v1beta1/pipelines/upload_version?name="+fakeVersionName+"&pipelineid="+resource.DefaultFakeUUID+"&deduplicate=maybe", bytes.NewReader(b.Bytes()))
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, 400, rr.Code)
+	assert.Contains(t, rr.Body.String(), "Invalid value for deduplicate")
+}
@@ -0,0 +1,136 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+const (
+	compareRunsPath = "/apis/v1beta1/runs:compare"
+	// Query string keys of the CompareRuns request.
+	compareRunsRunIDsKey       = "run_ids"
+	compareRunsExperimentIDKey = "experiment_id"
+	compareRunsFilterKey       = "filter"
+	compareRunsFormatKey       = "format"
+)
+
+// The columns every row of the CSV comparison starts with, before the parameter and metric columns.
+var compareRunsCSVColumns = []string{
+	"run_id", "name", "status", "created_at", "duration_seconds", "pipeline_version_id", "pipeline_version_name",
+}
+
+// The prefixes of the parameter and metric columns of the CSV comparison, so that they can't be
+// mistaken for each other or for the columns above.
+const (
+	compareRunsCSVParameterPrefix = "parameters."
+	compareRunsCSVMetricPrefix    = "metrics."
+)
+
+// CompareRunsHandler serves GET requests on /apis/v1beta1/runs:compare that ask for the CSV format,
+// and passes any other request to next.
+func CompareRunsHandler(runServer *RunServer, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != compareRunsPath || !isCSVFormat(r.URL.Query().Get(compareRunsFormatKey)) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		runServer.CompareRunsCSV(w, r)
+	})
+}
+
+func isCSVFormat(format string) bool {
+	if value, ok := api.CompareRunsRequest_ExportFormat_value[strings.ToUpper(format)]; ok {
+		return value == int32(api.CompareRunsRequest_CSV)
+	}
+	value, err := strconv.Atoi(format)
+	return err == nil && value == int(api.CompareRunsRequest_CSV)
+}
+
+// CompareRunsCSV writes the comparison of the runs of the query string as a CSV file, with a header
+// row followed by a row per run. The parameter and metric columns are named parameters.<name> and
+// metrics.<name>. Parameters and metrics a run doesn't have are left empty.
+func (s *RunServer) CompareRunsCSV(w http.ResponseWriter, r *http.Request) {
+	if s.options.CollectMetrics {
+		compareRunsRequests.Inc()
+	}
+
+	query := r.URL.Query()
+	request := &api.CompareRunsRequest{
+		RunIds:       query[compareRunsRunIDsKey],
+		ExperimentId: query.Get(compareRunsExperimentIDKey),
+		Filter:       query.Get(compareRunsFilterKey),
+		Format:       api.CompareRunsRequest_CSV,
+	}
+	response, err := s.compareRuns(httpRequestContext(r), request)
+	if err != nil {
+		writeHTTPErrorToResponse(w, "compare runs", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", `attachment; filename="runs.csv"`)
+	writer := csv.NewWriter(w)
+	header := append([]string{}, compareRunsCSVColumns...)
+	for _, name := range response.ParameterNames {
+		header = append(header, compareRunsCSVParameterPrefix+name)
+	}
+	for _, name := range response.MetricNames {
+		header = append(header, compareRunsCSVMetricPrefix+name)
+	}
+	writer.Write(header)
+	for _, run := range response.Runs {
+		writer.Write(toCSVRow(run, response.ParameterNames, response.MetricNames))
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		// The status is already written, so the error can only be logged.
+		util.LogError(util.Wrap(err, "Failed to write the runs comparison"))
+	}
+}
+
+func toCSVRow(run *api.RunComparison, parameterNames []string, metricNames []string) []string {
+	createdAt := ""
+	if run.GetCreatedAt() != nil {
+		createdAt = time.Unix(run.GetCreatedAt().GetSeconds(), 0).UTC().Format(time.RFC3339)
+	}
+	row := []string{
+		run.GetRunId(),
+		run.GetName(),
+		run.GetStatus(),
+		createdAt,
+		strconv.FormatInt(run.GetDurationSeconds(), 10),
+		run.GetPipelineVersionId(),
+		run.GetPipelineVersionName(),
+	}
+	for _, name := range parameterNames {
+		row = append(row, run.GetParameters()[name])
+	}
+	for _, name := range metricNames {
+		value := ""
+		if metric, ok := run.GetMetrics()[name]; ok {
+			value = strconv.FormatFloat(metric, 'g', -1, 64)
+		}
+		row = append(row, value)
+	}
+	return row
+}
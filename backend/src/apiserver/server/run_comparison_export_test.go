@@ -0,0 +1,74 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func compareRunsCSV(runServer *RunServer, query url.Values) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("GET", compareRunsPath+"?"+query.Encode(), nil)
+	rr := httptest.NewRecorder()
+	CompareRunsHandler(runServer, http.NotFoundHandler()).ServeHTTP(rr, req)
+	return rr
+}
+
+func TestCompareRunsCSV(t *testing.T) {
+	clientManager, runServer, runDetail, otherRunDetail := initWithComparedRuns(t)
+	defer clientManager.Close()
+
+	rr := compareRunsCSV(runServer, url.Values{
+		"run_ids": {runDetail.UUID, otherRunDetail.UUID},
+		"format":  {"CSV"},
+	})
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "text/csv", rr.Header().Get("Content-Type"))
+	records, err := csv.NewReader(rr.Body).ReadAll()
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{
+		{"run_id", "name", "status", "created_at", "duration_seconds", "pipeline_version_id", "pipeline_version_name", "parameters.param1", "metrics.loss", "metrics.node-1/accuracy", "metrics.node-2/accuracy"},
+		{runDetail.UUID, "run1", runDetail.Conditions, time.Unix(runDetail.CreatedAtInSec, 0).UTC().Format(time.RFC3339), "0", "", "", "world", "", "0.7", "0.8"},
+		{otherRunDetail.UUID, "run2", otherRunDetail.Conditions, time.Unix(otherRunDetail.CreatedAtInSec, 0).UTC().Format(time.RFC3339), "0", "", "", "hello", "0.25", "", ""},
+	}, records)
+}
+
+func TestCompareRunsCSV_InvalidRequest(t *testing.T) {
+	clientManager, runServer, _, _ := initWithComparedRuns(t)
+	defer clientManager.Close()
+
+	rr := compareRunsCSV(runServer, url.Values{"format": {"1"}})
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+}
+
+func TestCompareRunsHandler_PassesOtherRequests(t *testing.T) {
+	clientManager, runServer, runDetail, _ := initWithComparedRuns(t)
+	defer clientManager.Close()
+
+	for _, format := range []string{"", "JSON", "0"} {
+		req, _ := http.NewRequest("GET", compareRunsPath+"?run_ids="+runDetail.UUID+"&format="+format, nil)
+		passed := false
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { passed = true })
+		CompareRunsHandler(runServer, next).ServeHTTP(httptest.NewRecorder(), req)
+		assert.True(t, passed)
+	}
+}
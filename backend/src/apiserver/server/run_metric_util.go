@@ -19,6 +19,7 @@ import (
 
 	"github.com/golang/glog"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"google.golang.org/grpc/codes"
 )
@@ -51,6 +52,23 @@ func ValidateRunMetric(metric *api.RunMetric) error {
 		return util.NewInvalidInputError(
 			"metric.node_id '%s' cannot be longer than 128 characters", metric.GetNodeId())
 	}
+	if len(metric.GetSeries()) == 0 {
+		return nil
+	}
+	if _, ok := metric.GetValue().(*api.RunMetric_NumberValue); ok {
+		return util.NewInvalidInputError("metric.number_value must not be set for a metric with a series")
+	}
+	if len(metric.GetSeries()) > common.MaxRunMetricSeriesLength {
+		return util.NewInvalidInputError(
+			"metric.series cannot have more than %d points", common.MaxRunMetricSeriesLength)
+	}
+	steps := make(map[int64]bool)
+	for _, point := range metric.GetSeries() {
+		if steps[point.GetStep()] {
+			return util.NewInvalidInputError("metric.series has duplicate step %d", point.GetStep())
+		}
+		steps[point.GetStep()] = true
+	}
 	return nil
 }
 
@@ -75,6 +93,8 @@ func NewReportRunMetricResult(
 		result.Status = api.ReportRunMetricsResponse_ReportRunMetricResult_DUPLICATE_REPORTING
 	case codes.InvalidArgument:
 		result.Status = api.ReportRunMetricsResponse_ReportRunMetricResult_INVALID_ARGUMENT
+	case codes.ResourceExhausted:
+		result.Status = api.ReportRunMetricsResponse_ReportRunMetricResult_RESOURCE_EXHAUSTED
 	default:
 		result.Status = api.ReportRunMetricsResponse_ReportRunMetricResult_INTERNAL_ERROR
 	}
@@ -21,6 +21,7 @@ import (
 	"google.golang.org/grpc/codes"
 
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/stretchr/testify/assert"
 )
@@ -75,6 +76,35 @@ func TestValidateRunMetric_InvalidNodeIDs(t *testing.T) {
 	AssertUserError(t, err, codes.InvalidArgument)
 }
 
+func TestValidateRunMetric_Series(t *testing.T) {
+	metric := &api.RunMetric{
+		Name:   "loss",
+		NodeId: "node-1",
+		Series: []*api.RunMetricPoint{{Step: 1, NumberValue: 0.9}, {Step: 2, NumberValue: 0.4}},
+	}
+	err := ValidateRunMetric(metric)
+	assert.Nil(t, err)
+
+	// Both a series and a number value
+	metric.Value = &api.RunMetric_NumberValue{NumberValue: 0.4}
+	err = ValidateRunMetric(metric)
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	// Duplicate steps
+	metric.Value = nil
+	metric.Series = append(metric.Series, &api.RunMetricPoint{Step: 2, NumberValue: 0.3})
+	err = ValidateRunMetric(metric)
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	// Too many points
+	metric.Series = nil
+	for i := 0; i <= common.MaxRunMetricSeriesLength; i++ {
+		metric.Series = append(metric.Series, &api.RunMetricPoint{Step: int64(i)})
+	}
+	err = ValidateRunMetric(metric)
+	AssertUserError(t, err, codes.InvalidArgument)
+}
+
 func TestNewReportRunMetricResult_OK(t *testing.T) {
 	tests := []struct {
 		metricName string
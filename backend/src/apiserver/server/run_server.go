@@ -16,6 +16,8 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"io"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
@@ -28,6 +30,9 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
+// The maximum number of runs a single CompareRuns request compares.
+const maxComparedRuns = 100
+
 // Metric variables. Please prefix the metric names with run_server_.
 var (
 	// Used to calculate the request rate.
@@ -51,6 +56,11 @@ var (
 		Help: "The total number of DeleteRun requests",
 	})
 
+	compareRunsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_compare_requests",
+		Help: "The total number of CompareRuns requests",
+	})
+
 	archiveRunRequests = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "run_server_archive_requests",
 		Help: "The total number of ArchiveRun requests",
@@ -66,11 +76,36 @@ var (
 		Help: "The total number of ReportRunMetrics requests",
 	})
 
+	getRunMetricHistoryRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_get_metric_history_requests",
+		Help: "The total number of GetRunMetricHistory requests",
+	})
+
+	pushRunMetricsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_push_metrics_requests",
+		Help: "The total number of PushRunMetrics requests",
+	})
+
 	readArtifactRequests = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "run_server_read_artifact_requests",
 		Help: "The total number of ReadArtifact requests",
 	})
 
+	listRunArtifactsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_list_artifacts_requests",
+		Help: "The total number of ListRunArtifacts requests",
+	})
+
+	streamArtifactRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_stream_artifact_requests",
+		Help: "The total number of StreamArtifact requests",
+	})
+
+	downloadArtifactRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_download_artifact_requests",
+		Help: "The total number of artifact download requests over HTTP",
+	})
+
 	terminateRunRequests = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "run_server_terminate_requests",
 		Help: "The total number of TerminateRun requests",
@@ -151,7 +186,22 @@ func (s *RunServer) ListRuns(ctx context.Context, request *api.ListRunsRequest)
 		return nil, util.Wrap(err, "Failed to create list options")
 	}
 
-	filterContext, err := ValidateFilter(request.ResourceReferenceKey)
+	filterContext, err := authorizedListRunsFilterContext(s.resourceManager, ctx, request.ResourceReferenceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	runs, total_size, nextPageToken, err := s.resourceManager.ListRuns(filterContext, opts)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to list runs.")
+	}
+	return &api.ListRunsResponse{Runs: ToApiRuns(runs), TotalSize: int32(total_size), NextPageToken: nextPageToken}, nil
+}
+
+// authorizedListRunsFilterContext validates the resource reference runs are listed by, and checks
+// that the caller can access its namespace or experiment in multi-user mode.
+func authorizedListRunsFilterContext(resourceManager *resource.ResourceManager, ctx context.Context, referenceKey *api.ResourceKey) (*common.FilterContext, error) {
+	filterContext, err := ValidateFilter(referenceKey)
 	if err != nil {
 		return nil, util.Wrap(err, "Validating filter failed.")
 	}
@@ -166,7 +216,7 @@ func (s *RunServer) ListRuns(ctx context.Context, request *api.ListRunsRequest)
 			if len(namespace) == 0 {
 				return nil, util.NewInvalidInputError("Invalid resource references for ListRuns. Namespace is empty.")
 			}
-			err = isAuthorized(s.resourceManager, ctx, namespace)
+			err = isAuthorized(resourceManager, ctx, namespace)
 			if err != nil {
 				return nil, util.Wrap(err, "Failed to authorize with namespace resource reference.")
 			}
@@ -176,20 +226,77 @@ func (s *RunServer) ListRuns(ctx context.Context, request *api.ListRunsRequest)
 			if len(experimentID) == 0 {
 				return nil, util.NewInvalidInputError("Invalid resource references for run. Experiment ID is empty.")
 			}
-			err = CanAccessExperiment(s.resourceManager, ctx, experimentID)
+			err = CanAccessExperiment(resourceManager, ctx, experimentID)
 			if err != nil {
 				return nil, util.Wrap(err, "Failed to authorize with experiment resource reference.")
 			}
 		} else {
-			return nil, util.NewInvalidInputError("Invalid resource references for ListRuns. Got %+v", request.ResourceReferenceKey)
+			return nil, util.NewInvalidInputError("Invalid resource references for ListRuns. Got %+v", referenceKey)
 		}
 	}
+	return filterContext, nil
+}
 
-	runs, total_size, nextPageToken, err := s.resourceManager.ListRuns(filterContext, opts)
+func (s *RunServer) CompareRuns(ctx context.Context, request *api.CompareRunsRequest) (*api.CompareRunsResponse, error) {
+	if s.options.CollectMetrics {
+		compareRunsRequests.Inc()
+	}
+
+	if request.GetFormat() != api.CompareRunsRequest_JSON {
+		return nil, util.NewInvalidInputError("Exporting the comparison as %s is only supported over HTTP.", request.GetFormat())
+	}
+	return s.compareRuns(ctx, request)
+}
+
+// compareRuns compares the runs of the request, after checking that the caller can access them.
+func (s *RunServer) compareRuns(ctx context.Context, request *api.CompareRunsRequest) (*api.CompareRunsResponse, error) {
+	if len(request.GetRunIds()) > 0 {
+		if request.GetExperimentId() != "" || request.GetFilter() != "" {
+			return nil, util.NewInvalidInputError("Either run IDs or an experiment ID with an optional filter can be compared, but not both.")
+		}
+		if len(request.GetRunIds()) > maxComparedRuns {
+			return nil, util.NewInvalidInputError("At most %d runs can be compared. Got %d.", maxComparedRuns, len(request.GetRunIds()))
+		}
+		runs := make([]*model.Run, 0, len(request.GetRunIds()))
+		for _, runID := range request.GetRunIds() {
+			err := s.canAccessRun(ctx, runID)
+			if err != nil {
+				return nil, util.Wrap(err, "Failed to authorize the request.")
+			}
+			run, err := s.resourceManager.GetRun(runID)
+			if err != nil {
+				return nil, util.Wrapf(err, "Failed to compare run %s.", runID)
+			}
+			runs = append(runs, &run.Run)
+		}
+		return ToApiRunComparisons(runs)
+	}
+
+	experimentID := request.GetExperimentId()
+	if experimentID == "" {
+		return nil, util.NewInvalidInputError("Either run IDs or an experiment ID must be specified to compare runs.")
+	}
+	err := CanAccessExperiment(s.resourceManager, ctx, experimentID)
 	if err != nil {
-		return nil, util.Wrap(err, "Failed to list runs.")
+		return nil, util.Wrap(err, "Failed to authorize with the experiment.")
 	}
-	return &api.ListRunsResponse{Runs: ToApiRuns(runs), TotalSize: int32(total_size), NextPageToken: nextPageToken}, nil
+	opts, err := validatedListOptions(&model.Run{}, "", maxComparedRuns, "created_at desc", request.GetFilter())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create list options")
+	}
+	filterContext := &common.FilterContext{
+		ReferenceKey: &common.ReferenceKey{Type: common.Experiment, ID: experimentID},
+	}
+	runs, totalSize, _, err := s.resourceManager.ListRuns(filterContext, opts)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to list the runs to compare.")
+	}
+	response, err := ToApiRunComparisons(runs)
+	if err != nil {
+		return nil, err
+	}
+	response.TotalSize = int32(totalSize)
+	return response, nil
 }
 
 func (s *RunServer) ArchiveRun(ctx context.Context, request *api.ArchiveRunRequest) (*empty.Empty, error) {
@@ -249,31 +356,92 @@ func (s *RunServer) ReportRunMetrics(ctx context.Context, request *api.ReportRun
 		reportRunMetricsRequests.Inc()
 	}
 
+	return s.reportRunMetrics(request, s.resourceManager.ReportMetric)
+}
+
+func (s *RunServer) PushRunMetrics(ctx context.Context, request *api.ReportRunMetricsRequest) (*api.ReportRunMetricsResponse, error) {
+	if s.options.CollectMetrics {
+		pushRunMetricsRequests.Inc()
+	}
+
+	err := authenticateMetricsPush(ctx, request.GetRunId())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authenticate the request.")
+	}
+	return s.reportRunMetrics(request, s.resourceManager.PushMetric)
+}
+
+// reportRunMetrics reports each metric of the request with report. New metrics past the metric
+// limit of the run are dropped, and a warning is added to the run if any is.
+func (s *RunServer) reportRunMetrics(request *api.ReportRunMetricsRequest,
+	report func(metric *api.RunMetric, runUUID string) error) (*api.ReportRunMetricsResponse, error) {
 	// Makes sure run exists
-	_, err := s.resourceManager.GetRun(request.GetRunId())
+	run, err := s.resourceManager.GetRun(request.GetRunId())
 	if err != nil {
 		return nil, err
 	}
+	limit := common.GetRunMetricsLimit(run.Namespace)
+	reported := make(map[string]bool)
+	for _, metric := range run.Metrics {
+		reported[metric.NodeID+"/"+metric.Name] = true
+	}
+	dropped := false
 	response := &api.ReportRunMetricsResponse{
 		Results: []*api.ReportRunMetricsResponse_ReportRunMetricResult{},
 	}
 	for _, metric := range request.GetMetrics() {
+		key := metric.GetNodeId() + "/" + metric.GetName()
 		err := ValidateRunMetric(metric)
+		if err == nil && limit > 0 && !reported[key] && len(reported) >= limit {
+			err = util.NewResourceExhaustedError("run %s has reached its limit of %d metrics", request.GetRunId(), limit)
+			dropped = true
+		}
 		if err == nil {
-			err = s.resourceManager.ReportMetric(metric, request.GetRunId())
+			err = report(metric, request.GetRunId())
+		}
+		if err == nil {
+			reported[key] = true
 		}
 		response.Results = append(
 			response.Results,
 			NewReportRunMetricResult(metric.GetName(), metric.GetNodeId(), err))
 	}
+	if dropped {
+		err = s.resourceManager.AddRunWarning(request.GetRunId(),
+			fmt.Sprintf("Some metrics were dropped because the run reached its limit of %d metrics.", limit))
+		if err != nil {
+			return nil, util.Wrapf(err, "failed to add a warning to run '%v'.", request.GetRunId())
+		}
+	}
 	return response, nil
 }
 
+func (s *RunServer) GetRunMetricHistory(ctx context.Context, request *api.GetRunMetricHistoryRequest) (*api.GetRunMetricHistoryResponse, error) {
+	if s.options.CollectMetrics {
+		getRunMetricHistoryRequests.Inc()
+	}
+
+	err := s.canAccessRun(ctx, request.GetRunId())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request.")
+	}
+	points, err := s.resourceManager.GetRunMetricHistory(
+		request.GetRunId(), request.GetNodeId(), request.GetMetricName())
+	if err != nil {
+		return nil, util.Wrapf(err, "failed to get metric history '%+v'.", request)
+	}
+	return &api.GetRunMetricHistoryResponse{Points: ToApiRunMetricPoints(points)}, nil
+}
+
 func (s *RunServer) ReadArtifact(ctx context.Context, request *api.ReadArtifactRequest) (*api.ReadArtifactResponse, error) {
 	if s.options.CollectMetrics {
 		readArtifactRequests.Inc()
 	}
 
+	err := s.canAccessRun(ctx, request.GetRunId())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request.")
+	}
 	content, err := s.resourceManager.ReadArtifact(
 		request.GetRunId(), request.GetNodeId(), request.GetArtifactName())
 	if err != nil {
@@ -284,6 +452,67 @@ func (s *RunServer) ReadArtifact(ctx context.Context, request *api.ReadArtifactR
 	}, nil
 }
 
+func (s *RunServer) ListRunArtifacts(ctx context.Context, request *api.ListRunArtifactsRequest) (*api.ListRunArtifactsResponse, error) {
+	if s.options.CollectMetrics {
+		listRunArtifactsRequests.Inc()
+	}
+
+	err := s.canAccessRun(ctx, request.GetRunId())
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request.")
+	}
+	artifacts, err := s.resourceManager.ListRunArtifacts(request.GetRunId())
+	if err != nil {
+		return nil, util.Wrapf(err, "failed to list artifacts of run '%v'.", request.GetRunId())
+	}
+	return &api.ListRunArtifactsResponse{Artifacts: ToApiRunArtifacts(artifacts)}, nil
+}
+
+func (s *RunServer) StreamArtifact(request *api.StreamArtifactRequest, stream api.RunService_StreamArtifactServer) error {
+	if s.options.CollectMetrics {
+		streamArtifactRequests.Inc()
+	}
+
+	err := s.canAccessRun(stream.Context(), request.GetRunId())
+	if err != nil {
+		return util.Wrap(err, "Failed to authorize the request.")
+	}
+	if request.GetLength() < 0 {
+		return util.NewInvalidInputError("The length of the artifact to stream can't be negative, got %v.", request.GetLength())
+	}
+	reader, err := s.resourceManager.OpenArtifact(
+		request.GetRunId(), request.GetNodeId(), request.GetArtifactName(), request.GetUntar(), request.GetOffset())
+	if err != nil {
+		return util.Wrapf(err, "failed to stream artifact '%+v'.", request)
+	}
+	defer reader.Close()
+
+	var content io.Reader = reader
+	if request.GetLength() > 0 {
+		content = io.LimitReader(reader, request.GetLength())
+	}
+	// The first response carries the size and the content type, and is sent even if the content
+	// is empty.
+	response := &api.StreamArtifactResponse{Size: reader.Size, ContentType: reader.ContentType}
+	buf := make([]byte, artifactChunkSize)
+	for first := true; ; first = false {
+		n, readErr := io.ReadFull(content, buf)
+		if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+			return util.NewInternalServerError(readErr, "Failed to read artifact '%+v'", request)
+		}
+		if n > 0 || first {
+			response.Data = buf[:n]
+			if err := stream.Send(response); err != nil {
+				return util.Wrapf(err, "failed to stream artifact '%+v'.", request)
+			}
+			response = &api.StreamArtifactResponse{}
+		}
+		if readErr != nil {
+			return nil
+		}
+	}
+}
+
 func (s *RunServer) validateCreateRunRequest(request *api.CreateRunRequest) error {
 	run := request.Run
 	if run.Name == "" {
@@ -10,9 +10,12 @@ import (
 	"github.com/google/go-cmp/cmp"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 )
@@ -49,6 +52,7 @@ func TestCreateRun(t *testing.T) {
 			FinishedAt:     &timestamp.Timestamp{},
 			PipelineSpec: &api.PipelineSpec{
 				WorkflowManifest: testWorkflow.ToStringForStore(),
+				WorkflowDigest:   util.ComputeDigest([]byte(testWorkflow.ToStringForStore())),
 				Parameters:       []*api.Parameter{{Name: "param1", Value: "world"}},
 			},
 			ResourceReferences: []*api.ResourceReference{
@@ -101,6 +105,7 @@ func TestCreateRunPatch(t *testing.T) {
 			FinishedAt:     &timestamp.Timestamp{},
 			PipelineSpec: &api.PipelineSpec{
 				WorkflowManifest: testWorkflowPatch.ToStringForStore(),
+				WorkflowDigest:   util.ComputeDigest([]byte(testWorkflowPatch.ToStringForStore())),
 				Parameters: []*api.Parameter{
 					{Name: "param1", Value: "test-default-bucket"},
 					{Name: "param2", Value: "test-project-id"}},
@@ -182,6 +187,7 @@ func TestCreateRun_Multiuser(t *testing.T) {
 			FinishedAt:     &timestamp.Timestamp{},
 			PipelineSpec: &api.PipelineSpec{
 				WorkflowManifest: testWorkflow.ToStringForStore(),
+				WorkflowDigest:   util.ComputeDigest([]byte(testWorkflow.ToStringForStore())),
 				Parameters:       []*api.Parameter{{Name: "param1", Value: "world"}},
 			},
 			ResourceReferences: []*api.ResourceReference{
@@ -223,6 +229,7 @@ func TestListRun(t *testing.T) {
 		FinishedAt:     &timestamp.Timestamp{},
 		PipelineSpec: &api.PipelineSpec{
 			WorkflowManifest: testWorkflow.ToStringForStore(),
+			WorkflowDigest:   util.ComputeDigest([]byte(testWorkflow.ToStringForStore())),
 			Parameters:       []*api.Parameter{{Name: "param1", Value: "world"}},
 		},
 		ResourceReferences: []*api.ResourceReference{
@@ -288,6 +295,7 @@ func TestListRuns_Multiuser(t *testing.T) {
 		FinishedAt:     &timestamp.Timestamp{},
 		PipelineSpec: &api.PipelineSpec{
 			WorkflowManifest: testWorkflow.ToStringForStore(),
+			WorkflowDigest:   util.ComputeDigest([]byte(testWorkflow.ToStringForStore())),
 			Parameters:       []*api.Parameter{{Name: "param1", Value: "world"}},
 		},
 		ResourceReferences: []*api.ResourceReference{
@@ -535,6 +543,271 @@ func TestReportRunMetrics_Succeed(t *testing.T) {
 	assert.Equal(t, []*api.RunMetric{metric}, run.GetRun().GetMetrics())
 }
 
+func TestReportRunMetrics_LimitExceeded(t *testing.T) {
+	viper.Set(common.RunMetricsLimit, "1")
+	defer viper.Set(common.RunMetricsLimit, nil)
+	clientManager, resourceManager, runDetails := initWithOneTimeRun(t)
+	defer clientManager.Close()
+	runServer := RunServer{resourceManager: resourceManager, options: &RunServerOptions{CollectMetrics: false}}
+
+	response, err := runServer.ReportRunMetrics(context.Background(), &api.ReportRunMetricsRequest{
+		RunId: runDetails.UUID,
+		Metrics: []*api.RunMetric{
+			{Name: "metric-1", NodeId: "node-1", Value: &api.RunMetric_NumberValue{NumberValue: 0.1}},
+			{Name: "metric-2", NodeId: "node-1", Value: &api.RunMetric_NumberValue{NumberValue: 0.2}},
+			// Reporting the same metric again doesn't count against the limit.
+			{Name: "metric-1", NodeId: "node-1", Value: &api.RunMetric_NumberValue{NumberValue: 0.3}},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, api.ReportRunMetricsResponse_ReportRunMetricResult_OK, response.Results[0].Status)
+	assert.Equal(t, api.ReportRunMetricsResponse_ReportRunMetricResult_RESOURCE_EXHAUSTED, response.Results[1].Status)
+	assert.Equal(t, api.ReportRunMetricsResponse_ReportRunMetricResult_DUPLICATE_REPORTING, response.Results[2].Status)
+
+	run, err := runServer.GetRun(context.Background(), &api.GetRunRequest{
+		RunId: runDetails.UUID,
+	})
+	assert.Nil(t, err)
+	assert.Len(t, run.GetRun().GetMetrics(), 1)
+	assert.Equal(t, []string{"Some metrics were dropped because the run reached its limit of 1 metrics."},
+		run.GetRun().GetWarnings())
+}
+
+func TestPushRunMetrics_Unauthenticated(t *testing.T) {
+	clientManager, resourceManager, runDetails := initWithOneTimeRun(t)
+	defer clientManager.Close()
+	runServer := RunServer{resourceManager: resourceManager, options: &RunServerOptions{CollectMetrics: false}}
+	request := &api.ReportRunMetricsRequest{RunId: runDetails.UUID}
+
+	// Disabled without a token secret.
+	_, err := runServer.PushRunMetrics(context.Background(), request)
+	AssertUserError(t, err, codes.Unauthenticated)
+
+	viper.Set(common.MetricsPushTokenSecret, "secret")
+	defer viper.Set(common.MetricsPushTokenSecret, "")
+	_, err = runServer.PushRunMetrics(context.Background(), request)
+	AssertUserError(t, err, codes.Unauthenticated)
+
+	// The token of another run.
+	md := metadata.New(map[string]string{"authorization": "Bearer " + common.GetMetricsPushToken("other-run")})
+	_, err = runServer.PushRunMetrics(metadata.NewIncomingContext(context.Background(), md), request)
+	AssertUserError(t, err, codes.Unauthenticated)
+}
+
+func TestPushRunMetrics_Succeed(t *testing.T) {
+	viper.Set(common.MetricsPushTokenSecret, "secret")
+	defer viper.Set(common.MetricsPushTokenSecret, "")
+	clientManager, resourceManager, runDetails := initWithOneTimeRun(t)
+	defer clientManager.Close()
+	runServer := RunServer{resourceManager: resourceManager, options: &RunServerOptions{CollectMetrics: false}}
+	md := metadata.New(map[string]string{"authorization": "Bearer " + common.GetMetricsPushToken(runDetails.UUID)})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	for _, value := range []float64{0.2, 0.5} {
+		response, err := runServer.PushRunMetrics(ctx, &api.ReportRunMetricsRequest{
+			RunId: runDetails.UUID,
+			Metrics: []*api.RunMetric{
+				{Name: "progress", NodeId: "node-1", Value: &api.RunMetric_NumberValue{NumberValue: value}},
+				{Name: "invalid name", NodeId: "node-1"},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, api.ReportRunMetricsResponse_ReportRunMetricResult_OK, response.Results[0].Status)
+		assert.Equal(t, api.ReportRunMetricsResponse_ReportRunMetricResult_INVALID_ARGUMENT, response.Results[1].Status)
+	}
+
+	run, err := runServer.GetRun(context.Background(), &api.GetRunRequest{
+		RunId: runDetails.UUID,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []*api.RunMetric{{
+		Name:   "progress",
+		NodeId: "node-1",
+		Value:  &api.RunMetric_NumberValue{NumberValue: 0.5},
+	}}, run.GetRun().GetMetrics())
+}
+
+func TestGetRunMetricHistory(t *testing.T) {
+	clientManager, resourceManager, runDetails := initWithOneTimeRun(t)
+	defer clientManager.Close()
+	runServer := RunServer{resourceManager: resourceManager, options: &RunServerOptions{CollectMetrics: false}}
+
+	metric := &api.RunMetric{
+		Name:          "loss",
+		NodeId:        "node-1",
+		SeriesSummary: api.RunMetric_MIN,
+		Series: []*api.RunMetricPoint{
+			{Step: 2, NumberValue: 0.4},
+			{Step: 1, NumberValue: 0.9, Timestamp: &timestamp.Timestamp{Seconds: 10}},
+			{Step: 3, NumberValue: 0.5},
+		},
+	}
+	_, err := runServer.ReportRunMetrics(context.Background(), &api.ReportRunMetricsRequest{
+		RunId:   runDetails.UUID,
+		Metrics: []*api.RunMetric{metric},
+	})
+	assert.Nil(t, err)
+
+	response, err := runServer.GetRunMetricHistory(context.Background(), &api.GetRunMetricHistoryRequest{
+		RunId:      runDetails.UUID,
+		NodeId:     "node-1",
+		MetricName: "loss",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, &api.GetRunMetricHistoryResponse{
+		Points: []*api.RunMetricPoint{
+			{Step: 1, NumberValue: 0.9, Timestamp: &timestamp.Timestamp{Seconds: 10}},
+			{Step: 2, NumberValue: 0.4},
+			{Step: 3, NumberValue: 0.5},
+		},
+	}, response)
+
+	// The run reports the summary of the series.
+	run, err := runServer.GetRun(context.Background(), &api.GetRunRequest{
+		RunId: runDetails.UUID,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []*api.RunMetric{{
+		Name:   "loss",
+		NodeId: "node-1",
+		Value:  &api.RunMetric_NumberValue{NumberValue: 0.4},
+		Format: api.RunMetric_UNSPECIFIED,
+	}}, run.GetRun().GetMetrics())
+}
+
+func TestGetRunMetricHistory_NotFound(t *testing.T) {
+	clientManager, resourceManager, runDetails := initWithOneTimeRun(t)
+	defer clientManager.Close()
+	runServer := RunServer{resourceManager: resourceManager, options: &RunServerOptions{CollectMetrics: false}}
+
+	_, err := runServer.GetRunMetricHistory(context.Background(), &api.GetRunMetricHistoryRequest{
+		RunId:      runDetails.UUID,
+		NodeId:     "node-1",
+		MetricName: "loss",
+	})
+	AssertUserError(t, err, codes.NotFound)
+}
+
+// Util function to create an initial state with two runs of the experiment, with metrics.
+func initWithComparedRuns(t *testing.T) (*resource.FakeClientManager, *RunServer, *model.RunDetail, *model.RunDetail) {
+	clientManager, resourceManager, runDetail := initWithOneTimeRun(t)
+	runServer := &RunServer{resourceManager: resourceManager, options: &RunServerOptions{CollectMetrics: false}}
+	otherRun := &api.Run{
+		Name: "run2",
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+			Parameters: []*api.Parameter{
+				{Name: "param1", Value: "hello"},
+			},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: runDetail.ResourceReferences[0].ReferenceUUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}
+	clientManager.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(resource.FakeUUIDOne, nil))
+	otherRunDetail, err := resource.NewResourceManager(clientManager).CreateRun(otherRun)
+	assert.Nil(t, err)
+
+	_, err = runServer.ReportRunMetrics(context.Background(), &api.ReportRunMetricsRequest{
+		RunId: runDetail.UUID,
+		Metrics: []*api.RunMetric{
+			{Name: "accuracy", NodeId: "node-2", Value: &api.RunMetric_NumberValue{NumberValue: 0.8}},
+			{Name: "accuracy", NodeId: "node-1", Value: &api.RunMetric_NumberValue{NumberValue: 0.7}},
+		},
+	})
+	assert.Nil(t, err)
+	_, err = runServer.ReportRunMetrics(context.Background(), &api.ReportRunMetricsRequest{
+		RunId: otherRunDetail.UUID,
+		Metrics: []*api.RunMetric{
+			{Name: "loss", NodeId: "node-1", Value: &api.RunMetric_NumberValue{NumberValue: 0.25}},
+		},
+	})
+	assert.Nil(t, err)
+	return clientManager, runServer, runDetail, otherRunDetail
+}
+
+func TestCompareRuns_RunIds(t *testing.T) {
+	clientManager, runServer, runDetail, otherRunDetail := initWithComparedRuns(t)
+	defer clientManager.Close()
+
+	response, err := runServer.CompareRuns(context.Background(), &api.CompareRunsRequest{
+		RunIds: []string{otherRunDetail.UUID, runDetail.UUID},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"param1"}, response.ParameterNames)
+	assert.Equal(t, []string{"loss", "node-1/accuracy", "node-2/accuracy"}, response.MetricNames)
+	assert.Equal(t, int32(2), response.TotalSize)
+	assert.Equal(t, []*api.RunComparison{
+		{
+			RunId:      otherRunDetail.UUID,
+			Name:       "run2",
+			Status:     otherRunDetail.Conditions,
+			CreatedAt:  &timestamp.Timestamp{Seconds: otherRunDetail.CreatedAtInSec},
+			Parameters: map[string]string{"param1": "hello"},
+			Metrics:    map[string]float64{"loss": 0.25},
+		},
+		{
+			RunId:      runDetail.UUID,
+			Name:       "run1",
+			Status:     runDetail.Conditions,
+			CreatedAt:  &timestamp.Timestamp{Seconds: runDetail.CreatedAtInSec},
+			Parameters: map[string]string{"param1": "world"},
+			// The metrics of the nodes reporting the same name are compared apart.
+			Metrics: map[string]float64{"node-1/accuracy": 0.7, "node-2/accuracy": 0.8},
+		},
+	}, response.Runs)
+}
+
+func TestCompareRuns_Experiment(t *testing.T) {
+	clientManager, runServer, runDetail, otherRunDetail := initWithComparedRuns(t)
+	defer clientManager.Close()
+
+	response, err := runServer.CompareRuns(context.Background(), &api.CompareRunsRequest{
+		ExperimentId: runDetail.ResourceReferences[0].ReferenceUUID,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), response.TotalSize)
+	assert.Len(t, response.Runs, 2)
+	// The most recent runs come first.
+	assert.Equal(t, otherRunDetail.UUID, response.Runs[0].RunId)
+	assert.Equal(t, runDetail.UUID, response.Runs[1].RunId)
+	assert.Equal(t, []string{"loss", "node-1/accuracy", "node-2/accuracy"}, response.MetricNames)
+}
+
+func TestCompareRuns_InvalidRequests(t *testing.T) {
+	clientManager, runServer, runDetail, _ := initWithComparedRuns(t)
+	defer clientManager.Close()
+	experimentID := runDetail.ResourceReferences[0].ReferenceUUID
+
+	tooManyRunIDs := make([]string, maxComparedRuns+1)
+	for i := range tooManyRunIDs {
+		tooManyRunIDs[i] = runDetail.UUID
+	}
+	tests := []*api.CompareRunsRequest{
+		{},
+		{RunIds: []string{runDetail.UUID}, ExperimentId: experimentID},
+		{RunIds: tooManyRunIDs},
+		{ExperimentId: experimentID, Format: api.CompareRunsRequest_CSV},
+	}
+	for _, request := range tests {
+		_, err := runServer.CompareRuns(context.Background(), request)
+		AssertUserError(t, err, codes.InvalidArgument)
+	}
+}
+
+func TestCompareRuns_RunNotFound(t *testing.T) {
+	clientManager, runServer, runDetail, _ := initWithComparedRuns(t)
+	defer clientManager.Close()
+
+	_, err := runServer.CompareRuns(context.Background(), &api.CompareRunsRequest{
+		RunIds: []string{runDetail.UUID, "not-a-run"},
+	})
+	AssertUserError(t, err, codes.NotFound)
+}
+
 func TestReportRunMetrics_PartialFailures(t *testing.T) {
 	httpServer := getMockServer(t)
 	// Close the server when test finishes
@@ -590,6 +863,108 @@ func TestReportRunMetrics_PartialFailures(t *testing.T) {
 	assert.Equal(t, expectedResponse, response)
 }
 
+func TestListRunArtifacts(t *testing.T) {
+	clients, manager, runDetail := initWithArtifact(t, "0123456789")
+	defer clients.Close()
+	runServer := RunServer{resourceManager: manager, options: &RunServerOptions{CollectMetrics: false}}
+
+	response, err := runServer.ListRunArtifacts(context.Background(), &api.ListRunArtifactsRequest{RunId: runDetail.UUID})
+	assert.Nil(t, err)
+	assert.Equal(t, []*api.RunArtifact{{
+		Name:   "artifact-1",
+		NodeId: "node-1",
+		Type:   api.RunArtifact_OUTPUT,
+		Key:    "test/artifact.tgz",
+		Size:   10,
+	}}, response.Artifacts)
+}
+
+func TestListRunArtifacts_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+
+	clients, manager, runDetail := initWithArtifact(t, "0123456789")
+	defer clients.Close()
+	runServer := RunServer{resourceManager: manager, options: &RunServerOptions{CollectMetrics: false}}
+
+	_, err := runServer.ListRunArtifacts(context.Background(), &api.ListRunArtifactsRequest{RunId: runDetail.UUID})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Failed to authorize the request")
+}
+
+type fakeStreamArtifactServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*api.StreamArtifactResponse
+}
+
+func (s *fakeStreamArtifactServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeStreamArtifactServer) Send(response *api.StreamArtifactResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+func TestStreamArtifact(t *testing.T) {
+	clients, manager, runDetail := initWithArtifact(t, "0123456789")
+	defer clients.Close()
+	runServer := RunServer{resourceManager: manager, options: &RunServerOptions{CollectMetrics: false}}
+
+	stream := &fakeStreamArtifactServer{ctx: context.Background()}
+	err := runServer.StreamArtifact(&api.StreamArtifactRequest{
+		RunId:        runDetail.UUID,
+		NodeId:       "node-1",
+		ArtifactName: "artifact-1",
+		Offset:       2,
+		Length:       5,
+	}, stream)
+	assert.Nil(t, err)
+	assert.Equal(t, []*api.StreamArtifactResponse{
+		{Data: []byte("23456"), Size: 10, ContentType: "application/octet-stream"},
+	}, stream.responses)
+}
+
+func TestStreamArtifact_Chunks(t *testing.T) {
+	content := strings.Repeat("a", artifactChunkSize+1)
+	clients, manager, runDetail := initWithArtifact(t, content)
+	defer clients.Close()
+	runServer := RunServer{resourceManager: manager, options: &RunServerOptions{CollectMetrics: false}}
+
+	stream := &fakeStreamArtifactServer{ctx: context.Background()}
+	err := runServer.StreamArtifact(&api.StreamArtifactRequest{
+		RunId:        runDetail.UUID,
+		NodeId:       "node-1",
+		ArtifactName: "artifact-1",
+	}, stream)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(stream.responses))
+	assert.Equal(t, artifactChunkSize, len(stream.responses[0].Data))
+	assert.Equal(t, int64(artifactChunkSize+1), stream.responses[0].Size)
+	assert.Equal(t, []byte("a"), stream.responses[1].Data)
+	assert.Equal(t, int64(0), stream.responses[1].Size)
+}
+
+func TestStreamArtifact_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+
+	clients, manager, runDetail := initWithArtifact(t, "0123456789")
+	defer clients.Close()
+	runServer := RunServer{resourceManager: manager, options: &RunServerOptions{CollectMetrics: false}}
+
+	stream := &fakeStreamArtifactServer{ctx: context.Background()}
+	err := runServer.StreamArtifact(&api.StreamArtifactRequest{
+		RunId:        runDetail.UUID,
+		NodeId:       "node-1",
+		ArtifactName: "artifact-1",
+	}, stream)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Failed to authorize the request")
+	assert.Empty(t, stream.responses)
+}
+
 func TestCanAccessRun_Unauthorized(t *testing.T) {
 	viper.Set(common.MultiUserMode, "true")
 	defer viper.Set(common.MultiUserMode, "false")
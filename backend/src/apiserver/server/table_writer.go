@@ -0,0 +1,106 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+type tableColumnType int
+
+const (
+	// A column of strings.
+	stringColumn tableColumnType = iota
+	// A column of int64 values.
+	int64Column
+	// A column of float64 values.
+	doubleColumn
+	// A column of times, held as int64 seconds since epoch.
+	timestampColumn
+)
+
+type tableColumn struct {
+	name       string
+	columnType tableColumnType
+}
+
+// tableRow holds a value per column, of the Go type of the column, or nil for a missing value.
+type tableRow []interface{}
+
+// tableWriter streams a table. Rows are written in batches so that formats like Parquet can write
+// a batch as a row group, without holding the whole table in memory.
+type tableWriter interface {
+	WriteRows(rows []tableRow) error
+	// Close writes whatever the format needs after the last row. It doesn't close the underlying writer.
+	Close() error
+}
+
+type csvTableWriter struct {
+	writer  *csv.Writer
+	columns []tableColumn
+}
+
+func newCSVTableWriter(w io.Writer, columns []tableColumn) (*csvTableWriter, error) {
+	writer := csv.NewWriter(w)
+	header := make([]string, 0, len(columns))
+	for _, column := range columns {
+		header = append(header, column.name)
+	}
+	if err := writer.Write(header); err != nil {
+		return nil, util.Wrap(err, "Failed to write the CSV header")
+	}
+	return &csvTableWriter{writer: writer, columns: columns}, nil
+}
+
+func (w *csvTableWriter) WriteRows(rows []tableRow) error {
+	for _, row := range rows {
+		record := make([]string, len(w.columns))
+		for i, column := range w.columns {
+			record[i] = formatCSVValue(column.columnType, row[i])
+		}
+		if err := w.writer.Write(record); err != nil {
+			return util.Wrap(err, "Failed to write a CSV row")
+		}
+	}
+	// Flush every batch, so that the rows are streamed to the client.
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+func (w *csvTableWriter) Close() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+func formatCSVValue(columnType tableColumnType, value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	switch columnType {
+	case int64Column:
+		return strconv.FormatInt(value.(int64), 10)
+	case doubleColumn:
+		return strconv.FormatFloat(value.(float64), 'g', -1, 64)
+	case timestampColumn:
+		return time.Unix(value.(int64), 0).UTC().Format(time.RFC3339)
+	default:
+		return value.(string)
+	}
+}
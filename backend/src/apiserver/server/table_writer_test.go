@@ -0,0 +1,43 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSVTableWriter(t *testing.T) {
+	columns := []tableColumn{
+		{"id", stringColumn},
+		{"created_at", timestampColumn},
+		{"count", int64Column},
+		{"metrics.accuracy", doubleColumn},
+	}
+	buf := &bytes.Buffer{}
+	writer, err := newCSVTableWriter(buf, columns)
+	assert.Nil(t, err)
+	assert.Nil(t, writer.WriteRows([]tableRow{
+		{"run-1", int64(1600000000), int64(3), 0.75},
+		{"run,2", nil, int64(-1), nil},
+	}))
+	assert.Nil(t, writer.WriteRows([]tableRow{}))
+	assert.Nil(t, writer.Close())
+	assert.Equal(t, "id,created_at,count,metrics.accuracy\n"+
+		"run-1,2020-09-13T12:26:40Z,3,0.75\n"+
+		"\"run,2\",,-1,\n", buf.String())
+}
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"io"
 	"io/ioutil"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
@@ -27,6 +28,11 @@ import (
 const (
 	MaxFileNameLength = 100
 	MaxFileLength     = 32 << 20 // 32Mb
+	MaxLabelLength    = 255
+
+	// The header, and the prefix of its value, that metrics push tokens are passed in.
+	metricsPushAuthorizationHeader = "authorization"
+	metricsPushAuthorizationPrefix = "Bearer "
 )
 
 // This method extract the common logic of naming the pipeline.
@@ -159,27 +165,55 @@ func DecompressPipelineZip(compressedFile []byte) ([]byte, error) {
 }
 
 func ReadPipelineFile(fileName string, fileReader io.Reader, maxFileLength int) ([]byte, error) {
+	processedFile, _, err := ReadPipelinePackage(fileName, fileReader, maxFileLength)
+	if err != nil {
+		return nil, err
+	}
+	return processedFile, nil
+}
+
+// ReadPipelinePackage reads the pipeline file the same way as ReadPipelineFile and, in addition,
+// returns the uploaded archive when the file is a .zip or .tar.gz package, so that the other files
+// bundled with the pipeline can be kept. The returned archive is nil for YAML files.
+func ReadPipelinePackage(fileName string, fileReader io.Reader, maxFileLength int) ([]byte, []byte, error) {
 	// Read file into size limited byte array.
 	pipelineFileBytes, err := loadFile(fileReader, maxFileLength)
 	if err != nil {
-		return nil, util.Wrap(err, "Error read pipeline file.")
+		return nil, nil, util.Wrap(err, "Error read pipeline file.")
 	}
 
 	var processedFile []byte
+	var archive []byte
 	switch {
 	case isYamlFile(fileName):
 		processedFile = pipelineFileBytes
 	case isZipFile(pipelineFileBytes):
 		processedFile, err = DecompressPipelineZip(pipelineFileBytes)
+		archive = pipelineFileBytes
 	case isCompressedTarballFile(pipelineFileBytes):
 		processedFile, err = DecompressPipelineTarball(pipelineFileBytes)
+		archive = pipelineFileBytes
 	default:
-		return nil, util.NewInvalidInputError("Unexpected pipeline file format. Support .zip, .tar.gz or YAML.")
+		return nil, nil, util.NewInvalidInputError("Unexpected pipeline file format. Support .zip, .tar.gz or YAML.")
 	}
 	if err != nil {
-		return nil, util.Wrap(err, "Error decompress the pipeline file")
+		return nil, nil, util.Wrap(err, "Error decompress the pipeline file")
 	}
-	return processedFile, nil
+	return processedFile, archive, nil
+}
+
+// Labels are stored in the labels table, where both key and value are limited to
+// MaxLabelLength characters.
+func ValidateLabels(labels map[string]string) error {
+	for key, value := range labels {
+		if len(key) == 0 {
+			return util.NewInvalidInputError("Label key is empty. Please specify a valid label key.")
+		}
+		if len(key) > MaxLabelLength || len(value) > MaxLabelLength {
+			return util.NewInvalidInputError("Label %q is too long. Support maximum length of %v for both key and value.", key, MaxLabelLength)
+		}
+	}
+	return nil
 }
 
 func printParameters(params []*api.Parameter) string {
@@ -304,6 +338,23 @@ func getUserIdentity(ctx context.Context) (string, error) {
 	return "", util.NewBadRequestError(errors.New("Request header error: there is no user identity header."), "Request header error: there is no user identity header.")
 }
 
+// authenticateMetricsPush checks that the request carries the metrics push token of the run in an
+// "Authorization: Bearer <token>" header.
+func authenticateMetricsPush(ctx context.Context, runId string) error {
+	if common.GetMetricsPushTokenSecret() == "" {
+		return util.NewUnauthenticatedError("Pushing run metrics is disabled.")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	authorization := md.Get(metricsPushAuthorizationHeader)
+	if len(authorization) != 1 || !strings.HasPrefix(authorization[0], metricsPushAuthorizationPrefix) {
+		return util.NewUnauthenticatedError("Request header error: there is no metrics push token.")
+	}
+	if !common.IsValidMetricsPushToken(runId, strings.TrimPrefix(authorization[0], metricsPushAuthorizationPrefix)) {
+		return util.NewUnauthenticatedError("Invalid metrics push token for run %s.", runId)
+	}
+	return nil
+}
+
 func CanAccessExperiment(resourceManager *resource.ResourceManager, ctx context.Context, experimentID string) error {
 	if common.IsMultiUserMode() == false {
 		// Skip authz if not multi-user mode.
@@ -370,6 +421,17 @@ func CanAccessNamespace(resourceManager *resource.ResourceManager, ctx context.C
 	return nil
 }
 
+// httpRequestContext returns the context of a request served outside of the gRPC gateway, with the
+// user identity header as incoming metadata like the gateway forwards it.
+func httpRequestContext(r *http.Request) context.Context {
+	md := metadata.MD{}
+	userIDHeader := common.GetKubeflowUserIDHeader()
+	if values := r.Header[http.CanonicalHeaderKey(userIDHeader)]; len(values) > 0 {
+		md.Append(strings.ToLower(userIDHeader), values...)
+	}
+	return metadata.NewIncomingContext(r.Context(), md)
+}
+
 // isAuthorized verified whether the user identity, which is contains in the context object,
 // can access the target namespace. If the returned error is nil, the authorization passes.
 // Otherwise, Authorization fails with a non-nil error.
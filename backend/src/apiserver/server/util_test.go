@@ -185,6 +185,38 @@ func TestReadPipelineFile_MultifileTarball(t *testing.T) {
 	assert.Equal(t, expectedPipelineFile, pipelineFile)
 }
 
+func TestReadPipelinePackage_YAML(t *testing.T) {
+	file, _ := os.Open("test/arguments-parameters.yaml")
+	pipelineFile, pipelinePackage, err := ReadPipelinePackage("arguments-parameters.yaml", file, MaxFileLength)
+	assert.Nil(t, err)
+
+	expectedPipelineFile, _ := ioutil.ReadFile("test/arguments-parameters.yaml")
+	assert.Equal(t, expectedPipelineFile, pipelineFile)
+	assert.Nil(t, pipelinePackage)
+}
+
+func TestReadPipelinePackage_MultifileZip(t *testing.T) {
+	file, _ := os.Open("test/pipeline_plus_component/pipeline_plus_component.zip")
+	pipelineFile, pipelinePackage, err := ReadPipelinePackage("pipeline_plus_component.zip", file, MaxFileLength)
+	assert.Nil(t, err)
+
+	expectedPipelineFile, _ := ioutil.ReadFile("test/pipeline_plus_component/pipeline.yaml")
+	assert.Equal(t, expectedPipelineFile, pipelineFile)
+	expectedPipelinePackage, _ := ioutil.ReadFile("test/pipeline_plus_component/pipeline_plus_component.zip")
+	assert.Equal(t, expectedPipelinePackage, pipelinePackage)
+}
+
+func TestReadPipelinePackage_MultifileTarball(t *testing.T) {
+	file, _ := os.Open("test/pipeline_plus_component/pipeline_plus_component.tar.gz")
+	pipelineFile, pipelinePackage, err := ReadPipelinePackage("pipeline_plus_component.tar.gz", file, MaxFileLength)
+	assert.Nil(t, err)
+
+	expectedPipelineFile, _ := ioutil.ReadFile("test/pipeline_plus_component/pipeline.yaml")
+	assert.Equal(t, expectedPipelineFile, pipelineFile)
+	expectedPipelinePackage, _ := ioutil.ReadFile("test/pipeline_plus_component/pipeline_plus_component.tar.gz")
+	assert.Equal(t, expectedPipelinePackage, pipelinePackage)
+}
+
 func TestReadPipelineFile_UnknownFileFormat(t *testing.T) {
 	file, _ := os.Open("test/unknown_format.foo")
 	_, err := ReadPipelineFile("unknown_format.foo", file, MaxFileLength)
@@ -35,9 +35,11 @@ func NewFakeDb() (*DB, error) {
 		&model.Job{},
 		&model.Pipeline{},
 		&model.PipelineVersion{},
+		&model.Label{},
 		&model.ResourceReference{},
 		&model.RunDetail{},
 		&model.RunMetric{},
+		&model.RunMetricPoint{},
 		&model.DBStatus{},
 		&model.DefaultExperiment{})
 
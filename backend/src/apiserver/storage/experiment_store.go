@@ -29,6 +29,7 @@ type ExperimentStore struct {
 	uuid                   util.UUIDGeneratorInterface
 	resourceReferenceStore *ResourceReferenceStore
 	defaultExperimentStore *DefaultExperimentStore
+	labelStore             *LabelStore
 }
 
 var (
@@ -109,6 +110,10 @@ func (s *ExperimentStore) ListExperiments(filterContext *common.FilterContext, o
 		return errorF(err)
 	}
 
+	if err := s.addLabels(exps); err != nil {
+		return errorF(err)
+	}
+
 	if len(exps) <= opts.PageSize {
 		return exps, total_size, "", nil
 	}
@@ -140,9 +145,27 @@ func (s *ExperimentStore) GetExperiment(uuid string) (*model.Experiment, error)
 	if len(experiments) == 0 {
 		return nil, util.NewResourceNotFoundError("Experiment", fmt.Sprint(uuid))
 	}
+	if err := s.addLabels(experiments); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get experiment: %v", err.Error())
+	}
 	return experiments[0], nil
 }
 
+func (s *ExperimentStore) addLabels(experiments []*model.Experiment) error {
+	var ids []string
+	for _, experiment := range experiments {
+		ids = append(ids, experiment.UUID)
+	}
+	labels, err := s.labelStore.ListLabels(ids, common.Experiment)
+	if err != nil {
+		return err
+	}
+	for _, experiment := range experiments {
+		experiment.Labels = labels[experiment.UUID]
+	}
+	return nil
+}
+
 func (s *ExperimentStore) scanRows(rows *sql.Rows) ([]*model.Experiment, error) {
 	var experiments []*model.Experiment
 	for rows.Next() {
@@ -202,8 +225,14 @@ func (s *ExperimentStore) CreateExperiment(experiment *model.Experiment) (*model
 		return nil, util.NewInternalServerError(err, "Failed to create query to insert experiment to experiment table: %v",
 			err.Error())
 	}
-	_, err = s.db.Exec(sql, args...)
+	// Use a transaction to make sure both experiment and its labels are stored.
+	tx, err := s.db.Begin()
 	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create a new transaction to create experiment.")
+	}
+	_, err = tx.Exec(sql, args...)
+	if err != nil {
+		tx.Rollback()
 		if s.db.IsDuplicateError(err) {
 			return nil, util.NewAlreadyExistError(
 				"Failed to create a new experiment. The name %v already exists. Please specify a new name.", experiment.Name)
@@ -211,6 +240,16 @@ func (s *ExperimentStore) CreateExperiment(experiment *model.Experiment) (*model
 		return nil, util.NewInternalServerError(err, "Failed to add experiment to experiment table: %v",
 			err.Error())
 	}
+	err = s.labelStore.CreateLabels(tx, newExperiment.UUID, common.Experiment, newExperiment.Labels)
+	if err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err, "Failed to store labels to table for experiment %v", experiment.Name)
+	}
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err, "Failed to store experiment %v and its labels to table", experiment.Name)
+	}
 	return &newExperiment, nil
 }
 
@@ -240,6 +279,11 @@ func (s *ExperimentStore) DeleteExperiment(id string) error {
 		tx.Rollback()
 		return util.NewInternalServerError(err, "Failed to delete resource references from table for experiment %v ", id)
 	}
+	err = s.labelStore.DeleteLabels(tx, id, common.Experiment)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete labels from table for experiment %v ", id)
+	}
 	err = tx.Commit()
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to delete experiment %v and its resource references from table", id)
@@ -400,5 +444,6 @@ func NewExperimentStore(db *DB, time util.TimeInterface, uuid util.UUIDGenerator
 		uuid:                   uuid,
 		resourceReferenceStore: NewResourceReferenceStore(db),
 		defaultExperimentStore: NewDefaultExperimentStore(db),
+		labelStore:             NewLabelStore(db),
 	}
 }
@@ -251,6 +251,45 @@ func TestCreateExperiment(t *testing.T) {
 	assert.Equal(t, experimentExpected, *experiment, "Got unexpected experiment.")
 }
 
+func TestCreateExperiment_WithLabels(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	experimentStore := NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	experiment := createExperiment("experiment1")
+	experiment.Labels = map[string]string{"team": "ranking"}
+	_, err := experimentStore.CreateExperiment(experiment)
+	assert.Nil(t, err)
+	experimentStore.uuid = util.NewFakeUUIDGeneratorOrFatal(fakeIDTwo, nil)
+	_, err = experimentStore.CreateExperiment(createExperiment("experiment2"))
+	assert.Nil(t, err)
+
+	experiment, err = experimentStore.GetExperiment(fakeID)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "ranking"}, experiment.Labels)
+
+	filterProto := &api.Filter{
+		Predicates: []*api.Predicate{
+			{
+				Key:   "label.team",
+				Op:    api.Predicate_NOT_EQUALS,
+				Value: &api.Predicate_StringValue{StringValue: "ranking"},
+			},
+		},
+	}
+	opts, err := list.NewOptions(&model.Experiment{}, 10, "id", filterProto)
+	assert.Nil(t, err)
+	experiments, totalSize, _, err := experimentStore.ListExperiments(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, totalSize)
+	assert.Equal(t, fakeIDTwo, experiments[0].UUID)
+
+	err = experimentStore.DeleteExperiment(fakeID)
+	assert.Nil(t, err)
+	labels, err := experimentStore.labelStore.GetLabels(fakeID, common.Experiment)
+	assert.Nil(t, err)
+	assert.Empty(t, labels)
+}
+
 func TestCreateExperiment_DifferentNamespaces(t *testing.T) {
 	db := NewFakeDbOrFatal()
 	defer db.Close()
@@ -0,0 +1,56 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"io"
+
+	gcs "cloud.google.com/go/storage"
+)
+
+// GCSClientInterface is the subset of the Google Cloud Storage client used by GCSObjectStore.
+type GCSClientInterface interface {
+	PutObject(bucketName, objectName string, content []byte, contentType string) error
+	GetObject(bucketName, objectName string, offset int64) (io.ReadCloser, error)
+	DeleteObject(bucketName, objectName string) error
+	StatObject(bucketName, objectName string) (*gcs.ObjectAttrs, error)
+}
+
+type GCSClient struct {
+	Client *gcs.Client
+}
+
+func (c *GCSClient) PutObject(bucketName, objectName string, content []byte, contentType string) error {
+	writer := c.Client.Bucket(bucketName).Object(objectName).NewWriter(context.Background())
+	writer.ContentType = contentType
+	if _, err := writer.Write(content); err != nil {
+		writer.Close()
+		return err
+	}
+	return writer.Close()
+}
+
+func (c *GCSClient) GetObject(bucketName, objectName string, offset int64) (io.ReadCloser, error) {
+	return c.Client.Bucket(bucketName).Object(objectName).NewRangeReader(context.Background(), offset, -1)
+}
+
+func (c *GCSClient) DeleteObject(bucketName, objectName string) error {
+	return c.Client.Bucket(bucketName).Object(objectName).Delete(context.Background())
+}
+
+func (c *GCSClient) StatObject(bucketName, objectName string) (*gcs.ObjectAttrs, error) {
+	return c.Client.Bucket(bucketName).Object(objectName).Attrs(context.Background())
+}
@@ -0,0 +1,81 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+
+	gcs "cloud.google.com/go/storage"
+)
+
+type fakeGCSObject struct {
+	content     []byte
+	contentType string
+}
+
+type FakeGCSClient struct {
+	objects map[string]fakeGCSObject
+}
+
+func NewFakeGCSClient() *FakeGCSClient {
+	return &FakeGCSClient{
+		objects: make(map[string]fakeGCSObject),
+	}
+}
+
+func (c *FakeGCSClient) PutObject(bucketName, objectName string, content []byte, contentType string) error {
+	c.objects[objectName] = fakeGCSObject{content: content, contentType: contentType}
+	return nil
+}
+
+func (c *FakeGCSClient) GetObject(bucketName, objectName string, offset int64) (io.ReadCloser, error) {
+	object, ok := c.objects[objectName]
+	if !ok {
+		return nil, gcs.ErrObjectNotExist
+	}
+	return ioutil.NopCloser(bytes.NewReader(object.content[offset:])), nil
+}
+
+func (c *FakeGCSClient) DeleteObject(bucketName, objectName string) error {
+	if _, ok := c.objects[objectName]; !ok {
+		return gcs.ErrObjectNotExist
+	}
+	delete(c.objects, objectName)
+	return nil
+}
+
+func (c *FakeGCSClient) StatObject(bucketName, objectName string) (*gcs.ObjectAttrs, error) {
+	object, ok := c.objects[objectName]
+	if !ok {
+		return nil, gcs.ErrObjectNotExist
+	}
+	return &gcs.ObjectAttrs{
+		Bucket:      bucketName,
+		Name:        objectName,
+		Size:        int64(len(object.content)),
+		ContentType: object.contentType,
+	}, nil
+}
+
+func (c *FakeGCSClient) GetObjectCount() int {
+	return len(c.objects)
+}
+
+func (c *FakeGCSClient) ExistObject(objectName string) bool {
+	_, ok := c.objects[objectName]
+	return ok
+}
@@ -0,0 +1,105 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"io/ioutil"
+	"path"
+
+	gcs "cloud.google.com/go/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// GCSObjectStore stores files in a Google Cloud Storage bucket.
+type GCSObjectStore struct {
+	gcsClient  GCSClientInterface
+	bucketName string
+	baseFolder string
+}
+
+func (g *GCSObjectStore) GetPipelineKey(pipelineID string) string {
+	return path.Join(g.baseFolder, pipelineID)
+}
+
+func (g *GCSObjectStore) GetPipelinePackageKey(pipelineVersionID string) string {
+	return path.Join(g.baseFolder, pipelinePackageFolder, pipelineVersionID)
+}
+
+func (g *GCSObjectStore) AddFile(file []byte, filePath string) error {
+	err := g.gcsClient.PutObject(g.bucketName, filePath, file, "application/octet-stream")
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to store %v", filePath)
+	}
+	return nil
+}
+
+func (g *GCSObjectStore) DeleteFile(filePath string) error {
+	err := g.gcsClient.DeleteObject(g.bucketName, filePath)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to delete %v", filePath)
+	}
+	return nil
+}
+
+func (g *GCSObjectStore) GetFile(filePath string) ([]byte, error) {
+	reader, err := g.gcsClient.GetObject(g.bucketName, filePath, 0)
+	if err == gcs.ErrObjectNotExist {
+		return nil, util.NewNotFoundError(err, "File %v not found", filePath)
+	}
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	defer reader.Close()
+	bytes, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	return bytes, nil
+}
+
+func (g *GCSObjectStore) OpenFile(filePath string, offset int64) (*FileReader, error) {
+	attrs, err := g.gcsClient.StatObject(g.bucketName, filePath)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	if offset < 0 || offset > attrs.Size {
+		return nil, util.NewInvalidInputError("Offset %v is out of range for %v of size %v", offset, filePath, attrs.Size)
+	}
+	reader, err := g.gcsClient.GetObject(g.bucketName, filePath, offset)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	return &FileReader{ReadCloser: reader, Size: attrs.Size, ContentType: attrs.ContentType}, nil
+}
+
+func (g *GCSObjectStore) GetFileSize(filePath string) (int64, error) {
+	attrs, err := g.gcsClient.StatObject(g.bucketName, filePath)
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to get the size of %v", filePath)
+	}
+	return attrs.Size, nil
+}
+
+func (g *GCSObjectStore) AddAsYamlFile(o interface{}, filePath string) error {
+	return addAsYamlFile(g, o, filePath)
+}
+
+func (g *GCSObjectStore) GetFromYamlFile(o interface{}, filePath string) error {
+	return getFromYamlFile(g, o, filePath)
+}
+
+func NewGCSObjectStore(gcsClient GCSClientInterface, bucketName string, baseFolder string) *GCSObjectStore {
+	return &GCSObjectStore{gcsClient: gcsClient, bucketName: bucketName, baseFolder: baseFolder}
+}
@@ -0,0 +1,86 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGCSObjectStore_AddAndGetFile(t *testing.T) {
+	gcsClient := NewFakeGCSClient()
+	store := NewGCSObjectStore(gcsClient, "bucket", "pipeline")
+	err := store.AddFile([]byte("abc"), store.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	assert.True(t, gcsClient.ExistObject("pipeline/1"))
+
+	file, err := store.GetFile(store.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("abc"), file)
+}
+
+func TestGCSObjectStore_GetFileNotFound(t *testing.T) {
+	store := NewGCSObjectStore(NewFakeGCSClient(), "bucket", "pipeline")
+	_, err := store.GetFile(store.GetPipelineKey("1"))
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestGCSObjectStore_OpenFile(t *testing.T) {
+	store := NewGCSObjectStore(NewFakeGCSClient(), "bucket", "pipeline")
+	store.AddFile([]byte("abcdef"), "artifacts/a.tgz")
+
+	reader, err := store.OpenFile("artifacts/a.tgz", 4)
+	assert.Nil(t, err)
+	defer reader.Close()
+	content, err := ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("ef"), content)
+	assert.Equal(t, int64(6), reader.Size)
+	assert.Equal(t, "application/octet-stream", reader.ContentType)
+
+	_, err = store.OpenFile("artifacts/a.tgz", 7)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestGCSObjectStore_GetFileSize(t *testing.T) {
+	store := NewGCSObjectStore(NewFakeGCSClient(), "bucket", "pipeline")
+	store.AddFile([]byte("abc"), "artifacts/a.tgz")
+	size, err := store.GetFileSize("artifacts/a.tgz")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), size)
+}
+
+func TestGCSObjectStore_DeleteFile(t *testing.T) {
+	gcsClient := NewFakeGCSClient()
+	store := NewGCSObjectStore(gcsClient, "bucket", "pipeline")
+	store.AddFile([]byte("abc"), store.GetPipelineKey("1"))
+	err := store.DeleteFile(store.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, gcsClient.GetObjectCount())
+}
+
+func TestGCSObjectStore_YamlFile(t *testing.T) {
+	store := NewGCSObjectStore(NewFakeGCSClient(), "bucket", "pipeline")
+	err := store.AddAsYamlFile(Foo{ID: 1}, store.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	var foo Foo
+	err = store.GetFromYamlFile(&foo, store.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	assert.Equal(t, Foo{ID: 1}, foo)
+}
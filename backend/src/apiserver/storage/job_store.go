@@ -28,8 +28,14 @@ import (
 
 var jobColumns = []string{"UUID", "DisplayName", "Name", "Namespace", "ServiceAccount", "Description", "MaxConcurrency",
 	"NoCatchup", "CreatedAtInSec", "UpdatedAtInSec", "Enabled", "CronScheduleStartTimeInSec", "CronScheduleEndTimeInSec",
-	"Schedule", "PeriodicScheduleStartTimeInSec", "PeriodicScheduleEndTimeInSec", "IntervalSecond",
+	"Schedule", "CronScheduleTimeZone", "PeriodicScheduleStartTimeInSec", "PeriodicScheduleEndTimeInSec", "IntervalSecond",
+	"ObjectStoreEventBucket", "ObjectStoreEventPrefix", "ObjectStoreEventKeyParameter",
+	"RunCompletionJobId", "RunCompletionExperimentId", "RunCompletionStates", "RunCompletionRunIdParameter",
+	"RunCompletionOutputParameters",
 	"PipelineId", "PipelineName", "PipelineSpecManifest", "WorkflowSpecManifest", "Parameters", "Conditions",
+	"NextTriggeredTimeInSec", "BackfillId", "BackfillStartTimeInSec", "BackfillEndTimeInSec", "BackfillState",
+	"BackfillTotalRuns", "BackfillCreatedRuns", "BackfillNextScheduledTimeInSec", "WorkflowSpecDigest",
+	"MaxConsecutiveFailures", "BlackoutWindows",
 }
 
 type JobStoreInterface interface {
@@ -39,11 +45,17 @@ type JobStoreInterface interface {
 	DeleteJob(id string) error
 	EnableJob(id string, enabled bool) error
 	UpdateJob(swf *util.ScheduledWorkflow) error
+	// ListRunCompletionJobs returns the jobs triggered by the completion of the
+	// runs of the job or of the experiment.
+	ListRunCompletionJobs(jobId string, experimentId string) ([]*model.Job, error)
+	UpdateJobBackfill(id string, backfill model.Backfill) error
+	UpdateJobSpec(j *model.Job) error
 }
 
 type JobStore struct {
 	db                     *DB
 	resourceReferenceStore *ResourceReferenceStore
+	labelStore             *LabelStore
 	time                   util.TimeInterface
 }
 
@@ -102,6 +114,10 @@ func (s *JobStore) ListJobs(
 		return errorF(err)
 	}
 
+	if err := s.addLabels(jobs); err != nil {
+		return errorF(err)
+	}
+
 	if len(jobs) <= opts.PageSize {
 		return jobs, total_size, "", nil
 	}
@@ -167,9 +183,56 @@ func (s *JobStore) GetJob(id string) (*model.Job, error) {
 	if len(jobs) == 0 {
 		return nil, util.NewResourceNotFoundError("Job", fmt.Sprint(id))
 	}
+	if err := s.addLabels(jobs); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get job: %v", err.Error())
+	}
 	return jobs[0], nil
 }
 
+func (s *JobStore) ListRunCompletionJobs(jobId string, experimentId string) ([]*model.Job, error) {
+	condition := sq.Or{}
+	if jobId != "" {
+		condition = append(condition, sq.Eq{"RunCompletionJobId": jobId})
+	}
+	if experimentId != "" {
+		condition = append(condition, sq.Eq{"RunCompletionExperimentId": experimentId})
+	}
+	if len(condition) == 0 {
+		return nil, nil
+	}
+	sql, args, err := s.addResourceReferences(sq.Select(jobColumns...).From("jobs").Where(condition)).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list run completion jobs: %v",
+			err.Error())
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list run completion jobs: %v", err.Error())
+	}
+	defer rows.Close()
+	jobs, err := s.scanRows(rows)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list run completion jobs: %v", err.Error())
+	}
+	return jobs, nil
+}
+
+func (s *JobStore) addLabels(jobs []*model.Job) error {
+	var ids []string
+	for _, job := range jobs {
+		ids = append(ids, job.UUID)
+	}
+	labels, err := s.labelStore.ListLabels(ids, common.Job)
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		job.Labels = labels[job.UUID]
+	}
+	return nil
+}
+
 func (s *JobStore) addResourceReferences(filteredSelectBuilder sq.SelectBuilder) sq.SelectBuilder {
 	resourceRefConcatQuery := s.db.Concat([]string{`"["`, s.db.GroupConcat("r.Payload", ","), `"]"`}, "")
 	return sq.
@@ -186,16 +249,28 @@ func (s *JobStore) scanRows(r *sql.Rows) ([]*model.Job, error) {
 		var uuid, displayName, name, namespace, pipelineId, pipelineName, conditions, serviceAccount,
 			description, parameters, pipelineSpecManifest, workflowSpecManifest string
 		var cronScheduleStartTimeInSec, cronScheduleEndTimeInSec,
-			periodicScheduleStartTimeInSec, periodicScheduleEndTimeInSec, intervalSecond sql.NullInt64
-		var cron, resourceReferencesInString sql.NullString
+			periodicScheduleStartTimeInSec, periodicScheduleEndTimeInSec, intervalSecond, nextTriggeredTimeInSec sql.NullInt64
+		var cron, cronTimeZone, workflowSpecDigest, blackoutWindows, resourceReferencesInString sql.NullString
+		var objectStoreEventBucket, objectStoreEventPrefix, objectStoreEventKeyParameter sql.NullString
+		var backfillId, backfillState sql.NullString
+		var backfillStartTimeInSec, backfillEndTimeInSec, backfillTotalRuns, backfillCreatedRuns,
+			backfillNextScheduledTimeInSec sql.NullInt64
+		var runCompletionJobId, runCompletionExperimentId, runCompletionStates, runCompletionRunIdParameter,
+			runCompletionOutputParameters sql.NullString
 		var enabled, noCatchup bool
-		var createdAtInSec, updatedAtInSec, maxConcurrency int64
+		var createdAtInSec, updatedAtInSec, maxConcurrency, maxConsecutiveFailures int64
 		err := r.Scan(
 			&uuid, &displayName, &name, &namespace, &serviceAccount, &description,
 			&maxConcurrency, &noCatchup, &createdAtInSec, &updatedAtInSec, &enabled,
-			&cronScheduleStartTimeInSec, &cronScheduleEndTimeInSec, &cron,
+			&cronScheduleStartTimeInSec, &cronScheduleEndTimeInSec, &cron, &cronTimeZone,
 			&periodicScheduleStartTimeInSec, &periodicScheduleEndTimeInSec, &intervalSecond,
-			&pipelineId, &pipelineName, &pipelineSpecManifest, &workflowSpecManifest, &parameters, &conditions, &resourceReferencesInString)
+			&objectStoreEventBucket, &objectStoreEventPrefix, &objectStoreEventKeyParameter,
+			&runCompletionJobId, &runCompletionExperimentId, &runCompletionStates, &runCompletionRunIdParameter,
+			&runCompletionOutputParameters,
+			&pipelineId, &pipelineName, &pipelineSpecManifest, &workflowSpecManifest, &parameters, &conditions,
+			&nextTriggeredTimeInSec, &backfillId, &backfillStartTimeInSec, &backfillEndTimeInSec, &backfillState,
+			&backfillTotalRuns, &backfillCreatedRuns, &backfillNextScheduledTimeInSec,
+			&workflowSpecDigest, &maxConsecutiveFailures, &blackoutWindows, &resourceReferencesInString)
 		if err != nil {
 			return nil, err
 		}
@@ -217,22 +292,48 @@ func (s *JobStore) scanRows(r *sql.Rows) ([]*model.Job, error) {
 					CronScheduleStartTimeInSec: NullInt64ToPointer(cronScheduleStartTimeInSec),
 					CronScheduleEndTimeInSec:   NullInt64ToPointer(cronScheduleEndTimeInSec),
 					Cron:                       NullStringToPointer(cron),
+					CronScheduleTimeZone:       NullStringToPointer(cronTimeZone),
 				},
 				PeriodicSchedule: model.PeriodicSchedule{
 					PeriodicScheduleStartTimeInSec: NullInt64ToPointer(periodicScheduleStartTimeInSec),
 					PeriodicScheduleEndTimeInSec:   NullInt64ToPointer(periodicScheduleEndTimeInSec),
 					IntervalSecond:                 NullInt64ToPointer(intervalSecond),
 				},
+				ObjectStoreEvent: model.ObjectStoreEvent{
+					ObjectStoreEventBucket:       NullStringToPointer(objectStoreEventBucket),
+					ObjectStoreEventPrefix:       NullStringToPointer(objectStoreEventPrefix),
+					ObjectStoreEventKeyParameter: NullStringToPointer(objectStoreEventKeyParameter),
+				},
+				RunCompletion: model.RunCompletion{
+					RunCompletionJobId:            NullStringToPointer(runCompletionJobId),
+					RunCompletionExperimentId:     NullStringToPointer(runCompletionExperimentId),
+					RunCompletionStates:           NullStringToPointer(runCompletionStates),
+					RunCompletionRunIdParameter:   NullStringToPointer(runCompletionRunIdParameter),
+					RunCompletionOutputParameters: NullStringToPointer(runCompletionOutputParameters),
+				},
 			},
 			PipelineSpec: model.PipelineSpec{
 				PipelineId:           pipelineId,
 				PipelineName:         pipelineName,
 				PipelineSpecManifest: pipelineSpecManifest,
 				WorkflowSpecManifest: workflowSpecManifest,
+				WorkflowSpecDigest:   workflowSpecDigest.String,
 				Parameters:           parameters,
 			},
-			CreatedAtInSec: createdAtInSec,
-			UpdatedAtInSec: updatedAtInSec,
+			CreatedAtInSec:         createdAtInSec,
+			UpdatedAtInSec:         updatedAtInSec,
+			NextTriggeredTimeInSec: NullInt64ToPointer(nextTriggeredTimeInSec),
+			Backfill: model.Backfill{
+				BackfillId:                     NullStringToPointer(backfillId),
+				BackfillStartTimeInSec:         NullInt64ToPointer(backfillStartTimeInSec),
+				BackfillEndTimeInSec:           NullInt64ToPointer(backfillEndTimeInSec),
+				BackfillState:                  NullStringToPointer(backfillState),
+				BackfillTotalRuns:              NullInt64ToPointer(backfillTotalRuns),
+				BackfillCreatedRuns:            NullInt64ToPointer(backfillCreatedRuns),
+				BackfillNextScheduledTimeInSec: NullInt64ToPointer(backfillNextScheduledTimeInSec),
+			},
+			MaxConsecutiveFailures: maxConsecutiveFailures,
+			BlackoutWindows:        NullStringToPointer(blackoutWindows),
 		})
 	}
 	return jobs, nil
@@ -259,6 +360,11 @@ func (s *JobStore) DeleteJob(id string) error {
 		tx.Rollback()
 		return util.NewInternalServerError(err, "Failed to delete resource references from table for job %v ", id)
 	}
+	err = s.labelStore.DeleteLabels(tx, id, common.Job)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete labels from table for job %v ", id)
+	}
 	err = tx.Commit()
 	if err != nil {
 		tx.Rollback()
@@ -284,15 +390,27 @@ func (s *JobStore) CreateJob(j *model.Job) (*model.Job, error) {
 			"CronScheduleStartTimeInSec":     PointerToNullInt64(j.CronScheduleStartTimeInSec),
 			"CronScheduleEndTimeInSec":       PointerToNullInt64(j.CronScheduleEndTimeInSec),
 			"Schedule":                       PointerToNullString(j.Cron),
+			"CronScheduleTimeZone":           PointerToNullString(j.CronScheduleTimeZone),
 			"PeriodicScheduleStartTimeInSec": PointerToNullInt64(j.PeriodicScheduleStartTimeInSec),
 			"PeriodicScheduleEndTimeInSec":   PointerToNullInt64(j.PeriodicScheduleEndTimeInSec),
 			"IntervalSecond":                 PointerToNullInt64(j.IntervalSecond),
+			"ObjectStoreEventBucket":         PointerToNullString(j.ObjectStoreEventBucket),
+			"ObjectStoreEventPrefix":         PointerToNullString(j.ObjectStoreEventPrefix),
+			"ObjectStoreEventKeyParameter":   PointerToNullString(j.ObjectStoreEventKeyParameter),
+			"RunCompletionJobId":             PointerToNullString(j.RunCompletionJobId),
+			"RunCompletionExperimentId":      PointerToNullString(j.RunCompletionExperimentId),
+			"RunCompletionStates":            PointerToNullString(j.RunCompletionStates),
+			"RunCompletionRunIdParameter":    PointerToNullString(j.RunCompletionRunIdParameter),
+			"RunCompletionOutputParameters":  PointerToNullString(j.RunCompletionOutputParameters),
+			"MaxConsecutiveFailures":         j.MaxConsecutiveFailures,
+			"BlackoutWindows":                PointerToNullString(j.BlackoutWindows),
 			"CreatedAtInSec":                 j.CreatedAtInSec,
 			"UpdatedAtInSec":                 j.UpdatedAtInSec,
 			"PipelineId":                     j.PipelineId,
 			"PipelineName":                   j.PipelineName,
 			"PipelineSpecManifest":           j.PipelineSpecManifest,
 			"WorkflowSpecManifest":           j.WorkflowSpecManifest,
+			"WorkflowSpecDigest":             j.WorkflowSpecDigest,
 			"Parameters":                     j.Parameters,
 		}).ToSql()
 	if err != nil {
@@ -315,6 +433,11 @@ func (s *JobStore) CreateJob(j *model.Job) (*model.Job, error) {
 		tx.Rollback()
 		return nil, util.NewInternalServerError(err, "Failed to store resource references to table for job %v ", j.Name)
 	}
+	err = s.labelStore.CreateLabels(tx, j.UUID, common.Job, j.Labels)
+	if err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err, "Failed to store labels to table for job %v ", j.Name)
+	}
 
 	err = tx.Commit()
 	if err != nil {
@@ -351,23 +474,46 @@ func (s *JobStore) UpdateJob(swf *util.ScheduledWorkflow) error {
 		return err
 	}
 
+	setMap := sq.Eq{
+		"Name":                           swf.Name,
+		"Namespace":                      swf.Namespace,
+		"Enabled":                        swf.Spec.Enabled,
+		"Conditions":                     swf.ConditionSummary(),
+		"NextTriggeredTimeInSec":         PointerToNullInt64(swf.NextTriggeredTimeInSecOrNull()),
+		"MaxConcurrency":                 swf.MaxConcurrencyOr0(),
+		"NoCatchup":                      swf.NoCatchupOrFalse(),
+		"Parameters":                     parameters,
+		"UpdatedAtInSec":                 now,
+		"CronScheduleStartTimeInSec":     PointerToNullInt64(swf.CronScheduleStartTimeInSecOrNull()),
+		"CronScheduleEndTimeInSec":       PointerToNullInt64(swf.CronScheduleEndTimeInSecOrNull()),
+		"Schedule":                       swf.CronOrEmpty(),
+		"CronScheduleTimeZone":           PointerToNullString(swf.CronScheduleTimeZoneOrNull()),
+		"PeriodicScheduleStartTimeInSec": PointerToNullInt64(swf.PeriodicScheduleStartTimeInSecOrNull()),
+		"PeriodicScheduleEndTimeInSec":   PointerToNullInt64(swf.PeriodicScheduleEndTimeInSecOrNull()),
+		"IntervalSecond":                 swf.IntervalSecondOr0(),
+		"ObjectStoreEventBucket":         PointerToNullString(swf.ObjectStoreEventBucketOrNull()),
+		"ObjectStoreEventPrefix":         PointerToNullString(swf.ObjectStoreEventPrefixOrNull()),
+		"ObjectStoreEventKeyParameter":   PointerToNullString(swf.ObjectStoreEventKeyParameterOrNull()),
+		"RunCompletionJobId":             PointerToNullString(swf.RunCompletionJobIdOrNull()),
+		"RunCompletionExperimentId":      PointerToNullString(swf.RunCompletionExperimentIdOrNull()),
+		"RunCompletionStates":            PointerToNullString(swf.RunCompletionStatesOrNull()),
+		"RunCompletionRunIdParameter":    PointerToNullString(swf.RunCompletionRunIdParameterOrNull()),
+		"RunCompletionOutputParameters":  PointerToNullString(swf.RunCompletionOutputParametersOrNull()),
+		"MaxConsecutiveFailures":         swf.MaxConsecutiveFailuresOr0(),
+		"BlackoutWindows":                PointerToNullString(swf.BlackoutsOrNull()),
+	}
+	// The backfill is only updated once the controller reported its progress,
+	// so that the backfill stored when it was requested is not overwritten.
+	if backfillStatus := swf.BackfillStatusOrNull(); backfillStatus != nil {
+		setMap["BackfillState"] = string(backfillStatus.Phase)
+		setMap["BackfillTotalRuns"] = backfillStatus.TotalCount
+		setMap["BackfillCreatedRuns"] = backfillStatus.CreatedCount
+		setMap["BackfillNextScheduledTimeInSec"] = PointerToNullInt64(util.ToInt64Pointer(backfillStatus.NextScheduledTime))
+	}
+
 	sql, args, err := sq.
 		Update("jobs").
-		SetMap(sq.Eq{
-			"Name":                           swf.Name,
-			"Namespace":                      swf.Namespace,
-			"Enabled":                        swf.Spec.Enabled,
-			"Conditions":                     swf.ConditionSummary(),
-			"MaxConcurrency":                 swf.MaxConcurrencyOr0(),
-			"NoCatchup":                      swf.NoCatchupOrFalse(),
-			"Parameters":                     parameters,
-			"UpdatedAtInSec":                 now,
-			"CronScheduleStartTimeInSec":     PointerToNullInt64(swf.CronScheduleStartTimeInSecOrNull()),
-			"CronScheduleEndTimeInSec":       PointerToNullInt64(swf.CronScheduleEndTimeInSecOrNull()),
-			"Schedule":                       swf.CronOrEmpty(),
-			"PeriodicScheduleStartTimeInSec": PointerToNullInt64(swf.PeriodicScheduleStartTimeInSecOrNull()),
-			"PeriodicScheduleEndTimeInSec":   PointerToNullInt64(swf.PeriodicScheduleEndTimeInSecOrNull()),
-			"IntervalSecond":                 swf.IntervalSecondOr0()}).
+		SetMap(setMap).
 		Where(sq.Eq{"UUID": string(swf.UID)}).
 		ToSql()
 	if err != nil {
@@ -398,11 +544,118 @@ func (s *JobStore) UpdateJob(swf *util.ScheduledWorkflow) error {
 	return nil
 }
 
+// UpdateJobBackfill stores a new backfill of the job.
+func (s *JobStore) UpdateJobBackfill(id string, backfill model.Backfill) error {
+	sql, args, err := sq.
+		Update("jobs").
+		SetMap(sq.Eq{
+			"BackfillId":                     PointerToNullString(backfill.BackfillId),
+			"BackfillStartTimeInSec":         PointerToNullInt64(backfill.BackfillStartTimeInSec),
+			"BackfillEndTimeInSec":           PointerToNullInt64(backfill.BackfillEndTimeInSec),
+			"BackfillState":                  PointerToNullString(backfill.BackfillState),
+			"BackfillTotalRuns":              PointerToNullInt64(backfill.BackfillTotalRuns),
+			"BackfillCreatedRuns":            PointerToNullInt64(backfill.BackfillCreatedRuns),
+			"BackfillNextScheduledTimeInSec": PointerToNullInt64(backfill.BackfillNextScheduledTimeInSec),
+			"UpdatedAtInSec":                 s.time.Now().Unix()}).
+		Where(sq.Eq{"UUID": id}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Error when creating query to update the backfill of job %v", id)
+	}
+	_, err = s.db.Exec(sql, args...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Error when updating the backfill of job %v", id)
+	}
+	return nil
+}
+
+// UpdateJobSpec replaces the definition of the job and its resource
+// references. The run history, labels and schedule progress of the job are
+// kept.
+func (s *JobStore) UpdateJobSpec(j *model.Job) error {
+	jobSql, jobArgs, err := sq.
+		Update("jobs").
+		SetMap(sq.Eq{
+			"ServiceAccount":                 j.ServiceAccount,
+			"Description":                    j.Description,
+			"MaxConcurrency":                 j.MaxConcurrency,
+			"NoCatchup":                      j.NoCatchup,
+			"Conditions":                     j.Conditions,
+			"CronScheduleStartTimeInSec":     PointerToNullInt64(j.CronScheduleStartTimeInSec),
+			"CronScheduleEndTimeInSec":       PointerToNullInt64(j.CronScheduleEndTimeInSec),
+			"Schedule":                       PointerToNullString(j.Cron),
+			"CronScheduleTimeZone":           PointerToNullString(j.CronScheduleTimeZone),
+			"PeriodicScheduleStartTimeInSec": PointerToNullInt64(j.PeriodicScheduleStartTimeInSec),
+			"PeriodicScheduleEndTimeInSec":   PointerToNullInt64(j.PeriodicScheduleEndTimeInSec),
+			"IntervalSecond":                 PointerToNullInt64(j.IntervalSecond),
+			"ObjectStoreEventBucket":         PointerToNullString(j.ObjectStoreEventBucket),
+			"ObjectStoreEventPrefix":         PointerToNullString(j.ObjectStoreEventPrefix),
+			"ObjectStoreEventKeyParameter":   PointerToNullString(j.ObjectStoreEventKeyParameter),
+			"RunCompletionJobId":             PointerToNullString(j.RunCompletionJobId),
+			"RunCompletionExperimentId":      PointerToNullString(j.RunCompletionExperimentId),
+			"RunCompletionStates":            PointerToNullString(j.RunCompletionStates),
+			"RunCompletionRunIdParameter":    PointerToNullString(j.RunCompletionRunIdParameter),
+			"RunCompletionOutputParameters":  PointerToNullString(j.RunCompletionOutputParameters),
+			"MaxConsecutiveFailures":         j.MaxConsecutiveFailures,
+			"BlackoutWindows":                PointerToNullString(j.BlackoutWindows),
+			"UpdatedAtInSec":                 j.UpdatedAtInSec,
+			"PipelineId":                     j.PipelineId,
+			"PipelineName":                   j.PipelineName,
+			"PipelineSpecManifest":           j.PipelineSpecManifest,
+			"WorkflowSpecManifest":           j.WorkflowSpecManifest,
+			"WorkflowSpecDigest":             j.WorkflowSpecDigest,
+			"Parameters":                     j.Parameters,
+		}).
+		Where(sq.Eq{"UUID": j.UUID}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to update job %v", j.UUID)
+	}
+
+	// Use a transaction to make sure both job and its resource references are updated.
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a new transaction to update job.")
+	}
+	r, err := tx.Exec(jobSql, jobArgs...)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to update job %v in table", j.UUID)
+	}
+	rowsAffected, err := r.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to get affected rows while updating job %v", j.UUID)
+	}
+	if rowsAffected <= 0 {
+		tx.Rollback()
+		return util.NewResourceNotFoundError("Job", j.UUID)
+	}
+	// The references of the runs of the job to it are kept.
+	err = s.resourceReferenceStore.DeleteResourceReferencesFrom(tx, j.UUID, common.Job)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete resource references from table for job %v ", j.UUID)
+	}
+	err = s.resourceReferenceStore.CreateResourceReferences(tx, j.ResourceReferences)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to store resource references to table for job %v ", j.UUID)
+	}
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to update job %v and its resource references in table", j.UUID)
+	}
+	return nil
+}
+
 // factory function for job store
 func NewJobStore(db *DB, time util.TimeInterface) *JobStore {
 	return &JobStore{
 		db:                     db,
 		resourceReferenceStore: NewResourceReferenceStore(db),
+		labelStore:             NewLabelStore(db),
 		time:                   time,
 	}
 }
@@ -427,6 +427,35 @@ func TestListJobs_FilterByReferenceKey(t *testing.T) {
 	assert.Equal(t, 2, total_size) // both test jobs belong to namespace `n1`
 }
 
+func TestListJobs_FilterByLabel(t *testing.T) {
+	db, jobStore := initializeDbAndStore()
+	defer db.Close()
+	tx, _ := db.Begin()
+	err := jobStore.labelStore.CreateLabels(tx, "2", common.Job, map[string]string{"stage": "prod"})
+	assert.Nil(t, err)
+	assert.Nil(t, tx.Commit())
+
+	filterProto := &api.Filter{
+		Predicates: []*api.Predicate{
+			{
+				Key:   "label.stage",
+				Op:    api.Predicate_EQUALS,
+				Value: &api.Predicate_StringValue{StringValue: "prod"},
+			},
+		},
+	}
+	opts, err := list.NewOptions(&model.Job{}, 2, "name", filterProto)
+	assert.Nil(t, err)
+	jobs, total_size, nextPageToken, err := jobStore.ListJobs(
+		&common.FilterContext{ReferenceKey: &common.ReferenceKey{Type: common.Namespace, ID: "n1"}}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, "", nextPageToken)
+	assert.Equal(t, 1, total_size)
+	assert.Equal(t, 1, len(jobs))
+	assert.Equal(t, "2", jobs[0].UUID)
+	assert.Equal(t, map[string]string{"stage": "prod"}, jobs[0].Labels)
+}
+
 func TestListJobsError(t *testing.T) {
 	db, jobStore := initializeDbAndStore()
 	defer db.Close()
@@ -676,6 +705,192 @@ func TestEnableJob_DatabaseError(t *testing.T) {
 	assert.Contains(t, err.Error(), "Error when enabling job 1 to true: sql: database is closed")
 }
 
+func TestListRunCompletionJobs(t *testing.T) {
+	db, jobStore := initializeDbAndStore()
+	defer db.Close()
+
+	for _, job := range []*model.Job{
+		{UUID: "3", Name: "pp3", Trigger: model.Trigger{RunCompletion: model.RunCompletion{
+			RunCompletionJobId:        util.StringPointer("1"),
+			RunCompletionExperimentId: util.StringPointer(""),
+		}}},
+		{UUID: "4", Name: "pp4", Trigger: model.Trigger{RunCompletion: model.RunCompletion{
+			RunCompletionJobId:            util.StringPointer(""),
+			RunCompletionExperimentId:     util.StringPointer(defaultFakeExpId),
+			RunCompletionStates:           util.StringPointer("Succeeded,Failed"),
+			RunCompletionRunIdParameter:   util.StringPointer("upstream"),
+			RunCompletionOutputParameters: util.StringPointer(`{"model":"train-model"}`),
+		}}},
+	} {
+		_, err := jobStore.CreateJob(job)
+		assert.Nil(t, err)
+	}
+
+	jobs, err := jobStore.ListRunCompletionJobs("1", "")
+	assert.Nil(t, err)
+	assert.Len(t, jobs, 1)
+	assert.Equal(t, "3", jobs[0].UUID)
+
+	jobs, err = jobStore.ListRunCompletionJobs("2", defaultFakeExpId)
+	assert.Nil(t, err)
+	assert.Len(t, jobs, 1)
+	assert.Equal(t, "4", jobs[0].UUID)
+	assert.Equal(t, []string{"Succeeded", "Failed"}, jobs[0].RunCompletionStateList())
+	assert.Equal(t, "upstream", *jobs[0].RunCompletionRunIdParameter)
+
+	jobs, err = jobStore.ListRunCompletionJobs("", "")
+	assert.Nil(t, err)
+	assert.Empty(t, jobs)
+}
+
+func TestUpdateJobBackfill(t *testing.T) {
+	db, jobStore := initializeDbAndStore()
+	defer db.Close()
+
+	backfill := model.Backfill{
+		BackfillId:                     util.StringPointer("b1"),
+		BackfillStartTimeInSec:         util.Int64Pointer(10),
+		BackfillEndTimeInSec:           util.Int64Pointer(40),
+		BackfillState:                  util.StringPointer("Running"),
+		BackfillTotalRuns:              util.Int64Pointer(3),
+		BackfillCreatedRuns:            util.Int64Pointer(0),
+		BackfillNextScheduledTimeInSec: util.Int64Pointer(10),
+	}
+	err := jobStore.UpdateJobBackfill("1", backfill)
+	assert.Nil(t, err)
+	job, err := jobStore.GetJob("1")
+	assert.Nil(t, err)
+	assert.Equal(t, backfill, job.Backfill)
+
+	swf := util.NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "pp1", Namespace: "n1", UID: "1"},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled: true,
+			Backfill: &swfapi.Backfill{
+				ID:        "b1",
+				StartTime: metav1.NewTime(time.Unix(10, 0).UTC()),
+				EndTime:   metav1.NewTime(time.Unix(40, 0).UTC()),
+			},
+		},
+		Status: swfapi.ScheduledWorkflowStatus{
+			Backfill: &swfapi.BackfillStatus{ID: "b0", Phase: swfapi.BackfillCompleted},
+		},
+	})
+
+	// The status of a previous backfill is ignored.
+	err = jobStore.UpdateJob(swf)
+	assert.Nil(t, err)
+	job, err = jobStore.GetJob("1")
+	assert.Nil(t, err)
+	assert.Equal(t, backfill, job.Backfill)
+
+	swf.Status.Backfill = &swfapi.BackfillStatus{
+		ID:                "b1",
+		Phase:             swfapi.BackfillRunning,
+		TotalCount:        3,
+		CreatedCount:      1,
+		LastScheduledTime: util.MetaV1TimePointer(metav1.NewTime(time.Unix(10, 0).UTC())),
+		NextScheduledTime: util.MetaV1TimePointer(metav1.NewTime(time.Unix(20, 0).UTC())),
+	}
+	err = jobStore.UpdateJob(swf)
+	assert.Nil(t, err)
+	job, err = jobStore.GetJob("1")
+	assert.Nil(t, err)
+	backfill.BackfillCreatedRuns = util.Int64Pointer(1)
+	backfill.BackfillNextScheduledTimeInSec = util.Int64Pointer(20)
+	assert.Equal(t, backfill, job.Backfill)
+}
+
+func TestUpdateJob_DisabledByFailures(t *testing.T) {
+	db, jobStore := initializeDbAndStore()
+	defer db.Close()
+
+	swf := util.NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "pp1", Namespace: "n1", UID: "1"},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:       false,
+			FailurePolicy: &swfapi.FailurePolicy{MaxConsecutiveFailures: 3},
+			Blackouts: []swfapi.TimeWindow{{
+				StartTime: metav1.NewTime(time.Unix(100, 0).UTC()),
+				EndTime:   metav1.NewTime(time.Unix(200, 0).UTC()),
+			}},
+		},
+		Status: swfapi.ScheduledWorkflowStatus{
+			Conditions: []swfapi.ScheduledWorkflowCondition{{
+				Type:   swfapi.ScheduledWorkflowDisabledByFailures,
+				Status: core.ConditionTrue,
+			}},
+		},
+	})
+	err := jobStore.UpdateJob(swf)
+	assert.Nil(t, err)
+
+	job, err := jobStore.GetJob("1")
+	assert.Nil(t, err)
+	assert.False(t, job.Enabled)
+	assert.Equal(t, "DisabledByFailures", job.Conditions)
+	assert.Equal(t, int64(3), job.MaxConsecutiveFailures)
+	windows, err := job.BlackoutWindowList()
+	assert.Nil(t, err)
+	assert.Equal(t, []model.TimeWindow{{
+		StartTime: time.Unix(100, 0).UTC(),
+		EndTime:   time.Unix(200, 0).UTC(),
+	}}, windows)
+}
+
+func TestUpdateJobSpec(t *testing.T) {
+	db, jobStore := initializeDbAndStore()
+	defer db.Close()
+
+	// A run of the job.
+	runReference := &model.ResourceReference{
+		ResourceUUID: "run1", ResourceType: common.Run, ReferenceUUID: "1",
+		ReferenceName: "pp 1", ReferenceType: common.Job, Relationship: common.Creator,
+	}
+	tx, err := db.Begin()
+	assert.Nil(t, err)
+	assert.Nil(t, jobStore.resourceReferenceStore.CreateResourceReferences(tx, []*model.ResourceReference{runReference}))
+	assert.Nil(t, tx.Commit())
+
+	job, err := jobStore.GetJob("1")
+	assert.Nil(t, err)
+	job.MaxConcurrency = 3
+	job.NoCatchup = true
+	job.Trigger = model.Trigger{
+		CronSchedule: model.CronSchedule{Cron: util.StringPointer("0 0 * * * *")},
+	}
+	job.PipelineSpec = model.PipelineSpec{
+		WorkflowSpecManifest: "workflow",
+		Parameters:           `[{"name":"param1","value":"world"}]`,
+	}
+	job.UpdatedAtInSec = 5
+	job.ResourceReferences = []*model.ResourceReference{
+		{
+			ResourceUUID: "1", ResourceType: common.Job, ReferenceUUID: defaultFakeExpId,
+			ReferenceName: "exp1", ReferenceType: common.Experiment, Relationship: common.Owner,
+		},
+	}
+	err = jobStore.UpdateJobSpec(job)
+	assert.Nil(t, err)
+
+	updatedJob, err := jobStore.GetJob("1")
+	assert.Nil(t, err)
+	assert.Equal(t, job, updatedJob)
+
+	// The runs of the job still reference it.
+	reference, err := jobStore.resourceReferenceStore.GetResourceReference("run1", common.Run, common.Job)
+	assert.Nil(t, err)
+	assert.Equal(t, "1", reference.ReferenceUUID)
+}
+
+func TestUpdateJobSpec_RecordNotFound(t *testing.T) {
+	db, jobStore := initializeDbAndStore()
+	defer db.Close()
+
+	err := jobStore.UpdateJobSpec(&model.Job{UUID: "UNKNOWN_UID"})
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
 func TestUpdateJob_Success(t *testing.T) {
 	db, jobStore := initializeDbAndStore()
 	defer db.Close()
@@ -733,6 +948,7 @@ func TestUpdateJob_Success(t *testing.T) {
 					StartTime: util.MetaV1TimePointer(metav1.NewTime(time.Unix(10, 0).UTC())),
 					EndTime:   util.MetaV1TimePointer(metav1.NewTime(time.Unix(20, 0).UTC())),
 					Cron:      "MY_CRON",
+					TimeZone:  "America/New_York",
 				},
 				PeriodicSchedule: &swfapi.PeriodicSchedule{
 					StartTime:      util.MetaV1TimePointer(metav1.NewTime(time.Unix(30, 0).UTC())),
@@ -751,6 +967,9 @@ func TestUpdateJob_Success(t *testing.T) {
 				Message:            "The schedule is enabled.",
 			},
 			},
+			Trigger: swfapi.TriggerStatus{
+				NextTriggeredTime: util.MetaV1TimePointer(metav1.NewTime(time.Unix(60, 0).UTC())),
+			},
 		},
 	})
 
@@ -758,16 +977,17 @@ func TestUpdateJob_Success(t *testing.T) {
 	assert.Nil(t, err)
 
 	jobExpected = model.Job{
-		UUID:           "1",
-		DisplayName:    "pp 1",
-		Name:           "MY_NAME",
-		Namespace:      "MY_NAMESPACE",
-		Enabled:        false,
-		Conditions:     "Enabled",
-		CreatedAtInSec: 1,
-		UpdatedAtInSec: 1,
-		MaxConcurrency: 200,
-		NoCatchup:      true,
+		UUID:                   "1",
+		DisplayName:            "pp 1",
+		Name:                   "MY_NAME",
+		Namespace:              "MY_NAMESPACE",
+		Enabled:                false,
+		Conditions:             "Enabled",
+		CreatedAtInSec:         1,
+		UpdatedAtInSec:         1,
+		MaxConcurrency:         200,
+		NoCatchup:              true,
+		NextTriggeredTimeInSec: util.Int64Pointer(60),
 		PipelineSpec: model.PipelineSpec{
 			PipelineId:   "1",
 			PipelineName: "p1",
@@ -778,6 +998,7 @@ func TestUpdateJob_Success(t *testing.T) {
 				CronScheduleStartTimeInSec: util.Int64Pointer(10),
 				CronScheduleEndTimeInSec:   util.Int64Pointer(20),
 				Cron:                       util.StringPointer("MY_CRON"),
+				CronScheduleTimeZone:       util.StringPointer("America/New_York"),
 			},
 			PeriodicSchedule: model.PeriodicSchedule{
 				PeriodicScheduleStartTimeInSec: util.Int64Pointer(30),
@@ -0,0 +1,145 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+type LabelStoreInterface interface {
+	// Retrieve the labels of a resource.
+	GetLabels(resourceId string, resourceType common.ResourceType) (map[string]string, error)
+
+	// Replace all the labels of a resource with the given ones.
+	UpdateLabels(resourceId string, resourceType common.ResourceType, labels map[string]string) error
+}
+
+type LabelStore struct {
+	db *DB
+}
+
+// Create the labels of a resource.
+// This is always in company with creating a parent resource so a transaction is needed as input.
+func (s *LabelStore) CreateLabels(tx *sql.Tx, resourceId string, resourceType common.ResourceType,
+	labels map[string]string) error {
+	if len(labels) == 0 {
+		return nil
+	}
+	labelSqlBuilder := sq.
+		Insert("labels").
+		Columns("ResourceUUID", "ResourceType", "LabelKey", "LabelValue")
+	for key, value := range labels {
+		labelSqlBuilder = labelSqlBuilder.Values(resourceId, resourceType, key, value)
+	}
+	labelSql, labelArgs, err := labelSqlBuilder.ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to store labels.")
+	}
+	_, err = tx.Exec(labelSql, labelArgs...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to store labels for %s %s.", resourceType, resourceId)
+	}
+	return nil
+}
+
+// Delete all the labels of a resource.
+// This is always in company with deleting a parent resource so a transaction is needed as input.
+func (s *LabelStore) DeleteLabels(tx *sql.Tx, resourceId string, resourceType common.ResourceType) error {
+	labelSql, labelArgs, err := sq.
+		Delete("labels").
+		Where(sq.Eq{"ResourceUUID": resourceId, "ResourceType": resourceType}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete labels.")
+	}
+	_, err = tx.Exec(labelSql, labelArgs...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to delete labels for %s %s.", resourceType, resourceId)
+	}
+	return nil
+}
+
+func (s *LabelStore) UpdateLabels(resourceId string, resourceType common.ResourceType, labels map[string]string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a new transaction to update labels.")
+	}
+	err = s.DeleteLabels(tx, resourceId, resourceType)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	err = s.CreateLabels(tx, resourceId, resourceType, labels)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to update labels for %s %s.", resourceType, resourceId)
+	}
+	return nil
+}
+
+func (s *LabelStore) GetLabels(resourceId string, resourceType common.ResourceType) (map[string]string, error) {
+	labels, err := s.ListLabels([]string{resourceId}, resourceType)
+	if err != nil {
+		return nil, err
+	}
+	return labels[resourceId], nil
+}
+
+// Retrieve the labels of the given resources, keyed by resource ID. Resources
+// without labels are absent from the result.
+func (s *LabelStore) ListLabels(resourceIds []string, resourceType common.ResourceType) (
+	map[string]map[string]string, error) {
+	result := make(map[string]map[string]string)
+	if len(resourceIds) == 0 {
+		return result, nil
+	}
+	labelSql, labelArgs, err := sq.
+		Select("ResourceUUID", "LabelKey", "LabelValue").
+		From("labels").
+		Where(sq.Eq{"ResourceUUID": resourceIds, "ResourceType": resourceType}).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to get labels.")
+	}
+	rows, err := s.db.Query(labelSql, labelArgs...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get labels for %s.", resourceType)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var resourceId, key, value string
+		if err := rows.Scan(&resourceId, &key, &value); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to parse labels for %s.", resourceType)
+		}
+		if result[resourceId] == nil {
+			result[resourceId] = make(map[string]string)
+		}
+		result[resourceId][key] = value
+	}
+	return result, nil
+}
+
+func NewLabelStore(db *DB) *LabelStore {
+	return &LabelStore{db: db}
+}
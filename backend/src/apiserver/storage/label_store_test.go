@@ -0,0 +1,74 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabelStore(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	store := NewLabelStore(db)
+
+	// Create labels
+	tx, _ := db.Begin()
+	err := store.CreateLabels(tx, "p1", common.Pipeline, map[string]string{"team": "ranking", "stage": "prod"})
+	assert.Nil(t, err)
+	err = store.CreateLabels(tx, "p2", common.Pipeline, map[string]string{"team": "search"})
+	assert.Nil(t, err)
+	err = store.CreateLabels(tx, "p1", common.Experiment, map[string]string{"team": "infra"})
+	assert.Nil(t, err)
+	err = tx.Commit()
+	assert.Nil(t, err)
+
+	// Get labels
+	labels, err := store.GetLabels("p1", common.Pipeline)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "ranking", "stage": "prod"}, labels)
+
+	// List labels
+	allLabels, err := store.ListLabels([]string{"p1", "p2", "p3"}, common.Pipeline)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]map[string]string{
+		"p1": {"team": "ranking", "stage": "prod"},
+		"p2": {"team": "search"},
+	}, allLabels)
+
+	// Update labels
+	err = store.UpdateLabels("p1", common.Pipeline, map[string]string{"team": "ads"})
+	assert.Nil(t, err)
+	labels, err = store.GetLabels("p1", common.Pipeline)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "ads"}, labels)
+
+	// Delete labels
+	tx, _ = db.Begin()
+	err = store.DeleteLabels(tx, "p1", common.Pipeline)
+	assert.Nil(t, err)
+	err = tx.Commit()
+	assert.Nil(t, err)
+	labels, err = store.GetLabels("p1", common.Pipeline)
+	assert.Nil(t, err)
+	assert.Empty(t, labels)
+
+	// Labels of other resource types are untouched
+	labels, err = store.GetLabels("p1", common.Experiment)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "infra"}, labels)
+}
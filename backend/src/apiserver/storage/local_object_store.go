@@ -0,0 +1,135 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"io"
+	"io/ioutil"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// LocalObjectStore stores files in a directory of the local filesystem. It's meant for
+// single-node deployments and tests, where no object storage service is available.
+type LocalObjectStore struct {
+	rootDir    string
+	baseFolder string
+}
+
+func (l *LocalObjectStore) GetPipelineKey(pipelineID string) string {
+	return path.Join(l.baseFolder, pipelineID)
+}
+
+func (l *LocalObjectStore) GetPipelinePackageKey(pipelineVersionID string) string {
+	return path.Join(l.baseFolder, pipelinePackageFolder, pipelineVersionID)
+}
+
+func (l *LocalObjectStore) AddFile(file []byte, filePath string) error {
+	localPath := l.localPath(filePath)
+	if err := os.MkdirAll(filepath.Dir(localPath), 0755); err != nil {
+		return util.NewInternalServerError(err, "Failed to store %v", filePath)
+	}
+	// Write to a temporary file first, so that readers never see a partially written file.
+	tempFile, err := ioutil.TempFile(filepath.Dir(localPath), ".tmp-")
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to store %v", filePath)
+	}
+	defer os.Remove(tempFile.Name())
+	if _, err := tempFile.Write(file); err != nil {
+		tempFile.Close()
+		return util.NewInternalServerError(err, "Failed to store %v", filePath)
+	}
+	if err := tempFile.Close(); err != nil {
+		return util.NewInternalServerError(err, "Failed to store %v", filePath)
+	}
+	if err := os.Rename(tempFile.Name(), localPath); err != nil {
+		return util.NewInternalServerError(err, "Failed to store %v", filePath)
+	}
+	return nil
+}
+
+func (l *LocalObjectStore) DeleteFile(filePath string) error {
+	err := os.Remove(l.localPath(filePath))
+	if err != nil && !os.IsNotExist(err) {
+		return util.NewInternalServerError(err, "Failed to delete %v", filePath)
+	}
+	return nil
+}
+
+func (l *LocalObjectStore) GetFile(filePath string) ([]byte, error) {
+	bytes, err := ioutil.ReadFile(l.localPath(filePath))
+	if os.IsNotExist(err) {
+		return nil, util.NewNotFoundError(err, "File %v not found", filePath)
+	}
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	return bytes, nil
+}
+
+func (l *LocalObjectStore) OpenFile(filePath string, offset int64) (*FileReader, error) {
+	file, err := os.Open(l.localPath(filePath))
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	if offset < 0 || offset > info.Size() {
+		file.Close()
+		return nil, util.NewInvalidInputError("Offset %v is out of range for %v of size %v", offset, filePath, info.Size())
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	contentType := mime.TypeByExtension(path.Ext(filePath))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	return &FileReader{ReadCloser: file, Size: info.Size(), ContentType: contentType}, nil
+}
+
+func (l *LocalObjectStore) GetFileSize(filePath string) (int64, error) {
+	info, err := os.Stat(l.localPath(filePath))
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to get the size of %v", filePath)
+	}
+	return info.Size(), nil
+}
+
+func (l *LocalObjectStore) AddAsYamlFile(o interface{}, filePath string) error {
+	return addAsYamlFile(l, o, filePath)
+}
+
+func (l *LocalObjectStore) GetFromYamlFile(o interface{}, filePath string) error {
+	return getFromYamlFile(l, o, filePath)
+}
+
+// localPath maps a file path of the store to the local filesystem. The path is cleaned as an
+// absolute path first, so it can't escape the root directory.
+func (l *LocalObjectStore) localPath(filePath string) string {
+	return filepath.Join(l.rootDir, filepath.FromSlash(path.Clean("/"+filePath)))
+}
+
+func NewLocalObjectStore(rootDir string, baseFolder string) *LocalObjectStore {
+	return &LocalObjectStore{rootDir: rootDir, baseFolder: baseFolder}
+}
@@ -0,0 +1,122 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func newTestLocalObjectStore(t *testing.T) (*LocalObjectStore, string) {
+	rootDir, err := ioutil.TempDir("", "local-object-store")
+	assert.Nil(t, err)
+	return NewLocalObjectStore(rootDir, "pipeline"), rootDir
+}
+
+func TestLocalObjectStore_AddAndGetFile(t *testing.T) {
+	store, rootDir := newTestLocalObjectStore(t)
+	defer os.RemoveAll(rootDir)
+
+	err := store.AddFile([]byte("abc"), store.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	file, err := store.GetFile(store.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("abc"), file)
+
+	// Overwriting replaces the content.
+	err = store.AddFile([]byte("abcd"), store.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	file, err = store.GetFile(store.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("abcd"), file)
+}
+
+func TestLocalObjectStore_GetFileNotFound(t *testing.T) {
+	store, rootDir := newTestLocalObjectStore(t)
+	defer os.RemoveAll(rootDir)
+
+	_, err := store.GetFile(store.GetPipelineKey("1"))
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestLocalObjectStore_PathStaysUnderRootDir(t *testing.T) {
+	store, rootDir := newTestLocalObjectStore(t)
+	defer os.RemoveAll(rootDir)
+
+	err := store.AddFile([]byte("abc"), "../../escaped")
+	assert.Nil(t, err)
+	_, err = os.Stat(filepath.Join(rootDir, "escaped"))
+	assert.Nil(t, err)
+}
+
+func TestLocalObjectStore_OpenFile(t *testing.T) {
+	store, rootDir := newTestLocalObjectStore(t)
+	defer os.RemoveAll(rootDir)
+	store.AddFile([]byte("{\"a\": 1}"), "artifacts/metrics.json")
+
+	reader, err := store.OpenFile("artifacts/metrics.json", 1)
+	assert.Nil(t, err)
+	defer reader.Close()
+	content, err := ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("\"a\": 1}"), content)
+	assert.Equal(t, int64(8), reader.Size)
+	assert.Equal(t, "application/json", reader.ContentType)
+
+	_, err = store.OpenFile("artifacts/metrics.json", 9)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestLocalObjectStore_GetFileSize(t *testing.T) {
+	store, rootDir := newTestLocalObjectStore(t)
+	defer os.RemoveAll(rootDir)
+	store.AddFile([]byte("abc"), "artifacts/a.tgz")
+
+	size, err := store.GetFileSize("artifacts/a.tgz")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), size)
+}
+
+func TestLocalObjectStore_DeleteFile(t *testing.T) {
+	store, rootDir := newTestLocalObjectStore(t)
+	defer os.RemoveAll(rootDir)
+	store.AddFile([]byte("abc"), store.GetPipelineKey("1"))
+
+	err := store.DeleteFile(store.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	_, err = store.GetFile(store.GetPipelineKey("1"))
+	assert.NotNil(t, err)
+	// Deleting a missing file succeeds, like on S3.
+	err = store.DeleteFile(store.GetPipelineKey("1"))
+	assert.Nil(t, err)
+}
+
+func TestLocalObjectStore_YamlFile(t *testing.T) {
+	store, rootDir := newTestLocalObjectStore(t)
+	defer os.RemoveAll(rootDir)
+
+	err := store.AddAsYamlFile(Foo{ID: 1}, store.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	var foo Foo
+	err = store.GetFromYamlFile(&foo, store.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	assert.Equal(t, Foo{ID: 1}, foo)
+}
@@ -25,6 +25,7 @@ type MinioClientInterface interface {
 	PutObject(bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (n int64, err error)
 	GetObject(bucketName, objectName string, opts minio.GetObjectOptions) (io.Reader, error)
 	DeleteObject(bucketName, objectName string) error
+	StatObject(bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error)
 }
 
 type MinioClient struct {
@@ -42,3 +43,7 @@ func (c *MinioClient) GetObject(bucketName, objectName string, opts minio.GetObj
 func (c *MinioClient) DeleteObject(bucketName, objectName string) error {
 	return c.Client.RemoveObject(bucketName, objectName)
 }
+
+func (c *MinioClient) StatObject(bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+	return c.Client.StatObject(bucketName, objectName, opts)
+}
@@ -16,6 +16,7 @@ package storage
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 
 	"github.com/minio/minio-go"
@@ -42,10 +43,34 @@ func (c *FakeMinioClient) PutObject(bucketName, objectName string, reader io.Rea
 
 func (c *FakeMinioClient) GetObject(bucketName, objectName string,
 	opts minio.GetObjectOptions) (io.Reader, error) {
-	if _, ok := c.minioClient[objectName]; !ok {
-		return nil, errors.New("object not found")
+	content, ok := c.minioClient[objectName]
+	if !ok {
+		return nil, minio.ErrorResponse{Code: "NoSuchKey", Message: "object not found"}
+	}
+	if rangeHeader := opts.Header().Get("Range"); rangeHeader != "" {
+		var start, end int64
+		if n, _ := fmt.Sscanf(rangeHeader, "bytes=%d-%d", &start, &end); n == 2 {
+			content = content[start : end+1]
+		} else if n == 1 {
+			content = content[start:]
+		} else {
+			return nil, errors.Errorf("unsupported range %v", rangeHeader)
+		}
+	}
+	return bytes.NewReader(content), nil
+}
+
+func (c *FakeMinioClient) StatObject(bucketName, objectName string,
+	opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+	content, ok := c.minioClient[objectName]
+	if !ok {
+		return minio.ObjectInfo{}, errors.New("object not found")
 	}
-	return bytes.NewReader(c.minioClient[objectName]), nil
+	return minio.ObjectInfo{
+		Key:         objectName,
+		Size:        int64(len(content)),
+		ContentType: "application/octet-stream",
+	}, nil
 }
 
 func (c *FakeMinioClient) DeleteObject(bucketName, objectName string) error {
@@ -16,16 +16,21 @@ package storage
 
 import (
 	"bytes"
+	"io"
+	"io/ioutil"
 	"path"
 	"regexp"
 
 	"github.com/ghodss/yaml"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	minio "github.com/minio/minio-go"
+	"github.com/minio/minio-go/pkg/encrypt"
 )
 
 const (
 	multipartDefaultSize = -1
+	// Folder under the base folder where the uploaded pipeline packages are kept.
+	pipelinePackageFolder = "packages"
 )
 
 // Interface for object store.
@@ -33,17 +38,30 @@ type ObjectStoreInterface interface {
 	AddFile(template []byte, filePath string) error
 	DeleteFile(filePath string) error
 	GetFile(filePath string) ([]byte, error)
+	OpenFile(filePath string, offset int64) (*FileReader, error)
+	GetFileSize(filePath string) (int64, error)
 	AddAsYamlFile(o interface{}, filePath string) error
 	GetFromYamlFile(o interface{}, filePath string) error
 	GetPipelineKey(pipelineId string) string
+	GetPipelinePackageKey(pipelineVersionId string) string
 }
 
 // Managing pipeline using Minio
+// FileReader streams the content of a file in the object store. Size and
+// ContentType describe the whole file, regardless of the offset it was opened at.
+type FileReader struct {
+	io.ReadCloser
+	Size        int64
+	ContentType string
+}
+
 type MinioObjectStore struct {
 	minioClient      MinioClientInterface
 	bucketName       string
 	baseFolder       string
 	disableMultipart bool
+	// Server-side encryption applied to the files added to the store. Nil uses the bucket default.
+	serverSideEncryption encrypt.ServerSide
 }
 
 // GetPipelineKey adds the configured base folder to pipeline id.
@@ -51,6 +69,11 @@ func (m *MinioObjectStore) GetPipelineKey(pipelineID string) string {
 	return path.Join(m.baseFolder, pipelineID)
 }
 
+// GetPipelinePackageKey returns the key of the archive a pipeline version was uploaded in.
+func (m *MinioObjectStore) GetPipelinePackageKey(pipelineVersionID string) string {
+	return path.Join(m.baseFolder, pipelinePackageFolder, pipelineVersionID)
+}
+
 func (m *MinioObjectStore) AddFile(file []byte, filePath string) error {
 
 	var parts int64
@@ -63,7 +86,7 @@ func (m *MinioObjectStore) AddFile(file []byte, filePath string) error {
 
 	_, err := m.minioClient.PutObject(
 		m.bucketName, filePath, bytes.NewReader(file),
-		parts, minio.PutObjectOptions{ContentType: "application/octet-stream"})
+		parts, minio.PutObjectOptions{ContentType: "application/octet-stream", ServerSideEncryption: m.serverSideEncryption})
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to store %v", filePath)
 	}
@@ -81,11 +104,14 @@ func (m *MinioObjectStore) DeleteFile(filePath string) error {
 func (m *MinioObjectStore) GetFile(filePath string) ([]byte, error) {
 	reader, err := m.minioClient.GetObject(m.bucketName, filePath, minio.GetObjectOptions{})
 	if err != nil {
-		return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+		return nil, toGetFileError(err, filePath)
 	}
 
 	buf := new(bytes.Buffer)
-	buf.ReadFrom(reader)
+	// Minio only requests the object once it's read, so a missing object is reported here.
+	if _, err := buf.ReadFrom(reader); err != nil {
+		return nil, toGetFileError(err, filePath)
+	}
 
 	bytes := buf.Bytes()
 
@@ -98,20 +124,73 @@ func (m *MinioObjectStore) GetFile(filePath string) ([]byte, error) {
 	return bytes, nil
 }
 
+// toGetFileError reports a missing object as not found, and any other error as internal.
+func toGetFileError(err error, filePath string) error {
+	if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return util.NewNotFoundError(err, "File %v not found", filePath)
+	}
+	return util.NewInternalServerError(err, "Failed to get %v", filePath)
+}
+
+// OpenFile opens the file for streaming, starting at the given byte offset.
+// Unlike GetFile, the content is not buffered in memory, so it's suitable for
+// large files written by the pipeline steps.
+func (m *MinioObjectStore) OpenFile(filePath string, offset int64) (*FileReader, error) {
+	info, err := m.minioClient.StatObject(m.bucketName, filePath, minio.StatObjectOptions{})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	if offset < 0 || offset > info.Size {
+		return nil, util.NewInvalidInputError("Offset %v is out of range for %v of size %v", offset, filePath, info.Size)
+	}
+
+	opts := minio.GetObjectOptions{}
+	if offset > 0 {
+		if err := opts.SetRange(offset, 0); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+		}
+	}
+	reader, err := m.minioClient.GetObject(m.bucketName, filePath, opts)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	readCloser, ok := reader.(io.ReadCloser)
+	if !ok {
+		readCloser = ioutil.NopCloser(reader)
+	}
+	return &FileReader{ReadCloser: readCloser, Size: info.Size, ContentType: info.ContentType}, nil
+}
+
+func (m *MinioObjectStore) GetFileSize(filePath string) (int64, error) {
+	info, err := m.minioClient.StatObject(m.bucketName, filePath, minio.StatObjectOptions{})
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to get the size of %v", filePath)
+	}
+	return info.Size, nil
+}
+
 func (m *MinioObjectStore) AddAsYamlFile(o interface{}, filePath string) error {
+	return addAsYamlFile(m, o, filePath)
+}
+
+func (m *MinioObjectStore) GetFromYamlFile(o interface{}, filePath string) error {
+	return getFromYamlFile(m, o, filePath)
+}
+
+func addAsYamlFile(store ObjectStoreInterface, o interface{}, filePath string) error {
 	bytes, err := yaml.Marshal(o)
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to marshal %v: %v", filePath, err.Error())
 	}
-	err = m.AddFile(bytes, filePath)
+	err = store.AddFile(bytes, filePath)
 	if err != nil {
 		return util.Wrap(err, "Failed to add a yaml file.")
 	}
 	return nil
 }
 
-func (m *MinioObjectStore) GetFromYamlFile(o interface{}, filePath string) error {
-	bytes, err := m.GetFile(filePath)
+func getFromYamlFile(store ObjectStoreInterface, o interface{}, filePath string) error {
+	bytes, err := store.GetFile(filePath)
 	if err != nil {
 		return util.Wrap(err, "Failed to read from a yaml file.")
 	}
@@ -129,3 +208,9 @@ func buildPath(folder, file string) string {
 func NewMinioObjectStore(minioClient MinioClientInterface, bucketName string, baseFolder string, disableMultipart bool) *MinioObjectStore {
 	return &MinioObjectStore{minioClient: minioClient, bucketName: bucketName, baseFolder: baseFolder, disableMultipart: disableMultipart}
 }
+
+// NewS3ObjectStore creates an object store on AWS S3. Files are encrypted with the given
+// server-side encryption, such as SSE-KMS, or with the bucket default if it's nil.
+func NewS3ObjectStore(minioClient MinioClientInterface, bucketName string, baseFolder string, serverSideEncryption encrypt.ServerSide) *MinioObjectStore {
+	return &MinioObjectStore{minioClient: minioClient, bucketName: bucketName, baseFolder: baseFolder, serverSideEncryption: serverSideEncryption}
+}
@@ -17,10 +17,12 @@ package storage
 import (
 	"bytes"
 	"io"
+	"io/ioutil"
 	"testing"
 
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	minio "github.com/minio/minio-go"
+	"github.com/minio/minio-go/pkg/encrypt"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
@@ -44,6 +46,11 @@ func (c *FakeBadMinioClient) DeleteObject(bucketName, objectName string) error {
 	return errors.New("some error")
 }
 
+func (c *FakeBadMinioClient) StatObject(bucketName, objectName string,
+	opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+	return minio.ObjectInfo{}, errors.New("some error")
+}
+
 func TestAddFile(t *testing.T) {
 	minioClient := NewFakeMinioClient()
 	manager := &MinioObjectStore{minioClient: minioClient, baseFolder: "pipeline"}
@@ -53,6 +60,33 @@ func TestAddFile(t *testing.T) {
 	assert.True(t, minioClient.ExistObject("pipeline/1"))
 }
 
+func TestGetPipelinePackageKey(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: NewFakeMinioClient(), baseFolder: "pipeline"}
+	assert.Equal(t, "pipeline/packages/1", manager.GetPipelinePackageKey("1"))
+}
+
+type recordingMinioClient struct {
+	*FakeMinioClient
+	putOptions minio.PutObjectOptions
+}
+
+func (c *recordingMinioClient) PutObject(bucketName, objectName string, reader io.Reader,
+	objectSize int64, opts minio.PutObjectOptions) (n int64, err error) {
+	c.putOptions = opts
+	return c.FakeMinioClient.PutObject(bucketName, objectName, reader, objectSize, opts)
+}
+
+func TestS3ObjectStore_AddFileWithServerSideEncryption(t *testing.T) {
+	minioClient := &recordingMinioClient{FakeMinioClient: NewFakeMinioClient()}
+	sse, err := encrypt.NewSSEKMS("my-key", nil)
+	assert.Nil(t, err)
+	manager := NewS3ObjectStore(minioClient, "bucket", "pipeline", sse)
+	err = manager.AddFile([]byte("abc"), manager.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	assert.Equal(t, sse, minioClient.putOptions.ServerSideEncryption)
+	assert.True(t, minioClient.ExistObject("pipeline/1"))
+}
+
 func TestAddFileError(t *testing.T) {
 	manager := &MinioObjectStore{minioClient: &FakeBadMinioClient{}}
 	error := manager.AddFile([]byte("abc"), manager.GetPipelineKey("1"))
@@ -73,6 +107,52 @@ func TestGetFileError(t *testing.T) {
 	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
 }
 
+func TestGetFileNotFound(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: NewFakeMinioClient(), baseFolder: "pipeline"}
+	_, error := manager.GetFile(manager.GetPipelineKey("1"))
+	assert.Equal(t, codes.NotFound, error.(*util.UserError).ExternalStatusCode())
+}
+
+func TestOpenFile(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: NewFakeMinioClient(), baseFolder: "pipeline"}
+	manager.AddFile([]byte("abcdef"), manager.GetPipelineKey("1"))
+	reader, error := manager.OpenFile(manager.GetPipelineKey("1"), 2)
+	assert.Nil(t, error)
+	defer reader.Close()
+	content, error := ioutil.ReadAll(reader)
+	assert.Nil(t, error)
+	assert.Equal(t, []byte("cdef"), content)
+	assert.Equal(t, int64(6), reader.Size)
+	assert.Equal(t, "application/octet-stream", reader.ContentType)
+}
+
+func TestOpenFile_OffsetOutOfRange(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: NewFakeMinioClient(), baseFolder: "pipeline"}
+	manager.AddFile([]byte("abc"), manager.GetPipelineKey("1"))
+	_, error := manager.OpenFile(manager.GetPipelineKey("1"), 4)
+	assert.Equal(t, codes.InvalidArgument, error.(*util.UserError).ExternalStatusCode())
+}
+
+func TestOpenFileError(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: &FakeBadMinioClient{}, baseFolder: "pipeline"}
+	_, error := manager.OpenFile(manager.GetPipelineKey("1"), 0)
+	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
+}
+
+func TestGetFileSize(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: NewFakeMinioClient(), baseFolder: "pipeline"}
+	manager.AddFile([]byte("abc"), manager.GetPipelineKey("1"))
+	size, error := manager.GetFileSize(manager.GetPipelineKey("1"))
+	assert.Nil(t, error)
+	assert.Equal(t, int64(3), size)
+}
+
+func TestGetFileSizeError(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: &FakeBadMinioClient{}, baseFolder: "pipeline"}
+	_, error := manager.GetFileSize(manager.GetPipelineKey("1"))
+	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
+}
+
 func TestDeleteFile(t *testing.T) {
 	minioClient := NewFakeMinioClient()
 	manager := &MinioObjectStore{minioClient: minioClient, baseFolder: "pipeline"}
@@ -20,6 +20,7 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
@@ -41,6 +42,8 @@ var pipelineColumns = []string{
 	"pipeline_versions.PipelineId",
 	"pipeline_versions.Status",
 	"pipeline_versions.CodeSourceUrl",
+	"pipeline_versions.Digest",
+	"pipeline_versions.ParameterSchema",
 }
 
 var pipelineVersionColumns = []string{
@@ -51,6 +54,8 @@ var pipelineVersionColumns = []string{
 	"pipeline_versions.PipelineId",
 	"pipeline_versions.Status",
 	"pipeline_versions.CodeSourceUrl",
+	"pipeline_versions.Digest",
+	"pipeline_versions.ParameterSchema",
 }
 
 type PipelineStoreInterface interface {
@@ -63,8 +68,12 @@ type PipelineStoreInterface interface {
 	UpdatePipelineDefaultVersion(string, string) error
 
 	CreatePipelineVersion(*model.PipelineVersion) (*model.PipelineVersion, error)
+	// Create the version unless the pipeline has a ready or creating version with the same content
+	// digest, in which case that version is returned. The bool reports whether a version was created.
+	CreateOrGetPipelineVersion(*model.PipelineVersion) (*model.PipelineVersion, bool, error)
 	GetPipelineVersion(versionId string) (*model.PipelineVersion, error)
 	GetPipelineVersionWithStatus(versionId string, status model.PipelineVersionStatus) (*model.PipelineVersion, error)
+	// Get the earliest ready version of a pipeline with the given content digest.
 	ListPipelineVersions(pipelineId string, opts *list.Options) ([]*model.PipelineVersion, int, string, error)
 	DeletePipelineVersion(pipelineVersionId string) error
 	// Change status of a particular version.
@@ -75,9 +84,10 @@ type PipelineStoreInterface interface {
 }
 
 type PipelineStore struct {
-	db   *DB
-	time util.TimeInterface
-	uuid util.UUIDGeneratorInterface
+	db         *DB
+	time       util.TimeInterface
+	uuid       util.UUIDGeneratorInterface
+	labelStore *LabelStore
 }
 
 // Runs two SQL queries in a transaction to return a list of matching pipelines, as well as their
@@ -146,6 +156,10 @@ func (s *PipelineStore) ListPipelines(opts *list.Options) ([]*model.Pipeline, in
 		return errorF(err)
 	}
 
+	if err := s.addPipelineLabels(pipelines); err != nil {
+		return errorF(err)
+	}
+
 	if len(pipelines) <= opts.PageSize {
 		return pipelines, total_size, "", nil
 	}
@@ -161,7 +175,7 @@ func (s *PipelineStore) scanRows(rows *sql.Rows) ([]*model.Pipeline, error) {
 		var defaultVersionId sql.NullString
 		var createdAtInSec int64
 		var status model.PipelineStatus
-		var versionUUID, versionName, versionParameters, versionPipelineId, versionCodeSourceUrl, versionStatus sql.NullString
+		var versionUUID, versionName, versionParameters, versionPipelineId, versionCodeSourceUrl, versionStatus, versionDigest, versionParameterSchema sql.NullString
 		var versionCreatedAtInSec sql.NullInt64
 		if err := rows.Scan(
 			&uuid,
@@ -177,7 +191,9 @@ func (s *PipelineStore) scanRows(rows *sql.Rows) ([]*model.Pipeline, error) {
 			&versionParameters,
 			&versionPipelineId,
 			&versionStatus,
-			&versionCodeSourceUrl); err != nil {
+			&versionCodeSourceUrl,
+			&versionDigest,
+			&versionParameterSchema); err != nil {
 			return nil, err
 		}
 		if defaultVersionId.Valid {
@@ -190,13 +206,15 @@ func (s *PipelineStore) scanRows(rows *sql.Rows) ([]*model.Pipeline, error) {
 				Status:           status,
 				DefaultVersionId: defaultVersionId.String,
 				DefaultVersion: &model.PipelineVersion{
-					UUID:           versionUUID.String,
-					CreatedAtInSec: versionCreatedAtInSec.Int64,
-					Name:           versionName.String,
-					Parameters:     versionParameters.String,
-					PipelineId:     versionPipelineId.String,
-					Status:         model.PipelineVersionStatus(versionStatus.String),
-					CodeSourceUrl:  versionCodeSourceUrl.String,
+					UUID:            versionUUID.String,
+					CreatedAtInSec:  versionCreatedAtInSec.Int64,
+					Name:            versionName.String,
+					Parameters:      versionParameters.String,
+					PipelineId:      versionPipelineId.String,
+					Status:          model.PipelineVersionStatus(versionStatus.String),
+					CodeSourceUrl:   versionCodeSourceUrl.String,
+					Digest:          versionDigest.String,
+					ParameterSchema: versionParameterSchema.String,
 				}})
 		} else {
 			pipelines = append(pipelines, &model.Pipeline{
@@ -240,19 +258,86 @@ func (s *PipelineStore) GetPipelineWithStatus(id string, status model.PipelineSt
 	if len(pipelines) == 0 {
 		return nil, util.NewResourceNotFoundError("Pipeline", fmt.Sprint(id))
 	}
+	if err := s.addPipelineLabels(pipelines); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get pipeline: %v", err.Error())
+	}
 	return pipelines[0], nil
 }
 
+// Fill in the labels of the pipelines and of their default versions.
+func (s *PipelineStore) addPipelineLabels(pipelines []*model.Pipeline) error {
+	var ids []string
+	var defaultVersions []*model.PipelineVersion
+	for _, pipeline := range pipelines {
+		ids = append(ids, pipeline.UUID)
+		if pipeline.DefaultVersion != nil {
+			defaultVersions = append(defaultVersions, pipeline.DefaultVersion)
+		}
+	}
+	labels, err := s.labelStore.ListLabels(ids, common.Pipeline)
+	if err != nil {
+		return err
+	}
+	for _, pipeline := range pipelines {
+		pipeline.Labels = labels[pipeline.UUID]
+	}
+	return s.addPipelineVersionLabels(defaultVersions)
+}
+
+func (s *PipelineStore) addPipelineVersionLabels(versions []*model.PipelineVersion) error {
+	var ids []string
+	for _, version := range versions {
+		ids = append(ids, version.UUID)
+	}
+	labels, err := s.labelStore.ListLabels(ids, common.PipelineVersion)
+	if err != nil {
+		return err
+	}
+	for _, version := range versions {
+		version.Labels = labels[version.UUID]
+	}
+	return nil
+}
+
 func (s *PipelineStore) DeletePipeline(id string) error {
 	sql, args, err := sq.Delete("pipelines").Where(sq.Eq{"UUID": id}).ToSql()
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to create query to delete pipeline: %v", err.Error())
 	}
 
-	_, err = s.db.Exec(sql, args...)
+	// The versions of the pipeline are deleted along with it by the foreign key, but their labels aren't.
+	versionLabelSql, versionLabelArgs, err := sq.
+		Delete("labels").
+		Where(sq.Eq{"ResourceType": common.PipelineVersion}).
+		Where(sq.Expr("ResourceUUID IN (SELECT UUID FROM pipeline_versions WHERE PipelineId = ?)", id)).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete pipeline version labels: %v", err.Error())
+	}
+
+	// Use a transaction to make sure the pipeline, its labels and the labels of its versions are deleted.
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a new transaction to delete pipeline.")
+	}
+	_, err = tx.Exec(versionLabelSql, versionLabelArgs...)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete labels from table for versions of pipeline %v", id)
+	}
+	_, err = tx.Exec(sql, args...)
 	if err != nil {
+		tx.Rollback()
 		return util.NewInternalServerError(err, "Failed to delete pipeline: %v", err.Error())
 	}
+	err = s.labelStore.DeleteLabels(tx, id, common.Pipeline)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete labels from table for pipeline %v", id)
+	}
+	if err := tx.Commit(); err != nil {
+		return util.NewInternalServerError(err, "Failed to delete pipeline %v and its labels from table", id)
+	}
 	return nil
 }
 
@@ -306,13 +391,15 @@ func (s *PipelineStore) CreatePipeline(p *model.Pipeline) (*model.Pipeline, erro
 		Insert("pipeline_versions").
 		SetMap(
 			sq.Eq{
-				"UUID":           newPipeline.DefaultVersion.UUID,
-				"CreatedAtInSec": newPipeline.DefaultVersion.CreatedAtInSec,
-				"Name":           newPipeline.DefaultVersion.Name,
-				"Parameters":     newPipeline.DefaultVersion.Parameters,
-				"Status":         string(newPipeline.DefaultVersion.Status),
-				"PipelineId":     newPipeline.UUID,
-				"CodeSourceUrl":  newPipeline.DefaultVersion.CodeSourceUrl}).
+				"UUID":            newPipeline.DefaultVersion.UUID,
+				"CreatedAtInSec":  newPipeline.DefaultVersion.CreatedAtInSec,
+				"Name":            newPipeline.DefaultVersion.Name,
+				"Parameters":      newPipeline.DefaultVersion.Parameters,
+				"Status":          string(newPipeline.DefaultVersion.Status),
+				"PipelineId":      newPipeline.UUID,
+				"CodeSourceUrl":   newPipeline.DefaultVersion.CodeSourceUrl,
+				"Digest":          newPipeline.DefaultVersion.Digest,
+				"ParameterSchema": newPipeline.DefaultVersion.ParameterSchema}).
 		ToSql()
 	if err != nil {
 		return nil, util.NewInternalServerError(err,
@@ -351,6 +438,16 @@ func (s *PipelineStore) CreatePipeline(p *model.Pipeline) (*model.Pipeline, erro
 			"Failed to add pipeline version to pipeline_versions table: %v",
 			err.Error())
 	}
+	err = s.labelStore.CreateLabels(tx, newPipeline.UUID, common.Pipeline, newPipeline.Labels)
+	if err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err, "Failed to store labels to table for pipeline %v", p.Name)
+	}
+	err = s.labelStore.CreateLabels(tx, newPipeline.DefaultVersion.UUID, common.PipelineVersion, newPipeline.DefaultVersion.Labels)
+	if err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err, "Failed to store labels to table for pipeline version %v", newPipeline.DefaultVersion.Name)
+	}
 	if err := tx.Commit(); err != nil {
 		return nil, util.NewInternalServerError(err,
 			`Failed to update pipelines and pipeline_versions in a
@@ -444,15 +541,31 @@ func (s *PipelineStore) UpdatePipelineAndVersionsStatus(id string, status model.
 
 // factory function for pipeline store
 func NewPipelineStore(db *DB, time util.TimeInterface, uuid util.UUIDGeneratorInterface) *PipelineStore {
-	return &PipelineStore{db: db, time: time, uuid: uuid}
+	return &PipelineStore{db: db, time: time, uuid: uuid, labelStore: NewLabelStore(db)}
 }
 
 func (s *PipelineStore) CreatePipelineVersion(v *model.PipelineVersion) (*model.PipelineVersion, error) {
+	version, _, err := s.createPipelineVersion(v, false)
+	return version, err
+}
+
+func (s *PipelineStore) CreateOrGetPipelineVersion(v *model.PipelineVersion) (*model.PipelineVersion, bool, error) {
+	version, created, err := s.createPipelineVersion(v, true)
+	if err != nil || created {
+		return version, created, err
+	}
+	if err := s.addPipelineVersionLabels([]*model.PipelineVersion{version}); err != nil {
+		return nil, false, util.NewInternalServerError(err, "Failed to get pipeline version by digest: %v", err.Error())
+	}
+	return version, false, nil
+}
+
+func (s *PipelineStore) createPipelineVersion(v *model.PipelineVersion, deduplicate bool) (*model.PipelineVersion, bool, error) {
 	newPipelineVersion := *v
 	newPipelineVersion.CreatedAtInSec = s.time.Now().Unix()
 	id, err := s.uuid.NewRandom()
 	if err != nil {
-		return nil, util.NewInternalServerError(err, "Failed to create a pipeline version id.")
+		return nil, false, util.NewInternalServerError(err, "Failed to create a pipeline version id.")
 	}
 	newPipelineVersion.UUID = id.String()
 
@@ -461,16 +574,18 @@ func (s *PipelineStore) CreatePipelineVersion(v *model.PipelineVersion) (*model.
 		Insert("pipeline_versions").
 		SetMap(
 			sq.Eq{
-				"UUID":           newPipelineVersion.UUID,
-				"CreatedAtInSec": newPipelineVersion.CreatedAtInSec,
-				"Name":           newPipelineVersion.Name,
-				"Parameters":     newPipelineVersion.Parameters,
-				"PipelineId":     newPipelineVersion.PipelineId,
-				"Status":         string(newPipelineVersion.Status),
-				"CodeSourceUrl":  newPipelineVersion.CodeSourceUrl}).
+				"UUID":            newPipelineVersion.UUID,
+				"CreatedAtInSec":  newPipelineVersion.CreatedAtInSec,
+				"Name":            newPipelineVersion.Name,
+				"Parameters":      newPipelineVersion.Parameters,
+				"PipelineId":      newPipelineVersion.PipelineId,
+				"Status":          string(newPipelineVersion.Status),
+				"CodeSourceUrl":   newPipelineVersion.CodeSourceUrl,
+				"Digest":          newPipelineVersion.Digest,
+				"ParameterSchema": newPipelineVersion.ParameterSchema}).
 		ToSql()
 	if versionErr != nil {
-		return nil, util.NewInternalServerError(
+		return nil, false, util.NewInternalServerError(
 			versionErr,
 			"Failed to create query to insert version to pipeline version table: %v",
 			versionErr.Error())
@@ -481,7 +596,7 @@ func (s *PipelineStore) CreatePipelineVersion(v *model.PipelineVersion) (*model.
 		Where(sq.Eq{"UUID": newPipelineVersion.PipelineId}).
 		ToSql()
 	if pipelineErr != nil {
-		return nil, util.NewInternalServerError(
+		return nil, false, util.NewInternalServerError(
 			pipelineErr,
 			"Failed to create query to update pipeline default version id: %v",
 			pipelineErr.Error())
@@ -490,34 +605,54 @@ func (s *PipelineStore) CreatePipelineVersion(v *model.PipelineVersion) (*model.
 	// In a single transaction, insert new version and update default version.
 	tx, err := s.db.Begin()
 	if err != nil {
-		return nil, util.NewInternalServerError(
+		return nil, false, util.NewInternalServerError(
 			err,
 			"Failed to start a transaction: %v",
 			err.Error())
 	}
 
+	if deduplicate {
+		existing, err := s.getPipelineVersionByDigestForUpdate(tx, newPipelineVersion.PipelineId, newPipelineVersion.Digest)
+		if err != nil {
+			tx.Rollback()
+			return nil, false, err
+		}
+		if existing != nil {
+			if err := tx.Commit(); err != nil {
+				return nil, false, util.NewInternalServerError(err, "Failed to get pipeline version by digest: %v",
+					err.Error())
+			}
+			return existing, false, nil
+		}
+	}
+
 	_, err = tx.Exec(versionSql, versionArgs...)
 	if err != nil {
 		tx.Rollback()
 		if s.db.IsDuplicateError(err) {
-			return nil, util.NewAlreadyExistError(
+			return nil, false, util.NewAlreadyExistError(
 				"Failed to create a new pipeline version. The name %v already exist. Please specify a new name.", v.Name)
 		}
-		return nil, util.NewInternalServerError(err, "Failed to add version to pipeline version table: %v",
+		return nil, false, util.NewInternalServerError(err, "Failed to add version to pipeline version table: %v",
 			err.Error())
 	}
 	_, err = tx.Exec(pipelineSql, pipelineArgs...)
 	if err != nil {
 		tx.Rollback()
-		return nil, util.NewInternalServerError(err, "Failed to update pipeline default version id: %v",
+		return nil, false, util.NewInternalServerError(err, "Failed to update pipeline default version id: %v",
 			err.Error())
 	}
+	err = s.labelStore.CreateLabels(tx, newPipelineVersion.UUID, common.PipelineVersion, newPipelineVersion.Labels)
+	if err != nil {
+		tx.Rollback()
+		return nil, false, util.NewInternalServerError(err, "Failed to store labels to table for pipeline version %v", v.Name)
+	}
 	if err := tx.Commit(); err != nil {
-		return nil, util.NewInternalServerError(err, "Failed to create new pipeline version: %v",
+		return nil, false, util.NewInternalServerError(err, "Failed to create new pipeline version: %v",
 			err.Error())
 	}
 
-	return &newPipelineVersion, nil
+	return &newPipelineVersion, true, nil
 }
 
 func (s *PipelineStore) UpdatePipelineDefaultVersion(pipelineId string, versionId string) error {
@@ -564,13 +699,59 @@ func (s *PipelineStore) GetPipelineVersionWithStatus(versionId string, status mo
 	if len(versions) == 0 {
 		return nil, util.NewResourceNotFoundError("Version", fmt.Sprint(versionId))
 	}
+	if err := s.addPipelineVersionLabels(versions); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get pipeline version: %v", err.Error())
+	}
+	return versions[0], nil
+}
+
+// getPipelineVersionByDigestForUpdate returns the earliest ready version of the pipeline with the
+// given content digest, or nil if there is none. Versions still being created aren't matched since
+// their upload may yet fail, so identical uploads racing each other may each create a version. The
+// pipeline row stays locked until the transaction ends.
+func (s *PipelineStore) getPipelineVersionByDigestForUpdate(tx *sql.Tx, pipelineId string, digest string) (*model.PipelineVersion, error) {
+	lockSql, lockArgs, err := sq.Select("UUID").From("pipelines").Where(sq.Eq{"UUID": pipelineId}).ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to lock pipeline: %v", err.Error())
+	}
+	lockRows, err := tx.Query(s.db.SelectForUpdate(lockSql), lockArgs...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to lock pipeline %v: %v", pipelineId, err.Error())
+	}
+	lockRows.Close()
+
+	versionSql, versionArgs, err := sq.
+		Select(pipelineVersionColumns...).
+		From("pipeline_versions").
+		Where(sq.And{
+			sq.Eq{"PipelineId": pipelineId},
+			sq.Eq{"Digest": digest},
+			sq.Eq{"Status": model.PipelineVersionReady}}).
+		OrderBy("CreatedAtInSec", "UUID").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to get pipeline version by digest: %v", err.Error())
+	}
+	r, err := tx.Query(versionSql, versionArgs...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get pipeline version by digest: %v", err.Error())
+	}
+	defer r.Close()
+	versions, err := s.scanPipelineVersionRows(r)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get pipeline version by digest: %v", err.Error())
+	}
+	if len(versions) == 0 {
+		return nil, nil
+	}
 	return versions[0], nil
 }
 
 func (s *PipelineStore) scanPipelineVersionRows(rows *sql.Rows) ([]*model.PipelineVersion, error) {
 	var pipelineVersions []*model.PipelineVersion
 	for rows.Next() {
-		var uuid, name, parameters, pipelineId, codeSourceUrl, status sql.NullString
+		var uuid, name, parameters, pipelineId, codeSourceUrl, status, digest, parameterSchema sql.NullString
 		var createdAtInSec sql.NullInt64
 		if err := rows.Scan(
 			&uuid,
@@ -580,18 +761,22 @@ func (s *PipelineStore) scanPipelineVersionRows(rows *sql.Rows) ([]*model.Pipeli
 			&pipelineId,
 			&status,
 			&codeSourceUrl,
+			&digest,
+			&parameterSchema,
 		); err != nil {
 			return nil, err
 		}
 		if uuid.Valid {
 			pipelineVersions = append(pipelineVersions, &model.PipelineVersion{
-				UUID:           uuid.String,
-				CreatedAtInSec: createdAtInSec.Int64,
-				Name:           name.String,
-				Parameters:     parameters.String,
-				PipelineId:     pipelineId.String,
-				CodeSourceUrl:  codeSourceUrl.String,
-				Status:         model.PipelineVersionStatus(status.String)})
+				UUID:            uuid.String,
+				CreatedAtInSec:  createdAtInSec.Int64,
+				Name:            name.String,
+				Parameters:      parameters.String,
+				PipelineId:      pipelineId.String,
+				CodeSourceUrl:   codeSourceUrl.String,
+				Digest:          digest.String,
+				ParameterSchema: parameterSchema.String,
+				Status:          model.PipelineVersionStatus(status.String)})
 		}
 	}
 	return pipelineVersions, nil
@@ -659,6 +844,10 @@ func (s *PipelineStore) ListPipelineVersions(pipelineId string, opts *list.Optio
 		return errorF(err)
 	}
 
+	if err := s.addPipelineVersionLabels(pipelineVersions); err != nil {
+		return errorF(err)
+	}
+
 	if len(pipelineVersions) <= opts.PageSize {
 		return pipelineVersions, total_size, "", nil
 	}
@@ -692,6 +881,14 @@ func (s *PipelineStore) DeletePipelineVersion(versionId string) error {
 			"Failed to delete pipeline version: %v",
 			err.Error())
 	}
+	err = s.labelStore.DeleteLabels(tx, versionId, common.PipelineVersion)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(
+			err,
+			"Failed to delete labels of pipeline version: %v",
+			err.Error())
+	}
 
 	// (2) check whether this version is used as default version.
 	r, err := tx.Query(
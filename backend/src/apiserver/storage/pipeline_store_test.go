@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
@@ -149,6 +150,85 @@ func TestListPipelines_WithFilter(t *testing.T) {
 	assert.Equal(t, pipelinesExpected, pipelines)
 }
 
+func TestListPipelines_WithLabelFilter(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	pipelineStore := NewPipelineStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeUUID, nil))
+	pipeline := createPipeline("pipeline_foo")
+	pipeline.Labels = map[string]string{"team": "ranking"}
+	pipelineStore.CreatePipeline(pipeline)
+	pipelineStore.uuid = util.NewFakeUUIDGeneratorOrFatal(fakeUUIDTwo, nil)
+	pipeline = createPipeline("pipeline_bar")
+	pipeline.Labels = map[string]string{"team": "search"}
+	pipelineStore.CreatePipeline(pipeline)
+
+	expectedPipeline1 := &model.Pipeline{
+		UUID:             fakeUUID,
+		CreatedAtInSec:   1,
+		Name:             "pipeline_foo",
+		Parameters:       `[{"Name": "param1"}]`,
+		Status:           model.PipelineReady,
+		DefaultVersionId: fakeUUID,
+		Labels:           map[string]string{"team": "ranking"},
+		DefaultVersion: &model.PipelineVersion{
+			UUID:           fakeUUID,
+			CreatedAtInSec: 1,
+			Name:           "pipeline_foo",
+			Parameters:     `[{"Name": "param1"}]`,
+			PipelineId:     fakeUUID,
+			Status:         model.PipelineVersionReady,
+		}}
+	pipelinesExpected := []*model.Pipeline{expectedPipeline1}
+
+	filterProto := &api.Filter{
+		Predicates: []*api.Predicate{
+			&api.Predicate{
+				Key:   "label.team",
+				Op:    api.Predicate_EQUALS,
+				Value: &api.Predicate_StringValue{StringValue: "ranking"},
+			},
+		},
+	}
+	opts, err := list.NewOptions(&model.Pipeline{}, 10, "id", filterProto)
+	assert.Nil(t, err)
+
+	pipelines, totalSize, nextPageToken, err := pipelineStore.ListPipelines(opts)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "", nextPageToken)
+	assert.Equal(t, 1, totalSize)
+	assert.Equal(t, pipelinesExpected, pipelines)
+}
+
+func TestDeletePipeline_DeletesLabels(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	pipelineStore := NewPipelineStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeUUID, nil))
+	pipeline := createPipeline("pipeline1")
+	pipeline.Labels = map[string]string{"team": "ranking"}
+	_, err := pipelineStore.CreatePipeline(pipeline)
+	assert.Nil(t, err)
+	pipelineStore.uuid = util.NewFakeUUIDGeneratorOrFatal(fakeUUIDTwo, nil)
+	_, err = pipelineStore.CreatePipelineVersion(&model.PipelineVersion{
+		Name:       "pipeline_version_1",
+		Parameters: `[{"Name": "param1"}]`,
+		PipelineId: fakeUUID,
+		Status:     model.PipelineVersionReady,
+		Labels:     map[string]string{"stage": "prod"},
+	})
+	assert.Nil(t, err)
+
+	err = pipelineStore.DeletePipeline(fakeUUID)
+	assert.Nil(t, err)
+
+	labels, err := pipelineStore.labelStore.GetLabels(fakeUUID, common.Pipeline)
+	assert.Nil(t, err)
+	assert.Empty(t, labels)
+	labels, err = pipelineStore.labelStore.GetLabels(fakeUUIDTwo, common.PipelineVersion)
+	assert.Nil(t, err)
+	assert.Empty(t, labels)
+}
+
 func TestListPipelines_Pagination(t *testing.T) {
 	db := NewFakeDbOrFatal()
 	defer db.Close()
@@ -787,6 +867,81 @@ func TestGetPipelineVersion(t *testing.T) {
 		*pipelineVersion, "Got unexpected pipeline version.")
 }
 
+func TestCreateOrGetPipelineVersion(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	pipelineStore := NewPipelineStore(
+		db,
+		util.NewFakeTimeForEpoch(),
+		util.NewFakeUUIDGeneratorOrFatal(fakeUUID, nil))
+
+	pipelineStore.CreatePipeline(
+		&model.Pipeline{
+			Name:   "pipeline_1",
+			Status: model.PipelineReady,
+		})
+
+	pipelineStore.uuid = util.NewFakeUUIDGeneratorOrFatal(fakeUUIDTwo, nil)
+	pipelineVersion, created, err := pipelineStore.CreateOrGetPipelineVersion(
+		&model.PipelineVersion{
+			Name:       "pipeline_version_1",
+			PipelineId: fakeUUID,
+			Status:     model.PipelineVersionCreating,
+			Digest:     "sha256:abc",
+			Labels:     map[string]string{"stage": "prod"},
+		})
+	assert.Nil(t, err)
+	assert.True(t, created)
+	assert.Equal(t, fakeUUIDTwo, pipelineVersion.UUID)
+
+	// A version still being created doesn't count as existing.
+	pipelineStore.uuid = util.NewFakeUUIDGeneratorOrFatal(fakeUUIDThree, nil)
+	pipelineVersion, created, err = pipelineStore.CreateOrGetPipelineVersion(
+		&model.PipelineVersion{
+			Name:       "pipeline_version_2",
+			PipelineId: fakeUUID,
+			Status:     model.PipelineVersionCreating,
+			Digest:     "sha256:abc",
+		})
+	assert.Nil(t, err)
+	assert.True(t, created)
+	assert.Equal(t, fakeUUIDThree, pipelineVersion.UUID)
+
+	// The earliest ready version does.
+	err = pipelineStore.UpdatePipelineVersionStatus(fakeUUIDTwo, model.PipelineVersionReady)
+	assert.Nil(t, err)
+	err = pipelineStore.UpdatePipelineVersionStatus(fakeUUIDThree, model.PipelineVersionReady)
+	assert.Nil(t, err)
+	pipelineStore.uuid = util.NewFakeUUIDGeneratorOrFatal(fakeUUIDFour, nil)
+	pipelineVersion, created, err = pipelineStore.CreateOrGetPipelineVersion(
+		&model.PipelineVersion{
+			Name:       "pipeline_version_3",
+			PipelineId: fakeUUID,
+			Status:     model.PipelineVersionCreating,
+			Digest:     "sha256:abc",
+		})
+	assert.Nil(t, err)
+	assert.False(t, created)
+	assert.Equal(t, fakeUUIDTwo, pipelineVersion.UUID)
+	assert.Equal(t, map[string]string{"stage": "prod"}, pipelineVersion.Labels)
+
+	// A version being deleted doesn't.
+	err = pipelineStore.UpdatePipelineVersionStatus(fakeUUIDTwo, model.PipelineVersionDeleting)
+	assert.Nil(t, err)
+	err = pipelineStore.UpdatePipelineVersionStatus(fakeUUIDThree, model.PipelineVersionDeleting)
+	assert.Nil(t, err)
+	pipelineVersion, created, err = pipelineStore.CreateOrGetPipelineVersion(
+		&model.PipelineVersion{
+			Name:       "pipeline_version_3",
+			PipelineId: fakeUUID,
+			Status:     model.PipelineVersionCreating,
+			Digest:     "sha256:abc",
+		})
+	assert.Nil(t, err)
+	assert.True(t, created)
+	assert.Equal(t, fakeUUIDFour, pipelineVersion.UUID)
+}
+
 func TestGetPipelineVersion_InternalError(t *testing.T) {
 	db := NewFakeDbOrFatal()
 	defer db.Close()
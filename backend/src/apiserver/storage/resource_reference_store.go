@@ -99,6 +99,23 @@ func (s *ResourceReferenceStore) DeleteResourceReferences(tx *sql.Tx, id string,
 	return nil
 }
 
+// Delete the references from a specific resource to others, keeping the references to it.
+// This is always in company with updating the resource so a transaction is needed as input.
+func (s *ResourceReferenceStore) DeleteResourceReferencesFrom(tx *sql.Tx, id string, resourceType common.ResourceType) error {
+	refSql, refArgs, err := sq.
+		Delete("resource_references").
+		Where(sq.Eq{"ResourceUUID": id, "ResourceType": resourceType}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete resource references from %s %s", resourceType, id)
+	}
+	_, err = tx.Exec(refSql, refArgs...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to delete resource references from %s %s", resourceType, id)
+	}
+	return nil
+}
+
 func (s *ResourceReferenceStore) GetResourceReference(resourceId string, resourceType common.ResourceType,
 	referenceType common.ResourceType) (*model.ResourceReference, error) {
 	sql, args, err := sq.Select(resourceReferenceColumns...).
@@ -32,9 +32,14 @@ import (
 	"k8s.io/apimachinery/pkg/util/json"
 )
 
+// The number of metric points inserted or deleted per statement, to stay under the limit of query
+// parameters.
+const metricPointsInsertBatchSize = 100
+
 var runColumns = []string{"UUID", "ExperimentUUID", "DisplayName", "Name", "StorageState", "Namespace", "ServiceAccount", "Description",
 	"CreatedAtInSec", "ScheduledAtInSec", "FinishedAtInSec", "Conditions", "PipelineId", "PipelineName", "PipelineSpecManifest",
-	"WorkflowSpecManifest", "Parameters", "pipelineRuntimeManifest", "WorkflowRuntimeManifest",
+	"WorkflowSpecManifest", "Parameters", "pipelineRuntimeManifest", "WorkflowRuntimeManifest", "WorkflowSpecDigest",
+	"Warnings",
 }
 
 type RunStoreInterface interface {
@@ -60,9 +65,19 @@ type RunStoreInterface interface {
 	// Update the run table or create one if the run doesn't exist
 	CreateOrUpdateRun(run *model.RunDetail) error
 
+	// Add a warning to a run, unless the run already has it.
+	AddRunWarning(runId string, warning string) error
+
 	// Store a new metric entry to run_metrics table.
 	ReportMetric(metric *model.RunMetric) (err error)
 
+	// Store a metric entry to run_metrics table, or update it if it exists, and add its series to
+	// run_metric_points table.
+	PushMetric(metric *model.RunMetric) error
+
+	// Get the series of a metric from run_metric_points table, ordered by step.
+	GetMetricHistory(runId string, nodeId string, name string) ([]*model.RunMetricPoint, error)
+
 	// Terminate a run
 	TerminateRun(runId string) error
 }
@@ -258,7 +273,7 @@ func (s *RunStore) scanRowsToRunDetails(rows *sql.Rows) ([]*model.RunDetail, err
 			pipelineName, pipelineSpecManifest, workflowSpecManifest, parameters, conditions, pipelineRuntimeManifest,
 			workflowRuntimeManifest string
 		var createdAtInSec, scheduledAtInSec, finishedAtInSec int64
-		var metricsInString, resourceReferencesInString sql.NullString
+		var metricsInString, resourceReferencesInString, workflowSpecDigest, warnings sql.NullString
 		err := rows.Scan(
 			&uuid,
 			&experimentUUID,
@@ -279,6 +294,8 @@ func (s *RunStore) scanRowsToRunDetails(rows *sql.Rows) ([]*model.RunDetail, err
 			&parameters,
 			&pipelineRuntimeManifest,
 			&workflowRuntimeManifest,
+			&workflowSpecDigest,
+			&warnings,
 			&resourceReferencesInString,
 			&metricsInString,
 		)
@@ -311,6 +328,7 @@ func (s *RunStore) scanRowsToRunDetails(rows *sql.Rows) ([]*model.RunDetail, err
 			ScheduledAtInSec:   scheduledAtInSec,
 			FinishedAtInSec:    finishedAtInSec,
 			Conditions:         conditions,
+			Warnings:           warnings.String,
 			Metrics:            metrics,
 			ResourceReferences: resourceReferences,
 			PipelineSpec: model.PipelineSpec{
@@ -318,6 +336,7 @@ func (s *RunStore) scanRowsToRunDetails(rows *sql.Rows) ([]*model.RunDetail, err
 				PipelineName:         pipelineName,
 				PipelineSpecManifest: pipelineRuntimeManifest,
 				WorkflowSpecManifest: workflowSpecManifest,
+				WorkflowSpecDigest:   workflowSpecDigest.String,
 				Parameters:           parameters,
 			},
 		},
@@ -379,6 +398,7 @@ func (s *RunStore) CreateRun(r *model.RunDetail) (*model.RunDetail, error) {
 			"PipelineName":            r.PipelineName,
 			"PipelineSpecManifest":    r.PipelineSpecManifest,
 			"WorkflowSpecManifest":    r.WorkflowSpecManifest,
+			"WorkflowSpecDigest":      r.WorkflowSpecDigest,
 			"Parameters":              r.Parameters,
 		}).ToSql()
 	if err != nil {
@@ -545,6 +565,67 @@ func (s *RunStore) DeleteRun(id string) error {
 	return nil
 }
 
+// AddRunWarning adds a warning to the warnings of a run, unless the run already has it.
+func (s *RunStore) AddRunWarning(runId string, warning string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a new transaction to add run warning.")
+	}
+	selectSql, selectArgs, err := sq.Select("Warnings").From("run_details").Where(sq.Eq{"UUID": runId}).ToSql()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to create query to get warnings of run %s", runId)
+	}
+	var warningsInString sql.NullString
+	err = tx.QueryRow(selectSql, selectArgs...).Scan(&warningsInString)
+	if err != nil {
+		tx.Rollback()
+		if err == sql.ErrNoRows {
+			return util.NewResourceNotFoundError("Run", runId)
+		}
+		return util.NewInternalServerError(err, "Failed to get warnings of run %s", runId)
+	}
+	warnings := []string{}
+	if warningsInString.String != "" {
+		err = json.Unmarshal([]byte(warningsInString.String), &warnings)
+		if err != nil {
+			glog.Errorf("Failed to parse warnings (%v) of run %s from DB: %v", warningsInString.String, runId, err)
+			warnings = []string{}
+		}
+	}
+	for _, existingWarning := range warnings {
+		if existingWarning == warning {
+			tx.Rollback()
+			return nil
+		}
+	}
+	warningsBytes, err := json.Marshal(append(warnings, warning))
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to marshal warnings of run %s", runId)
+	}
+	updateSql, updateArgs, err := sq.
+		Update("run_details").
+		SetMap(sq.Eq{"Warnings": string(warningsBytes)}).
+		Where(sq.Eq{"UUID": runId}).
+		ToSql()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to create query to add warning to run %s", runId)
+	}
+	_, err = tx.Exec(updateSql, updateArgs...)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to add warning to run %s", runId)
+	}
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to add warning to run %s", runId)
+	}
+	return nil
+}
+
 // ReportMetric inserts a new metric to run_metrics table. Conflicting metrics
 // are ignored.
 func (s *RunStore) ReportMetric(metric *model.RunMetric) (err error) {
@@ -566,17 +647,263 @@ func (s *RunStore) ReportMetric(metric *model.RunMetric) (err error) {
 		return util.NewInternalServerError(err,
 			"failed to create query for inserting metric: %+v", metric)
 	}
-	_, err = s.db.Exec(sql, args...)
+	// Use a transaction to make sure both the metric and its series are stored.
+	tx, err := s.db.Begin()
 	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a new transaction to report metric.")
+	}
+	_, err = tx.Exec(sql, args...)
+	if err != nil {
+		tx.Rollback()
 		if s.db.IsDuplicateError(err) {
 			return util.NewAlreadyExistError(
 				"same metric has been reported before: %s/%s", metric.NodeID, metric.Name)
 		}
 		return util.NewInternalServerError(err, "failed to insert metric: %v", metric)
 	}
+	err = insertMetricPoints(tx, metric)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "failed to insert metric: %v", metric)
+	}
+	return nil
+}
+
+// PushMetric inserts a metric to run_metrics table, or updates it if it has been reported before.
+// The points of its series are added to run_metric_points table, replacing the points at the same
+// steps, and the value of the metric is derived from the whole stored series.
+func (s *RunStore) PushMetric(metric *model.RunMetric) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a new transaction to push metric.")
+	}
+	if len(metric.Series) > 0 {
+		err = deleteMetricPointsAtSameSteps(tx, metric)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		err = insertMetricPoints(tx, metric)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		metric.NumberValue, err = summarizeMetricPoints(tx, metric)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	payloadBytes, err := json.Marshal(metric)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err,
+			"failed to marshal metric to json: %+v", metric)
+	}
+	updateSql, updateArgs, err := sq.
+		Update("run_metrics").
+		SetMap(sq.Eq{
+			"NumberValue": metric.NumberValue,
+			"Format":      metric.Format,
+			"Payload":     string(payloadBytes)}).
+		Where(sq.Eq{"RunUUID": metric.RunUUID, "NodeID": metric.NodeID, "Name": metric.Name}).
+		ToSql()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err,
+			"failed to create query for updating metric: %+v", metric)
+	}
+	result, err := tx.Exec(updateSql, updateArgs...)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "failed to update metric: %v", metric)
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "failed to update metric: %v", metric)
+	}
+	if updated == 0 {
+		insertSql, insertArgs, err := sq.
+			Insert("run_metrics").
+			SetMap(sq.Eq{
+				"RunUUID":     metric.RunUUID,
+				"NodeID":      metric.NodeID,
+				"Name":        metric.Name,
+				"NumberValue": metric.NumberValue,
+				"Format":      metric.Format,
+				"Payload":     string(payloadBytes)}).ToSql()
+		if err != nil {
+			tx.Rollback()
+			return util.NewInternalServerError(err,
+				"failed to create query for inserting metric: %+v", metric)
+		}
+		// MySQL doesn't count the rows an update leaves unchanged, so the metric can already exist.
+		_, err = tx.Exec(insertSql, insertArgs...)
+		if err != nil && !s.db.IsDuplicateError(err) {
+			tx.Rollback()
+			return util.NewInternalServerError(err, "failed to insert metric: %v", metric)
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "failed to push metric: %v", metric)
+	}
+	return nil
+}
+
+// insertMetricPoints inserts the series of a metric to run_metric_points table.
+func insertMetricPoints(tx *sql.Tx, metric *model.RunMetric) error {
+	for start := 0; start < len(metric.Series); start += metricPointsInsertBatchSize {
+		end := start + metricPointsInsertBatchSize
+		if end > len(metric.Series) {
+			end = len(metric.Series)
+		}
+		insertBuilder := sq.
+			Insert("run_metric_points").
+			Columns("RunUUID", "NodeID", "Name", "Step", "TimestampInSec", "NumberValue")
+		for _, point := range metric.Series[start:end] {
+			insertBuilder = insertBuilder.Values(
+				metric.RunUUID, metric.NodeID, metric.Name, point.Step, point.TimestampInSec, point.NumberValue)
+		}
+		pointsSql, pointsArgs, err := insertBuilder.ToSql()
+		if err != nil {
+			return util.NewInternalServerError(err,
+				"failed to create query for inserting the series of metric: %s/%s", metric.NodeID, metric.Name)
+		}
+		_, err = tx.Exec(pointsSql, pointsArgs...)
+		if err != nil {
+			return util.NewInternalServerError(err,
+				"failed to insert the series of metric: %s/%s", metric.NodeID, metric.Name)
+		}
+	}
+	return nil
+}
+
+// deleteMetricPointsAtSameSteps deletes the stored points of a metric at the steps of its series.
+func deleteMetricPointsAtSameSteps(tx *sql.Tx, metric *model.RunMetric) error {
+	for start := 0; start < len(metric.Series); start += metricPointsInsertBatchSize {
+		end := start + metricPointsInsertBatchSize
+		if end > len(metric.Series) {
+			end = len(metric.Series)
+		}
+		steps := make([]int64, 0, end-start)
+		for _, point := range metric.Series[start:end] {
+			steps = append(steps, point.Step)
+		}
+		deleteSql, deleteArgs, err := sq.
+			Delete("run_metric_points").
+			Where(sq.Eq{"RunUUID": metric.RunUUID, "NodeID": metric.NodeID, "Name": metric.Name, "Step": steps}).
+			ToSql()
+		if err != nil {
+			return util.NewInternalServerError(err,
+				"failed to create query for replacing the series of metric: %s/%s", metric.NodeID, metric.Name)
+		}
+		_, err = tx.Exec(deleteSql, deleteArgs...)
+		if err != nil {
+			return util.NewInternalServerError(err,
+				"failed to replace the series of metric: %s/%s", metric.NodeID, metric.Name)
+		}
+	}
 	return nil
 }
 
+// summarizeMetricPoints derives the value of a metric from its whole stored series. It fails if the
+// series has grown longer than the limit.
+func summarizeMetricPoints(tx *sql.Tx, metric *model.RunMetric) (float64, error) {
+	where := sq.Eq{"RunUUID": metric.RunUUID, "NodeID": metric.NodeID, "Name": metric.Name}
+	countSql, countArgs, err := sq.Select("count(*)").From("run_metric_points").Where(where).ToSql()
+	if err != nil {
+		return 0, util.NewInternalServerError(err,
+			"failed to create query for counting the series of metric: %s/%s", metric.NodeID, metric.Name)
+	}
+	var count int
+	err = tx.QueryRow(countSql, countArgs...).Scan(&count)
+	if err != nil {
+		return 0, util.NewInternalServerError(err,
+			"failed to count the series of metric: %s/%s", metric.NodeID, metric.Name)
+	}
+	if count > common.MaxRunMetricSeriesLength {
+		return 0, util.NewInvalidInputError(
+			"metric.series cannot have more than %d points", common.MaxRunMetricSeriesLength)
+	}
+
+	var selectBuilder sq.SelectBuilder
+	switch metric.SeriesSummary {
+	case api.RunMetric_MIN.String():
+		selectBuilder = sq.Select("min(NumberValue)")
+	case api.RunMetric_MAX.String():
+		selectBuilder = sq.Select("max(NumberValue)")
+	default:
+		selectBuilder = sq.Select("NumberValue").OrderBy("Step DESC").Limit(1)
+	}
+	valueSql, valueArgs, err := selectBuilder.From("run_metric_points").Where(where).ToSql()
+	if err != nil {
+		return 0, util.NewInternalServerError(err,
+			"failed to create query for summarizing the series of metric: %s/%s", metric.NodeID, metric.Name)
+	}
+	var value float64
+	err = tx.QueryRow(valueSql, valueArgs...).Scan(&value)
+	if err != nil {
+		return 0, util.NewInternalServerError(err,
+			"failed to summarize the series of metric: %s/%s", metric.NodeID, metric.Name)
+	}
+	return value, nil
+}
+
+// GetMetricHistory returns the series of a metric ordered by step. The series is empty if the
+// metric was reported without one.
+func (s *RunStore) GetMetricHistory(runId string, nodeId string, name string) ([]*model.RunMetricPoint, error) {
+	metricSql, metricArgs, err := sq.
+		Select("count(*)").
+		From("run_metrics").
+		Where(sq.Eq{"RunUUID": runId, "NodeID": nodeId, "Name": name}).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to get metric: %v", err.Error())
+	}
+	var count int
+	err = s.db.QueryRow(metricSql, metricArgs...).Scan(&count)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get metric: %v", err.Error())
+	}
+	if count == 0 {
+		return nil, util.NewResourceNotFoundError("Metric", fmt.Sprintf("%s/%s/%s", runId, nodeId, name))
+	}
+
+	sql, args, err := sq.
+		Select("Step", "TimestampInSec", "NumberValue").
+		From("run_metric_points").
+		Where(sq.Eq{"RunUUID": runId, "NodeID": nodeId, "Name": name}).
+		OrderBy("Step").
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to get metric history: %v", err.Error())
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get metric history: %v", err.Error())
+	}
+	defer rows.Close()
+	points := []*model.RunMetricPoint{}
+	for rows.Next() {
+		point := &model.RunMetricPoint{RunUUID: runId, NodeID: nodeId, Name: name}
+		err = rows.Scan(&point.Step, &point.TimestampInSec, &point.NumberValue)
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to parse metric history: %v", err.Error())
+		}
+		points = append(points, point)
+	}
+	return points, nil
+}
+
 func (s *RunStore) toListableModels(runs []model.RunDetail) []model.ListableDataModel {
 	models := make([]model.ListableDataModel, len(runs))
 	for i := range models {
@@ -918,6 +918,178 @@ func TestReportMetric_DupReports_Fail(t *testing.T) {
 	assert.True(t, ok)
 }
 
+func TestReportMetric_Series(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	metric := &model.RunMetric{
+		RunUUID:     "1",
+		NodeID:      "node1",
+		Name:        "loss",
+		NumberValue: 0.4,
+		Format:      "RAW",
+		Series: []*model.RunMetricPoint{
+			{RunUUID: "1", NodeID: "node1", Name: "loss", Step: 2, NumberValue: 0.4},
+			{RunUUID: "1", NodeID: "node1", Name: "loss", Step: 1, TimestampInSec: 10, NumberValue: 0.9},
+		},
+	}
+	err := runStore.ReportMetric(metric)
+	assert.Nil(t, err)
+
+	points, err := runStore.GetMetricHistory("1", "node1", "loss")
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.RunMetricPoint{
+		{RunUUID: "1", NodeID: "node1", Name: "loss", Step: 1, TimestampInSec: 10, NumberValue: 0.9},
+		{RunUUID: "1", NodeID: "node1", Name: "loss", Step: 2, NumberValue: 0.4},
+	}, points)
+
+	// The run's metric holds the summary value, not the series.
+	runDetail, err := runStore.GetRun("1")
+	assert.Nil(t, err)
+	sort.Sort(RunMetricSorter(runDetail.Run.Metrics))
+	assert.Equal(t, &model.RunMetric{
+		RunUUID:     "1",
+		NodeID:      "node1",
+		Name:        "loss",
+		NumberValue: 0.4,
+		Format:      "RAW",
+	}, runDetail.Run.Metrics[1])
+}
+
+func TestReportMetric_SeriesOfDupReport_NotStored(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	metric := &model.RunMetric{
+		RunUUID: "1",
+		NodeID:  "node1",
+		Name:    "dummymetric",
+		Series:  []*model.RunMetricPoint{{RunUUID: "1", NodeID: "node1", Name: "dummymetric", Step: 1}},
+	}
+	err := runStore.ReportMetric(metric)
+	assert.NotNil(t, err)
+
+	points, err := runStore.GetMetricHistory("1", "node1", "dummymetric")
+	assert.Nil(t, err)
+	assert.Empty(t, points)
+}
+
+func TestPushMetric_UpdatesValue(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	err := runStore.PushMetric(&model.RunMetric{
+		RunUUID: "1", NodeID: "node1", Name: "progress", NumberValue: 0.2, Format: "PERCENTAGE"})
+	assert.Nil(t, err)
+	err = runStore.PushMetric(&model.RunMetric{
+		RunUUID: "1", NodeID: "node1", Name: "progress", NumberValue: 0.5, Format: "PERCENTAGE"})
+	assert.Nil(t, err)
+
+	runDetail, err := runStore.GetRun("1")
+	assert.Nil(t, err)
+	sort.Sort(RunMetricSorter(runDetail.Run.Metrics))
+	assert.Equal(t, &model.RunMetric{
+		RunUUID:     "1",
+		NodeID:      "node1",
+		Name:        "progress",
+		NumberValue: 0.5,
+		Format:      "PERCENTAGE",
+	}, runDetail.Run.Metrics[1])
+}
+
+func TestPushMetric_Series(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	err := runStore.PushMetric(&model.RunMetric{
+		RunUUID: "1", NodeID: "node1", Name: "loss", SeriesSummary: "MIN",
+		Series: []*model.RunMetricPoint{{Step: 1, NumberValue: 0.9}, {Step: 2, NumberValue: 0.7}},
+	})
+	assert.Nil(t, err)
+	// Replaces step 2 and adds step 3.
+	err = runStore.PushMetric(&model.RunMetric{
+		RunUUID: "1", NodeID: "node1", Name: "loss", SeriesSummary: "MIN",
+		Series: []*model.RunMetricPoint{{Step: 2, NumberValue: 0.6}, {Step: 3, NumberValue: 0.8}},
+	})
+	assert.Nil(t, err)
+
+	points, err := runStore.GetMetricHistory("1", "node1", "loss")
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.RunMetricPoint{
+		{RunUUID: "1", NodeID: "node1", Name: "loss", Step: 1, NumberValue: 0.9},
+		{RunUUID: "1", NodeID: "node1", Name: "loss", Step: 2, NumberValue: 0.6},
+		{RunUUID: "1", NodeID: "node1", Name: "loss", Step: 3, NumberValue: 0.8},
+	}, points)
+	runDetail, err := runStore.GetRun("1")
+	assert.Nil(t, err)
+	sort.Sort(RunMetricSorter(runDetail.Run.Metrics))
+	assert.Equal(t, 0.6, runDetail.Run.Metrics[1].NumberValue)
+
+	// Without a summary, the value is the one at the last step.
+	err = runStore.PushMetric(&model.RunMetric{
+		RunUUID: "1", NodeID: "node1", Name: "loss",
+		Series: []*model.RunMetricPoint{{Step: 0, NumberValue: 1.0}},
+	})
+	assert.Nil(t, err)
+	runDetail, err = runStore.GetRun("1")
+	assert.Nil(t, err)
+	sort.Sort(RunMetricSorter(runDetail.Run.Metrics))
+	assert.Equal(t, 0.8, runDetail.Run.Metrics[1].NumberValue)
+}
+
+func TestPushMetric_SeriesTooLong_Fail(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	metric := &model.RunMetric{RunUUID: "1", NodeID: "node1", Name: "loss"}
+	for i := 0; i < common.MaxRunMetricSeriesLength; i++ {
+		metric.Series = append(metric.Series, &model.RunMetricPoint{Step: int64(i)})
+	}
+	err := runStore.PushMetric(metric)
+	assert.Nil(t, err)
+
+	err = runStore.PushMetric(&model.RunMetric{
+		RunUUID: "1", NodeID: "node1", Name: "loss",
+		Series: []*model.RunMetricPoint{{Step: int64(common.MaxRunMetricSeriesLength)}},
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	points, err := runStore.GetMetricHistory("1", "node1", "loss")
+	assert.Nil(t, err)
+	assert.Len(t, points, common.MaxRunMetricSeriesLength)
+}
+
+func TestAddRunWarning(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	err := runStore.AddRunWarning("1", "warning 1")
+	assert.Nil(t, err)
+	err = runStore.AddRunWarning("1", "warning 2")
+	assert.Nil(t, err)
+	err = runStore.AddRunWarning("1", "warning 1")
+	assert.Nil(t, err)
+
+	runDetail, err := runStore.GetRun("1")
+	assert.Nil(t, err)
+	assert.Equal(t, `["warning 1","warning 2"]`, runDetail.Warnings)
+}
+
+func TestAddRunWarning_NotFound(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	err := runStore.AddRunWarning("unknown", "warning")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestGetMetricHistory_NotFound(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	_, err := runStore.GetMetricHistory("1", "node1", "unknown")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
 func TestGetRun_InvalidMetricPayload_Ignore(t *testing.T) {
 	db, runStore := initializeRunStore()
 	defer db.Close()
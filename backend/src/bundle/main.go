@@ -0,0 +1,162 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command bundle exports the pipelines, pipeline versions, experiments and jobs
+// of a KFP instance to a tarball, and imports such a tarball into another
+// instance:
+//
+//	bundle -context=staging export -o kfp.tgz
+//	bundle -context=production import -f kfp.tgz
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/common/bundle"
+	"github.com/kubeflow/pipelines/backend/src/common/client/api_server"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+var (
+	kubeconfig  = flag.String("kubeconfig", "", "Path to a kubeconfig. Defaults to the standard kubeconfig loading rules.")
+	kubeContext = flag.String("context", "", "The kubeconfig context of the KFP instance.")
+	namespace   = flag.String("namespace", "kubeflow", "The namespace KFP is deployed in.")
+	debug       = flag.Bool("debug", false, "Log the requests sent to the API server.")
+)
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(),
+		"Usage: %s [flags] export -o <bundle.tgz> [-user-namespace <namespace>]\n"+
+			"       %s [flags] import -f <bundle.tgz>\n", os.Args[0], os.Args[0])
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() < 1 {
+		usage()
+		os.Exit(2)
+	}
+
+	clients, err := newClients()
+	if err != nil {
+		glog.Fatalf("Failed to create the API clients: %v", err)
+	}
+
+	switch flag.Arg(0) {
+	case "export":
+		err = runExport(clients, flag.Args()[1:])
+	case "import":
+		err = runImport(clients, flag.Args()[1:])
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		glog.Fatalf("%v", util.ExtractErrorForCLI(err, *debug))
+	}
+}
+
+func newClients() (*bundle.Clients, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = *kubeconfig
+	overrides := &clientcmd.ConfigOverrides{
+		CurrentContext: *kubeContext,
+		Context:        clientcmdapi.Context{Namespace: *namespace},
+	}
+	clientConfig := clientcmd.NewInteractiveDeferredLoadingClientConfig(loadingRules, overrides, os.Stdin)
+
+	pipelineClient, err := api_server.NewPipelineClient(clientConfig, *debug)
+	if err != nil {
+		return nil, err
+	}
+	pipelineUploadClient, err := api_server.NewPipelineUploadClient(clientConfig, *debug)
+	if err != nil {
+		return nil, err
+	}
+	experimentClient, err := api_server.NewExperimentClient(clientConfig, *debug)
+	if err != nil {
+		return nil, err
+	}
+	jobClient, err := api_server.NewJobClient(clientConfig, *debug)
+	if err != nil {
+		return nil, err
+	}
+	return &bundle.Clients{
+		Pipeline:       pipelineClient,
+		PipelineUpload: pipelineUploadClient,
+		Experiment:     experimentClient,
+		Job:            jobClient,
+	}, nil
+}
+
+func runExport(clients *bundle.Clients, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	output := flags.String("o", "", "Path of the bundle to write.")
+	userNamespace := flags.String("user-namespace", "",
+		"Only export the experiments and jobs of this namespace. Required in multi-user mode.")
+	flags.Parse(args)
+	if *output == "" {
+		return util.NewInvalidInputError("The export command requires -o")
+	}
+
+	b, err := bundle.Export(clients, bundle.ExportOptions{Namespace: *userNamespace})
+	if err != nil {
+		return err
+	}
+	file, err := os.Create(*output)
+	if err != nil {
+		return util.Wrapf(err, "Failed to create %s", *output)
+	}
+	defer file.Close()
+	if err := b.Write(file); err != nil {
+		return err
+	}
+	fmt.Printf("Exported %d pipelines, %d experiments and %d jobs to %s\n",
+		len(b.Manifest.Pipelines), len(b.Manifest.Experiments), len(b.Manifest.Jobs), *output)
+	return nil
+}
+
+func runImport(clients *bundle.Clients, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	input := flags.String("f", "", "Path of the bundle to import.")
+	flags.Parse(args)
+	if *input == "" {
+		return util.NewInvalidInputError("The import command requires -f")
+	}
+
+	file, err := os.Open(*input)
+	if err != nil {
+		return util.Wrapf(err, "Failed to open %s", *input)
+	}
+	defer file.Close()
+	b, err := bundle.Read(file)
+	if err != nil {
+		return err
+	}
+	result, err := bundle.Import(clients, b)
+	// Report progress even on failure, since importing again resumes from there.
+	fmt.Printf("Pipelines: %d created, %d skipped\n", result.PipelinesCreated, result.PipelinesSkipped)
+	fmt.Printf("Pipeline versions: %d created, %d skipped\n",
+		result.PipelineVersionsCreated, result.PipelineVersionsSkipped)
+	fmt.Printf("Experiments: %d created, %d skipped\n", result.ExperimentsCreated, result.ExperimentsSkipped)
+	fmt.Printf("Jobs: %d created, %d skipped\n", result.JobsCreated, result.JobsSkipped)
+	return err
+}
@@ -0,0 +1,267 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bundle exports pipelines, pipeline versions, experiments and jobs of
+// a KFP instance into a self-contained tarball, and imports such a tarball into
+// another instance. All cross references inside a bundle use names instead of
+// IDs, so that a bundle can be imported into an instance with different IDs.
+package bundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+
+	jobmodel "github.com/kubeflow/pipelines/backend/api/go_http_client/job_model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+const (
+	// FormatVersion is the version of the bundle layout written by Export.
+	FormatVersion = 1
+
+	manifestFileName = "manifest.json"
+	templatesDir     = "templates"
+)
+
+// Manifest is the index of a bundle. It is stored as manifest.json at the root
+// of the tarball.
+type Manifest struct {
+	FormatVersion int           `json:"format_version"`
+	Pipelines     []*Pipeline   `json:"pipelines"`
+	Experiments   []*Experiment `json:"experiments"`
+	Jobs          []*Job        `json:"jobs"`
+}
+
+type Pipeline struct {
+	Name           string            `json:"name"`
+	Description    string            `json:"description,omitempty"`
+	Labels         map[string]string `json:"labels,omitempty"`
+	DefaultVersion string            `json:"default_version,omitempty"`
+	// Versions are ordered from the oldest to the newest.
+	Versions []*PipelineVersion `json:"versions"`
+}
+
+type PipelineVersion struct {
+	Name          string            `json:"name"`
+	Labels        map[string]string `json:"labels,omitempty"`
+	CodeSourceURL string            `json:"code_source_url,omitempty"`
+	Digest        string            `json:"digest"`
+	// Template is the path of the pipeline template inside the bundle.
+	Template string `json:"template"`
+}
+
+type Experiment struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Namespace   string            `json:"namespace,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+type Job struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Experiment  string `json:"experiment,omitempty"`
+	// Pipeline and PipelineVersion reference a pipeline version in the bundle.
+	// An empty PipelineVersion refers to the default version of the pipeline.
+	Pipeline        string `json:"pipeline,omitempty"`
+	PipelineVersion string `json:"pipeline_version,omitempty"`
+	// WorkflowManifest is set for jobs created from a raw workflow instead of
+	// a pipeline.
+	WorkflowManifest string                   `json:"workflow_manifest,omitempty"`
+	Parameters       []*jobmodel.APIParameter `json:"parameters,omitempty"`
+	Trigger          *jobmodel.APITrigger     `json:"trigger,omitempty"`
+	MaxConcurrency   int64                    `json:"max_concurrency,omitempty"`
+	NoCatchup        bool                     `json:"no_catchup,omitempty"`
+	Enabled          bool                     `json:"enabled"`
+	ServiceAccount   string                   `json:"service_account,omitempty"`
+	Labels           map[string]string        `json:"labels,omitempty"`
+}
+
+// Bundle is an in-memory bundle: the manifest and the templates it references,
+// keyed by their path inside the bundle.
+type Bundle struct {
+	Manifest  *Manifest
+	Templates map[string][]byte
+}
+
+func newBundle() *Bundle {
+	return &Bundle{
+		Manifest:  &Manifest{FormatVersion: FormatVersion},
+		Templates: map[string][]byte{},
+	}
+}
+
+// addTemplate stores the template content-addressed, so that versions sharing
+// the same template are only stored once, and returns its path and digest.
+func (b *Bundle) addTemplate(template []byte) (string, string) {
+	digest := util.ComputeDigest(template)
+	templatePath := path.Join(templatesDir, strings.Replace(digest, ":", "-", 1)+".yaml")
+	b.Templates[templatePath] = template
+	return templatePath, digest
+}
+
+// Write serializes the bundle as a gzipped tarball.
+func (b *Bundle) Write(w io.Writer) error {
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+	manifest, err := json.MarshalIndent(b.Manifest, "", "  ")
+	if err != nil {
+		return util.Wrap(err, "Failed to marshal the bundle manifest")
+	}
+	if err := writeTarFile(tarWriter, manifestFileName, manifest); err != nil {
+		return err
+	}
+	for _, p := range sortedKeys(b.Templates) {
+		if err := writeTarFile(tarWriter, p, b.Templates[p]); err != nil {
+			return err
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return util.Wrap(err, "Failed to write the bundle")
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return util.Wrap(err, "Failed to write the bundle")
+	}
+	return nil
+}
+
+// Read parses a gzipped tarball written by Write.
+func Read(r io.Reader) (*Bundle, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, util.NewInvalidInputErrorWithDetails(err, "The bundle is not a gzipped tarball")
+	}
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(gzipReader)
+	b := &Bundle{Templates: map[string][]byte{}}
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to read the bundle")
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		content, err := ioutil.ReadAll(tarReader)
+		if err != nil {
+			return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to read the bundle")
+		}
+		if header.Name == manifestFileName {
+			b.Manifest = &Manifest{}
+			if err := json.Unmarshal(content, b.Manifest); err != nil {
+				return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to parse the bundle manifest")
+			}
+		} else {
+			b.Templates[header.Name] = content
+		}
+	}
+	if b.Manifest == nil {
+		return nil, util.NewInvalidInputError("The bundle has no %s", manifestFileName)
+	}
+	if b.Manifest.FormatVersion != FormatVersion {
+		return nil, util.NewInvalidInputError("Unsupported bundle format version %v", b.Manifest.FormatVersion)
+	}
+	if err := b.validate(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// validate checks that every reference in the manifest can be resolved inside
+// the bundle.
+func (b *Bundle) validate() error {
+	versions := map[string]map[string]bool{}
+	for _, p := range b.Manifest.Pipelines {
+		if _, ok := versions[p.Name]; ok {
+			return util.NewInvalidInputError("Pipeline %q appears more than once in the bundle", p.Name)
+		}
+		versions[p.Name] = map[string]bool{}
+		for _, v := range p.Versions {
+			template, ok := b.Templates[v.Template]
+			if !ok {
+				return util.NewInvalidInputError("Template %q of pipeline version %q/%q is missing from the bundle", v.Template, p.Name, v.Name)
+			}
+			if digest := util.ComputeDigest(template); digest != v.Digest {
+				return util.NewInvalidInputError("Template %q of pipeline version %q/%q has digest %q, expected %q", v.Template, p.Name, v.Name, digest, v.Digest)
+			}
+			versions[p.Name][v.Name] = true
+		}
+		if len(p.Versions) == 0 {
+			return util.NewInvalidInputError("Pipeline %q has no versions in the bundle", p.Name)
+		}
+		if p.DefaultVersion != "" && !versions[p.Name][p.DefaultVersion] {
+			return util.NewInvalidInputError("Default version %q of pipeline %q is missing from the bundle", p.DefaultVersion, p.Name)
+		}
+	}
+	experiments := map[string]bool{}
+	for _, e := range b.Manifest.Experiments {
+		// Jobs refer to experiments by name only, so names must be unique
+		// across namespaces. Export one namespace at a time otherwise.
+		if experiments[e.Name] {
+			return util.NewInvalidInputError("Experiment %q appears more than once in the bundle", e.Name)
+		}
+		experiments[e.Name] = true
+	}
+	for _, j := range b.Manifest.Jobs {
+		if j.Experiment != "" && !experiments[j.Experiment] {
+			return util.NewInvalidInputError("Experiment %q of job %q is missing from the bundle", j.Experiment, j.Name)
+		}
+		if j.Pipeline == "" {
+			if j.WorkflowManifest == "" {
+				return util.NewInvalidInputError("Job %q has neither a pipeline nor a workflow manifest", j.Name)
+			}
+			continue
+		}
+		if _, ok := versions[j.Pipeline]; !ok {
+			return util.NewInvalidInputError("Pipeline %q of job %q is missing from the bundle", j.Pipeline, j.Name)
+		}
+		if j.PipelineVersion != "" && !versions[j.Pipeline][j.PipelineVersion] {
+			return util.NewInvalidInputError("Pipeline version %q/%q of job %q is missing from the bundle", j.Pipeline, j.PipelineVersion, j.Name)
+		}
+	}
+	return nil
+}
+
+func sortedKeys(m map[string][]byte) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func writeTarFile(w *tar.Writer, name string, content []byte) error {
+	header := &tar.Header{
+		Name: name,
+		Mode: 0644,
+		Size: int64(len(content)),
+	}
+	if err := w.WriteHeader(header); err != nil {
+		return util.Wrapf(err, "Failed to write %s to the bundle", name)
+	}
+	if _, err := w.Write(content); err != nil {
+		return util.Wrapf(err, "Failed to write %s to the bundle", name)
+	}
+	return nil
+}
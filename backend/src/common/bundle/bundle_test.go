@@ -0,0 +1,451 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	experimentparams "github.com/kubeflow/pipelines/backend/api/go_http_client/experiment_client/experiment_service"
+	experimentmodel "github.com/kubeflow/pipelines/backend/api/go_http_client/experiment_model"
+	jobparams "github.com/kubeflow/pipelines/backend/api/go_http_client/job_client/job_service"
+	jobmodel "github.com/kubeflow/pipelines/backend/api/go_http_client/job_model"
+	pipelineparams "github.com/kubeflow/pipelines/backend/api/go_http_client/pipeline_client/pipeline_service"
+	pipelinemodel "github.com/kubeflow/pipelines/backend/api/go_http_client/pipeline_model"
+	uploadparams "github.com/kubeflow/pipelines/backend/api/go_http_client/pipeline_upload_client/pipeline_upload_service"
+	uploadmodel "github.com/kubeflow/pipelines/backend/api/go_http_client/pipeline_upload_model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeInstance is an in-memory KFP instance. IDs are generated with a prefix,
+// so that IDs of two instances never match.
+type fakeInstance struct {
+	prefix      string
+	nextID      int
+	clock       time.Time
+	pipelines   []*pipelinemodel.APIPipeline
+	versions    map[string][]*pipelinemodel.APIPipelineVersion
+	templates   map[string]string
+	experiments []*experimentmodel.APIExperiment
+	jobs        []*jobmodel.APIJob
+}
+
+func newFakeInstance(prefix string) *fakeInstance {
+	return &fakeInstance{
+		prefix:    prefix,
+		clock:     time.Unix(1, 0),
+		versions:  map[string][]*pipelinemodel.APIPipelineVersion{},
+		templates: map[string]string{},
+	}
+}
+
+func (f *fakeInstance) clients() *Clients {
+	return &Clients{
+		Pipeline:       &fakePipelineClient{f},
+		PipelineUpload: &fakeUploadClient{f},
+		Experiment:     &fakeExperimentClient{f},
+		Job:            &fakeJobClient{f},
+	}
+}
+
+func (f *fakeInstance) newID() string {
+	f.nextID++
+	return fmt.Sprintf("%s-%d", f.prefix, f.nextID)
+}
+
+func (f *fakeInstance) now() strfmt.DateTime {
+	f.clock = f.clock.Add(time.Second)
+	return strfmt.DateTime(f.clock)
+}
+
+func (f *fakeInstance) pipelineByID(id string) *pipelinemodel.APIPipeline {
+	for _, p := range f.pipelines {
+		if p.ID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+func (f *fakeInstance) addVersion(pipelineID string, name string, template string) *pipelinemodel.APIPipelineVersion {
+	version := &pipelinemodel.APIPipelineVersion{
+		ID:        f.newID(),
+		Name:      name,
+		CreatedAt: f.now(),
+		Digest:    util.ComputeDigest([]byte(template)),
+	}
+	f.versions[pipelineID] = append(f.versions[pipelineID], version)
+	f.templates[version.ID] = template
+	return version
+}
+
+func (f *fakeInstance) addPipeline(name string, template string) *pipelinemodel.APIPipeline {
+	pipeline := &pipelinemodel.APIPipeline{ID: f.newID(), Name: name}
+	f.pipelines = append(f.pipelines, pipeline)
+	pipeline.DefaultVersion = f.addVersion(pipeline.ID, name, template)
+	return pipeline
+}
+
+func (f *fakeInstance) findVersion(versionID string) *pipelinemodel.APIPipelineVersion {
+	for _, versions := range f.versions {
+		for _, v := range versions {
+			if v.ID == versionID {
+				return v
+			}
+		}
+	}
+	return nil
+}
+
+type fakePipelineClient struct{ *fakeInstance }
+
+func (c *fakePipelineClient) ListAll(params *pipelineparams.ListPipelinesParams, maxResultSize int) (
+	[]*pipelinemodel.APIPipeline, error) {
+	return c.pipelines, nil
+}
+
+func (c *fakePipelineClient) ListAllPipelineVersions(params *pipelineparams.ListPipelineVersionsParams,
+	maxResultSize int) ([]*pipelinemodel.APIPipelineVersion, error) {
+	// Return the newest version first, to check that export orders them.
+	versions := c.versions[*params.ResourceKeyID]
+	result := make([]*pipelinemodel.APIPipelineVersion, 0, len(versions))
+	for i := len(versions) - 1; i >= 0; i-- {
+		result = append(result, versions[i])
+	}
+	return result, nil
+}
+
+func (c *fakePipelineClient) GetPipelineVersionTemplateRaw(params *pipelineparams.GetPipelineVersionTemplateParams) (
+	string, error) {
+	return c.templates[params.VersionID], nil
+}
+
+func (c *fakePipelineClient) UpdatePipelineLabels(params *pipelineparams.UpdatePipelineLabelsParams) (
+	*pipelinemodel.APIPipeline, error) {
+	pipeline := c.pipelineByID(params.ID)
+	pipeline.Labels = params.Body.Labels
+	return pipeline, nil
+}
+
+func (c *fakePipelineClient) UpdatePipelineVersionLabels(params *pipelineparams.UpdatePipelineVersionLabelsParams) (
+	*pipelinemodel.APIPipelineVersion, error) {
+	version := c.findVersion(params.VersionID)
+	version.Labels = params.Body.Labels
+	return version, nil
+}
+
+func (c *fakePipelineClient) UpdatePipelineDefaultVersion(params *pipelineparams.UpdatePipelineDefaultVersionParams) error {
+	c.pipelineByID(params.PipelineID).DefaultVersion = c.findVersion(params.VersionID)
+	return nil
+}
+
+func (c *fakePipelineClient) DeletePipelineVersion(params *pipelineparams.DeletePipelineVersionParams) error {
+	for pipelineID, versions := range c.versions {
+		for i, v := range versions {
+			if v.ID == params.VersionID {
+				c.versions[pipelineID] = append(versions[:i], versions[i+1:]...)
+				delete(c.templates, v.ID)
+				return nil
+			}
+		}
+	}
+	return util.NewResourceNotFoundError("Pipeline version", params.VersionID)
+}
+
+type fakeUploadClient struct{ *fakeInstance }
+
+func (c *fakeUploadClient) Upload(params *uploadparams.UploadPipelineParams) (*uploadmodel.APIPipeline, error) {
+	template, err := ioutil.ReadAll(params.Uploadfile)
+	if err != nil {
+		return nil, err
+	}
+	pipeline := c.addPipeline(*params.Name, string(template))
+	pipeline.Description = *params.Description
+	return &uploadmodel.APIPipeline{ID: pipeline.ID, Name: pipeline.Name}, nil
+}
+
+func (c *fakeUploadClient) UploadVersion(params *uploadparams.UploadPipelineVersionParams) (
+	*uploadmodel.APIPipelineVersion, error) {
+	template, err := ioutil.ReadAll(params.Uploadfile)
+	if err != nil {
+		return nil, err
+	}
+	version := c.addVersion(*params.Pipelineid, *params.Name, string(template))
+	return &uploadmodel.APIPipelineVersion{ID: version.ID, Name: version.Name}, nil
+}
+
+type fakeExperimentClient struct{ *fakeInstance }
+
+func (c *fakeExperimentClient) Create(params *experimentparams.CreateExperimentParams) (
+	*experimentmodel.APIExperiment, error) {
+	experiment := *params.Body
+	experiment.ID = c.newID()
+	c.experiments = append(c.experiments, &experiment)
+	return &experiment, nil
+}
+
+func (c *fakeExperimentClient) ListAll(params *experimentparams.ListExperimentParams, maxResultSize int) (
+	[]*experimentmodel.APIExperiment, error) {
+	return c.experiments, nil
+}
+
+type fakeJobClient struct{ *fakeInstance }
+
+func (c *fakeJobClient) Create(params *jobparams.CreateJobParams) (*jobmodel.APIJob, error) {
+	job := *params.Body
+	job.ID = c.newID()
+	c.jobs = append(c.jobs, &job)
+	return &job, nil
+}
+
+func (c *fakeJobClient) ListAll(params *jobparams.ListJobsParams, maxResultSize int) ([]*jobmodel.APIJob, error) {
+	var jobs []*jobmodel.APIJob
+	for _, j := range c.jobs {
+		for _, ref := range j.ResourceReferences {
+			if ref.Key.Type == jobmodel.APIResourceTypeEXPERIMENT && ref.Key.ID == *params.ResourceReferenceKeyID {
+				jobs = append(jobs, j)
+			}
+		}
+	}
+	return jobs, nil
+}
+
+func newSourceInstance() *fakeInstance {
+	source := newFakeInstance("source")
+	pipeline := source.addPipeline("training", "template: v1")
+	pipeline.Description = "trains a model"
+	pipeline.Labels = map[string]string{"team": "ml"}
+	v2 := source.addVersion(pipeline.ID, "v2", "template: v2")
+	v2.Labels = map[string]string{"stage": "prod"}
+	pipeline.DefaultVersion = v2
+	// Shares its template with the first version.
+	source.addVersion(pipeline.ID, "v1-copy", "template: v1")
+
+	source.experiments = append(source.experiments, &experimentmodel.APIExperiment{
+		ID:     "source-exp",
+		Name:   "nightly",
+		Labels: map[string]string{"team": "ml"},
+	})
+	source.jobs = append(source.jobs,
+		&jobmodel.APIJob{
+			ID:             "source-job-1",
+			Name:           "nightly-training",
+			Enabled:        true,
+			MaxConcurrency: 2,
+			PipelineSpec: &jobmodel.APIPipelineSpec{
+				Parameters: []*jobmodel.APIParameter{{Name: "lr", Value: "0.1"}},
+			},
+			Trigger: &jobmodel.APITrigger{CronSchedule: &jobmodel.APICronSchedule{Cron: "0 0 0 * * *"}},
+			ResourceReferences: []*jobmodel.APIResourceReference{
+				{Key: &jobmodel.APIResourceKey{Type: jobmodel.APIResourceTypeEXPERIMENT, ID: "source-exp"},
+					Relationship: jobmodel.APIRelationshipOWNER},
+				{Key: &jobmodel.APIResourceKey{Type: jobmodel.APIResourceTypePIPELINEVERSION, ID: v2.ID},
+					Relationship: jobmodel.APIRelationshipCREATOR},
+			},
+		},
+		&jobmodel.APIJob{
+			ID:           "source-job-2",
+			Name:         "raw-workflow",
+			PipelineSpec: &jobmodel.APIPipelineSpec{WorkflowManifest: "kind: Workflow"},
+			ResourceReferences: []*jobmodel.APIResourceReference{
+				{Key: &jobmodel.APIResourceKey{Type: jobmodel.APIResourceTypeEXPERIMENT, ID: "source-exp"},
+					Relationship: jobmodel.APIRelationshipOWNER},
+			},
+		})
+	return source
+}
+
+func exportAndRead(t *testing.T, source *fakeInstance) *Bundle {
+	exported, err := Export(source.clients(), ExportOptions{})
+	require.Nil(t, err)
+	var buf bytes.Buffer
+	require.Nil(t, exported.Write(&buf))
+	b, err := Read(&buf)
+	require.Nil(t, err)
+	return b
+}
+
+func TestExport(t *testing.T) {
+	b := exportAndRead(t, newSourceInstance())
+
+	require.Len(t, b.Manifest.Pipelines, 1)
+	pipeline := b.Manifest.Pipelines[0]
+	assert.Equal(t, "training", pipeline.Name)
+	assert.Equal(t, "v2", pipeline.DefaultVersion)
+	require.Len(t, pipeline.Versions, 3)
+	assert.Equal(t, []string{"training", "v2", "v1-copy"},
+		[]string{pipeline.Versions[0].Name, pipeline.Versions[1].Name, pipeline.Versions[2].Name})
+	assert.Equal(t, pipeline.Versions[0].Template, pipeline.Versions[2].Template)
+	assert.Len(t, b.Templates, 2)
+	assert.Equal(t, "template: v2", string(b.Templates[pipeline.Versions[1].Template]))
+
+	require.Len(t, b.Manifest.Jobs, 2)
+	assert.Equal(t, "nightly", b.Manifest.Jobs[0].Experiment)
+	assert.Equal(t, "training", b.Manifest.Jobs[0].Pipeline)
+	assert.Equal(t, "v2", b.Manifest.Jobs[0].PipelineVersion)
+	assert.Equal(t, "kind: Workflow", b.Manifest.Jobs[1].WorkflowManifest)
+}
+
+func TestExport_PinsVersionOfJobWithPipelineID(t *testing.T) {
+	source := newSourceInstance()
+	pipeline := source.pipelines[0]
+	// Created with the pipeline ID when the first version was the default.
+	source.jobs[0].ResourceReferences = source.jobs[0].ResourceReferences[:1]
+	source.jobs[0].PipelineSpec.PipelineID = pipeline.ID
+	source.jobs[0].PipelineSpec.WorkflowManifest = "template: v1"
+	source.jobs[0].PipelineSpec.WorkflowDigest = util.ComputeDigest([]byte("template: v1"))
+	// None of the versions has its template anymore.
+	source.jobs = append(source.jobs, &jobmodel.APIJob{
+		ID:   "source-job-3",
+		Name: "old-training",
+		PipelineSpec: &jobmodel.APIPipelineSpec{
+			PipelineID:       pipeline.ID,
+			WorkflowManifest: "template: v0",
+		},
+		ResourceReferences: source.jobs[0].ResourceReferences,
+	})
+
+	b := exportAndRead(t, source)
+	require.Len(t, b.Manifest.Jobs, 3)
+	assert.Equal(t, "training", b.Manifest.Jobs[0].Pipeline)
+	assert.Equal(t, "training", b.Manifest.Jobs[0].PipelineVersion)
+	assert.Equal(t, "", b.Manifest.Jobs[2].Pipeline)
+	assert.Equal(t, "template: v0", b.Manifest.Jobs[2].WorkflowManifest)
+}
+
+func TestImport(t *testing.T) {
+	b := exportAndRead(t, newSourceInstance())
+	target := newFakeInstance("target")
+
+	result, err := Import(target.clients(), b)
+	require.Nil(t, err)
+	assert.Equal(t, &ImportResult{
+		PipelinesCreated:        1,
+		PipelineVersionsCreated: 3,
+		ExperimentsCreated:      1,
+		JobsCreated:             2,
+	}, result)
+
+	require.Len(t, target.pipelines, 1)
+	pipeline := target.pipelines[0]
+	assert.Equal(t, "trains a model", pipeline.Description)
+	assert.Equal(t, map[string]string{"team": "ml"}, pipeline.Labels)
+	assert.Equal(t, "v2", pipeline.DefaultVersion.Name)
+	assert.Equal(t, map[string]string{"stage": "prod"}, pipeline.DefaultVersion.Labels)
+	assert.Equal(t, "template: v1", target.templates[target.versions[pipeline.ID][0].ID])
+
+	require.Len(t, target.jobs, 2)
+	assert.Equal(t, []*jobmodel.APIResourceReference{
+		{Key: &jobmodel.APIResourceKey{Type: jobmodel.APIResourceTypeEXPERIMENT, ID: target.experiments[0].ID},
+			Relationship: jobmodel.APIRelationshipOWNER},
+		{Key: &jobmodel.APIResourceKey{Type: jobmodel.APIResourceTypePIPELINEVERSION, ID: pipeline.DefaultVersion.ID},
+			Relationship: jobmodel.APIRelationshipCREATOR},
+	}, target.jobs[0].ResourceReferences)
+	assert.Equal(t, []*jobmodel.APIParameter{{Name: "lr", Value: "0.1"}}, target.jobs[0].PipelineSpec.Parameters)
+	assert.Equal(t, "kind: Workflow", target.jobs[1].PipelineSpec.WorkflowManifest)
+}
+
+func TestImport_DeletesPlaceholderVersion(t *testing.T) {
+	source := newSourceInstance()
+	// No version is named after the pipeline.
+	source.versions[source.pipelines[0].ID][0].Name = "v1"
+	b := exportAndRead(t, source)
+	target := newFakeInstance("target")
+
+	result, err := Import(target.clients(), b)
+	require.Nil(t, err)
+	assert.Equal(t, 3, result.PipelineVersionsCreated)
+
+	pipeline := target.pipelines[0]
+	var names []string
+	for _, v := range target.versions[pipeline.ID] {
+		names = append(names, v.Name)
+	}
+	assert.Equal(t, []string{"v1", "v2", "v1-copy"}, names)
+	assert.Equal(t, "v2", pipeline.DefaultVersion.Name)
+}
+
+func TestImport_Idempotent(t *testing.T) {
+	b := exportAndRead(t, newSourceInstance())
+	target := newFakeInstance("target")
+	_, err := Import(target.clients(), b)
+	require.Nil(t, err)
+
+	result, err := Import(target.clients(), b)
+	require.Nil(t, err)
+	assert.Equal(t, &ImportResult{
+		PipelinesSkipped:        1,
+		PipelineVersionsSkipped: 3,
+		ExperimentsSkipped:      1,
+		JobsSkipped:             2,
+	}, result)
+	assert.Len(t, target.pipelines, 1)
+	assert.Len(t, target.versions[target.pipelines[0].ID], 3)
+	assert.Len(t, target.experiments, 1)
+	assert.Len(t, target.jobs, 2)
+}
+
+func TestImport_ResumesInterruptedPipelineImport(t *testing.T) {
+	source := newSourceInstance()
+	// No version is named after the pipeline.
+	source.versions[source.pipelines[0].ID][0].Name = "v1"
+	b := exportAndRead(t, source)
+	target := newFakeInstance("target")
+	// Interrupted right after uploading the pipeline with its placeholder version.
+	target.addPipeline("training", "template: v1")
+
+	result, err := Import(target.clients(), b)
+	require.Nil(t, err)
+	assert.Equal(t, 1, result.PipelinesSkipped)
+	assert.Equal(t, 3, result.PipelineVersionsCreated)
+
+	pipeline := target.pipelines[0]
+	var names []string
+	for _, v := range target.versions[pipeline.ID] {
+		names = append(names, v.Name)
+	}
+	assert.Equal(t, []string{"v1", "v2", "v1-copy"}, names)
+	assert.Equal(t, "v2", pipeline.DefaultVersion.Name)
+	assert.Equal(t, map[string]string{"stage": "prod"}, pipeline.DefaultVersion.Labels)
+	assert.Equal(t, map[string]string{"team": "ml"}, pipeline.Labels)
+}
+
+func TestImport_ConflictingVersion(t *testing.T) {
+	b := exportAndRead(t, newSourceInstance())
+	target := newFakeInstance("target")
+	pipeline := target.addPipeline("training", "template: v1")
+	target.addVersion(pipeline.ID, "v2", "template: something else")
+
+	_, err := Import(target.clients(), b)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Pipeline version \"v2\" already exists")
+}
+
+func TestRead_DigestMismatch(t *testing.T) {
+	b := exportAndRead(t, newSourceInstance())
+	b.Templates[b.Manifest.Pipelines[0].Versions[1].Template] = []byte("tampered")
+	var buf bytes.Buffer
+	require.Nil(t, b.Write(&buf))
+
+	_, err := Read(&buf)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "has digest")
+}
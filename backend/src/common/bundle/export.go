@@ -0,0 +1,242 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	experimentparams "github.com/kubeflow/pipelines/backend/api/go_http_client/experiment_client/experiment_service"
+	experimentmodel "github.com/kubeflow/pipelines/backend/api/go_http_client/experiment_model"
+	jobparams "github.com/kubeflow/pipelines/backend/api/go_http_client/job_client/job_service"
+	jobmodel "github.com/kubeflow/pipelines/backend/api/go_http_client/job_model"
+	pipelineparams "github.com/kubeflow/pipelines/backend/api/go_http_client/pipeline_client/pipeline_service"
+	pipelinemodel "github.com/kubeflow/pipelines/backend/api/go_http_client/pipeline_model"
+	uploadparams "github.com/kubeflow/pipelines/backend/api/go_http_client/pipeline_upload_client/pipeline_upload_service"
+	uploadmodel "github.com/kubeflow/pipelines/backend/api/go_http_client/pipeline_upload_model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// The interfaces below list the methods of the api_server clients used by
+// export and import, so that both can be tested against in-memory fakes.
+
+type PipelineClient interface {
+	ListAll(params *pipelineparams.ListPipelinesParams, maxResultSize int) ([]*pipelinemodel.APIPipeline, error)
+	ListAllPipelineVersions(params *pipelineparams.ListPipelineVersionsParams, maxResultSize int) (
+		[]*pipelinemodel.APIPipelineVersion, error)
+	GetPipelineVersionTemplateRaw(params *pipelineparams.GetPipelineVersionTemplateParams) (string, error)
+	UpdatePipelineLabels(params *pipelineparams.UpdatePipelineLabelsParams) (*pipelinemodel.APIPipeline, error)
+	UpdatePipelineVersionLabels(params *pipelineparams.UpdatePipelineVersionLabelsParams) (
+		*pipelinemodel.APIPipelineVersion, error)
+	UpdatePipelineDefaultVersion(params *pipelineparams.UpdatePipelineDefaultVersionParams) error
+	DeletePipelineVersion(params *pipelineparams.DeletePipelineVersionParams) error
+}
+
+type PipelineUploadClient interface {
+	Upload(params *uploadparams.UploadPipelineParams) (*uploadmodel.APIPipeline, error)
+	UploadVersion(params *uploadparams.UploadPipelineVersionParams) (*uploadmodel.APIPipelineVersion, error)
+}
+
+type ExperimentClient interface {
+	Create(params *experimentparams.CreateExperimentParams) (*experimentmodel.APIExperiment, error)
+	ListAll(params *experimentparams.ListExperimentParams, maxResultSize int) ([]*experimentmodel.APIExperiment, error)
+}
+
+type JobClient interface {
+	Create(params *jobparams.CreateJobParams) (*jobmodel.APIJob, error)
+	ListAll(params *jobparams.ListJobsParams, maxResultSize int) ([]*jobmodel.APIJob, error)
+}
+
+// versionName identifies a pipeline version by names instead of by ID.
+type versionName struct {
+	pipeline string
+	version  string
+}
+
+// Clients groups the API clients of one KFP instance.
+type Clients struct {
+	Pipeline       PipelineClient
+	PipelineUpload PipelineUploadClient
+	Experiment     ExperimentClient
+	Job            JobClient
+}
+
+// ExportOptions restricts what is exported.
+type ExportOptions struct {
+	// Namespace restricts the exported experiments and jobs to one namespace.
+	// Pipelines are not namespaced and are always exported.
+	Namespace string
+}
+
+// Export reads all pipelines with their versions and templates, experiments
+// and jobs from the instance, and returns them as a bundle.
+func Export(clients *Clients, options ExportOptions) (*Bundle, error) {
+	b := newBundle()
+	// Exported pipelines by pipeline ID and version names by version ID, used to
+	// rewrite job references.
+	versionNames := map[string]versionName{}
+	exportedPipelines := map[string]*Pipeline{}
+
+	pipelines, err := clients.Pipeline.ListAll(&pipelineparams.ListPipelinesParams{}, math.MaxInt32)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to list pipelines")
+	}
+	for _, p := range pipelines {
+		pipeline, err := exportPipeline(clients.Pipeline, b, p, versionNames)
+		if err != nil {
+			return nil, err
+		}
+		exportedPipelines[p.ID] = pipeline
+		b.Manifest.Pipelines = append(b.Manifest.Pipelines, pipeline)
+	}
+
+	experimentParams := &experimentparams.ListExperimentParams{}
+	if options.Namespace != "" {
+		experimentParams.ResourceReferenceKeyType = util.StringPointer(string(experimentmodel.APIResourceTypeNAMESPACE))
+		experimentParams.ResourceReferenceKeyID = util.StringPointer(options.Namespace)
+	}
+	experiments, err := clients.Experiment.ListAll(experimentParams, math.MaxInt32)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to list experiments")
+	}
+	for _, e := range experiments {
+		b.Manifest.Experiments = append(b.Manifest.Experiments, &Experiment{
+			Name:        e.Name,
+			Description: e.Description,
+			Namespace:   experimentNamespace(e),
+			Labels:      e.Labels,
+		})
+		jobs, err := clients.Job.ListAll(&jobparams.ListJobsParams{
+			ResourceReferenceKeyType: util.StringPointer(string(jobmodel.APIResourceTypeEXPERIMENT)),
+			ResourceReferenceKeyID:   util.StringPointer(e.ID),
+		}, math.MaxInt32)
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to list jobs of experiment %q", e.Name)
+		}
+		for _, j := range jobs {
+			job, err := exportJob(j, e.Name, exportedPipelines, versionNames)
+			if err != nil {
+				return nil, err
+			}
+			b.Manifest.Jobs = append(b.Manifest.Jobs, job)
+		}
+	}
+	if err := b.validate(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func exportPipeline(client PipelineClient, b *Bundle, p *pipelinemodel.APIPipeline,
+	versionNames map[string]versionName) (*Pipeline, error) {
+	pipeline := &Pipeline{
+		Name:        p.Name,
+		Description: p.Description,
+		Labels:      p.Labels,
+	}
+	if p.DefaultVersion != nil {
+		pipeline.DefaultVersion = p.DefaultVersion.Name
+	}
+	versions, err := client.ListAllPipelineVersions(&pipelineparams.ListPipelineVersionsParams{
+		ResourceKeyType: util.StringPointer(string(pipelinemodel.APIResourceTypePIPELINE)),
+		ResourceKeyID:   util.StringPointer(p.ID),
+	}, math.MaxInt32)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to list versions of pipeline %q", p.Name)
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return time.Time(versions[i].CreatedAt).Before(time.Time(versions[j].CreatedAt))
+	})
+	for _, v := range versions {
+		template, err := client.GetPipelineVersionTemplateRaw(
+			&pipelineparams.GetPipelineVersionTemplateParams{VersionID: v.ID})
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to get the template of pipeline version %q/%q", p.Name, v.Name)
+		}
+		templatePath, digest := b.addTemplate([]byte(template))
+		pipeline.Versions = append(pipeline.Versions, &PipelineVersion{
+			Name:          v.Name,
+			Labels:        v.Labels,
+			CodeSourceURL: v.CodeSourceURL,
+			Digest:        digest,
+			Template:      templatePath,
+		})
+		versionNames[v.ID] = versionName{pipeline: p.Name, version: v.Name}
+	}
+	return pipeline, nil
+}
+
+func exportJob(j *jobmodel.APIJob, experimentName string, pipelines map[string]*Pipeline,
+	versionNames map[string]versionName) (*Job, error) {
+	job := &Job{
+		Name:           j.Name,
+		Description:    j.Description,
+		Experiment:     experimentName,
+		Trigger:        j.Trigger,
+		MaxConcurrency: j.MaxConcurrency,
+		NoCatchup:      j.NoCatchup,
+		Enabled:        j.Enabled,
+		ServiceAccount: j.ServiceAccount,
+		Labels:         j.Labels,
+	}
+	if j.PipelineSpec != nil {
+		job.Parameters = j.PipelineSpec.Parameters
+	}
+	for _, ref := range j.ResourceReferences {
+		if ref.Key == nil || ref.Key.Type != jobmodel.APIResourceTypePIPELINEVERSION {
+			continue
+		}
+		names, ok := versionNames[ref.Key.ID]
+		if !ok {
+			return nil, util.NewResourceNotFoundError("Pipeline version", ref.Key.ID)
+		}
+		job.Pipeline, job.PipelineVersion = names.pipeline, names.version
+		return job, nil
+	}
+	if j.PipelineSpec != nil && j.PipelineSpec.PipelineID != "" {
+		pipeline, ok := pipelines[j.PipelineSpec.PipelineID]
+		if !ok {
+			return nil, util.NewResourceNotFoundError("Pipeline", j.PipelineSpec.PipelineID)
+		}
+		// Jobs created with only a pipeline ID run the version that was the
+		// default at the time, so pin the version with the job's template.
+		digest := j.PipelineSpec.WorkflowDigest
+		if digest == "" {
+			digest = util.ComputeDigest([]byte(j.PipelineSpec.WorkflowManifest))
+		}
+		for _, v := range pipeline.Versions {
+			if v.Digest == digest {
+				job.Pipeline, job.PipelineVersion = pipeline.Name, v.Name
+				return job, nil
+			}
+		}
+		// The version is gone, so keep the workflow the job runs instead.
+	}
+	if j.PipelineSpec == nil || j.PipelineSpec.WorkflowManifest == "" {
+		return nil, util.NewInvalidInputError("Job %q has neither a pipeline nor a workflow manifest", j.Name)
+	}
+	job.WorkflowManifest = j.PipelineSpec.WorkflowManifest
+	return job, nil
+}
+
+func experimentNamespace(e *experimentmodel.APIExperiment) string {
+	for _, ref := range e.ResourceReferences {
+		if ref.Key != nil && ref.Key.Type == experimentmodel.APIResourceTypeNAMESPACE {
+			return ref.Key.ID
+		}
+	}
+	return ""
+}
@@ -0,0 +1,372 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"bytes"
+	"math"
+	"path"
+	"reflect"
+
+	"github.com/go-openapi/runtime"
+	experimentparams "github.com/kubeflow/pipelines/backend/api/go_http_client/experiment_client/experiment_service"
+	experimentmodel "github.com/kubeflow/pipelines/backend/api/go_http_client/experiment_model"
+	jobparams "github.com/kubeflow/pipelines/backend/api/go_http_client/job_client/job_service"
+	jobmodel "github.com/kubeflow/pipelines/backend/api/go_http_client/job_model"
+	pipelineparams "github.com/kubeflow/pipelines/backend/api/go_http_client/pipeline_client/pipeline_service"
+	pipelinemodel "github.com/kubeflow/pipelines/backend/api/go_http_client/pipeline_model"
+	uploadparams "github.com/kubeflow/pipelines/backend/api/go_http_client/pipeline_upload_client/pipeline_upload_service"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// ImportResult counts the resources created and skipped by Import.
+type ImportResult struct {
+	PipelinesCreated        int
+	PipelinesSkipped        int
+	PipelineVersionsCreated int
+	PipelineVersionsSkipped int
+	ExperimentsCreated      int
+	ExperimentsSkipped      int
+	JobsCreated             int
+	JobsSkipped             int
+}
+
+// importer holds the IDs of the resources in the target instance, keyed by
+// the names used in the bundle.
+type importer struct {
+	clients     *Clients
+	bundle      *Bundle
+	result      *ImportResult
+	pipelineIDs map[string]string
+	versionIDs  map[versionName]string
+	// experimentIDs is keyed by namespace, then by experiment name.
+	experimentIDs map[string]map[string]string
+	experimentNS  map[string]string
+}
+
+// Import recreates the content of the bundle in the instance. Import is
+// idempotent: resources are matched by name and the ones that already exist
+// aren't created again, so an interrupted import can simply be run again.
+// Existing pipelines still get the bundled labels and default version, and
+// lose the placeholder version an interrupted import may have left.
+// A pipeline version that exists under the same name with a different
+// template is reported as an error rather than silently skipped.
+func Import(clients *Clients, b *Bundle) (*ImportResult, error) {
+	i := &importer{
+		clients:       clients,
+		bundle:        b,
+		result:        &ImportResult{},
+		pipelineIDs:   map[string]string{},
+		versionIDs:    map[versionName]string{},
+		experimentIDs: map[string]map[string]string{},
+		experimentNS:  map[string]string{},
+	}
+	if err := i.importPipelines(); err != nil {
+		return i.result, err
+	}
+	if err := i.importExperiments(); err != nil {
+		return i.result, err
+	}
+	if err := i.importJobs(); err != nil {
+		return i.result, err
+	}
+	return i.result, nil
+}
+
+func (i *importer) importPipelines() error {
+	existing, err := i.clients.Pipeline.ListAll(&pipelineparams.ListPipelinesParams{}, math.MaxInt32)
+	if err != nil {
+		return util.Wrap(err, "Failed to list pipelines")
+	}
+	pipelines := map[string]*pipelinemodel.APIPipeline{}
+	for _, p := range existing {
+		pipelines[p.Name] = p
+		i.pipelineIDs[p.Name] = p.ID
+	}
+	for _, p := range i.bundle.Manifest.Pipelines {
+		if err := i.importPipeline(p, pipelines[p.Name]); err != nil {
+			return util.Wrapf(err, "Failed to import pipeline %q", p.Name)
+		}
+	}
+	return nil
+}
+
+// importPipeline brings the pipeline of the instance, which is nil if it
+// doesn't exist yet, in line with the bundled pipeline. Each step is decided
+// from the current state of the pipeline, so that an import interrupted half
+// way through a pipeline is completed when it is run again.
+func (i *importer) importPipeline(p *Pipeline, pipeline *pipelinemodel.APIPipeline) error {
+	created := pipeline == nil
+	if created {
+		// Uploading a pipeline creates a version with the pipeline name, so
+		// upload the bundled version of that name if there is one. Otherwise
+		// the version is a placeholder, deleted once the bundled versions are
+		// imported.
+		first := p.Versions[0]
+		for _, v := range p.Versions {
+			if v.Name == p.Name {
+				first = v
+			}
+		}
+		uploaded, err := i.clients.PipelineUpload.Upload(&uploadparams.UploadPipelineParams{
+			Name:        util.StringPointer(p.Name),
+			Description: util.StringPointer(p.Description),
+			Uploadfile:  i.template(first),
+		})
+		if err != nil {
+			return err
+		}
+		pipeline = &pipelinemodel.APIPipeline{ID: uploaded.ID, Name: uploaded.Name}
+		i.pipelineIDs[p.Name] = pipeline.ID
+		i.result.PipelinesCreated++
+	} else {
+		i.result.PipelinesSkipped++
+	}
+	if len(p.Labels) > 0 && !reflect.DeepEqual(p.Labels, pipeline.Labels) {
+		_, err := i.clients.Pipeline.UpdatePipelineLabels(&pipelineparams.UpdatePipelineLabelsParams{
+			ID:   pipeline.ID,
+			Body: &pipelinemodel.APIUpdatePipelineLabelsRequest{ID: pipeline.ID, Labels: p.Labels},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	versions, err := i.clients.Pipeline.ListAllPipelineVersions(&pipelineparams.ListPipelineVersionsParams{
+		ResourceKeyType: util.StringPointer(string(pipelinemodel.APIResourceTypePIPELINE)),
+		ResourceKeyID:   util.StringPointer(pipeline.ID),
+	}, math.MaxInt32)
+	if err != nil {
+		return err
+	}
+	existingVersions := map[string]*pipelinemodel.APIPipelineVersion{}
+	for _, v := range versions {
+		existingVersions[v.Name] = v
+	}
+	// The version created together with a new pipeline is its default one.
+	defaultVersionID := ""
+	if created {
+		if v, ok := existingVersions[p.Name]; ok {
+			defaultVersionID = v.ID
+		}
+	} else if pipeline.DefaultVersion != nil {
+		defaultVersionID = pipeline.DefaultVersion.ID
+	}
+
+	bundled := map[string]bool{}
+	for _, v := range p.Versions {
+		bundled[v.Name] = true
+		if existing, ok := existingVersions[v.Name]; ok {
+			// Versions created before digests were recorded have no digest.
+			if existing.Digest != "" && existing.Digest != v.Digest {
+				return util.NewAlreadyExistError(
+					"Pipeline version %q already exists with digest %q, but the bundle has digest %q",
+					v.Name, existing.Digest, v.Digest)
+			}
+			i.versionIDs[versionName{pipeline: p.Name, version: v.Name}] = existing.ID
+			if created && v.Name == p.Name {
+				i.result.PipelineVersionsCreated++
+			} else {
+				i.result.PipelineVersionsSkipped++
+			}
+			if err := i.updateVersionLabels(existing.ID, existing.Labels, v); err != nil {
+				return err
+			}
+			continue
+		}
+		// The upload API does not take a code source URL, so it is only kept
+		// in the bundle for reference.
+		version, err := i.clients.PipelineUpload.UploadVersion(&uploadparams.UploadPipelineVersionParams{
+			Name:       util.StringPointer(v.Name),
+			Pipelineid: util.StringPointer(pipeline.ID),
+			Uploadfile: i.template(v),
+		})
+		if err != nil {
+			return err
+		}
+		i.versionIDs[versionName{pipeline: p.Name, version: v.Name}] = version.ID
+		i.result.PipelineVersionsCreated++
+		if err := i.updateVersionLabels(version.ID, nil, v); err != nil {
+			return err
+		}
+	}
+
+	if p.DefaultVersion != "" {
+		versionID := i.versionIDs[versionName{pipeline: p.Name, version: p.DefaultVersion}]
+		if versionID != defaultVersionID {
+			err := i.clients.Pipeline.UpdatePipelineDefaultVersion(&pipelineparams.UpdatePipelineDefaultVersionParams{
+				PipelineID: pipeline.ID,
+				VersionID:  versionID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	// A placeholder left by an earlier, interrupted import has the template of
+	// the first bundled version too.
+	if placeholder, ok := existingVersions[p.Name]; ok && !bundled[p.Name] && placeholder.Digest == p.Versions[0].Digest {
+		err := i.clients.Pipeline.DeletePipelineVersion(&pipelineparams.DeletePipelineVersionParams{
+			VersionID: placeholder.ID,
+		})
+		if err != nil {
+			return util.Wrap(err, "Failed to delete the version created by the pipeline upload")
+		}
+	}
+	return nil
+}
+
+// updateVersionLabels sets the labels of the bundled version on the version of
+// the instance, unless it already has them.
+func (i *importer) updateVersionLabels(versionID string, labels map[string]string, v *PipelineVersion) error {
+	if len(v.Labels) == 0 || reflect.DeepEqual(v.Labels, labels) {
+		return nil
+	}
+	_, err := i.clients.Pipeline.UpdatePipelineVersionLabels(&pipelineparams.UpdatePipelineVersionLabelsParams{
+		VersionID: versionID,
+		Body:      &pipelinemodel.APIUpdatePipelineVersionLabelsRequest{VersionID: versionID, Labels: v.Labels},
+	})
+	return err
+}
+
+func (i *importer) template(v *PipelineVersion) runtime.NamedReadCloser {
+	return runtime.NamedReader(path.Base(v.Template), bytes.NewReader(i.bundle.Templates[v.Template]))
+}
+
+func (i *importer) importExperiments() error {
+	for _, e := range i.bundle.Manifest.Experiments {
+		ids, err := i.listExperiments(e.Namespace)
+		if err != nil {
+			return err
+		}
+		i.experimentNS[e.Name] = e.Namespace
+		if _, ok := ids[e.Name]; ok {
+			i.result.ExperimentsSkipped++
+			continue
+		}
+		experiment := &experimentmodel.APIExperiment{
+			Name:        e.Name,
+			Description: e.Description,
+			Labels:      e.Labels,
+		}
+		if e.Namespace != "" {
+			experiment.ResourceReferences = []*experimentmodel.APIResourceReference{{
+				Key: &experimentmodel.APIResourceKey{
+					Type: experimentmodel.APIResourceTypeNAMESPACE,
+					ID:   e.Namespace,
+				},
+				Relationship: experimentmodel.APIRelationshipOWNER,
+			}}
+		}
+		created, err := i.clients.Experiment.Create(&experimentparams.CreateExperimentParams{Body: experiment})
+		if err != nil {
+			return util.Wrapf(err, "Failed to import experiment %q", e.Name)
+		}
+		ids[e.Name] = created.ID
+		i.result.ExperimentsCreated++
+	}
+	return nil
+}
+
+// listExperiments returns the IDs of the existing experiments of a namespace
+// by name. The list is fetched once per namespace.
+func (i *importer) listExperiments(namespace string) (map[string]string, error) {
+	if ids, ok := i.experimentIDs[namespace]; ok {
+		return ids, nil
+	}
+	params := &experimentparams.ListExperimentParams{}
+	if namespace != "" {
+		params.ResourceReferenceKeyType = util.StringPointer(string(experimentmodel.APIResourceTypeNAMESPACE))
+		params.ResourceReferenceKeyID = util.StringPointer(namespace)
+	}
+	experiments, err := i.clients.Experiment.ListAll(params, math.MaxInt32)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to list experiments")
+	}
+	ids := map[string]string{}
+	for _, e := range experiments {
+		ids[e.Name] = e.ID
+	}
+	i.experimentIDs[namespace] = ids
+	return ids, nil
+}
+
+func (i *importer) importJobs() error {
+	// Names of the existing jobs, keyed by experiment ID.
+	existingJobs := map[string]map[string]bool{}
+	for _, j := range i.bundle.Manifest.Jobs {
+		var experimentID string
+		if j.Experiment != "" {
+			experimentID = i.experimentIDs[i.experimentNS[j.Experiment]][j.Experiment]
+			if _, ok := existingJobs[experimentID]; !ok {
+				jobs, err := i.clients.Job.ListAll(&jobparams.ListJobsParams{
+					ResourceReferenceKeyType: util.StringPointer(string(jobmodel.APIResourceTypeEXPERIMENT)),
+					ResourceReferenceKeyID:   util.StringPointer(experimentID),
+				}, math.MaxInt32)
+				if err != nil {
+					return util.Wrapf(err, "Failed to list jobs of experiment %q", j.Experiment)
+				}
+				existingJobs[experimentID] = map[string]bool{}
+				for _, job := range jobs {
+					existingJobs[experimentID][job.Name] = true
+				}
+			}
+			if existingJobs[experimentID][j.Name] {
+				i.result.JobsSkipped++
+				continue
+			}
+		}
+		if _, err := i.clients.Job.Create(&jobparams.CreateJobParams{Body: i.toAPIJob(j, experimentID)}); err != nil {
+			return util.Wrapf(err, "Failed to import job %q", j.Name)
+		}
+		i.result.JobsCreated++
+	}
+	return nil
+}
+
+func (i *importer) toAPIJob(j *Job, experimentID string) *jobmodel.APIJob {
+	job := &jobmodel.APIJob{
+		Name:           j.Name,
+		Description:    j.Description,
+		PipelineSpec:   &jobmodel.APIPipelineSpec{Parameters: j.Parameters},
+		Trigger:        j.Trigger,
+		MaxConcurrency: j.MaxConcurrency,
+		NoCatchup:      j.NoCatchup,
+		Enabled:        j.Enabled,
+		ServiceAccount: j.ServiceAccount,
+		Labels:         j.Labels,
+	}
+	if experimentID != "" {
+		job.ResourceReferences = append(job.ResourceReferences, &jobmodel.APIResourceReference{
+			Key:          &jobmodel.APIResourceKey{Type: jobmodel.APIResourceTypeEXPERIMENT, ID: experimentID},
+			Relationship: jobmodel.APIRelationshipOWNER,
+		})
+	}
+	switch {
+	case j.PipelineVersion != "":
+		job.ResourceReferences = append(job.ResourceReferences, &jobmodel.APIResourceReference{
+			Key: &jobmodel.APIResourceKey{
+				Type: jobmodel.APIResourceTypePIPELINEVERSION,
+				ID:   i.versionIDs[versionName{pipeline: j.Pipeline, version: j.PipelineVersion}],
+			},
+			Relationship: jobmodel.APIRelationshipCREATOR,
+		})
+	case j.Pipeline != "":
+		job.PipelineSpec.PipelineID = i.pipelineIDs[j.Pipeline]
+	default:
+		job.PipelineSpec.WorkflowManifest = j.WorkflowManifest
+	}
+	return job
+}
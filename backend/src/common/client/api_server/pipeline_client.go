@@ -276,6 +276,28 @@ func (c *PipelineClient) GetPipelineVersion(parameters *params.GetPipelineVersio
 
 func (c *PipelineClient) GetPipelineVersionTemplate(parameters *params.GetPipelineVersionTemplateParams) (
 	*workflowapi.Workflow, error) {
+	template, err := c.GetPipelineVersionTemplateRaw(parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal response
+	var workflow workflowapi.Workflow
+	err = yaml.Unmarshal([]byte(template), &workflow)
+	if err != nil {
+		return nil, util.NewUserError(err,
+			fmt.Sprintf("Failed to unmarshal reponse. Params: '%+v'. Response: '%s'", parameters,
+				template),
+			fmt.Sprintf("Failed to unmarshal reponse"))
+	}
+
+	return &workflow, nil
+}
+
+// GetPipelineVersionTemplateRaw returns the template of a pipeline version
+// exactly as it is stored, so that its content digest is preserved.
+func (c *PipelineClient) GetPipelineVersionTemplateRaw(parameters *params.GetPipelineVersionTemplateParams) (
+	string, error) {
 	// Create context with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
 	defer cancel()
@@ -290,20 +312,129 @@ func (c *PipelineClient) GetPipelineVersionTemplate(parameters *params.GetPipeli
 			err = CreateErrorCouldNotRecoverAPIStatus(err)
 		}
 
-		return nil, util.NewUserError(err,
+		return "", util.NewUserError(err,
 			fmt.Sprintf("Failed to get template. Params: '%+v'", parameters),
 			fmt.Sprintf("Failed to get template for pipeline version '%v'", parameters.VersionID))
 	}
 
-	// Unmarshal response
-	var workflow workflowapi.Workflow
-	err = yaml.Unmarshal([]byte(response.Payload.Template), &workflow)
+	return response.Payload.Template, nil
+}
+
+func (c *PipelineClient) ListAllPipelineVersions(parameters *params.ListPipelineVersionsParams, maxResultSize int) (
+	[]*model.APIPipelineVersion, error) {
+	if maxResultSize < 0 {
+		maxResultSize = 0
+	}
+
+	allResults := make([]*model.APIPipelineVersion, 0)
+	firstCall := true
+	for (firstCall || (parameters.PageToken != nil && *parameters.PageToken != "")) &&
+		(len(allResults) < maxResultSize) {
+		results, _, pageToken, err := c.ListPipelineVersions(parameters)
+		if err != nil {
+			return nil, err
+		}
+		allResults = append(allResults, results...)
+		parameters.PageToken = util.StringPointer(pageToken)
+		firstCall = false
+	}
+	if len(allResults) > maxResultSize {
+		allResults = allResults[0:maxResultSize]
+	}
+
+	return allResults, nil
+}
+
+func (c *PipelineClient) UpdatePipelineLabels(parameters *params.UpdatePipelineLabelsParams) (*model.APIPipeline,
+	error) {
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
+	defer cancel()
+
+	// Make service call
+	parameters.Context = ctx
+	response, err := c.apiClient.PipelineService.UpdatePipelineLabels(parameters, PassThroughAuth)
 	if err != nil {
+		if defaultError, ok := err.(*params.UpdatePipelineLabelsDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
 		return nil, util.NewUserError(err,
-			fmt.Sprintf("Failed to unmarshal reponse. Params: '%+v'. Response: '%s'", parameters,
-				response.Payload.Template),
-			fmt.Sprintf("Failed to unmarshal reponse"))
+			fmt.Sprintf("Failed to update pipeline labels. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to update labels of pipeline '%v'", parameters.ID))
 	}
 
-	return &workflow, nil
+	return response.Payload, nil
+}
+
+func (c *PipelineClient) UpdatePipelineVersionLabels(parameters *params.UpdatePipelineVersionLabelsParams) (
+	*model.APIPipelineVersion, error) {
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
+	defer cancel()
+
+	// Make service call
+	parameters.Context = ctx
+	response, err := c.apiClient.PipelineService.UpdatePipelineVersionLabels(parameters, PassThroughAuth)
+	if err != nil {
+		if defaultError, ok := err.(*params.UpdatePipelineVersionLabelsDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return nil, util.NewUserError(err,
+			fmt.Sprintf("Failed to update pipeline version labels. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to update labels of pipeline version '%v'", parameters.VersionID))
+	}
+
+	return response.Payload, nil
+}
+
+func (c *PipelineClient) UpdatePipelineDefaultVersion(parameters *params.UpdatePipelineDefaultVersionParams) error {
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
+	defer cancel()
+
+	// Make service call
+	parameters.Context = ctx
+	_, err := c.apiClient.PipelineService.UpdatePipelineDefaultVersion(parameters, PassThroughAuth)
+	if err != nil {
+		if defaultError, ok := err.(*params.UpdatePipelineDefaultVersionDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return util.NewUserError(err,
+			fmt.Sprintf("Failed to update pipeline default version. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to update default version of pipeline '%v'", parameters.PipelineID))
+	}
+
+	return nil
+}
+
+func (c *PipelineClient) DeletePipelineVersion(parameters *params.DeletePipelineVersionParams) error {
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
+	defer cancel()
+
+	// Make service call
+	parameters.Context = ctx
+	_, err := c.apiClient.PipelineService.DeletePipelineVersion(parameters, PassThroughAuth)
+	if err != nil {
+		if defaultError, ok := err.(*params.DeletePipelineVersionDefault); ok {
+			err = CreateErrorFromAPIStatus(defaultError.Payload.Error, defaultError.Payload.Code)
+		} else {
+			err = CreateErrorCouldNotRecoverAPIStatus(err)
+		}
+
+		return util.NewUserError(err,
+			fmt.Sprintf("Failed to delete pipeline version. Params: '%+v'", parameters),
+			fmt.Sprintf("Failed to delete pipeline version '%v'", parameters.VersionID))
+	}
+
+	return nil
 }
@@ -96,7 +96,12 @@ func (c *PipelineUploadClient) UploadPipelineVersion(filePath string, parameters
 	}
 	defer file.Close()
 	parameters.Uploadfile = runtime.NamedReader(filePath, file)
+	return c.UploadVersion(parameters)
+}
 
+// UploadVersion uploads the pipeline version file set in parameters.Uploadfile.
+func (c *PipelineUploadClient) UploadVersion(parameters *params.UploadPipelineVersionParams) (*model.APIPipelineVersion,
+	error) {
 	// Create context with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), apiServerDefaultTimeout)
 	defer cancel()
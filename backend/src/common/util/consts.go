@@ -47,6 +47,10 @@ const (
 	// It captures the the name of the Run.
 	AnnotationKeyRunName = "pipelines.kubeflow.org/run_name"
 
+	// AnnotationKeyJobName is a ScheduledWorkflow annotation key.
+	// It captures the name of the Job.
+	AnnotationKeyJobName = "pipelines.kubeflow.org/job_name"
+
 	AnnotationKeyIstioSidecarInject           = "sidecar.istio.io/inject"
 	AnnotationValueIstioSidecarInjectEnabled  = "true"
 	AnnotationValueIstioSidecarInjectDisabled = "false"
@@ -55,4 +59,10 @@ const (
 	// It captures whether this step will be selected by cache service.
 	// To disable/enable cache for a single run, this label needs to be added in every step under a run.
 	LabelKeyCacheEnabled = "pipelines.kubeflow.org/cache_enabled"
+
+	// EnvKeyRunID is the environment variable holding the ID of the run in the containers of its steps.
+	EnvKeyRunID = "KFP_RUN_ID"
+	// EnvKeyMetricsPushToken is the environment variable holding the token that the steps of a run
+	// authenticate with to push metrics of the run while running.
+	EnvKeyMetricsPushToken = "KFP_METRICS_PUSH_TOKEN"
 )
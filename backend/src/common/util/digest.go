@@ -0,0 +1,29 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+const digestAlgorithmSHA256 = "sha256"
+
+// ComputeDigest returns the content digest of the provided bytes in the form
+// of "sha256:<hex>".
+func ComputeDigest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return digestAlgorithmSHA256 + ":" + hex.EncodeToString(sum[:])
+}
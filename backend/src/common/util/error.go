@@ -160,6 +160,14 @@ func NewResourcesNotFoundError(resourceTypesFormat string, resourceNames ...inte
 		codes.NotFound)
 }
 
+func NewNotFoundError(err error, externalFormat string, a ...interface{}) *UserError {
+	externalMessage := fmt.Sprintf(externalFormat, a...)
+	return newUserError(
+		errors.Wrapf(err, fmt.Sprintf("NotFoundError: %v", externalMessage)),
+		externalMessage,
+		codes.NotFound)
+}
+
 func NewInvalidInputError(messageFormat string, a ...interface{}) *UserError {
 	message := fmt.Sprintf(messageFormat, a...)
 	return newUserError(errors.Errorf("Invalid input error: %v", message), message, codes.InvalidArgument)
@@ -177,6 +185,16 @@ func NewAlreadyExistError(messageFormat string, a ...interface{}) *UserError {
 	return newUserError(errors.Errorf("Already exist error: %v", message), message, codes.AlreadyExists)
 }
 
+func NewUnauthenticatedError(messageFormat string, a ...interface{}) *UserError {
+	message := fmt.Sprintf(messageFormat, a...)
+	return newUserError(errors.Errorf("Unauthenticated error: %v", message), message, codes.Unauthenticated)
+}
+
+func NewResourceExhaustedError(messageFormat string, a ...interface{}) *UserError {
+	message := fmt.Sprintf(messageFormat, a...)
+	return newUserError(errors.Errorf("Resource exhausted error: %v", message), message, codes.ResourceExhausted)
+}
+
 func NewBadRequestError(err error, externalFormat string, a ...interface{}) *UserError {
 	externalMessage := fmt.Sprintf(externalFormat, a...)
 	return newUserError(
@@ -0,0 +1,219 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/ghodss/yaml"
+)
+
+// AnnotationKeyParameterSchema is a Workflow annotation key. Its value is a
+// YAML or JSON list of ParameterSpec describing the workflow parameters, e.g.
+//
+//   pipelines.kubeflow.org/parameter_schema: |
+//     - name: epochs
+//       type: int
+//       min: 1
+//       required: true
+//     - name: optimizer
+//       type: enum
+//       enum: [adam, sgd]
+const AnnotationKeyParameterSchema = "pipelines.kubeflow.org/parameter_schema"
+
+type ParameterType string
+
+const (
+	ParameterTypeString      ParameterType = "string"
+	ParameterTypeInt         ParameterType = "int"
+	ParameterTypeFloat       ParameterType = "float"
+	ParameterTypeBool        ParameterType = "bool"
+	ParameterTypeEnum        ParameterType = "enum"
+	ParameterTypeJSON        ParameterType = "json"
+	ParameterTypeArtifactURI ParameterType = "artifact_uri"
+)
+
+// ParameterSpec is the typed metadata of a workflow parameter.
+type ParameterSpec struct {
+	Name        string        `json:"name"`
+	Type        ParameterType `json:"type"`
+	Description string        `json:"description,omitempty"`
+	Required    bool          `json:"required,omitempty"`
+	// Default is the value of the parameter in the workflow. It is filled in
+	// from the workflow arguments and cannot be set in the annotation.
+	Default *string `json:"default,omitempty"`
+	// Min and Max bound int and float parameters, inclusively.
+	Min *float64 `json:"min,omitempty"`
+	Max *float64 `json:"max,omitempty"`
+	// Pattern is a regular expression that string and artifact_uri values
+	// must match entirely.
+	Pattern string `json:"pattern,omitempty"`
+	// Enum lists the allowed values of enum parameters.
+	Enum []string `json:"enum,omitempty"`
+}
+
+// GetParameterSchema returns the parameter schema declared in the template as
+// a JSON string, or an empty string if the template declares none.
+func GetParameterSchema(template []byte) (string, error) {
+	wf, err := ValidateWorkflow(template)
+	if err != nil {
+		return "", Wrap(err, "Failed to get parameter schema from the workflow")
+	}
+	specs, err := parseParameterSchema(wf)
+	if err != nil || specs == nil {
+		return "", err
+	}
+	schemaBytes, err := json.Marshal(specs)
+	if err != nil {
+		return "", NewInvalidInputErrorWithDetails(err, "Failed to marshal the parameter schema.")
+	}
+	return string(schemaBytes), nil
+}
+
+// parseParameterSchema parses and validates the parameter schema annotation of
+// the workflow. It returns nil if the workflow has no such annotation.
+func parseParameterSchema(wf *v1alpha1.Workflow) ([]*ParameterSpec, error) {
+	annotation, ok := wf.Annotations[AnnotationKeyParameterSchema]
+	if !ok {
+		return nil, nil
+	}
+	var specs []*ParameterSpec
+	if err := yaml.Unmarshal([]byte(annotation), &specs); err != nil {
+		return nil, NewInvalidInputErrorWithDetails(err, "Failed to parse the parameter schema.")
+	}
+	defaults := make(map[string]*string)
+	for _, param := range wf.Spec.Arguments.Parameters {
+		defaults[param.Name] = param.Value
+	}
+	seen := make(map[string]bool)
+	for _, spec := range specs {
+		if spec.Name == "" {
+			return nil, NewInvalidInputError("Invalid parameter schema: a parameter has no name.")
+		}
+		if seen[spec.Name] {
+			return nil, NewInvalidInputError("Invalid parameter schema: parameter %q is declared more than once.", spec.Name)
+		}
+		seen[spec.Name] = true
+		defaultValue, ok := defaults[spec.Name]
+		if !ok {
+			return nil, NewInvalidInputError("Invalid parameter schema: %q is not a parameter of the workflow.", spec.Name)
+		}
+		spec.Default = defaultValue
+		if err := spec.validate(); err != nil {
+			return nil, err
+		}
+		if defaultValue != nil && *defaultValue != "" {
+			if err := spec.verifyValue(*defaultValue); err != nil {
+				return nil, Wrapf(err, "Invalid default value of parameter %q", spec.Name)
+			}
+		}
+	}
+	return specs, nil
+}
+
+// validate checks that the spec itself is consistent.
+func (s *ParameterSpec) validate() error {
+	if s.Type == "" {
+		s.Type = ParameterTypeString
+	}
+	switch s.Type {
+	case ParameterTypeString, ParameterTypeInt, ParameterTypeFloat, ParameterTypeBool, ParameterTypeEnum,
+		ParameterTypeJSON, ParameterTypeArtifactURI:
+	default:
+		return NewInvalidInputError("Invalid parameter schema: parameter %q has unknown type %q.", s.Name, s.Type)
+	}
+	if (s.Min != nil || s.Max != nil) && s.Type != ParameterTypeInt && s.Type != ParameterTypeFloat {
+		return NewInvalidInputError("Invalid parameter schema: min and max only apply to int and float parameters, but %q is %s.", s.Name, s.Type)
+	}
+	if s.Min != nil && s.Max != nil && *s.Min > *s.Max {
+		return NewInvalidInputError("Invalid parameter schema: min of parameter %q is greater than its max.", s.Name)
+	}
+	if (s.Type == ParameterTypeEnum) != (len(s.Enum) > 0) {
+		return NewInvalidInputError("Invalid parameter schema: enum values must be set on enum parameters only, check %q.", s.Name)
+	}
+	if s.Pattern != "" {
+		if s.Type != ParameterTypeString && s.Type != ParameterTypeArtifactURI {
+			return NewInvalidInputError("Invalid parameter schema: pattern only applies to string and artifact_uri parameters, but %q is %s.", s.Name, s.Type)
+		}
+		if _, err := regexp.Compile(s.Pattern); err != nil {
+			return NewInvalidInputErrorWithDetails(err, "Invalid parameter schema: invalid pattern of parameter "+s.Name)
+		}
+	}
+	return nil
+}
+
+// verifyValue checks a non-templated value against the spec.
+func (s *ParameterSpec) verifyValue(value string) error {
+	switch s.Type {
+	case ParameterTypeInt:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return NewInvalidInputError("Invalid value %q for parameter %q: expected an integer.", value, s.Name)
+		}
+		return s.verifyRange(value, float64(i))
+	case ParameterTypeFloat:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return NewInvalidInputError("Invalid value %q for parameter %q: expected a number.", value, s.Name)
+		}
+		return s.verifyRange(value, f)
+	case ParameterTypeBool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return NewInvalidInputError("Invalid value %q for parameter %q: expected true or false.", value, s.Name)
+		}
+	case ParameterTypeEnum:
+		for _, allowed := range s.Enum {
+			if value == allowed {
+				return nil
+			}
+		}
+		return NewInvalidInputError("Invalid value %q for parameter %q: expected one of %s.", value, s.Name, strings.Join(s.Enum, ", "))
+	case ParameterTypeJSON:
+		if !json.Valid([]byte(value)) {
+			return NewInvalidInputError("Invalid value %q for parameter %q: expected a JSON value.", value, s.Name)
+		}
+	case ParameterTypeArtifactURI:
+		uri, err := url.Parse(value)
+		if err != nil || uri.Scheme == "" || (uri.Host == "" && uri.Path == "") {
+			return NewInvalidInputError("Invalid value %q for parameter %q: expected a URI such as gs://bucket/path.", value, s.Name)
+		}
+	}
+	if s.Pattern != "" && !regexp.MustCompile("^(?:"+s.Pattern+")$").MatchString(value) {
+		return NewInvalidInputError("Invalid value %q for parameter %q: does not match pattern %q.", value, s.Name, s.Pattern)
+	}
+	return nil
+}
+
+func (s *ParameterSpec) verifyRange(value string, v float64) error {
+	if s.Min != nil && v < *s.Min {
+		return NewInvalidInputError("Invalid value %q for parameter %q: must be at least %v.", value, s.Name, *s.Min)
+	}
+	if s.Max != nil && v > *s.Max {
+		return NewInvalidInputError("Invalid value %q for parameter %q: must be at most %v.", value, s.Name, *s.Max)
+	}
+	return nil
+}
+
+// isTemplatedValue reports whether the value is resolved later, by Argo
+// ({{...}}) or by the scheduled workflow controller ([[...]]), and thus
+// cannot be checked against its type yet.
+func isTemplatedValue(value string) bool {
+	return strings.Contains(value, "{{") || strings.Contains(value, "[[")
+}
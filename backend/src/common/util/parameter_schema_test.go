@@ -0,0 +1,163 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+
+	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testParameterSchema = `
+- name: epochs
+  type: int
+  min: 1
+  max: 100
+  required: true
+  description: Number of epochs.
+- name: rate
+  type: float
+  max: 1
+- name: debug
+  type: bool
+- name: optimizer
+  type: enum
+  enum: [adam, sgd]
+- name: config
+  type: json
+- name: data
+  type: artifact_uri
+  pattern: gs://.*
+- name: tag
+  pattern: "[a-z]+"
+`
+
+func workflowWithParameterSchema(schema string) *v1alpha1.Workflow {
+	return &v1alpha1.Workflow{
+		TypeMeta: v1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Workflow"},
+		ObjectMeta: v1.ObjectMeta{
+			Annotations: map[string]string{AnnotationKeyParameterSchema: schema},
+		},
+		Spec: v1alpha1.WorkflowSpec{Arguments: v1alpha1.Arguments{
+			Parameters: []v1alpha1.Parameter{
+				{Name: "epochs", Value: StringPointer("10")},
+				{Name: "rate", Value: StringPointer("0.5")},
+				{Name: "debug"},
+				{Name: "optimizer", Value: StringPointer("adam")},
+				{Name: "config", Value: StringPointer("{}")},
+				{Name: "data", Value: StringPointer("gs://bucket/data")},
+				{Name: "tag", Value: StringPointer("latest")},
+			}}}}
+}
+
+func TestGetParameterSchema(t *testing.T) {
+	templateBytes, _ := yaml.Marshal(workflowWithParameterSchema(`[{"name": "epochs", "type": "int", "min": 1}, {"name": "tag"}]`))
+	schema, err := GetParameterSchema(templateBytes)
+	assert.Nil(t, err)
+	assert.Equal(t,
+		`[{"name":"epochs","type":"int","default":"10","min":1},{"name":"tag","type":"string","default":"latest"}]`,
+		schema)
+}
+
+func TestGetParameterSchema_NoSchema(t *testing.T) {
+	template := v1alpha1.Workflow{TypeMeta: v1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Workflow"}}
+	templateBytes, _ := yaml.Marshal(template)
+	schema, err := GetParameterSchema(templateBytes)
+	assert.Nil(t, err)
+	assert.Equal(t, "", schema)
+}
+
+func TestGetParameterSchema_InvalidSchema(t *testing.T) {
+	tests := []struct {
+		schema string
+		errMsg string
+	}{
+		{`[{"name": "unknown"}]`, "not a parameter of the workflow"},
+		{`[{"name": "tag"}, {"name": "tag"}]`, "declared more than once"},
+		{`[{"name": "tag", "type": "date"}]`, "unknown type"},
+		{`[{"name": "tag", "min": 1}]`, "min and max only apply"},
+		{`[{"name": "epochs", "type": "int", "min": 5, "max": 1}]`, "greater than its max"},
+		{`[{"name": "optimizer", "type": "enum"}]`, "enum values must be set"},
+		{`[{"name": "tag", "pattern": "("}]`, "invalid pattern"},
+		{`[{"name": "epochs", "type": "int", "max": 5}]`, "Invalid default value"},
+		{`not a list`, "Failed to parse the parameter schema"},
+	}
+	for _, test := range tests {
+		templateBytes, _ := yaml.Marshal(workflowWithParameterSchema(test.schema))
+		_, err := GetParameterSchema(templateBytes)
+		assert.NotNil(t, err, test.schema)
+		assert.Equal(t, codes.InvalidArgument, err.(*UserError).ExternalStatusCode(), test.schema)
+		assert.Contains(t, err.Error(), test.errMsg, test.schema)
+	}
+}
+
+func TestVerifyParameterValues(t *testing.T) {
+	workflow := NewWorkflow(workflowWithParameterSchema(testParameterSchema))
+
+	assert.Nil(t, workflow.VerifyParameterValues(map[string]string{}))
+	assert.Nil(t, workflow.VerifyParameterValues(map[string]string{
+		"epochs":    "100",
+		"rate":      "0.01",
+		"debug":     "true",
+		"optimizer": "sgd",
+		"config":    `{"layers": [1, 2]}`,
+		"data":      "gs://bucket/other",
+		"tag":       "nightly",
+	}))
+	// Values resolved later are not checked.
+	assert.Nil(t, workflow.VerifyParameterValues(map[string]string{
+		"epochs": "[[Index]]",
+		"tag":    "{{workflow.uid}}",
+	}))
+}
+
+func TestVerifyParameterValues_InvalidValues(t *testing.T) {
+	workflow := NewWorkflow(workflowWithParameterSchema(testParameterSchema))
+
+	tests := []struct {
+		name   string
+		value  string
+		errMsg string
+	}{
+		{"epochs", "", "Missing value for required parameter \"epochs\""},
+		{"epochs", "ten", "expected an integer"},
+		{"epochs", "1.5", "expected an integer"},
+		{"epochs", "0", "must be at least 1"},
+		{"epochs", "101", "must be at most 100"},
+		{"rate", "fast", "expected a number"},
+		{"rate", "1.5", "must be at most 1"},
+		{"debug", "maybe", "expected true or false"},
+		{"optimizer", "rmsprop", "expected one of adam, sgd"},
+		{"config", "{", "expected a JSON value"},
+		{"data", "bucket/data", "expected a URI"},
+		{"data", "s3://bucket/data", "does not match pattern"},
+		{"tag", "v1", "does not match pattern"},
+	}
+	for _, test := range tests {
+		err := workflow.VerifyParameterValues(map[string]string{test.name: test.value})
+		assert.NotNil(t, err, test.name+"="+test.value)
+		assert.Equal(t, codes.InvalidArgument, err.(*UserError).ExternalStatusCode())
+		assert.Contains(t, err.Error(), test.errMsg, test.name+"="+test.value)
+	}
+}
+
+func TestVerifyParameterValues_NoSchema(t *testing.T) {
+	workflow := NewWorkflow(&v1alpha1.Workflow{})
+	assert.Nil(t, workflow.VerifyParameterValues(map[string]string{"anything": "goes"}))
+}
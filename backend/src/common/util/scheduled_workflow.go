@@ -15,6 +15,8 @@
 package util
 
 import (
+	"strings"
+
 	workflowapi "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/golang/glog"
 	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
@@ -59,6 +61,13 @@ func (s *ScheduledWorkflow) CronOrEmpty() string {
 	return ""
 }
 
+func (s *ScheduledWorkflow) CronScheduleTimeZoneOrNull() *string {
+	if s.Spec.CronSchedule != nil && s.Spec.CronSchedule.TimeZone != "" {
+		return StringPointer(s.Spec.CronSchedule.TimeZone)
+	}
+	return nil
+}
+
 func (s *ScheduledWorkflow) PeriodicScheduleStartTimeInSecOrNull() *int64 {
 	if s.Spec.PeriodicSchedule != nil && s.Spec.PeriodicSchedule.StartTime != nil {
 		return Int64Pointer(s.Spec.PeriodicSchedule.StartTime.Unix())
@@ -94,6 +103,107 @@ func (s *ScheduledWorkflow) IntervalSecondOr0() int64 {
 	return 0
 }
 
+func (s *ScheduledWorkflow) ObjectStoreEventBucketOrNull() *string {
+	if s.Spec.ObjectStoreEvent != nil {
+		return StringPointer(s.Spec.ObjectStoreEvent.Bucket)
+	}
+	return nil
+}
+
+func (s *ScheduledWorkflow) ObjectStoreEventPrefixOrNull() *string {
+	if s.Spec.ObjectStoreEvent != nil {
+		return StringPointer(s.Spec.ObjectStoreEvent.Prefix)
+	}
+	return nil
+}
+
+func (s *ScheduledWorkflow) ObjectStoreEventKeyParameterOrNull() *string {
+	if s.Spec.ObjectStoreEvent != nil {
+		return StringPointer(s.Spec.ObjectStoreEvent.KeyParameter)
+	}
+	return nil
+}
+
+func (s *ScheduledWorkflow) RunCompletionJobIdOrNull() *string {
+	if s.Spec.RunCompletion != nil {
+		return StringPointer(s.Spec.RunCompletion.JobID)
+	}
+	return nil
+}
+
+func (s *ScheduledWorkflow) RunCompletionExperimentIdOrNull() *string {
+	if s.Spec.RunCompletion != nil {
+		return StringPointer(s.Spec.RunCompletion.ExperimentID)
+	}
+	return nil
+}
+
+func (s *ScheduledWorkflow) RunCompletionStatesOrNull() *string {
+	if s.Spec.RunCompletion != nil {
+		return StringPointer(strings.Join(s.Spec.RunCompletion.States, ","))
+	}
+	return nil
+}
+
+func (s *ScheduledWorkflow) RunCompletionRunIdParameterOrNull() *string {
+	if s.Spec.RunCompletion != nil {
+		return StringPointer(s.Spec.RunCompletion.RunIDParameter)
+	}
+	return nil
+}
+
+func (s *ScheduledWorkflow) RunCompletionOutputParametersOrNull() *string {
+	if s.Spec.RunCompletion == nil || len(s.Spec.RunCompletion.OutputParameters) == 0 {
+		return nil
+	}
+	outputParameters, err := json.Marshal(s.Spec.RunCompletion.OutputParameters)
+	if err != nil {
+		glog.Errorf("Could not marshal the output parameters of the run completion trigger: %v", err)
+		return nil
+	}
+	return StringPointer(string(outputParameters))
+}
+
+// MaxConsecutiveFailuresOr0 returns the number of consecutive failed
+// workflows after which the schedule is disabled, or 0 if failed workflows
+// never disable it.
+func (s *ScheduledWorkflow) MaxConsecutiveFailuresOr0() int64 {
+	if s.Spec.FailurePolicy != nil {
+		return s.Spec.FailurePolicy.MaxConsecutiveFailures
+	}
+	return 0
+}
+
+// BlackoutsOrNull returns the blackout windows of the schedule as a JSON list,
+// or nil if it has none.
+func (s *ScheduledWorkflow) BlackoutsOrNull() *string {
+	if len(s.Spec.Blackouts) == 0 {
+		return nil
+	}
+	blackouts, err := json.Marshal(s.Spec.Blackouts)
+	if err != nil {
+		glog.Errorf("Could not marshal the blackout windows of the schedule: %v", err)
+		return nil
+	}
+	return StringPointer(string(blackouts))
+}
+
+func (s *ScheduledWorkflow) NextTriggeredTimeInSecOrNull() *int64 {
+	if s.Status.Trigger.NextTriggeredTime != nil {
+		return Int64Pointer(s.Status.Trigger.NextTriggeredTime.Unix())
+	}
+	return nil
+}
+
+// BackfillStatusOrNull returns the progress of the backfill of the spec, or nil
+// if the controller has not reported it yet.
+func (s *ScheduledWorkflow) BackfillStatusOrNull() *swfapi.BackfillStatus {
+	if s.Spec.Backfill == nil || s.Status.Backfill == nil || s.Status.Backfill.ID != s.Spec.Backfill.ID {
+		return nil
+	}
+	return s.Status.Backfill
+}
+
 func (s *ScheduledWorkflow) ConditionSummary() string {
 	if s.Status.Conditions == nil || len(s.Status.Conditions) == 0 {
 		return "NO_STATUS"
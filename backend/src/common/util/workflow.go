@@ -15,12 +15,14 @@
 package util
 
 import (
+	"sort"
 	"strings"
 
 	workflowapi "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/golang/glog"
 	swfregister "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow"
 	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/json"
@@ -75,6 +77,35 @@ func (w *Workflow) VerifyParameters(desiredParams map[string]string) error {
 	return nil
 }
 
+// VerifyParameterValues checks the parameter values against the parameter
+// schema annotation of the workflow, if any. Parameters that are not in
+// desiredParams are checked with their value in the workflow.
+func (w *Workflow) VerifyParameterValues(desiredParams map[string]string) error {
+	specs, err := parseParameterSchema(w.Workflow)
+	if err != nil {
+		return err
+	}
+	for _, spec := range specs {
+		value, ok := desiredParams[spec.Name]
+		if !ok && spec.Default != nil {
+			value = *spec.Default
+		}
+		if value == "" {
+			if spec.Required {
+				return NewInvalidInputError("Missing value for required parameter %q.", spec.Name)
+			}
+			continue
+		}
+		if isTemplatedValue(value) {
+			continue
+		}
+		if err := spec.verifyValue(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Get converts this object to a workflowapi.Workflow.
 func (w *Workflow) Get() *workflowapi.Workflow {
 	return w.Workflow
@@ -215,6 +246,47 @@ func (w *Workflow) SetLabelsToAllTemplates(key string, value string) {
 	}
 }
 
+// SetEnvToAllContainers sets an environment variable on the containers of all container and
+// script templates in a Workflow.
+func (w *Workflow) SetEnvToAllContainers(name string, value string) {
+	w.setEnvVarToAllContainers(corev1.EnvVar{Name: name, Value: value})
+}
+
+// SetEnvFromSecretToAllContainers sets an environment variable to the value of a key of a secret
+// on the containers of all container and script templates in a Workflow, so that the value is
+// not part of the Workflow.
+func (w *Workflow) SetEnvFromSecretToAllContainers(name string, secretName string, key string) {
+	w.setEnvVarToAllContainers(corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+			Key:                  key,
+		}},
+	})
+}
+
+func (w *Workflow) setEnvVarToAllContainers(envVar corev1.EnvVar) {
+	for index := range w.Spec.Templates {
+		template := &w.Spec.Templates[index]
+		if template.Container != nil {
+			template.Container.Env = setEnv(template.Container.Env, envVar)
+		}
+		if template.Script != nil {
+			template.Script.Env = setEnv(template.Script.Env, envVar)
+		}
+	}
+}
+
+func setEnv(env []corev1.EnvVar, envVar corev1.EnvVar) []corev1.EnvVar {
+	for index := range env {
+		if env[index].Name == envVar.Name {
+			env[index] = envVar
+			return env
+		}
+	}
+	return append(env, envVar)
+}
+
 // SetOwnerReferences sets owner references on a Workflow.
 func (w *Workflow) SetOwnerReferences(schedule *swfapi.ScheduledWorkflow) {
 	w.OwnerReferences = []metav1.OwnerReference{
@@ -281,6 +353,89 @@ func (w *Workflow) FindObjectStoreArtifactKeyOrEmpty(nodeID string, artifactName
 	return s3Key
 }
 
+// NodeArtifact is an input or output artifact of a workflow node.
+type NodeArtifact struct {
+	NodeID string
+	Name   string
+	Input  bool
+	// The object store key of the artifact. Empty if the artifact isn't stored in S3.
+	ObjectStoreKey string
+	// The template that produced the artifact. Empty for an input artifact that
+	// wasn't produced by any node of the workflow.
+	ProducerTemplateName string
+}
+
+// NodeArtifacts returns the input and output artifacts of all the nodes of the
+// workflow, sorted by node ID, with the inputs of a node before its outputs.
+func (w *Workflow) NodeArtifacts() []NodeArtifact {
+	nodeIDs := make([]string, 0, len(w.Status.Nodes))
+	producers := make(map[string]string)
+	for nodeID, node := range w.Status.Nodes {
+		nodeIDs = append(nodeIDs, nodeID)
+		if node.Outputs == nil {
+			continue
+		}
+		for _, artifact := range node.Outputs.Artifacts {
+			if key := s3KeyOrEmpty(artifact); key != "" {
+				producers[key] = node.TemplateName
+			}
+		}
+	}
+	sort.Strings(nodeIDs)
+
+	artifacts := []NodeArtifact{}
+	for _, nodeID := range nodeIDs {
+		node := w.Status.Nodes[nodeID]
+		if node.Inputs != nil {
+			for _, artifact := range node.Inputs.Artifacts {
+				key := s3KeyOrEmpty(artifact)
+				artifacts = append(artifacts, NodeArtifact{
+					NodeID:               nodeID,
+					Name:                 artifact.Name,
+					Input:                true,
+					ObjectStoreKey:       key,
+					ProducerTemplateName: producers[key],
+				})
+			}
+		}
+		if node.Outputs != nil {
+			for _, artifact := range node.Outputs.Artifacts {
+				artifacts = append(artifacts, NodeArtifact{
+					NodeID:               nodeID,
+					Name:                 artifact.Name,
+					ObjectStoreKey:       s3KeyOrEmpty(artifact),
+					ProducerTemplateName: node.TemplateName,
+				})
+			}
+		}
+	}
+	return artifacts
+}
+
+func s3KeyOrEmpty(artifact workflowapi.Artifact) string {
+	if artifact.S3 == nil {
+		return ""
+	}
+	return artifact.S3.Key
+}
+
+// OutputParameters returns the values of the output parameters of all the
+// nodes of the workflow, by parameter name.
+func (w *Workflow) OutputParameters() map[string]string {
+	outputParameters := make(map[string]string)
+	for _, node := range w.Status.Nodes {
+		if node.Outputs == nil {
+			continue
+		}
+		for _, parameter := range node.Outputs.Parameters {
+			if parameter.Value != nil {
+				outputParameters[parameter.Name] = *parameter.Value
+			}
+		}
+	}
+	return outputParameters
+}
+
 // IsInFinalState whether the workflow is in a final state.
 func (w *Workflow) IsInFinalState() bool {
 	// Workflows in the statuses other than pending or running are considered final.
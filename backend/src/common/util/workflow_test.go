@@ -21,6 +21,7 @@ import (
 	"github.com/ghodss/yaml"
 	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -287,6 +288,47 @@ func TestWorkflow_SetLabelsToAllTemplates(t *testing.T) {
 	assert.Equal(t, expected, workflow.Get())
 }
 
+func TestWorkflow_SetEnvToAllContainers(t *testing.T) {
+	workflow := NewWorkflow(&workflowapi.Workflow{
+		Spec: workflowapi.WorkflowSpec{
+			Templates: []workflowapi.Template{
+				{Name: "container", Container: &corev1.Container{
+					Env: []corev1.EnvVar{{Name: "KEY", Value: "old"}, {Name: "OTHER", Value: "other"}},
+				}},
+				{Name: "script", Script: &workflowapi.ScriptTemplate{}},
+				{Name: "dag", DAG: &workflowapi.DAGTemplate{}},
+			},
+		},
+	})
+	workflow.SetEnvToAllContainers("KEY", "value")
+
+	assert.Equal(t, []corev1.EnvVar{{Name: "KEY", Value: "value"}, {Name: "OTHER", Value: "other"}},
+		workflow.Spec.Templates[0].Container.Env)
+	assert.Equal(t, []corev1.EnvVar{{Name: "KEY", Value: "value"}}, workflow.Spec.Templates[1].Script.Env)
+	assert.Nil(t, workflow.Spec.Templates[2].Container)
+}
+
+func TestWorkflow_SetEnvFromSecretToAllContainers(t *testing.T) {
+	workflow := NewWorkflow(&workflowapi.Workflow{
+		Spec: workflowapi.WorkflowSpec{
+			Templates: []workflowapi.Template{
+				{Name: "container", Container: &corev1.Container{
+					Env: []corev1.EnvVar{{Name: "KEY", Value: "old"}},
+				}},
+			},
+		},
+	})
+	workflow.SetEnvFromSecretToAllContainers("KEY", "secret", "secret-key")
+
+	assert.Equal(t, []corev1.EnvVar{{
+		Name: "KEY",
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+			Key:                  "secret-key",
+		}},
+	}}, workflow.Spec.Templates[0].Container.Env)
+}
+
 func TestSetLabels(t *testing.T) {
 	workflow := NewWorkflow(&workflowapi.Workflow{
 		ObjectMeta: metav1.ObjectMeta{
@@ -440,6 +482,53 @@ func TestFindS3ArtifactKey_Succeed(t *testing.T) {
 	assert.Equal(t, expectedPath, actualPath)
 }
 
+func TestNodeArtifacts(t *testing.T) {
+	workflow := NewWorkflow(&workflowapi.Workflow{
+		Status: workflowapi.WorkflowStatus{
+			Nodes: map[string]workflowapi.NodeStatus{
+				"node-2": {
+					TemplateName: "train",
+					Inputs: &workflowapi.Inputs{
+						Artifacts: []workflowapi.Artifact{
+							{Name: "data", ArtifactLocation: workflowapi.ArtifactLocation{S3: &workflowapi.S3Artifact{Key: "run/node-1/data.tgz"}}},
+							{Name: "config", ArtifactLocation: workflowapi.ArtifactLocation{S3: &workflowapi.S3Artifact{Key: "configs/config.tgz"}}},
+						},
+					},
+					Outputs: &workflowapi.Outputs{
+						Artifacts: []workflowapi.Artifact{
+							{Name: "model", ArtifactLocation: workflowapi.ArtifactLocation{S3: &workflowapi.S3Artifact{Key: "run/node-2/model.tgz"}}},
+						},
+					},
+				},
+				"node-1": {
+					TemplateName: "preprocess",
+					Outputs: &workflowapi.Outputs{
+						Artifacts: []workflowapi.Artifact{
+							{Name: "data", ArtifactLocation: workflowapi.ArtifactLocation{S3: &workflowapi.S3Artifact{Key: "run/node-1/data.tgz"}}},
+							{Name: "log", ArtifactLocation: workflowapi.ArtifactLocation{HTTP: &workflowapi.HTTPArtifact{URL: "http://log"}}},
+						},
+					},
+				},
+				"node-3": {TemplateName: "noop"},
+			},
+		},
+	})
+
+	expected := []NodeArtifact{
+		{NodeID: "node-1", Name: "data", ObjectStoreKey: "run/node-1/data.tgz", ProducerTemplateName: "preprocess"},
+		{NodeID: "node-1", Name: "log", ProducerTemplateName: "preprocess"},
+		{NodeID: "node-2", Name: "data", Input: true, ObjectStoreKey: "run/node-1/data.tgz", ProducerTemplateName: "preprocess"},
+		{NodeID: "node-2", Name: "config", Input: true, ObjectStoreKey: "configs/config.tgz"},
+		{NodeID: "node-2", Name: "model", ObjectStoreKey: "run/node-2/model.tgz", ProducerTemplateName: "train"},
+	}
+	assert.Equal(t, expected, workflow.NodeArtifacts())
+}
+
+func TestNodeArtifacts_NoStatus(t *testing.T) {
+	workflow := NewWorkflow(&workflowapi.Workflow{})
+	assert.Empty(t, workflow.NodeArtifacts())
+}
+
 func TestFindS3ArtifactKey_ArtifactNotFound(t *testing.T) {
 	workflow := NewWorkflow(&workflowapi.Workflow{
 		Status: workflowapi.WorkflowStatus{
@@ -479,6 +568,34 @@ func TestFindS3ArtifactKey_NodeNotFound(t *testing.T) {
 	assert.Empty(t, actualPath)
 }
 
+func TestWorkflow_OutputParameters(t *testing.T) {
+	workflow := NewWorkflow(&workflowapi.Workflow{
+		Status: workflowapi.WorkflowStatus{
+			Nodes: map[string]workflowapi.NodeStatus{
+				"node-1": {
+					Outputs: &workflowapi.Outputs{
+						Parameters: []workflowapi.Parameter{
+							{Name: "train-model", Value: StringPointer("gs://bucket/model")},
+							{Name: "train-unset"},
+						},
+					},
+				},
+				"node-2": {
+					Outputs: &workflowapi.Outputs{
+						Parameters: []workflowapi.Parameter{{Name: "eval-accuracy", Value: StringPointer("0.9")}},
+					},
+				},
+				"node-3": {},
+			},
+		},
+	})
+
+	assert.Equal(t, map[string]string{
+		"train-model":   "gs://bucket/model",
+		"eval-accuracy": "0.9",
+	}, workflow.OutputParameters())
+}
+
 func TestReplaceUID(t *testing.T) {
 	workflowString := `apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -0,0 +1,83 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/util"
+	minio "github.com/minio/minio-go"
+	"github.com/minio/minio-go/pkg/credentials"
+	wraperror "github.com/pkg/errors"
+)
+
+// ObjectStoreClientInterface lists the objects watched by object store event
+// triggers.
+type ObjectStoreClientInterface interface {
+	// ListObjects lists at most maxKeys objects whose key comes after
+	// startAfter, in key order. The returned bool reports whether there are
+	// more objects to list.
+	ListObjects(bucket string, prefix string, startAfter string, maxKeys int) ([]util.StoredObject, bool, error)
+}
+
+// ObjectStoreClient is a client to list objects in MinIO or any S3 compatible
+// object store.
+type ObjectStoreClient struct {
+	minioClient *minio.Client
+}
+
+// NewObjectStoreClient creates an instance of the client.
+func NewObjectStoreClient(minioClient *minio.Client) *ObjectStoreClient {
+	return &ObjectStoreClient{
+		minioClient: minioClient,
+	}
+}
+
+// CreateObjectStoreClient creates a client for the object store at host:port.
+// Credentials are read from the MINIO_ACCESS_KEY and MINIO_SECRET_KEY (or
+// AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY) environment variables, or from
+// the IAM role of the instance.
+func CreateObjectStoreClient(host string, port string, secure bool, region string) (*ObjectStoreClient, error) {
+	cred := credentials.New(&credentials.Chain{Providers: []credentials.Provider{
+		&credentials.EnvMinio{},
+		&credentials.EnvAWS{},
+		&credentials.IAM{Client: &http.Client{Transport: http.DefaultTransport}},
+	}})
+	minioClient, err := minio.NewWithCredentials(fmt.Sprintf("%s:%s", host, port), cred, secure, region)
+	if err != nil {
+		return nil, wraperror.Wrapf(err, "Error while creating object store client: %v", err)
+	}
+	return NewObjectStoreClient(minioClient), nil
+}
+
+// ListObjects returns the objects of the bucket whose key starts with the
+// prefix, one page at a time.
+func (c *ObjectStoreClient) ListObjects(bucket string, prefix string, startAfter string, maxKeys int) (
+	[]util.StoredObject, bool, error) {
+	core := minio.Core{Client: c.minioClient}
+	result, err := core.ListObjectsV2(bucket, prefix, "" /* continuationToken */, false, /* fetchOwner */
+		"" /* delimiter */, maxKeys, startAfter)
+	if err != nil {
+		return nil, false, wraperror.Wrapf(err,
+			"Failed to list objects in bucket (%v) with prefix (%v) after (%v): %v", bucket, prefix, startAfter, err)
+	}
+
+	objects := make([]util.StoredObject, 0, len(result.Contents))
+	for _, info := range result.Contents {
+		objects = append(objects, util.StoredObject{Key: info.Key, LastModified: info.LastModified})
+	}
+	return objects, result.IsTruncated, nil
+}
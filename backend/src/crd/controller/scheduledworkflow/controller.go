@@ -16,6 +16,8 @@ package main
 
 import (
 	"fmt"
+	"math"
+	"sync"
 	"time"
 
 	workflowapi "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
@@ -62,6 +64,18 @@ type Controller struct {
 	swfClient      *client.ScheduledWorkflowClient
 	workflowClient *client.WorkflowClient
 
+	// Lists the objects watched by object store event triggers. If nil, such
+	// triggers fail permanently.
+	objectStoreClient client.ObjectStoreClientInterface
+
+	// The maximum number of objects listed per sync of an object store event
+	// trigger. Larger listings are scanned over several syncs.
+	objectListMaxKeys int
+
+	// The listings in progress, by namespace/name of the ScheduledWorkflow.
+	objectScans      map[string]*util.ObjectScan
+	objectScansMutex sync.Mutex
+
 	// workqueue is a rate limited work queue. This is used to queue work to be
 	// processed instead of performing it as soon as a change happens. This
 	// means we can ensure we only process a fixed amount of resources at a
@@ -71,6 +85,9 @@ type Controller struct {
 
 	// An interface to generate the current time.
 	time commonutil.TimeInterface
+
+	// The namespaces whose ScheduledWorkflows are handled by this replica.
+	shard *util.NamespaceShard
 }
 
 // NewController returns a new sample controller
@@ -80,7 +97,10 @@ func NewController(
 		workflowClientSet workflowclientset.Interface,
 		swfInformerFactory swfinformers.SharedInformerFactory,
 		workflowInformerFactory workflowinformers.SharedInformerFactory,
-		time commonutil.TimeInterface) *Controller {
+		objectStoreClient client.ObjectStoreClientInterface,
+		objectListMaxKeys int,
+		time commonutil.TimeInterface,
+		shard *util.NamespaceShard) *Controller {
 
 	// obtain references to shared informers
 	swfInformer := swfInformerFactory.Scheduledworkflow().V1beta1().ScheduledWorkflows()
@@ -98,12 +118,16 @@ func NewController(
 	recorder := eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: util.ControllerAgentName})
 
 	controller := &Controller{
-		kubeClient:     client.NewKubeClient(kubeClientSet, recorder),
-		swfClient:      client.NewScheduledWorkflowClient(swfClientSet, swfInformer),
-		workflowClient: client.NewWorkflowClient(workflowClientSet, workflowInformer),
+		kubeClient:        client.NewKubeClient(kubeClientSet, recorder),
+		swfClient:         client.NewScheduledWorkflowClient(swfClientSet, swfInformer),
+		workflowClient:    client.NewWorkflowClient(workflowClientSet, workflowInformer),
+		objectStoreClient: objectStoreClient,
+		objectListMaxKeys: objectListMaxKeys,
+		objectScans:       map[string]*util.ObjectScan{},
 		workqueue: workqueue.NewNamedRateLimitingQueue(
 			workqueue.NewItemExponentialFailureRateLimiter(DefaultJobBackOff, MaxJobBackOff), swfregister.Kind),
-		time: time,
+		time:  time,
+		shard: shard,
 	}
 
 	log.Info("Setting up event handlers")
@@ -193,16 +217,35 @@ func (c *Controller) enqueueScheduledWorkflow(obj interface{}) {
 		runtime.HandleError(fmt.Errorf("Equeuing object: error: %v: %+v", err, obj))
 		return
 	}
+	if !c.ownsKey(key) {
+		return
+	}
 	c.workqueue.AddRateLimited(key)
 }
 
 func (c *Controller) enqueueScheduledWorkflowForDelete(obj interface{}) {
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 	if err == nil {
+		c.objectScansMutex.Lock()
+		delete(c.objectScans, key)
+		c.objectScansMutex.Unlock()
+	}
+	if err == nil && c.ownsKey(key) {
 		c.workqueue.Add(key)
 	}
 }
 
+// ownsKey returns whether the ScheduledWorkflow of the namespace/name key is
+// handled by this replica of the controller.
+func (c *Controller) ownsKey(key string) bool {
+	namespace, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		runtime.HandleError(fmt.Errorf("Invalid resource key: %s", key))
+		return false
+	}
+	return c.shard.Owns(namespace)
+}
+
 // handleWorkflow will take any resource implementing metav1.Object and attempt
 // to find the ScheduledWorkflow that 'owns' it. It does this by looking at the
 // objects metadata.ownerReferences field for an appropriate OwnerReference.
@@ -407,21 +450,77 @@ func (c *Controller) syncHandler(key string) (
 				wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't fetch completed workflows: %v", name, err)
 	}
 
-	workflow, nextScheduledEpoch, err := c.submitNextWorkflowIfNeeded(swf, len(active), nowEpoch)
+	// Disable the ScheduledWorkflow before submitting any workflow if its failure policy says so.
+	if swf.ShouldDisableForFailures(completed) {
+		swf = util.NewScheduledWorkflow(swf.Get().DeepCopy())
+		swf.DisableForFailures()
+		log.WithFields(log.Fields{
+			ScheduledWorkflow: name,
+		}).Infof("Syncing ScheduledWorkflow (%v): disabled after %v consecutive failed workflows.",
+			name, swf.Spec.FailurePolicy.MaxConsecutiveFailures)
+	}
+
+	var workflow *commonutil.Workflow
+	var nextScheduledEpoch int64
+	var triggeredObject *util.StoredObject
+	var scanningObjects bool
+	var triggeredRun *swfapi.PendingRun
+	if swf.IsObjectStoreEventTriggered() {
+		if c.objectStoreClient == nil {
+			// Permanent failure.
+			return false, false, swf,
+					wraperror.Errorf("Syncing ScheduledWorkflow (%v): no object store is configured for object store event triggers", name)
+		}
+		workflow, triggeredObject, scanningObjects, err = c.submitNextWorkflowForObjectIfNeeded(key, swf, len(active), nowEpoch)
+		nextScheduledEpoch = math.MaxInt64
+		if triggeredObject != nil {
+			nextScheduledEpoch = triggeredObject.LastModified.Unix()
+		}
+	} else if swf.IsRunCompletionTriggered() {
+		workflow, triggeredRun, err = c.submitWorkflowForPendingRunIfNeeded(swf, len(active), nowEpoch)
+		nextScheduledEpoch = math.MaxInt64
+		if triggeredRun != nil {
+			nextScheduledEpoch = nowEpoch
+		}
+	} else {
+		workflow, nextScheduledEpoch, err = c.submitNextWorkflowIfNeeded(swf, len(active), nowEpoch)
+	}
 	if err != nil {
 		return false, true, swf,
 				wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't fetch completed workflows: %v", name, err)
 	}
 
-	err = c.updateStatus(swf, workflow, active, completed, nextScheduledEpoch, nowEpoch)
+	// The workflows of the schedule take precedence over the workflow of the manual trigger,
+	// which takes precedence over the workflows of the backfill.
+	var manualWorkflow *commonutil.Workflow
+	if workflow == nil {
+		manualWorkflow, err = c.submitManualWorkflowIfNeeded(swf, len(active), nowEpoch)
+		if err != nil {
+			return false, true, swf,
+					wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't submit manual workflow: %v", name, err)
+		}
+	}
+
+	var backfillWorkflow *commonutil.Workflow
+	var backfillScheduledEpoch int64
+	if workflow == nil && manualWorkflow == nil {
+		backfillWorkflow, backfillScheduledEpoch, err = c.submitNextBackfillWorkflowIfNeeded(swf, len(active), nowEpoch)
+		if err != nil {
+			return false, true, swf,
+					wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't submit backfill workflow: %v", name, err)
+		}
+	}
+
+	err = c.updateStatus(swf, workflow, triggeredObject, triggeredRun, manualWorkflow, backfillWorkflow, backfillScheduledEpoch,
+		active, completed, nextScheduledEpoch, nowEpoch)
 	if err != nil {
 		return false, true, swf,
 				wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't update swf status: %v", name, err)
 	}
 
-	if workflow != nil {
+	if workflow != nil || manualWorkflow != nil || backfillWorkflow != nil || scanningObjects {
 		// Success. Since we created a new workflow, sync again soon since there might be one more
-		// resource to create.
+		// resource to create. Also sync again soon to list the next objects of a scan in progress.
 		log.WithFields(log.Fields{
 			ScheduledWorkflow: name,
 		}).Infof("Syncing ScheduledWorkflow (%v): success, requeuing for further processing.", name)
@@ -453,7 +552,9 @@ func (c *Controller) submitNextWorkflowIfNeeded(swf *util.ScheduledWorkflow,
 		return nil, nextScheduledEpoch, nil
 	}
 
-	workflow, err = c.submitNewWorkflowIfNotAlreadySubmitted(swf, nextScheduledEpoch, nowEpoch)
+	workflow, err = c.submitNewWorkflowIfNotAlreadySubmitted(swf, func() (*commonutil.Workflow, error) {
+		return swf.NewWorkflow(nextScheduledEpoch, nowEpoch)
+	})
 	if err != nil {
 		log.WithFields(log.Fields{
 			ScheduledWorkflow: swf.Name,
@@ -471,8 +572,166 @@ func (c *Controller) submitNextWorkflowIfNeeded(swf *util.ScheduledWorkflow,
 	return workflow, nextScheduledEpoch, nil
 }
 
+// Submits a workflow for the least recently modified object that did not trigger a workflow
+// yet, if any. Each call lists at most objectListMaxKeys objects, so the object is only known
+// once enough calls scanned the whole listing. Returns the submitted workflow, the object that
+// triggered it, whether the scan is still in progress and an error (if any).
+func (c *Controller) submitNextWorkflowForObjectIfNeeded(key string, swf *util.ScheduledWorkflow,
+		activeWorkflowCount int, nowEpoch int64) (
+		workflow *commonutil.Workflow, object *util.StoredObject, scanning bool, err error) {
+	if !swf.CanCreateWorkflow(int64(activeWorkflowCount), nowEpoch) {
+		log.WithFields(log.Fields{
+			ScheduledWorkflow: swf.Name,
+		}).Infof("Submitting workflow for ScheduledWorkflow (%v): nothing to submit (disabled, paused or max concurrency reached)",
+			swf.Name)
+		return nil, nil, false, nil
+	}
+
+	trigger := swf.Spec.Trigger.ObjectStoreEvent
+	scan := c.objectScan(key)
+	objects, truncated, err := c.objectStoreClient.ListObjects(
+		trigger.Bucket, trigger.Prefix, scan.StartAfter(), c.objectListMaxKeys)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	object, done := scan.AddPage(swf, objects, truncated)
+	if !done {
+		log.WithFields(log.Fields{
+			ScheduledWorkflow: swf.Name,
+		}).Infof("Submitting workflow for ScheduledWorkflow (%v): nothing to submit yet (listed bucket %v with prefix %v up to %v)",
+			swf.Name, trigger.Bucket, trigger.Prefix, scan.StartAfter())
+		return nil, nil, true, nil
+	}
+	if object == nil {
+		log.WithFields(log.Fields{
+			ScheduledWorkflow: swf.Name,
+		}).Infof("Submitting workflow for ScheduledWorkflow (%v): nothing to submit (no new object in bucket %v with prefix %v)",
+			swf.Name, trigger.Bucket, trigger.Prefix)
+		return nil, nil, false, nil
+	}
+
+	workflow, err = c.submitNewWorkflowIfNotAlreadySubmitted(swf, func() (*commonutil.Workflow, error) {
+		return swf.NewWorkflowForObject(object, nowEpoch)
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			ScheduledWorkflow: swf.Name,
+		}).Errorf("Submitting workflow for ScheduledWorkflow (%v): transient error while submitting workflow: %v",
+			swf.Name, err)
+		return nil, nil, false, err
+	}
+	log.WithFields(log.Fields{
+		ScheduledWorkflow: swf.Name,
+		Workflow:          workflow.Get().Name,
+	}).Infof("Submitting workflow for ScheduledWorkflow (%v): workflow (%v) successfully submitted (object: %v)",
+		swf.Name, workflow.Get().Name, object.Key)
+	return workflow, object, false, nil
+}
+
+// Submits a workflow for the oldest upstream run queued in the run completion trigger, if any and
+// if the max concurrency allows it. The workflow is scheduled now. Returns the submitted workflow,
+// the upstream run that triggered it and an error (if any).
+func (c *Controller) submitWorkflowForPendingRunIfNeeded(swf *util.ScheduledWorkflow,
+		activeWorkflowCount int, nowEpoch int64) (
+		workflow *commonutil.Workflow, run *swfapi.PendingRun, err error) {
+	run = swf.NextPendingRun()
+	if run == nil || !swf.CanCreateWorkflow(int64(activeWorkflowCount), nowEpoch) {
+		log.WithFields(log.Fields{
+			ScheduledWorkflow: swf.Name,
+		}).Infof("Submitting workflow for ScheduledWorkflow (%v): nothing to submit (no pending upstream run, disabled, paused or max concurrency reached)",
+			swf.Name)
+		return nil, nil, nil
+	}
+
+	workflow, err = c.submitNewWorkflowIfNotAlreadySubmitted(swf, func() (*commonutil.Workflow, error) {
+		return swf.NewWorkflowForRun(run, nowEpoch)
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			ScheduledWorkflow: swf.Name,
+		}).Errorf("Submitting workflow for ScheduledWorkflow (%v): transient error while submitting workflow: %v",
+			swf.Name, err)
+		return nil, nil, err
+	}
+	log.WithFields(log.Fields{
+		ScheduledWorkflow: swf.Name,
+		Workflow:          workflow.Get().Name,
+	}).Infof("Submitting workflow for ScheduledWorkflow (%v): workflow (%v) successfully submitted (upstream run: %v)",
+		swf.Name, workflow.Get().Name, run.RunID)
+	return workflow, run, nil
+}
+
+// objectScan returns the listing in progress of the objects watched by the ScheduledWorkflow.
+func (c *Controller) objectScan(key string) *util.ObjectScan {
+	c.objectScansMutex.Lock()
+	defer c.objectScansMutex.Unlock()
+	scan, ok := c.objectScans[key]
+	if !ok {
+		scan = &util.ObjectScan{}
+		c.objectScans[key] = scan
+	}
+	return scan
+}
+
+// Submits the workflow of the manual trigger of the ScheduledWorkflow, if it is pending and if
+// the max concurrency allows it. The workflow is scheduled now. Returns the submitted workflow
+// and an error (if any).
+func (c *Controller) submitManualWorkflowIfNeeded(swf *util.ScheduledWorkflow,
+		activeWorkflowCount int, nowEpoch int64) (
+		workflow *commonutil.Workflow, err error) {
+	if !swf.ShouldRunManualTriggerNow(int64(activeWorkflowCount)) {
+		return nil, nil
+	}
+
+	workflow, err = c.submitNewWorkflowIfNotAlreadySubmitted(swf, func() (*commonutil.Workflow, error) {
+		return swf.NewWorkflow(nowEpoch, nowEpoch)
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			ScheduledWorkflow: swf.Name,
+		}).Errorf("Submitting manual workflow for ScheduledWorkflow (%v): transient error while submitting workflow: %v",
+			swf.Name, err)
+		return nil, err
+	}
+	log.WithFields(log.Fields{
+		ScheduledWorkflow: swf.Name,
+		Workflow:          workflow.Get().Name,
+	}).Infof("Submitting manual workflow for ScheduledWorkflow (%v): workflow (%v) successfully submitted (manual trigger: %v)",
+		swf.Name, workflow.Get().Name, swf.Spec.ManualTrigger.ID)
+	return workflow, nil
+}
+
+// Submits the next workflow of the backfill of the ScheduledWorkflow, if any and if the max
+// concurrency allows it. Returns the submitted workflow, its scheduled time and an error (if
+// any).
+func (c *Controller) submitNextBackfillWorkflowIfNeeded(swf *util.ScheduledWorkflow,
+		activeWorkflowCount int, nowEpoch int64) (
+		workflow *commonutil.Workflow, scheduledEpoch int64, err error) {
+	scheduledEpoch, shouldRunNow := swf.GetNextBackfillEpoch(int64(activeWorkflowCount))
+	if !shouldRunNow {
+		return nil, scheduledEpoch, nil
+	}
+
+	workflow, err = c.submitNewWorkflowIfNotAlreadySubmitted(swf, func() (*commonutil.Workflow, error) {
+		return swf.NewBackfillWorkflow(scheduledEpoch, nowEpoch)
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			ScheduledWorkflow: swf.Name,
+		}).Errorf("Submitting backfill workflow for ScheduledWorkflow (%v): transient error while submitting workflow: %v",
+			swf.Name, err)
+		return nil, scheduledEpoch, err
+	}
+	log.WithFields(log.Fields{
+		ScheduledWorkflow: swf.Name,
+		Workflow:          workflow.Get().Name,
+	}).Infof("Submitting backfill workflow for ScheduledWorkflow (%v): workflow (%v) successfully submitted (scheduled at: %v)",
+		swf.Name, workflow.Get().Name, commonutil.FormatTimeForLogging(scheduledEpoch))
+	return workflow, scheduledEpoch, nil
+}
+
 func (c *Controller) submitNewWorkflowIfNotAlreadySubmitted(
-		swf *util.ScheduledWorkflow, nextScheduledEpoch int64, nowEpoch int64) (
+		swf *util.ScheduledWorkflow, newWorkflow func() (*commonutil.Workflow, error)) (
 		*commonutil.Workflow, error) {
 
 	workflowName := swf.NextResourceName()
@@ -495,8 +754,11 @@ func (c *Controller) submitNewWorkflowIfNotAlreadySubmitted(
 	}
 
 	// If the workflow is not found, we need to create it.
-	newWorkflow, err := swf.NewWorkflow(nextScheduledEpoch, nowEpoch)
-	createdWorkflow, err := c.workflowClient.Create(swf.Namespace, newWorkflow)
+	workflow, err := newWorkflow()
+	if err != nil {
+		return nil, err
+	}
+	createdWorkflow, err := c.workflowClient.Create(swf.Namespace, workflow)
 
 	if err != nil {
 		return nil, err
@@ -507,6 +769,11 @@ func (c *Controller) submitNewWorkflowIfNotAlreadySubmitted(
 func (c *Controller) updateStatus(
 		swf *util.ScheduledWorkflow,
 		workflow *commonutil.Workflow,
+		triggeredObject *util.StoredObject,
+		triggeredRun *swfapi.PendingRun,
+		manualWorkflow *commonutil.Workflow,
+		backfillWorkflow *commonutil.Workflow,
+		backfillScheduledEpoch int64,
 		active []swfapi.WorkflowStatus,
 		completed []swfapi.WorkflowStatus,
 		nextScheduledEpoch int64,
@@ -516,6 +783,12 @@ func (c *Controller) updateStatus(
 	// Or create a copy manually for better performance
 	swfCopy := util.NewScheduledWorkflow(swf.Get().DeepCopy())
 	swfCopy.UpdateStatus(nowEpoch, workflow, nextScheduledEpoch, active, completed)
+	if triggeredObject != nil {
+		swfCopy.UpdateObjectStoreEventStatus(triggeredObject)
+	}
+	swfCopy.UpdateRunCompletionStatus(triggeredRun)
+	swfCopy.UpdateManualTriggerStatus(manualWorkflow)
+	swfCopy.UpdateBackfillStatus(backfillWorkflow, backfillScheduledEpoch)
 
 	// Until #38113 is merged, we must use Update instead of UpdateStatus to
 	// update the Status block of the ScheduledWorkflow. UpdateStatus will not
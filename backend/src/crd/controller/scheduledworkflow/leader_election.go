@@ -0,0 +1,145 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/util"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/client-go/tools/record"
+)
+
+// serviceAccountNamespaceFile holds the namespace of the pod, in a cluster.
+const serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// runWithLeaderElection runs the controller only while this replica is the
+// leader, so that several replicas can run as hot standbys without submitting
+// the same workflows twice. Each shard elects its own leader.
+func runWithLeaderElection(cfg *rest.Config, kubeClient kubernetes.Interface, shard *util.NamespaceShard, shardIndex int,
+	run func(stopCh <-chan struct{}), stopCh <-chan struct{}) error {
+	lockNamespace, err := leaderElectionLockNamespace()
+	if err != nil {
+		return err
+	}
+	lockName := leaderElectionLockName
+	if shard.IsSharded() {
+		lockName = fmt.Sprintf("%s-%d", lockName, shardIndex)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return fmt.Errorf("failed to get the hostname: %v", err)
+	}
+	// The UUID distinguishes the replicas sharing a hostname.
+	identity := hostname + "_" + uuid.New().String()
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events(lockNamespace)})
+	recorder := eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: util.ControllerAgentName})
+
+	lockConfig := resourcelock.ResourceLockConfig{Identity: identity, EventRecorder: recorder}
+	var lock resourcelock.Interface
+	if leaderElectionResourceLock == leasesResourceLock {
+		leaseClient, err := newLeaseClient(cfg)
+		if err != nil {
+			return fmt.Errorf("failed to create the client of the leader election lease: %v", err)
+		}
+		lock = &leaseLock{
+			leaseMeta:  metav1.ObjectMeta{Namespace: lockNamespace, Name: lockName},
+			client:     leaseClient,
+			lockConfig: lockConfig,
+		}
+	} else {
+		lock, err = resourcelock.New(leaderElectionResourceLock, lockNamespace, lockName, kubeClient.CoreV1(), lockConfig)
+		if err != nil {
+			return fmt.Errorf("failed to create the leader election lock: %v", err)
+		}
+	}
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:          lock,
+		LeaseDuration: leaderElectionLeaseDuration,
+		RenewDeadline: leaderElectionRenewDeadline,
+		RetryPeriod:   leaderElectionRetryPeriod,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(leaderStopCh <-chan struct{}) {
+				log.Infof("Started leading as %v with lock %v.", identity, lock.Describe())
+				isLeader.Set(1)
+				run(mergeStopChannels(stopCh, leaderStopCh))
+			},
+			OnStoppedLeading: func() {
+				isLeader.Set(0)
+				// Another replica may already be leading, so the workers must
+				// not keep running. The replica restarts as a standby.
+				log.Fatalf("Stopped leading as %v with lock %v.", identity, lock.Describe())
+			},
+			OnNewLeader: func(leader string) {
+				log.Infof("The new leader is %v.", leader)
+				leaderChanges.Inc()
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create the leader elector: %v", err)
+	}
+
+	log.Infof("Waiting to become the leader as %v with lock %v.", identity, lock.Describe())
+	go elector.Run()
+	<-stopCh
+	return nil
+}
+
+// leaderElectionLockNamespace returns the namespace of the leader election
+// lock. Unless a namespace is set by the flags, as when the controller watches
+// all the namespaces, the lock is in the namespace of the pod.
+func leaderElectionLockNamespace() (string, error) {
+	if leaderElectionNamespace != "" {
+		return leaderElectionNamespace, nil
+	}
+	if namespace != "" {
+		return namespace, nil
+	}
+	data, err := ioutil.ReadFile(serviceAccountNamespaceFile)
+	if err != nil || strings.TrimSpace(string(data)) == "" {
+		return "", fmt.Errorf("leader election requires a namespace outside of a pod: set --leaderElectionNamespace or --namespace")
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// mergeStopChannels returns a channel closed when either channel is closed.
+func mergeStopChannels(a <-chan struct{}, b <-chan struct{}) <-chan struct{} {
+	merged := make(chan struct{})
+	go func() {
+		defer close(merged)
+		select {
+		case <-a:
+		case <-b:
+		}
+	}()
+	return merged
+}
@@ -0,0 +1,199 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// leasesResourceLock is the type of the leader election locks stored in
+// coordination.k8s.io/v1 Leases. The vendored client-go only has ConfigMap and
+// Endpoints locks, so the Lease lock is implemented here, on a REST client.
+const leasesResourceLock = "leases"
+
+var leaseGroupVersion = schema.GroupVersion{Group: "coordination.k8s.io", Version: "v1"}
+
+// lease is the part of a coordination.k8s.io/v1 Lease used by leader election.
+type lease struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              leaseSpec `json:"spec,omitempty"`
+}
+
+type leaseSpec struct {
+	HolderIdentity       *string           `json:"holderIdentity,omitempty"`
+	LeaseDurationSeconds *int32            `json:"leaseDurationSeconds,omitempty"`
+	AcquireTime          *metav1.MicroTime `json:"acquireTime,omitempty"`
+	RenewTime            *metav1.MicroTime `json:"renewTime,omitempty"`
+	LeaseTransitions     *int32            `json:"leaseTransitions,omitempty"`
+}
+
+// DeepCopyObject lets events be recorded about the lease.
+func (l *lease) DeepCopyObject() runtime.Object {
+	data, _ := json.Marshal(l)
+	copied := &lease{}
+	_ = json.Unmarshal(data, copied)
+	return copied
+}
+
+// leaseLock is a leader election lock stored in a Lease, like the Lease lock of
+// newer client-go versions.
+type leaseLock struct {
+	leaseMeta  metav1.ObjectMeta
+	client     rest.Interface
+	lockConfig resourcelock.ResourceLockConfig
+	lease      *lease
+}
+
+// newLeaseClient creates a REST client of the coordination.k8s.io/v1 API.
+func newLeaseClient(config *rest.Config) (rest.Interface, error) {
+	leaseConfig := rest.CopyConfig(config)
+	leaseConfig.APIPath = "/apis"
+	leaseConfig.GroupVersion = &leaseGroupVersion
+	leaseConfig.NegotiatedSerializer = serializer.DirectCodecFactory{CodecFactory: scheme.Codecs}
+	if leaseConfig.UserAgent == "" {
+		leaseConfig.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+	return rest.RESTClientFor(leaseConfig)
+}
+
+// Get returns the election record of the lease.
+func (l *leaseLock) Get() (*resourcelock.LeaderElectionRecord, error) {
+	data, err := l.client.Get().
+		Namespace(l.leaseMeta.Namespace).
+		Resource("leases").
+		Name(l.leaseMeta.Name).
+		DoRaw()
+	if err != nil {
+		return nil, err
+	}
+	got := &lease{}
+	if err := json.Unmarshal(data, got); err != nil {
+		return nil, err
+	}
+	l.lease = got
+	return toLeaderElectionRecord(&got.Spec), nil
+}
+
+// Create creates the lease with the election record.
+func (l *leaseLock) Create(ler resourcelock.LeaderElectionRecord) error {
+	return l.write(&lease{
+		TypeMeta: metav1.TypeMeta{APIVersion: leaseGroupVersion.String(), Kind: "Lease"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      l.leaseMeta.Name,
+			Namespace: l.leaseMeta.Namespace,
+		},
+		Spec: toLeaseSpec(ler),
+	}, false)
+}
+
+// Update replaces the election record of the lease.
+func (l *leaseLock) Update(ler resourcelock.LeaderElectionRecord) error {
+	if l.lease == nil {
+		return errors.New("lease not initialized, call get or create first")
+	}
+	updated := l.lease.DeepCopyObject().(*lease)
+	updated.Spec = toLeaseSpec(ler)
+	return l.write(updated, true)
+}
+
+// write creates or updates the lease, and keeps the result for the next update.
+func (l *leaseLock) write(toWrite *lease, update bool) error {
+	body, err := json.Marshal(toWrite)
+	if err != nil {
+		return err
+	}
+	request := l.client.Post().Namespace(l.leaseMeta.Namespace).Resource("leases")
+	if update {
+		request = l.client.Put().Namespace(l.leaseMeta.Namespace).Resource("leases").Name(l.leaseMeta.Name)
+	}
+	data, err := request.SetHeader("Content-Type", "application/json").Body(body).DoRaw()
+	if err != nil {
+		return err
+	}
+	written := &lease{}
+	if err := json.Unmarshal(data, written); err != nil {
+		return err
+	}
+	l.lease = written
+	return nil
+}
+
+// RecordEvent records an event about the lease.
+func (l *leaseLock) RecordEvent(s string) {
+	if l.lockConfig.EventRecorder == nil || l.lease == nil {
+		return
+	}
+	events := fmt.Sprintf("%v %v", l.lockConfig.Identity, s)
+	// The kind of the lease is set, since the scheme doesn't know the type.
+	subject := &lease{
+		TypeMeta:   metav1.TypeMeta{APIVersion: leaseGroupVersion.String(), Kind: "Lease"},
+		ObjectMeta: l.lease.ObjectMeta,
+	}
+	l.lockConfig.EventRecorder.Eventf(subject, corev1.EventTypeNormal, "LeaderElection", events)
+}
+
+// Describe returns the namespace and the name of the lease.
+func (l *leaseLock) Describe() string {
+	return fmt.Sprintf("%v/%v", l.leaseMeta.Namespace, l.leaseMeta.Name)
+}
+
+// Identity returns the identity of the replica.
+func (l *leaseLock) Identity() string {
+	return l.lockConfig.Identity
+}
+
+func toLeaseSpec(ler resourcelock.LeaderElectionRecord) leaseSpec {
+	leaseDurationSeconds := int32(ler.LeaseDurationSeconds)
+	leaseTransitions := int32(ler.LeaderTransitions)
+	return leaseSpec{
+		HolderIdentity:       &ler.HolderIdentity,
+		LeaseDurationSeconds: &leaseDurationSeconds,
+		AcquireTime:          &metav1.MicroTime{Time: ler.AcquireTime.Time},
+		RenewTime:            &metav1.MicroTime{Time: ler.RenewTime.Time},
+		LeaseTransitions:     &leaseTransitions,
+	}
+}
+
+func toLeaderElectionRecord(spec *leaseSpec) *resourcelock.LeaderElectionRecord {
+	var record resourcelock.LeaderElectionRecord
+	if spec.HolderIdentity != nil {
+		record.HolderIdentity = *spec.HolderIdentity
+	}
+	if spec.LeaseDurationSeconds != nil {
+		record.LeaseDurationSeconds = int(*spec.LeaseDurationSeconds)
+	}
+	if spec.LeaseTransitions != nil {
+		record.LeaderTransitions = int(*spec.LeaseTransitions)
+	}
+	if spec.AcquireTime != nil {
+		record.AcquireTime = metav1.NewTime(spec.AcquireTime.Time)
+	}
+	if spec.RenewTime != nil {
+		record.RenewTime = metav1.NewTime(spec.RenewTime.Time)
+	}
+	return &record
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// fakeLeaseServer serves a single lease of the coordination.k8s.io/v1 API.
+func fakeLeaseServer(t *testing.T) *httptest.Server {
+	const leasesPath = "/apis/coordination.k8s.io/v1/namespaces/NS/leases"
+	var stored []byte
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.Nil(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == leasesPath+"/LOCK":
+			if stored == nil {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`))
+				return
+			}
+		case r.Method == http.MethodPost && r.URL.Path == leasesPath:
+			stored = body
+		case r.Method == http.MethodPut && r.URL.Path == leasesPath+"/LOCK":
+			stored = body
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write(stored)
+	}))
+}
+
+func TestLeaseLock(t *testing.T) {
+	server := fakeLeaseServer(t)
+	defer server.Close()
+	client, err := newLeaseClient(&rest.Config{Host: server.URL})
+	require.Nil(t, err)
+	lock := &leaseLock{
+		leaseMeta:  metav1.ObjectMeta{Namespace: "NS", Name: "LOCK"},
+		client:     client,
+		lockConfig: resourcelock.ResourceLockConfig{Identity: "REPLICA1"},
+	}
+	assert.Equal(t, "NS/LOCK", lock.Describe())
+	assert.Equal(t, "REPLICA1", lock.Identity())
+
+	_, err = lock.Get()
+	assert.True(t, apierrors.IsNotFound(err))
+	assert.NotNil(t, lock.Update(resourcelock.LeaderElectionRecord{}))
+
+	acquireTime := metav1.NewTime(time.Unix(10, 0))
+	record := resourcelock.LeaderElectionRecord{
+		HolderIdentity:       "REPLICA1",
+		LeaseDurationSeconds: 15,
+		AcquireTime:          acquireTime,
+		RenewTime:            acquireTime,
+	}
+	require.Nil(t, lock.Create(record))
+	got, err := lock.Get()
+	require.Nil(t, err)
+	assert.Equal(t, record, *got)
+
+	record.RenewTime = metav1.NewTime(time.Unix(20, 0))
+	record.LeaderTransitions = 1
+	require.Nil(t, lock.Update(record))
+	got, err = lock.Get()
+	require.Nil(t, err)
+	assert.Equal(t, record, *got)
+}
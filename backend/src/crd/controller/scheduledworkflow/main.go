@@ -16,24 +16,45 @@ package main
 
 import (
 	"flag"
+	"net/http"
 	"time"
 
 	workflowclientSet "github.com/argoproj/argo/pkg/client/clientset/versioned"
 	workflowinformers "github.com/argoproj/argo/pkg/client/informers/externalversions"
 	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/client"
+	"github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/util"
 	swfclientset "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/clientset/versioned"
 	swfinformers "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/informers/externalversions"
 	"github.com/kubeflow/pipelines/backend/src/crd/pkg/signals"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/client-go/kubernetes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/util/workqueue"
 )
 
 var (
-	masterURL  string
-	kubeconfig string
-	namespace  string
+	masterURL          string
+	kubeconfig         string
+	namespace          string
+	minioServiceHost   string
+	minioServicePort   string
+	minioServiceRegion string
+	minioServiceSecure bool
+	objectListMaxKeys  int
+
+	leaderElect                 bool
+	leaderElectionNamespace     string
+	leaderElectionLockName      string
+	leaderElectionResourceLock  string
+	leaderElectionLeaseDuration time.Duration
+	leaderElectionRenewDeadline time.Duration
+	leaderElectionRetryPeriod   time.Duration
+	shardCount                  int
+	shardIndex                  int
+	metricsAddress              string
 )
 
 func main() {
@@ -42,6 +63,20 @@ func main() {
 	// set up signals so we handle the first shutdown signal gracefully
 	stopCh := signals.SetupSignalHandler()
 
+	shard, err := util.NewNamespaceShard(shardCount, shardIndex)
+	if err != nil {
+		log.Fatalf("Error building shard: %s", err.Error())
+	}
+
+	// The metrics provider must be set before the work queue is created.
+	workqueue.SetProvider(workqueueMetricsProvider{})
+	if metricsAddress != "" {
+		go func() {
+			http.Handle("/metrics", promhttp.Handler())
+			log.Fatal(http.ListenAndServe(metricsAddress, nil))
+		}()
+	}
+
 	cfg, err := clientcmd.BuildConfigFromFlags(masterURL, kubeconfig)
 	if err != nil {
 		log.Fatalf("Error building kubeconfig: %s", err.Error())
@@ -72,19 +107,39 @@ func main() {
 		workflowInformerFactory = workflowinformers.NewFilteredSharedInformerFactory(workflowClient, time.Second*30, namespace, nil)
 	}
 
+	objectStoreClient, err := client.CreateObjectStoreClient(
+		minioServiceHost, minioServicePort, minioServiceSecure, minioServiceRegion)
+	if err != nil {
+		log.Fatalf("Error building object store client: %s", err.Error())
+	}
+
 	controller := NewController(
 		kubeClient,
 		scheduleClient,
 		workflowClient,
 		scheduleInformerFactory,
 		workflowInformerFactory,
-		commonutil.NewRealTime())
+		objectStoreClient,
+		objectListMaxKeys,
+		commonutil.NewRealTime(),
+		shard)
 
+	// The informers of the standbys keep their caches in sync, so that they can
+	// take over right away.
 	go scheduleInformerFactory.Start(stopCh)
 	go workflowInformerFactory.Start(stopCh)
 
-	if err = controller.Run(2, stopCh); err != nil {
-		log.Fatalf("Error running controller: %s", err.Error())
+	run := func(stopCh <-chan struct{}) {
+		if err := controller.Run(2, stopCh); err != nil {
+			log.Fatalf("Error running controller: %s", err.Error())
+		}
+	}
+	if !leaderElect {
+		run(stopCh)
+		return
+	}
+	if err = runWithLeaderElection(cfg, kubeClient, shard, shardIndex, run, stopCh); err != nil {
+		log.Fatalf("Error running leader election: %s", err.Error())
 	}
 }
 
@@ -92,4 +147,19 @@ func init() {
 	flag.StringVar(&kubeconfig, "kubeconfig", "", "Path to a kubeconfig. Only required if out-of-cluster.")
 	flag.StringVar(&masterURL, "master", "", "The address of the Kubernetes API server. Overrides any value in kubeconfig. Only required if out-of-cluster.")
 	flag.StringVar(&namespace, "namespace", "", "The namespace name used for Kubernetes informers to obtain the listers.")
+	flag.StringVar(&minioServiceHost, "minioServiceHost", "minio-service", "The host of the object store watched by object store event triggers.")
+	flag.StringVar(&minioServicePort, "minioServicePort", "9000", "The port of the object store watched by object store event triggers.")
+	flag.StringVar(&minioServiceRegion, "minioServiceRegion", "", "The region of the object store watched by object store event triggers.")
+	flag.BoolVar(&minioServiceSecure, "minioServiceSecure", false, "Whether to connect to the object store over TLS.")
+	flag.IntVar(&objectListMaxKeys, "objectListMaxKeys", 1000, "The maximum number of objects listed per sync of an object store event trigger. Larger buckets are listed over several syncs.")
+	flag.BoolVar(&leaderElect, "leaderElect", true, "Whether to elect a leader among the replicas of the controller, so that only the leader creates workflows. Required to run several replicas.")
+	flag.StringVar(&leaderElectionNamespace, "leaderElectionNamespace", "", "The namespace of the leader election lock. Defaults to the namespace of the informers, or else to the namespace of the pod.")
+	flag.StringVar(&leaderElectionLockName, "leaderElectionLockName", "ml-pipeline-scheduledworkflow", "The name of the leader election lock. Each shard appends its index to it.")
+	flag.StringVar(&leaderElectionResourceLock, "leaderElectionResourceLock", leasesResourceLock, "The type of the leader election lock, one of leases, configmaps or endpoints.")
+	flag.DurationVar(&leaderElectionLeaseDuration, "leaderElectionLeaseDuration", 15*time.Second, "How long the standbys wait after the last renewal of the leader before taking over.")
+	flag.DurationVar(&leaderElectionRenewDeadline, "leaderElectionRenewDeadline", 10*time.Second, "How long the leader tries to renew its leadership before stopping to lead.")
+	flag.DurationVar(&leaderElectionRetryPeriod, "leaderElectionRetryPeriod", 2*time.Second, "How long the replicas wait between attempts to acquire or renew the leadership.")
+	flag.IntVar(&shardCount, "shardCount", 1, "The number of shards the namespaces are split into by hash. Each shard is handled by its own replicas.")
+	flag.IntVar(&shardIndex, "shardIndex", 0, "The index of the shard handled by this replica, in the range 0 to shardCount-1.")
+	flag.StringVar(&metricsAddress, "metricsAddress", ":8080", "The address on which Prometheus metrics are served. Empty disables them.")
 }
\ No newline at end of file
@@ -0,0 +1,83 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// Metric variables. Please prefix the metric names with scheduledworkflow_.
+var (
+	isLeader = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "scheduledworkflow_leader",
+		Help: "Whether this replica is the leader of the controller, 1 if it is and 0 otherwise",
+	})
+
+	leaderChanges = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "scheduledworkflow_leader_changes",
+		Help: "The total number of changes of the leader of the controller observed by this replica",
+	})
+
+	workqueueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "scheduledworkflow_workqueue_depth",
+		Help: "The number of ScheduledWorkflows waiting in the work queue",
+	}, []string{"name"})
+
+	workqueueAdds = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "scheduledworkflow_workqueue_adds",
+		Help: "The total number of ScheduledWorkflows added to the work queue",
+	}, []string{"name"})
+
+	workqueueLatency = promauto.NewSummaryVec(prometheus.SummaryOpts{
+		Name: "scheduledworkflow_workqueue_latency_microseconds",
+		Help: "How long a ScheduledWorkflow stays in the work queue before being processed",
+	}, []string{"name"})
+
+	workqueueWorkDuration = promauto.NewSummaryVec(prometheus.SummaryOpts{
+		Name: "scheduledworkflow_workqueue_work_duration_microseconds",
+		Help: "How long processing a ScheduledWorkflow from the work queue takes",
+	}, []string{"name"})
+
+	workqueueRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "scheduledworkflow_workqueue_retries",
+		Help: "The total number of ScheduledWorkflows requeued after a failure",
+	}, []string{"name"})
+)
+
+// workqueueMetricsProvider reports the metrics of the work queue to
+// Prometheus.
+type workqueueMetricsProvider struct{}
+
+func (workqueueMetricsProvider) NewDepthMetric(name string) workqueue.GaugeMetric {
+	return workqueueDepth.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewAddsMetric(name string) workqueue.CounterMetric {
+	return workqueueAdds.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewLatencyMetric(name string) workqueue.SummaryMetric {
+	return workqueueLatency.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewWorkDurationMetric(name string) workqueue.SummaryMetric {
+	return workqueueWorkDuration.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewRetriesMetric(name string) workqueue.CounterMetric {
+	return workqueueRetries.WithLabelValues(name)
+}
@@ -0,0 +1,154 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"math"
+	"time"
+
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MaxBackfillWorkflows is the maximum number of workflows a backfill can create.
+const MaxBackfillWorkflows = 1000
+
+// GetNextBackfillEpoch returns the first time of the cron or periodic schedule
+// of the trigger that is after lastEpoch, or at or after the start of the
+// backfill if lastEpoch is nil, and before the end of the backfill. It returns
+// math.MaxInt64 if there is none.
+//
+// The start and end times of the schedule are ignored. Periodic schedules are
+// aligned on the start of the backfill.
+func GetNextBackfillEpoch(trigger *swfapi.Trigger, backfill *swfapi.Backfill, lastEpoch *int64) int64 {
+	startEpoch := backfill.StartTime.Unix()
+	nextEpoch := int64(math.MaxInt64)
+	if trigger.CronSchedule != nil {
+		schedule := *trigger.CronSchedule
+		schedule.StartTime = nil
+		schedule.EndTime = nil
+		effectiveLastEpoch := startEpoch - 1
+		if lastEpoch != nil {
+			effectiveLastEpoch = *lastEpoch
+		}
+		nextEpoch = NewCronSchedule(&schedule).GetNextScheduledEpoch(&effectiveLastEpoch, startEpoch)
+	} else if trigger.PeriodicSchedule != nil {
+		schedule := *trigger.PeriodicSchedule
+		schedule.StartTime = nil
+		schedule.EndTime = nil
+		nextEpoch = startEpoch
+		if lastEpoch != nil {
+			nextEpoch = NewPeriodicSchedule(&schedule).GetNextScheduledEpoch(lastEpoch, startEpoch)
+		}
+	}
+	if nextEpoch >= backfill.EndTime.Unix() {
+		return math.MaxInt64
+	}
+	return nextEpoch
+}
+
+// CountBackfillWorkflows returns the number of workflows the backfill creates,
+// counting up to limit+1 at most.
+func CountBackfillWorkflows(trigger *swfapi.Trigger, backfill *swfapi.Backfill, limit int64) int64 {
+	var count int64
+	var lastEpoch *int64
+	for count <= limit {
+		nextEpoch := GetNextBackfillEpoch(trigger, backfill, lastEpoch)
+		if nextEpoch == math.MaxInt64 {
+			break
+		}
+		count++
+		lastEpoch = &nextEpoch
+	}
+	return count
+}
+
+// backfillStatus returns the status of the backfill of the spec, or nil if it
+// has no status yet.
+func (s *ScheduledWorkflow) backfillStatus() *swfapi.BackfillStatus {
+	if s.Spec.Backfill == nil || s.Status.Backfill == nil || s.Status.Backfill.ID != s.Spec.Backfill.ID {
+		return nil
+	}
+	return s.Status.Backfill
+}
+
+// GetNextBackfillEpoch returns the scheduled epoch of the next workflow of the
+// backfill, and whether it should be created now.
+func (s *ScheduledWorkflow) GetNextBackfillEpoch(activeWorkflowCount int64) (
+	nextScheduledEpoch int64, shouldRunNow bool) {
+	backfill := s.Spec.Backfill
+	if backfill == nil || backfill.Cancelled {
+		return math.MaxInt64, false
+	}
+	var lastEpoch *int64
+	if status := s.backfillStatus(); status != nil {
+		lastEpoch = commonutil.ToInt64Pointer(status.LastScheduledTime)
+	}
+	nextScheduledEpoch = GetNextBackfillEpoch(&s.Spec.Trigger, backfill, lastEpoch)
+	if nextScheduledEpoch == math.MaxInt64 || activeWorkflowCount >= s.maxConcurrency() {
+		return nextScheduledEpoch, false
+	}
+	return nextScheduledEpoch, true
+}
+
+// NewBackfillWorkflow creates a workflow of the backfill. The previous
+// scheduled time of the workflow is the scheduled time of the last workflow of
+// the backfill.
+func (s *ScheduledWorkflow) NewBackfillWorkflow(scheduledEpoch int64, nowEpoch int64) (
+	*commonutil.Workflow, error) {
+	previousScheduledEpoch := scheduledEpoch
+	if status := s.backfillStatus(); status != nil && status.LastScheduledTime != nil {
+		previousScheduledEpoch = status.LastScheduledTime.Unix()
+	}
+	return s.newWorkflow(scheduledEpoch, previousScheduledEpoch, nowEpoch, nil)
+}
+
+// UpdateBackfillStatus updates the progress of the backfill, given the
+// workflow of the backfill that was just created, if any.
+func (s *ScheduledWorkflow) UpdateBackfillStatus(workflow *commonutil.Workflow, scheduledEpoch int64) {
+	backfill := s.Spec.Backfill
+	if backfill == nil {
+		return
+	}
+	status := s.backfillStatus()
+	if status == nil {
+		status = &swfapi.BackfillStatus{
+			ID:         backfill.ID,
+			TotalCount: CountBackfillWorkflows(&s.Spec.Trigger, backfill, MaxBackfillWorkflows),
+		}
+		s.Status.Backfill = status
+	}
+
+	if workflow != nil {
+		status.CreatedCount++
+		status.LastScheduledTime = commonutil.Metav1TimePointer(
+			metav1.NewTime(time.Unix(scheduledEpoch, 0).UTC()))
+		s.Status.Trigger.LastIndex = commonutil.Int64Pointer(s.nextIndex())
+	}
+
+	nextEpoch := GetNextBackfillEpoch(&s.Spec.Trigger, backfill,
+		commonutil.ToInt64Pointer(status.LastScheduledTime))
+	status.NextScheduledTime = nil
+	if nextEpoch == math.MaxInt64 {
+		status.Phase = swfapi.BackfillCompleted
+	} else if backfill.Cancelled {
+		status.Phase = swfapi.BackfillCancelled
+	} else {
+		status.Phase = swfapi.BackfillRunning
+		status.NextScheduledTime = commonutil.Metav1TimePointer(
+			metav1.NewTime(time.Unix(nextEpoch, 0).UTC()))
+	}
+}
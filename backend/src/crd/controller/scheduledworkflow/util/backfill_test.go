@@ -0,0 +1,162 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func backfillSchedule(trigger swfapi.Trigger, backfill *swfapi.Backfill) *ScheduledWorkflow {
+	return NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "SCHEDULE1",
+			CreationTimestamp: metav1.NewTime(time.Unix(100*hour, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			MaxConcurrency: commonutil.Int64Pointer(2),
+			Trigger:        trigger,
+			Backfill:       backfill,
+		},
+	})
+}
+
+func newBackfill(id string, startEpoch int64, endEpoch int64) *swfapi.Backfill {
+	return &swfapi.Backfill{
+		ID:        id,
+		StartTime: metav1.NewTime(time.Unix(startEpoch, 0).UTC()),
+		EndTime:   metav1.NewTime(time.Unix(endEpoch, 0).UTC()),
+	}
+}
+
+func TestGetNextBackfillEpoch_Cron(t *testing.T) {
+	// Every hour at minute 30, with a schedule that starts after the backfill.
+	trigger := &swfapi.Trigger{CronSchedule: &swfapi.CronSchedule{
+		StartTime: commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(100*hour, 0).UTC())),
+		Cron:      "0 30 * * * *",
+	}}
+	backfill := newBackfill("B1", 10*hour, 12*hour+30*minute)
+
+	assert.Equal(t, int64(10*hour+30*minute), GetNextBackfillEpoch(trigger, backfill, nil))
+	assert.Equal(t, int64(11*hour+30*minute),
+		GetNextBackfillEpoch(trigger, backfill, commonutil.Int64Pointer(10*hour+30*minute)))
+	// The end is exclusive.
+	assert.Equal(t, int64(math.MaxInt64),
+		GetNextBackfillEpoch(trigger, backfill, commonutil.Int64Pointer(11*hour+30*minute)))
+	assert.Equal(t, int64(2), CountBackfillWorkflows(trigger, backfill, MaxBackfillWorkflows))
+
+	// The start is inclusive.
+	backfill = newBackfill("B1", 10*hour+30*minute, 11*hour)
+	assert.Equal(t, int64(10*hour+30*minute), GetNextBackfillEpoch(trigger, backfill, nil))
+}
+
+func TestGetNextBackfillEpoch_Periodic(t *testing.T) {
+	trigger := &swfapi.Trigger{PeriodicSchedule: &swfapi.PeriodicSchedule{
+		EndTime:        commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(hour, 0).UTC())),
+		IntervalSecond: hour,
+	}}
+	backfill := newBackfill("B1", 10*hour, 13*hour)
+
+	assert.Equal(t, int64(10*hour), GetNextBackfillEpoch(trigger, backfill, nil))
+	assert.Equal(t, int64(11*hour),
+		GetNextBackfillEpoch(trigger, backfill, commonutil.Int64Pointer(10*hour)))
+	assert.Equal(t, int64(3), CountBackfillWorkflows(trigger, backfill, MaxBackfillWorkflows))
+	// Counting stops after the limit.
+	assert.Equal(t, int64(2), CountBackfillWorkflows(trigger, backfill, 1))
+
+	// No schedule.
+	assert.Equal(t, int64(math.MaxInt64), GetNextBackfillEpoch(&swfapi.Trigger{}, backfill, nil))
+}
+
+func TestScheduledWorkflow_GetNextBackfillEpoch(t *testing.T) {
+	trigger := swfapi.Trigger{PeriodicSchedule: &swfapi.PeriodicSchedule{IntervalSecond: hour}}
+
+	schedule := backfillSchedule(trigger, nil)
+	nextScheduledEpoch, shouldRunNow := schedule.GetNextBackfillEpoch(0)
+	assert.Equal(t, int64(math.MaxInt64), nextScheduledEpoch)
+	assert.False(t, shouldRunNow)
+
+	schedule = backfillSchedule(trigger, newBackfill("B1", 10*hour, 12*hour))
+	nextScheduledEpoch, shouldRunNow = schedule.GetNextBackfillEpoch(1)
+	assert.Equal(t, int64(10*hour), nextScheduledEpoch)
+	assert.True(t, shouldRunNow)
+
+	// Max concurrency reached.
+	nextScheduledEpoch, shouldRunNow = schedule.GetNextBackfillEpoch(2)
+	assert.Equal(t, int64(10*hour), nextScheduledEpoch)
+	assert.False(t, shouldRunNow)
+
+	// Resumes after the last workflow created.
+	schedule.UpdateBackfillStatus(&commonutil.Workflow{}, 10*hour)
+	nextScheduledEpoch, shouldRunNow = schedule.GetNextBackfillEpoch(1)
+	assert.Equal(t, int64(11*hour), nextScheduledEpoch)
+	assert.True(t, shouldRunNow)
+
+	// The status of a previous backfill is ignored.
+	schedule.Spec.Backfill = newBackfill("B2", 10*hour, 12*hour)
+	nextScheduledEpoch, shouldRunNow = schedule.GetNextBackfillEpoch(1)
+	assert.Equal(t, int64(10*hour), nextScheduledEpoch)
+	assert.True(t, shouldRunNow)
+
+	// Cancelled.
+	schedule.Spec.Backfill.Cancelled = true
+	_, shouldRunNow = schedule.GetNextBackfillEpoch(0)
+	assert.False(t, shouldRunNow)
+}
+
+func TestScheduledWorkflow_UpdateBackfillStatus(t *testing.T) {
+	trigger := swfapi.Trigger{PeriodicSchedule: &swfapi.PeriodicSchedule{IntervalSecond: hour}}
+	schedule := backfillSchedule(trigger, newBackfill("B1", 10*hour, 12*hour))
+	schedule.Status.Trigger.LastIndex = commonutil.Int64Pointer(4)
+
+	schedule.UpdateBackfillStatus(nil, 0)
+	assert.Equal(t, &swfapi.BackfillStatus{
+		ID:                "B1",
+		Phase:             swfapi.BackfillRunning,
+		TotalCount:        2,
+		NextScheduledTime: commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(10*hour, 0).UTC())),
+	}, schedule.Status.Backfill)
+	assert.Equal(t, int64(4), *schedule.Status.Trigger.LastIndex)
+
+	schedule.UpdateBackfillStatus(&commonutil.Workflow{}, 10*hour)
+	assert.Equal(t, &swfapi.BackfillStatus{
+		ID:                "B1",
+		Phase:             swfapi.BackfillRunning,
+		TotalCount:        2,
+		CreatedCount:      1,
+		LastScheduledTime: commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(10*hour, 0).UTC())),
+		NextScheduledTime: commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(11*hour, 0).UTC())),
+	}, schedule.Status.Backfill)
+	assert.Equal(t, int64(5), *schedule.Status.Trigger.LastIndex)
+	// The schedule itself is not affected.
+	assert.Nil(t, schedule.Status.Trigger.LastTriggeredTime)
+
+	schedule.Spec.Backfill.Cancelled = true
+	schedule.UpdateBackfillStatus(nil, 0)
+	assert.Equal(t, swfapi.BackfillCancelled, schedule.Status.Backfill.Phase)
+	assert.Nil(t, schedule.Status.Backfill.NextScheduledTime)
+
+	schedule.Spec.Backfill.Cancelled = false
+	schedule.UpdateBackfillStatus(&commonutil.Workflow{}, 11*hour)
+	assert.Equal(t, swfapi.BackfillCompleted, schedule.Status.Backfill.Phase)
+	assert.Equal(t, int64(2), schedule.Status.Backfill.CreatedCount)
+	assert.Nil(t, schedule.Status.Backfill.NextScheduledTime)
+}
@@ -24,6 +24,20 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+const (
+	// cronStarBit mirrors the bit robfig/cron sets on a field of a
+	// SpecSchedule when the field was specified with a "*".
+	cronStarBit = 1 << 63
+
+	// A day is longer than any time zone transition, so the offsets a day
+	// before and a day after a wall clock time are the offsets on both sides
+	// of the transition, if any.
+	secondsPerDay = 24 * 60 * 60
+
+	// maxDSTShift is larger than the shift of any daylight saving transition.
+	maxDSTShift = 2 * time.Hour
+)
+
 // CronSchedule is a type to help manipulate CronSchedule objects.
 type CronSchedule struct {
 	*swfapi.CronSchedule
@@ -85,11 +99,19 @@ func (s *CronSchedule) getNextScheduledEpochImp(lastJobEpoch int64, catchup bool
 		return math.MaxInt64
 	}
 
+	location, err := s.Location()
+	if err != nil {
+		// This should never happen, validation should have caught this at resource creation.
+		log.Errorf("%+v", wraperror.Errorf(
+			"Found invalid time zone (%v): %v", s.TimeZone, err))
+		return math.MaxInt64
+	}
+
 	startEpoch := lastJobEpoch
 	if s.StartTime != nil && s.StartTime.Unix() > startEpoch {
 		startEpoch = s.StartTime.Unix()
 	}
-	result := schedule.Next(time.Unix(startEpoch, 0).UTC()).Unix()
+	result := nextInLocation(schedule, time.Unix(startEpoch, 0), location).Unix()
 
 	var endTime int64 = math.MaxInt64
 	if s.EndTime != nil {
@@ -109,7 +131,7 @@ func (s *CronSchedule) getNextScheduledEpochImp(lastJobEpoch int64, catchup bool
 	next := result
 	var nextNext int64
 	for {
-		nextNext = schedule.Next(time.Unix(next, 0).UTC()).Unix()
+		nextNext = nextInLocation(schedule, time.Unix(next, 0), location).Unix()
 		if nextNext <= nowEpoch && nextNext <= endTime {
 			next = nextNext
 		} else {
@@ -118,3 +140,91 @@ func (s *CronSchedule) getNextScheduledEpochImp(lastJobEpoch int64, catchup bool
 	}
 	return next
 }
+
+// Location returns the time zone in which the schedule is evaluated, UTC if
+// none is specified.
+func (s *CronSchedule) Location() (*time.Location, error) {
+	if s.TimeZone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(s.TimeZone)
+}
+
+// nextInLocation returns the first activation of the schedule after t, with
+// the schedule evaluated on the wall clock of the location.
+//
+// Wall clock times skipped by a daylight saving transition are activated at
+// the corresponding time after the transition, e.g. 02:30 is activated at
+// 03:30 when clocks move from 02:00 to 03:00. Wall clock times repeated by a
+// transition are activated once, at their first occurrence, unless the hour
+// field of the schedule is a "*", in which case both occurrences are
+// activated so that hourly schedules keep running every hour.
+func nextInLocation(schedule cron.Schedule, t time.Time, location *time.Location) time.Time {
+	spec, ok := schedule.(*cron.SpecSchedule)
+	if !ok || location == time.UTC {
+		// Schedules such as "@every 1h" do not depend on the wall clock.
+		return schedule.Next(t.UTC())
+	}
+	everyHour := spec.Hour&cronStarBit != 0
+
+	// Start earlier than t on the wall clock, so that the second occurrence of
+	// a repeated time is found when t is in the first one.
+	wall := wallClock(t.In(location)).Add(-maxDSTShift)
+	var next time.Time
+	for {
+		wall = spec.Next(wall)
+		if wall.IsZero() {
+			return next
+		}
+		instants := wallClockToInstants(wall, location)
+		if !everyHour {
+			instants = instants[:1]
+		}
+		for _, instant := range instants {
+			if instant.After(t) && (next.IsZero() || instant.Before(next)) {
+				next = instant
+			}
+		}
+		// The instants of later wall clock times are not earlier than the first
+		// instant of this one.
+		if instants[0].After(t) {
+			return next
+		}
+	}
+}
+
+// wallClock returns the wall clock time of t as a time in UTC.
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+}
+
+// wallClockToInstants returns the instants at which the location shows the
+// wall clock time, in chronological order. A repeated time has two instants;
+// a skipped time has the one it is moved forward to.
+func wallClockToInstants(wall time.Time, location *time.Location) []time.Time {
+	wallEpoch := wall.Unix()
+	offsetBefore := offsetAt(wallEpoch-secondsPerDay, location)
+	offsetAfter := offsetAt(wallEpoch+secondsPerDay, location)
+
+	var instants []time.Time
+	for _, offset := range []int{offsetBefore, offsetAfter} {
+		epoch := wallEpoch - int64(offset)
+		if offsetAt(epoch, location) != offset {
+			continue
+		}
+		if len(instants) > 0 && instants[0].Unix() == epoch {
+			continue
+		}
+		instants = append(instants, time.Unix(epoch, 0))
+	}
+	if len(instants) == 0 {
+		// The time is skipped, move it forward by the length of the gap.
+		instants = append(instants, time.Unix(wallEpoch-int64(offsetBefore), 0))
+	}
+	return instants
+}
+
+func offsetAt(epoch int64, location *time.Location) int {
+	_, offset := time.Unix(epoch, 0).In(location).Zone()
+	return offset
+}
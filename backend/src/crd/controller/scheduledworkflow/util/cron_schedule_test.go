@@ -161,3 +161,96 @@ func TestCronSchedule_GetNextScheduledEpochNoCatchup(t *testing.T) {
 	assert.Equal(t, int64(10*hour+15*minute+minute),
 		schedule.GetNextScheduledEpochNoCatchup(nil, defaultStartEpoch, 0))
 }
+
+func TestCronSchedule_GetNextScheduledEpoch_TimeZone(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.Nil(t, err)
+	schedule := NewCronSchedule(&swfapi.CronSchedule{
+		Cron:     "0 0 9 * * *",
+		TimeZone: "America/New_York",
+	})
+	lastJobEpoch := time.Date(2020, 1, 1, 9, 0, 0, 0, newYork).Unix()
+	assert.Equal(t, time.Date(2020, 1, 2, 9, 0, 0, 0, newYork).Unix(),
+		schedule.GetNextScheduledEpoch(&lastJobEpoch, 0))
+
+	// 9:00 stays 9:00 on the wall clock after the transition to daylight saving time.
+	lastJobEpoch = time.Date(2020, 3, 7, 9, 0, 0, 0, newYork).Unix()
+	assert.Equal(t, time.Date(2020, 3, 8, 9, 0, 0, 0, newYork).Unix(),
+		schedule.GetNextScheduledEpoch(&lastJobEpoch, 0))
+	assert.Equal(t, lastJobEpoch+23*hour, schedule.GetNextScheduledEpoch(&lastJobEpoch, 0))
+}
+
+func TestCronSchedule_GetNextScheduledEpoch_TimeZone_SkippedHour(t *testing.T) {
+	// On 2020-03-08, clocks in New York move from 02:00 EST to 03:00 EDT.
+	transition := time.Date(2020, 3, 8, 7, 0, 0, 0, time.UTC).Unix()
+
+	// Daily schedule: 02:30 runs at 03:30 EDT, and at 02:30 the day after.
+	schedule := NewCronSchedule(&swfapi.CronSchedule{
+		Cron:     "0 30 2 * * *",
+		TimeZone: "America/New_York",
+	})
+	lastJobEpoch := transition - 24*hour + 30*minute
+	nextEpoch := schedule.GetNextScheduledEpoch(&lastJobEpoch, 0)
+	assert.Equal(t, transition+30*minute, nextEpoch)
+	assert.Equal(t, transition+23*hour+30*minute, schedule.GetNextScheduledEpoch(&nextEpoch, 0))
+
+	// Hourly schedule: the skipped hour is not run.
+	schedule = NewCronSchedule(&swfapi.CronSchedule{
+		Cron:     "0 0 * * * *",
+		TimeZone: "America/New_York",
+	})
+	lastJobEpoch = transition - hour
+	nextEpoch = schedule.GetNextScheduledEpoch(&lastJobEpoch, 0)
+	assert.Equal(t, transition, nextEpoch)
+	assert.Equal(t, transition+hour, schedule.GetNextScheduledEpoch(&nextEpoch, 0))
+}
+
+func TestCronSchedule_GetNextScheduledEpoch_TimeZone_RepeatedHour(t *testing.T) {
+	// On 2020-11-01, clocks in New York move from 02:00 EDT back to 01:00 EST.
+	transition := time.Date(2020, 11, 1, 6, 0, 0, 0, time.UTC).Unix()
+
+	// Daily schedule: 01:30 runs once, at its first occurrence.
+	schedule := NewCronSchedule(&swfapi.CronSchedule{
+		Cron:     "0 30 1 * * *",
+		TimeZone: "America/New_York",
+	})
+	lastJobEpoch := transition - 24*hour - 30*minute
+	nextEpoch := schedule.GetNextScheduledEpoch(&lastJobEpoch, 0)
+	assert.Equal(t, transition-30*minute, nextEpoch)
+	assert.Equal(t, transition+24*hour+30*minute, schedule.GetNextScheduledEpoch(&nextEpoch, 0))
+
+	// Hourly schedule: both occurrences of 01:00 are run.
+	schedule = NewCronSchedule(&swfapi.CronSchedule{
+		Cron:     "0 0 * * * *",
+		TimeZone: "America/New_York",
+	})
+	lastJobEpoch = transition - 2*hour
+	nextEpoch = schedule.GetNextScheduledEpoch(&lastJobEpoch, 0)
+	assert.Equal(t, transition-hour, nextEpoch)
+	nextEpoch = schedule.GetNextScheduledEpoch(&nextEpoch, 0)
+	assert.Equal(t, transition, nextEpoch)
+	assert.Equal(t, transition+hour, schedule.GetNextScheduledEpoch(&nextEpoch, 0))
+}
+
+func TestCronSchedule_GetNextScheduledEpochNoCatchup_TimeZone(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.Nil(t, err)
+	schedule := NewCronSchedule(&swfapi.CronSchedule{
+		Cron:     "0 0 9 * * *",
+		TimeZone: "America/New_York",
+	})
+	lastJobEpoch := time.Date(2020, 3, 1, 9, 0, 0, 0, newYork).Unix()
+	nowEpoch := time.Date(2020, 3, 10, 12, 0, 0, 0, newYork).Unix()
+	assert.Equal(t, time.Date(2020, 3, 10, 9, 0, 0, 0, newYork).Unix(),
+		schedule.GetNextScheduledEpochNoCatchup(&lastJobEpoch, 0, nowEpoch))
+}
+
+func TestCronSchedule_getNextScheduledEpoch_InvalidTimeZone(t *testing.T) {
+	schedule := NewCronSchedule(&swfapi.CronSchedule{
+		Cron:     "0 * * * * *",
+		TimeZone: "Mars/Olympus_Mons",
+	})
+	lastJobEpoch := int64(0)
+	assert.Equal(t, int64(math.MaxInt64),
+		schedule.getNextScheduledEpoch(lastJobEpoch))
+}
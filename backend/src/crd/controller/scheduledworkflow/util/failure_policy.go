@@ -0,0 +1,106 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"sort"
+
+	workflowapi "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+)
+
+// maxConsecutiveFailures returns the number of consecutive failed workflows
+// after which the schedule is disabled, or 0 if failures never disable it.
+func (s *ScheduledWorkflow) maxConsecutiveFailures() int64 {
+	if s.Spec.FailurePolicy == nil {
+		return 0
+	}
+	return s.Spec.FailurePolicy.MaxConsecutiveFailures
+}
+
+func (s *ScheduledWorkflow) disabledByFailures() bool {
+	return !s.enabled() && s.Status.FailurePolicy != nil && s.Status.FailurePolicy.Disabled
+}
+
+// countConsecutiveFailures returns the number of the completed workflows with
+// the highest indexes that failed, ignoring the workflows with an index lower
+// than or equal to minIndex.
+func countConsecutiveFailures(completed []swfapi.WorkflowStatus, minIndex int64) int64 {
+	sorted := make([]swfapi.WorkflowStatus, len(completed))
+	copy(sorted, completed)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Index > sorted[j].Index
+	})
+	var count int64
+	for _, workflow := range sorted {
+		if workflow.Index <= minIndex {
+			break
+		}
+		if workflow.Phase != workflowapi.NodeFailed && workflow.Phase != workflowapi.NodeError {
+			break
+		}
+		count++
+	}
+	return count
+}
+
+// ShouldDisableForFailures returns whether the failure policy should disable
+// the schedule, given its completed workflows.
+func (s *ScheduledWorkflow) ShouldDisableForFailures(completed []swfapi.WorkflowStatus) bool {
+	maxFailures := s.maxConsecutiveFailures()
+	if !s.enabled() || maxFailures <= 0 {
+		return false
+	}
+	var ignoredUntilIndex int64
+	if s.Status.FailurePolicy != nil {
+		ignoredUntilIndex = s.Status.FailurePolicy.IgnoredUntilIndex
+	}
+	return countConsecutiveFailures(completed, ignoredUntilIndex) >= maxFailures
+}
+
+// DisableForFailures disables the schedule because of its failed workflows.
+// The workflows created so far do not count as failures once the schedule is
+// enabled again.
+func (s *ScheduledWorkflow) DisableForFailures() {
+	s.Spec.Enabled = false
+	s.Status.FailurePolicy = &swfapi.FailurePolicyStatus{
+		Disabled:          true,
+		IgnoredUntilIndex: s.lastIndex(),
+	}
+}
+
+// updateFailurePolicyStatus resets the status of the failure policy once the
+// schedule is enabled again.
+func (s *ScheduledWorkflow) updateFailurePolicyStatus() {
+	if s.enabled() && s.Status.FailurePolicy != nil {
+		s.Status.FailurePolicy.Disabled = false
+	}
+}
+
+// blackoutEndEpoch returns the end of the blackout window of the schedule
+// that contains nowEpoch, and whether there is one.
+func (s *ScheduledWorkflow) blackoutEndEpoch(nowEpoch int64) (int64, bool) {
+	for _, window := range s.Spec.Blackouts {
+		if window.StartTime.Unix() <= nowEpoch && nowEpoch < window.EndTime.Unix() {
+			return window.EndTime.Unix(), true
+		}
+	}
+	return 0, false
+}
+
+func (s *ScheduledWorkflow) inBlackout(nowEpoch int64) bool {
+	_, ok := s.blackoutEndEpoch(nowEpoch)
+	return ok
+}
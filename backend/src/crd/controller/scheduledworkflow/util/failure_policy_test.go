@@ -0,0 +1,117 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	workflowapi "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func failurePolicySchedule(maxConsecutiveFailures int64) *ScheduledWorkflow {
+	return NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "SCHEDULE1"},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:        true,
+			MaxConcurrency: commonutil.Int64Pointer(1),
+			Trigger: swfapi.Trigger{PeriodicSchedule: &swfapi.PeriodicSchedule{
+				IntervalSecond: hour,
+			}},
+			FailurePolicy: &swfapi.FailurePolicy{MaxConsecutiveFailures: maxConsecutiveFailures},
+		},
+		Status: swfapi.ScheduledWorkflowStatus{
+			Trigger: swfapi.TriggerStatus{LastIndex: commonutil.Int64Pointer(4)},
+		},
+	})
+}
+
+func completedWorkflows(phases ...workflowapi.NodePhase) []swfapi.WorkflowStatus {
+	result := make([]swfapi.WorkflowStatus, 0, len(phases))
+	for index, phase := range phases {
+		result = append(result, swfapi.WorkflowStatus{Index: int64(index + 1), Phase: phase})
+	}
+	return result
+}
+
+func TestCountConsecutiveFailures(t *testing.T) {
+	completed := completedWorkflows(
+		workflowapi.NodeFailed, workflowapi.NodeSucceeded, workflowapi.NodeError, workflowapi.NodeFailed)
+	// The order of the history does not matter.
+	completed[0], completed[3] = completed[3], completed[0]
+	assert.Equal(t, int64(2), countConsecutiveFailures(completed, 0))
+	assert.Equal(t, int64(1), countConsecutiveFailures(completed, 3))
+	assert.Equal(t, int64(0), countConsecutiveFailures(completed, 4))
+	assert.Equal(t, int64(0), countConsecutiveFailures(nil, 0))
+}
+
+func TestScheduledWorkflow_ShouldDisableForFailures(t *testing.T) {
+	completed := completedWorkflows(
+		workflowapi.NodeSucceeded, workflowapi.NodeFailed, workflowapi.NodeFailed, workflowapi.NodeFailed)
+
+	assert.False(t, failurePolicySchedule(0).ShouldDisableForFailures(completed))
+	assert.False(t, failurePolicySchedule(4).ShouldDisableForFailures(completed))
+	schedule := failurePolicySchedule(3)
+	assert.True(t, schedule.ShouldDisableForFailures(completed))
+
+	schedule.DisableForFailures()
+	assert.False(t, schedule.Spec.Enabled)
+	assert.Equal(t, &swfapi.FailurePolicyStatus{Disabled: true, IgnoredUntilIndex: 4}, schedule.Status.FailurePolicy)
+	assert.False(t, schedule.ShouldDisableForFailures(completed))
+	schedule.UpdateStatus(10*hour, nil, 11*hour, nil, completed)
+	assert.Equal(t, swfapi.ScheduledWorkflowDisabledByFailures, schedule.Status.Conditions[0].Type)
+	assert.Equal(t, "The schedule was disabled after 3 consecutive failed workflows.", schedule.Status.Conditions[0].Message)
+
+	// Once enabled again, the same failures do not disable the schedule.
+	schedule.Spec.Enabled = true
+	assert.False(t, schedule.ShouldDisableForFailures(completed))
+	schedule.UpdateStatus(10*hour, nil, 11*hour, nil, completed)
+	assert.Equal(t, swfapi.ScheduledWorkflowEnabled, schedule.Status.Conditions[0].Type)
+	assert.False(t, schedule.Status.FailurePolicy.Disabled)
+
+	// A manually disabled schedule is just disabled.
+	schedule.Spec.Enabled = false
+	schedule.UpdateStatus(10*hour, nil, 11*hour, nil, completed)
+	assert.Equal(t, swfapi.ScheduledWorkflowDisabled, schedule.Status.Conditions[0].Type)
+}
+
+func TestScheduledWorkflow_Blackout(t *testing.T) {
+	schedule := failurePolicySchedule(0)
+	schedule.Spec.Blackouts = []swfapi.TimeWindow{{
+		StartTime: metav1.NewTime(time.Unix(10*hour, 0).UTC()),
+		EndTime:   metav1.NewTime(time.Unix(12*hour, 0).UTC()),
+	}}
+	schedule.Status.Trigger.LastTriggeredTime = commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(9*hour, 0).UTC()))
+
+	nextScheduledEpoch, shouldRunNow := schedule.GetNextScheduledEpoch(0, 10*hour)
+	assert.Equal(t, int64(10*hour), nextScheduledEpoch)
+	assert.False(t, shouldRunNow)
+	assert.False(t, schedule.CanCreateWorkflow(0, 11*hour))
+	schedule.UpdateStatus(11*hour, nil, nextScheduledEpoch, nil, nil)
+	assert.Equal(t, swfapi.ScheduledWorkflowPaused, schedule.Status.Conditions[0].Type)
+	assert.Equal(t, "The schedule is paused until 1970-01-01T12:00:00Z.", schedule.Status.Conditions[0].Message)
+
+	// The end of the window is exclusive.
+	nextScheduledEpoch, shouldRunNow = schedule.GetNextScheduledEpoch(0, 12*hour)
+	assert.Equal(t, int64(10*hour), nextScheduledEpoch)
+	assert.True(t, shouldRunNow)
+	assert.True(t, schedule.CanCreateWorkflow(0, 12*hour))
+	schedule.UpdateStatus(12*hour, nil, nextScheduledEpoch, nil, nil)
+	assert.Equal(t, swfapi.ScheduledWorkflowEnabled, schedule.Status.Conditions[0].Type)
+}
@@ -0,0 +1,43 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// HasPendingManualTrigger returns whether the manual trigger of the spec has
+// not created its workflow yet.
+func (s *ScheduledWorkflow) HasPendingManualTrigger() bool {
+	return s.Spec.ManualTrigger != nil && s.Spec.ManualTrigger.ID != s.Status.Trigger.LastManualTriggerID
+}
+
+// ShouldRunManualTriggerNow returns whether the workflow of the manual trigger
+// should be created now, given the number of active workflows. The manual
+// trigger ignores whether the schedule is enabled.
+func (s *ScheduledWorkflow) ShouldRunManualTriggerNow(activeWorkflowCount int64) bool {
+	return s.HasPendingManualTrigger() && activeWorkflowCount < s.maxConcurrency()
+}
+
+// UpdateManualTriggerStatus records that the manual trigger created the
+// workflow, if any. The last triggered time of the schedule is unchanged so
+// that the manual trigger does not skip any time of the schedule.
+func (s *ScheduledWorkflow) UpdateManualTriggerStatus(workflow *commonutil.Workflow) {
+	if workflow == nil || s.Spec.ManualTrigger == nil {
+		return
+	}
+	s.Status.Trigger.LastManualTriggerID = s.Spec.ManualTrigger.ID
+	s.Status.Trigger.LastIndex = commonutil.Int64Pointer(s.nextIndex())
+}
@@ -0,0 +1,75 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	workflowapi "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func manualTriggerSchedule(manualTrigger *swfapi.ManualTrigger) *ScheduledWorkflow {
+	return NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "SCHEDULE1"},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:        false,
+			MaxConcurrency: commonutil.Int64Pointer(2),
+			Trigger: swfapi.Trigger{CronSchedule: &swfapi.CronSchedule{
+				Cron: "0 0 * * * *",
+			}},
+			ManualTrigger: manualTrigger,
+		},
+	})
+}
+
+func TestScheduledWorkflow_ShouldRunManualTriggerNow(t *testing.T) {
+	schedule := manualTriggerSchedule(nil)
+	assert.False(t, schedule.HasPendingManualTrigger())
+	assert.False(t, schedule.ShouldRunManualTriggerNow(0))
+
+	// The manual trigger runs even if the schedule is disabled, within the max concurrency.
+	schedule = manualTriggerSchedule(&swfapi.ManualTrigger{ID: "M1"})
+	assert.True(t, schedule.HasPendingManualTrigger())
+	assert.True(t, schedule.ShouldRunManualTriggerNow(1))
+	assert.False(t, schedule.ShouldRunManualTriggerNow(2))
+
+	schedule.Status.Trigger.LastManualTriggerID = "M1"
+	assert.False(t, schedule.HasPendingManualTrigger())
+	assert.False(t, schedule.ShouldRunManualTriggerNow(0))
+}
+
+func TestScheduledWorkflow_UpdateManualTriggerStatus(t *testing.T) {
+	lastTriggeredTime := commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(10*hour, 0).UTC()))
+	schedule := manualTriggerSchedule(&swfapi.ManualTrigger{ID: "M1"})
+	schedule.Status.Trigger.LastTriggeredTime = lastTriggeredTime
+	schedule.Status.Trigger.LastIndex = commonutil.Int64Pointer(4)
+
+	// Nothing changes until the workflow is created.
+	schedule.UpdateManualTriggerStatus(nil)
+	assert.True(t, schedule.HasPendingManualTrigger())
+	assert.Equal(t, int64(4), *schedule.Status.Trigger.LastIndex)
+
+	schedule.UpdateManualTriggerStatus(commonutil.NewWorkflow(&workflowapi.Workflow{}))
+	assert.Equal(t, swfapi.TriggerStatus{
+		LastTriggeredTime:   lastTriggeredTime,
+		LastIndex:           commonutil.Int64Pointer(5),
+		LastManualTriggerID: "M1",
+	}, schedule.Status.Trigger)
+}
@@ -0,0 +1,58 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"hash/fnv"
+
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// NamespaceShard selects the namespaces whose ScheduledWorkflows are handled
+// by a replica of the controller when the work is sharded across replicas.
+// The namespaces are assigned to the shards by hash.
+type NamespaceShard struct {
+	count uint32
+	index uint32
+}
+
+// NewNamespaceShard returns the shard of the given index out of count shards.
+func NewNamespaceShard(count int, index int) (*NamespaceShard, error) {
+	if count < 1 {
+		return nil, commonutil.NewInvalidInputError(
+			"The number of shards must be at least 1. Received %v.", count)
+	}
+	if index < 0 || index >= count {
+		return nil, commonutil.NewInvalidInputError(
+			"The shard index must be in the range 0-%v. Received %v.", count-1, index)
+	}
+	return &NamespaceShard{count: uint32(count), index: uint32(index)}, nil
+}
+
+// IsSharded returns whether the work is split across several shards.
+func (s *NamespaceShard) IsSharded() bool {
+	return s != nil && s.count > 1
+}
+
+// Owns returns whether the ScheduledWorkflows of the namespace belong to the
+// shard. Without sharding, the shard owns all namespaces.
+func (s *NamespaceShard) Owns(namespace string) bool {
+	if !s.IsSharded() {
+		return true
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(namespace))
+	return hash.Sum32()%s.count == s.index
+}
@@ -0,0 +1,71 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNamespaceShard_InvalidShard(t *testing.T) {
+	_, err := NewNamespaceShard(0, 0)
+	assert.Contains(t, err.Error(), "The number of shards must be at least 1")
+
+	_, err = NewNamespaceShard(3, 3)
+	assert.Contains(t, err.Error(), "The shard index must be in the range 0-2")
+
+	_, err = NewNamespaceShard(3, -1)
+	assert.Contains(t, err.Error(), "The shard index must be in the range 0-2")
+}
+
+func TestNamespaceShard_NotSharded(t *testing.T) {
+	shard, err := NewNamespaceShard(1, 0)
+	assert.Nil(t, err)
+	assert.False(t, shard.IsSharded())
+	assert.True(t, shard.Owns("ns1"))
+
+	var noShard *NamespaceShard
+	assert.False(t, noShard.IsSharded())
+	assert.True(t, noShard.Owns("ns1"))
+}
+
+func TestNamespaceShard_Owns(t *testing.T) {
+	var shards []*NamespaceShard
+	for index := 0; index < 3; index++ {
+		shard, err := NewNamespaceShard(3, index)
+		assert.Nil(t, err)
+		assert.True(t, shard.IsSharded())
+		shards = append(shards, shard)
+	}
+
+	// Each namespace is owned by exactly one shard.
+	owned := make([]int, len(shards))
+	for i := 0; i < 30; i++ {
+		namespace := fmt.Sprintf("ns%v", i)
+		owners := 0
+		for index, shard := range shards {
+			if shard.Owns(namespace) {
+				owners++
+				owned[index]++
+			}
+		}
+		assert.Equal(t, 1, owners, namespace)
+	}
+	for index := range shards {
+		assert.True(t, owned[index] > 0, "shard %v owns no namespace", index)
+	}
+}
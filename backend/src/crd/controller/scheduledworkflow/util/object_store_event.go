@@ -0,0 +1,134 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"time"
+
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StoredObject is an object of the bucket watched by an object store event
+// trigger.
+type StoredObject struct {
+	Key          string
+	LastModified time.Time
+}
+
+// IsObjectStoreEventTriggered returns whether the schedule creates workflows
+// for new objects of an object store, rather than at scheduled times.
+func (s *ScheduledWorkflow) IsObjectStoreEventTriggered() bool {
+	return s.Spec.Trigger.ObjectStoreEvent != nil
+}
+
+// CanCreateWorkflow returns whether a new workflow may be created now, given
+// the number of active workflows.
+func (s *ScheduledWorkflow) CanCreateWorkflow(activeWorkflowCount int64, nowEpoch int64) bool {
+	return s.enabled() && !s.inBlackout(nowEpoch) && activeWorkflowCount < s.maxConcurrency()
+}
+
+// NextObjectToTrigger returns the least recently modified object that has not
+// created a workflow yet, or nil if there is none. Objects modified before the
+// creation of the schedule never create a workflow.
+//
+// Objects are compared at the second precision of their modification time,
+// which is the precision at which the status is stored.
+func (s *ScheduledWorkflow) NextObjectToTrigger(objects []StoredObject) *StoredObject {
+	lastEpoch := s.creationEpoch()
+	lastKeys := make(map[string]bool)
+	status := s.Status.Trigger.ObjectStoreEvent
+	if status != nil && status.LastObjectModifiedTime != nil {
+		lastEpoch = status.LastObjectModifiedTime.Unix()
+		for _, key := range status.LastObjectKeys {
+			lastKeys[key] = true
+		}
+	}
+
+	var next *StoredObject
+	for i := range objects {
+		object := &objects[i]
+		epoch := object.LastModified.Unix()
+		if epoch < lastEpoch || (epoch == lastEpoch && lastKeys[object.Key]) {
+			continue
+		}
+		if next == nil || epoch < next.LastModified.Unix() ||
+			(epoch == next.LastModified.Unix() && object.Key < next.Key) {
+			next = object
+		}
+	}
+	return next
+}
+
+// ObjectScan spreads the listing of the objects watched by a schedule over
+// several syncs, so that each sync lists a bounded number of objects. The
+// object to trigger is only known once the whole listing is scanned.
+type ObjectScan struct {
+	startAfter string
+	next       *StoredObject
+}
+
+// StartAfter returns the key after which the next page of the listing starts.
+// It is empty at the start of a scan.
+func (o *ObjectScan) StartAfter() string {
+	return o.startAfter
+}
+
+// AddPage scans a page of the listing. Once the last page is scanned, it
+// returns the least recently modified object of the whole listing that has not
+// created a workflow yet (or nil) and true, and the next scan starts over.
+// Until then, it returns nil and false.
+func (o *ObjectScan) AddPage(s *ScheduledWorkflow, objects []StoredObject, truncated bool) (*StoredObject, bool) {
+	candidates := objects
+	if o.next != nil {
+		candidates = append(append([]StoredObject{}, objects...), *o.next)
+	}
+	next := s.NextObjectToTrigger(candidates)
+	if truncated && len(objects) > 0 {
+		o.startAfter = objects[len(objects)-1].Key
+		o.next = next
+		return nil, false
+	}
+	o.startAfter = ""
+	o.next = nil
+	return next, true
+}
+
+// NewWorkflowForObject creates a workflow for a new object. The key of the
+// object is passed in the key parameter of the trigger, and its modification
+// time is the scheduled time of the workflow.
+func (s *ScheduledWorkflow) NewWorkflowForObject(object *StoredObject, nowEpoch int64) (
+	*commonutil.Workflow, error) {
+	scheduledEpoch := object.LastModified.Unix()
+	return s.newWorkflow(scheduledEpoch, s.previousScheduledEpoch(scheduledEpoch), nowEpoch, map[string]string{
+		s.Spec.Trigger.ObjectStoreEvent.KeyParameter: object.Key,
+	})
+}
+
+// UpdateObjectStoreEventStatus records that the object created a workflow, so
+// that it does not create another one.
+func (s *ScheduledWorkflow) UpdateObjectStoreEventStatus(object *StoredObject) {
+	epoch := object.LastModified.Unix()
+	status := s.Status.Trigger.ObjectStoreEvent
+	if status != nil && status.LastObjectModifiedTime != nil && status.LastObjectModifiedTime.Unix() == epoch {
+		status.LastObjectKeys = append(status.LastObjectKeys, object.Key)
+		return
+	}
+	s.Status.Trigger.ObjectStoreEvent = &swfapi.ObjectStoreEventTriggerStatus{
+		LastObjectModifiedTime: commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(epoch, 0).UTC())),
+		LastObjectKeys:         []string{object.Key},
+	}
+}
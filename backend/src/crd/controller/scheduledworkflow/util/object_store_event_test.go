@@ -0,0 +1,184 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	workflowapi "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func objectStoreEventSchedule(status *swfapi.ObjectStoreEventTriggerStatus) *ScheduledWorkflow {
+	return NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "SCHEDULE1",
+			CreationTimestamp: metav1.NewTime(time.Unix(10*hour, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled: true,
+			Trigger: swfapi.Trigger{
+				ObjectStoreEvent: &swfapi.ObjectStoreEventTrigger{
+					Bucket:       "BUCKET",
+					Prefix:       "data/",
+					KeyParameter: "KEY",
+				},
+			},
+			Workflow: &swfapi.WorkflowResource{
+				Parameters: []swfapi.Parameter{
+					{Name: "KEY", Value: "IGNORED"},
+					{Name: "TIME", Value: "[[ScheduledTime]]"},
+				},
+				Spec: workflowapi.WorkflowSpec{
+					Arguments: workflowapi.Arguments{
+						Parameters: []workflowapi.Parameter{
+							{Name: "KEY", Value: commonutil.StringPointer("")},
+							{Name: "TIME", Value: commonutil.StringPointer("")},
+						},
+					},
+				},
+			},
+		},
+		Status: swfapi.ScheduledWorkflowStatus{
+			Trigger: swfapi.TriggerStatus{ObjectStoreEvent: status},
+		},
+	})
+}
+
+func storedObject(key string, epoch int64) StoredObject {
+	return StoredObject{Key: key, LastModified: time.Unix(epoch, 0).UTC()}
+}
+
+func TestScheduledWorkflow_IsObjectStoreEventTriggered(t *testing.T) {
+	schedule := objectStoreEventSchedule(nil)
+	assert.True(t, schedule.IsObjectStoreEventTriggered())
+	assert.False(t, schedule.isOneOffRun())
+	nextScheduledEpoch, shouldRunNow := schedule.GetNextScheduledEpoch(0, 11*hour)
+	assert.False(t, shouldRunNow)
+	assert.Equal(t, int64(math.MaxInt64), nextScheduledEpoch)
+}
+
+func TestScheduledWorkflow_CanCreateWorkflow(t *testing.T) {
+	schedule := objectStoreEventSchedule(nil)
+	assert.True(t, schedule.CanCreateWorkflow(0, 0))
+	assert.False(t, schedule.CanCreateWorkflow(1, 0))
+
+	schedule.Spec.Enabled = false
+	assert.False(t, schedule.CanCreateWorkflow(0, 0))
+}
+
+func TestScheduledWorkflow_NextObjectToTrigger(t *testing.T) {
+	objects := []StoredObject{
+		storedObject("data/c", 12*hour),
+		storedObject("data/old", 9*hour),
+		storedObject("data/b", 11*hour),
+		storedObject("data/a", 11*hour),
+	}
+
+	// Objects created before the schedule are ignored.
+	schedule := objectStoreEventSchedule(nil)
+	assert.Equal(t, &objects[3], schedule.NextObjectToTrigger(objects))
+
+	// Objects already seen at the last modification time are ignored.
+	schedule = objectStoreEventSchedule(&swfapi.ObjectStoreEventTriggerStatus{
+		LastObjectModifiedTime: commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(11*hour, 0).UTC())),
+		LastObjectKeys:         []string{"data/a"},
+	})
+	assert.Equal(t, &objects[2], schedule.NextObjectToTrigger(objects))
+
+	schedule.Status.Trigger.ObjectStoreEvent.LastObjectKeys = []string{"data/a", "data/b"}
+	assert.Equal(t, &objects[0], schedule.NextObjectToTrigger(objects))
+
+	// No new object.
+	schedule.Status.Trigger.ObjectStoreEvent.LastObjectModifiedTime =
+		commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(12*hour, 0).UTC()))
+	schedule.Status.Trigger.ObjectStoreEvent.LastObjectKeys = []string{"data/c"}
+	assert.Nil(t, schedule.NextObjectToTrigger(objects))
+	assert.Nil(t, schedule.NextObjectToTrigger(nil))
+}
+
+func TestObjectScan_AddPage(t *testing.T) {
+	schedule := objectStoreEventSchedule(nil)
+	scan := &ObjectScan{}
+	assert.Equal(t, "", scan.StartAfter())
+
+	// The least recently modified object is only known once the last page is scanned.
+	object, done := scan.AddPage(schedule, []StoredObject{
+		storedObject("data/a", 12*hour),
+		storedObject("data/b", 13*hour),
+	}, true)
+	assert.Nil(t, object)
+	assert.False(t, done)
+	assert.Equal(t, "data/b", scan.StartAfter())
+
+	object, done = scan.AddPage(schedule, []StoredObject{
+		storedObject("data/c", 11*hour),
+		storedObject("data/old", 9*hour),
+	}, true)
+	assert.Nil(t, object)
+	assert.False(t, done)
+	assert.Equal(t, "data/old", scan.StartAfter())
+
+	object, done = scan.AddPage(schedule, []StoredObject{storedObject("data/z", 14*hour)}, false)
+	assert.True(t, done)
+	assert.Equal(t, "data/c", object.Key)
+
+	// The next scan starts over.
+	assert.Equal(t, "", scan.StartAfter())
+	object, done = scan.AddPage(schedule, nil, false)
+	assert.True(t, done)
+	assert.Nil(t, object)
+}
+
+func TestScheduledWorkflow_UpdateObjectStoreEventStatus(t *testing.T) {
+	schedule := objectStoreEventSchedule(nil)
+
+	a := storedObject("data/a", 11*hour)
+	schedule.UpdateObjectStoreEventStatus(&a)
+	assert.Equal(t, &swfapi.ObjectStoreEventTriggerStatus{
+		LastObjectModifiedTime: commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(11*hour, 0).UTC())),
+		LastObjectKeys:         []string{"data/a"},
+	}, schedule.Status.Trigger.ObjectStoreEvent)
+
+	b := storedObject("data/b", 11*hour)
+	schedule.UpdateObjectStoreEventStatus(&b)
+	assert.Equal(t, []string{"data/a", "data/b"}, schedule.Status.Trigger.ObjectStoreEvent.LastObjectKeys)
+
+	c := storedObject("data/c", 12*hour)
+	schedule.UpdateObjectStoreEventStatus(&c)
+	assert.Equal(t, &swfapi.ObjectStoreEventTriggerStatus{
+		LastObjectModifiedTime: commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(12*hour, 0).UTC())),
+		LastObjectKeys:         []string{"data/c"},
+	}, schedule.Status.Trigger.ObjectStoreEvent)
+}
+
+func TestScheduledWorkflow_NewWorkflowForObject(t *testing.T) {
+	schedule := objectStoreEventSchedule(nil)
+	schedule.uuid = commonutil.NewFakeUUIDGeneratorOrFatal("123e4567-e89b-12d3-a456-426655440001", nil)
+
+	object := storedObject("data/a", 11*hour)
+	result, err := schedule.NewWorkflowForObject(&object, 12*hour)
+	assert.Nil(t, err)
+	assert.Equal(t, []workflowapi.Parameter{
+		{Name: "KEY", Value: commonutil.StringPointer("data/a")},
+		{Name: "TIME", Value: commonutil.StringPointer("19700101110000")},
+	}, result.Spec.Arguments.Parameters)
+	assert.Equal(t, int64(11*hour), result.ScheduledAtInSecOr0())
+}
@@ -19,41 +19,64 @@ import (
 	"regexp"
 	"strings"
 	"time"
+
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
 )
 
 const (
-	scheduledTimeExpression = "[[ScheduledTime]]"
-	currentTimeExpression   = "[[CurrentTime]]"
-	IndexExpression         = "[[Index]]"
-	scheduledTimePrefix     = "[[ScheduledTime."
-	currentTimePrefix       = "[[CurrentTime."
-	defaultTimeFormat       = "20060102150405"
-	suffix                  = "]]"
+	IndexExpression   = "[[Index]]"
+	jobNameExpression = "[[JobName]]"
+	jobIDExpression   = "[[JobID]]"
+	runIDExpression   = "[[RunID]]"
+	defaultTimeFormat = "20060102150405"
+)
+
+var (
+	// A macro is a name within double brackets, so that values such as the
+	// nested JSON list [[1, 2], [3, 4]] are not mistaken for macros.
+	macroRegexp = regexp.MustCompile(`\[\[[A-Za-z]+[^\[\]]*\]\]`)
+	// A time macro is the name of a time, optionally followed by an offset
+	// (a signed Go duration such as -24h or +1h30m) and by a Go time layout
+	// after a dot.
+	timeMacroRegexp = regexp.MustCompile(
+		`^\[\[(ScheduledTime|PreviousScheduledTime|CurrentTime)` +
+			`([+-](?:[0-9]+(?:\.[0-9]+)?(?:ns|us|µs|ms|s|m|h))+)?(?:\.(.+))?\]\]$`)
 )
 
 // ParameterFormatter is an object that substitutes specific strings
 // in workflow parameters by information about the workflow execution (time at
 // which the workflow was started, time at which the workflow was scheduled, etc.)
 type ParameterFormatter struct {
-	scheduledEpoch int64
-	nowEpoch       int64
-	index          int64
+	scheduledEpoch         int64
+	previousScheduledEpoch int64
+	nowEpoch               int64
+	index                  int64
+	location               *time.Location
+	jobName                string
+	jobID                  string
+	runID                  string
 }
 
-// NewParameterFormatter returns a new ParameterFormatter.
-func NewParameterFormatter(scheduledEpoch int64, nowEpoch int64,
-	index int64) *ParameterFormatter {
+// NewParameterFormatter returns a new ParameterFormatter. Times are formatted
+// in the provided location.
+func NewParameterFormatter(scheduledEpoch int64, previousScheduledEpoch int64, nowEpoch int64,
+	index int64, location *time.Location, jobName string, jobID string, runID string) *ParameterFormatter {
 	return &ParameterFormatter{
-		scheduledEpoch: scheduledEpoch,
-		nowEpoch:       nowEpoch,
-		index:          index,
+		scheduledEpoch:         scheduledEpoch,
+		previousScheduledEpoch: previousScheduledEpoch,
+		nowEpoch:               nowEpoch,
+		index:                  index,
+		location:               location,
+		jobName:                jobName,
+		jobID:                  jobID,
+		runID:                  runID,
 	}
 }
 
-// Format substitutes special strings in the provided string.
+// Format substitutes special strings in the provided string. Unknown macros
+// are left as is.
 func (p *ParameterFormatter) Format(s string) string {
-	re := regexp.MustCompile(`\[\[(.*?)\]\]`)
-	matches := re.FindAllString(s, -1)
+	matches := macroRegexp.FindAllString(s, -1)
 	if matches == nil {
 		return s
 	}
@@ -61,30 +84,67 @@ func (p *ParameterFormatter) Format(s string) string {
 	result := s
 
 	for _, match := range matches {
-		substitute := p.createSubtitute(match)
+		substitute, ok := p.createSubtitute(match)
+		if !ok {
+			substitute = match
+		}
 		result = strings.Replace(result, match, substitute, 1)
 	}
 
 	return result
 }
 
-func (p *ParameterFormatter) createSubtitute(match string) string {
+func (p *ParameterFormatter) createSubtitute(match string) (string, bool) {
+	switch match {
+	case IndexExpression:
+		return fmt.Sprintf("%v", p.index), true
+	case jobNameExpression:
+		return p.jobName, true
+	case jobIDExpression:
+		return p.jobID, true
+	case runIDExpression:
+		return p.runID, true
+	}
+
+	groups := timeMacroRegexp.FindStringSubmatch(match)
+	if groups == nil {
+		return "", false
+	}
+	var epoch int64
+	switch groups[1] {
+	case "ScheduledTime":
+		epoch = p.scheduledEpoch
+	case "PreviousScheduledTime":
+		epoch = p.previousScheduledEpoch
+	default:
+		epoch = p.nowEpoch
+	}
+	t := time.Unix(epoch, 0).In(p.location)
+	if groups[2] != "" {
+		offset, err := time.ParseDuration(groups[2])
+		if err != nil {
+			return "", false
+		}
+		t = t.Add(offset)
+	}
+	layout := defaultTimeFormat
+	if groups[3] != "" {
+		layout = groups[3]
+	}
+	return t.Format(layout), true
+}
 
-	if strings.HasPrefix(match, scheduledTimeExpression) {
-		return time.Unix(p.scheduledEpoch, 0).UTC().Format(defaultTimeFormat)
-	} else if strings.HasPrefix(match, currentTimeExpression) {
-		return time.Unix(p.nowEpoch, 0).UTC().Format(defaultTimeFormat)
-	} else if strings.HasPrefix(match, IndexExpression) {
-		return fmt.Sprintf("%v", p.index)
-	} else if strings.HasPrefix(match, scheduledTimePrefix) {
-		match = strings.Replace(match, scheduledTimePrefix, "", 1)
-		match = strings.Replace(match, suffix, "", 1)
-		return time.Unix(p.scheduledEpoch, 0).UTC().Format(match)
-	} else if strings.HasPrefix(match, currentTimePrefix) {
-		match = strings.Replace(match, currentTimePrefix, "", 1)
-		match = strings.Replace(match, suffix, "", 1)
-		return time.Unix(p.nowEpoch, 0).UTC().Format(match)
-	} else {
-		return match
+// ValidateParameterMacros returns an error if the provided string contains a
+// macro that the ParameterFormatter does not know.
+func ValidateParameterMacros(s string) error {
+	formatter := NewParameterFormatter(0, 0, 0, 0, time.UTC, "", "", "")
+	for _, match := range macroRegexp.FindAllString(s, -1) {
+		if _, ok := formatter.createSubtitute(match); !ok {
+			return commonutil.NewInvalidInputError(
+				"Unknown macro %v. The supported macros are [[ScheduledTime]], [[PreviousScheduledTime]] and "+
+					"[[CurrentTime]], optionally with an offset and a time layout such as [[ScheduledTime-24h.2006-01-02]], "+
+					"and [[Index]], [[JobName]], [[JobID]] and [[RunID]]", match)
+		}
 	}
+	return nil
 }
@@ -16,6 +16,7 @@ package util
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -23,8 +24,13 @@ import (
 func TestParameterFormatter_Format(t *testing.T) {
 	formatter := NewParameterFormatter(
 		25, /* scheduled time */
+		20, /* previous scheduled time */
 		26, /* current time */
-		27 /* index */)
+		27, /* index */
+		time.UTC,
+		"JOB", /* job name */
+		"JOB_ID",
+		"RUN_ID")
 
 	// Test [[ScheduledTime]] substitution
 	assert.Equal(t, "FOO 19700101000025 FOO", formatter.Format("FOO [[ScheduledTime]] FOO"))
@@ -49,4 +55,75 @@ func TestParameterFormatter_Format(t *testing.T) {
 
 	// Test empty string
 	assert.Equal(t, "", formatter.Format(""))
+
+	// Test unknown macro
+	assert.Equal(t, "FOO [[Unknown]] FOO", formatter.Format("FOO [[Unknown]] FOO"))
+}
+
+func TestParameterFormatter_Format_Macros(t *testing.T) {
+	const day = 24 * 3600
+	formatter := NewParameterFormatter(
+		10*day+3600, /* scheduled time */
+		9*day+3600,  /* previous scheduled time */
+		10*day+7200, /* current time */
+		27,          /* index */
+		time.UTC,
+		"JOB", /* job name */
+		"JOB_ID",
+		"RUN_ID")
+
+	// Test [[PreviousScheduledTime]] substitution
+	assert.Equal(t, "19700110010000", formatter.Format("[[PreviousScheduledTime]]"))
+	assert.Equal(t, "1970-01-10", formatter.Format("[[PreviousScheduledTime.2006-01-02]]"))
+
+	// Test time offsets
+	assert.Equal(t, "1970-01-10", formatter.Format("[[ScheduledTime-24h.2006-01-02]]"))
+	assert.Equal(t, "19700111023000", formatter.Format("[[ScheduledTime+1h30m]]"))
+	assert.Equal(t, "01:30", formatter.Format("[[CurrentTime-0.5h.15:04]]"))
+
+	// Test job and run substitution
+	assert.Equal(t, "JOB JOB_ID RUN_ID", formatter.Format("[[JobName]] [[JobID]] [[RunID]]"))
+}
+
+func TestValidateParameterMacros(t *testing.T) {
+	assert.Nil(t, ValidateParameterMacros("FOO FOO"))
+	assert.Nil(t, ValidateParameterMacros("[[1, 2], [3, 4]]"))
+	assert.Nil(t, ValidateParameterMacros(`{"matrix": [["a", "b"]], "at": "[[ScheduledTime]]"}`))
+	assert.Nil(t, ValidateParameterMacros(
+		"[[ScheduledTime]] [[CurrentTime.15-04-05]] [[PreviousScheduledTime-24h.2006-01-02]] [[Index]] [[JobName]] [[JobID]] [[RunID]]"))
+
+	for _, value := range []string{
+		"[[Unknown]]",
+		"FOO [[ScheduledTime]] [[scheduledtime]]",
+		"[[ScheduledTime-1d]]",
+		"[[ScheduledTime-24h.]]",
+		"[[CurrentTime24h]]",
+	} {
+		err := ValidateParameterMacros(value)
+		assert.NotNil(t, err, value)
+		assert.Contains(t, err.Error(), "Unknown macro", value)
+	}
+}
+
+func TestParameterFormatter_Format_NestedList(t *testing.T) {
+	formatter := NewParameterFormatter(25, 26, 27, 28, time.UTC, "JOB", "JOB_ID", "RUN_ID")
+	assert.Equal(t, "[[1, 2], [3, 4]] 28", formatter.Format("[[1, 2], [3, 4]] [[Index]]"))
+}
+
+func TestParameterFormatter_Format_Location(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.Nil(t, err)
+	formatter := NewParameterFormatter(
+		25, /* scheduled time */
+		20, /* previous scheduled time */
+		26, /* current time */
+		27, /* index */
+		tokyo,
+		"JOB", /* job name */
+		"JOB_ID",
+		"RUN_ID")
+
+	assert.Equal(t, "FOO 19700101090025 FOO", formatter.Format("FOO [[ScheduledTime]] FOO"))
+	assert.Equal(t, "FOO 19700101090026 FOO", formatter.Format("FOO [[CurrentTime]] FOO"))
+	assert.Equal(t, "FOO 09-00-25+09:00 FOO", formatter.Format("FOO [[ScheduledTime.15-04-05Z07:00]] FOO"))
 }
@@ -0,0 +1,102 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+)
+
+// maxTriggeredRunIDs is the number of upstream runs that created a workflow
+// remembered in the status of a run completion trigger.
+const maxTriggeredRunIDs = 100
+
+// IsRunCompletionTriggered returns whether the schedule creates workflows when
+// upstream runs complete, rather than at scheduled times.
+func (s *ScheduledWorkflow) IsRunCompletionTriggered() bool {
+	return s.Spec.Trigger.RunCompletion != nil
+}
+
+// AddPendingRun queues the completed upstream run, so that it creates a
+// workflow. It returns false if the run is already queued or already created
+// a workflow.
+func (s *ScheduledWorkflow) AddPendingRun(run swfapi.PendingRun) bool {
+	trigger := s.Spec.Trigger.RunCompletion
+	if trigger == nil {
+		return false
+	}
+	for _, pending := range trigger.PendingRuns {
+		if pending.RunID == run.RunID {
+			return false
+		}
+	}
+	if status := s.Status.Trigger.RunCompletion; status != nil {
+		for _, runID := range status.TriggeredRunIDs {
+			if runID == run.RunID {
+				return false
+			}
+		}
+	}
+	trigger.PendingRuns = append(trigger.PendingRuns, run)
+	return true
+}
+
+// NextPendingRun returns the oldest queued upstream run, or nil if there is
+// none.
+func (s *ScheduledWorkflow) NextPendingRun() *swfapi.PendingRun {
+	trigger := s.Spec.Trigger.RunCompletion
+	if trigger == nil || len(trigger.PendingRuns) == 0 {
+		return nil
+	}
+	return &trigger.PendingRuns[0]
+}
+
+// NewWorkflowForRun creates a workflow for a completed upstream run. The
+// parameters of the upstream run override the formatted parameters of the
+// workflow, which is scheduled now.
+func (s *ScheduledWorkflow) NewWorkflowForRun(run *swfapi.PendingRun, nowEpoch int64) (
+	*commonutil.Workflow, error) {
+	parameters := make(map[string]string, len(run.Parameters))
+	for _, param := range run.Parameters {
+		parameters[param.Name] = param.Value
+	}
+	return s.newWorkflow(nowEpoch, s.previousScheduledEpoch(nowEpoch), nowEpoch, parameters)
+}
+
+// UpdateRunCompletionStatus records that the upstream run created a workflow:
+// the run leaves the queue, and is remembered so that it is not queued again.
+func (s *ScheduledWorkflow) UpdateRunCompletionStatus(run *swfapi.PendingRun) {
+	trigger := s.Spec.Trigger.RunCompletion
+	if run == nil || trigger == nil {
+		return
+	}
+	runID := run.RunID
+	pendingRuns := make([]swfapi.PendingRun, 0, len(trigger.PendingRuns))
+	for _, pending := range trigger.PendingRuns {
+		if pending.RunID != runID {
+			pendingRuns = append(pendingRuns, pending)
+		}
+	}
+	trigger.PendingRuns = pendingRuns
+
+	if s.Status.Trigger.RunCompletion == nil {
+		s.Status.Trigger.RunCompletion = &swfapi.RunCompletionTriggerStatus{}
+	}
+	status := s.Status.Trigger.RunCompletion
+	status.TriggeredRunIDs = append(status.TriggeredRunIDs, runID)
+	if len(status.TriggeredRunIDs) > maxTriggeredRunIDs {
+		status.TriggeredRunIDs = status.TriggeredRunIDs[len(status.TriggeredRunIDs)-maxTriggeredRunIDs:]
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	workflowapi "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func runCompletionSchedule(pendingRuns ...swfapi.PendingRun) *ScheduledWorkflow {
+	return NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "SCHEDULE1",
+			CreationTimestamp: metav1.NewTime(time.Unix(10*hour, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled: true,
+			Trigger: swfapi.Trigger{
+				RunCompletion: &swfapi.RunCompletionTrigger{
+					JobID:          "JOB1",
+					RunIDParameter: "UPSTREAM",
+					PendingRuns:    pendingRuns,
+				},
+			},
+			Workflow: &swfapi.WorkflowResource{
+				Parameters: []swfapi.Parameter{
+					{Name: "UPSTREAM", Value: "IGNORED"},
+					{Name: "INDEX", Value: "[[Index]]"},
+				},
+				Spec: workflowapi.WorkflowSpec{
+					Arguments: workflowapi.Arguments{
+						Parameters: []workflowapi.Parameter{
+							{Name: "UPSTREAM", Value: commonutil.StringPointer("")},
+							{Name: "INDEX", Value: commonutil.StringPointer("")},
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestScheduledWorkflow_AddPendingRun(t *testing.T) {
+	schedule := runCompletionSchedule()
+	assert.True(t, schedule.IsRunCompletionTriggered())
+	assert.Nil(t, schedule.NextPendingRun())
+
+	assert.True(t, schedule.AddPendingRun(swfapi.PendingRun{RunID: "RUN1"}))
+	assert.True(t, schedule.AddPendingRun(swfapi.PendingRun{RunID: "RUN2"}))
+	// Already queued.
+	assert.False(t, schedule.AddPendingRun(swfapi.PendingRun{RunID: "RUN1"}))
+	assert.Equal(t, "RUN1", schedule.NextPendingRun().RunID)
+
+	// Already created a workflow.
+	schedule.UpdateRunCompletionStatus(schedule.NextPendingRun())
+	assert.False(t, schedule.AddPendingRun(swfapi.PendingRun{RunID: "RUN1"}))
+	assert.Equal(t, "RUN2", schedule.NextPendingRun().RunID)
+
+	// Not a run completion trigger.
+	assert.False(t, manualTriggerSchedule(nil).AddPendingRun(swfapi.PendingRun{RunID: "RUN1"}))
+}
+
+func TestScheduledWorkflow_NewWorkflowForRun(t *testing.T) {
+	schedule := runCompletionSchedule()
+	schedule.uuid = commonutil.NewFakeUUIDGeneratorOrFatal("123e4567-e89b-12d3-a456-426655440001", nil)
+	schedule.Status.Trigger.LastIndex = commonutil.Int64Pointer(2)
+
+	result, err := schedule.NewWorkflowForRun(&swfapi.PendingRun{
+		RunID:      "RUN1",
+		Parameters: []swfapi.Parameter{{Name: "UPSTREAM", Value: "RUN1"}},
+	}, 12*hour)
+	assert.Nil(t, err)
+	assert.Equal(t, []workflowapi.Parameter{
+		{Name: "UPSTREAM", Value: commonutil.StringPointer("RUN1")},
+		{Name: "INDEX", Value: commonutil.StringPointer("3")},
+	}, result.Spec.Arguments.Parameters)
+	assert.Equal(t, int64(12*hour), result.ScheduledAtInSecOr0())
+}
+
+func TestScheduledWorkflow_UpdateRunCompletionStatus(t *testing.T) {
+	schedule := runCompletionSchedule(swfapi.PendingRun{RunID: "RUN1"}, swfapi.PendingRun{RunID: "RUN2"})
+
+	// Nothing changes until the workflow is created.
+	schedule.UpdateRunCompletionStatus(nil)
+	assert.Len(t, schedule.Spec.Trigger.RunCompletion.PendingRuns, 2)
+	assert.Nil(t, schedule.Status.Trigger.RunCompletion)
+
+	schedule.UpdateRunCompletionStatus(schedule.NextPendingRun())
+	assert.Equal(t, []swfapi.PendingRun{{RunID: "RUN2"}}, schedule.Spec.Trigger.RunCompletion.PendingRuns)
+	assert.Equal(t, []string{"RUN1"}, schedule.Status.Trigger.RunCompletion.TriggeredRunIDs)
+
+	// Only the most recent runs are remembered.
+	for i := 0; i < maxTriggeredRunIDs; i++ {
+		schedule.UpdateRunCompletionStatus(&swfapi.PendingRun{RunID: fmt.Sprintf("RUN%d", i+3)})
+	}
+	assert.Len(t, schedule.Status.Trigger.RunCompletion.TriggeredRunIDs, maxTriggeredRunIDs)
+	assert.Equal(t, "RUN3", schedule.Status.Trigger.RunCompletion.TriggeredRunIDs[0])
+}
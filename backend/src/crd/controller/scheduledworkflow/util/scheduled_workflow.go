@@ -124,7 +124,9 @@ func (s *ScheduledWorkflow) MinIndex() int64 {
 
 func (s *ScheduledWorkflow) isOneOffRun() bool {
 	return s.Spec.Trigger.CronSchedule == nil &&
-		s.Spec.Trigger.PeriodicSchedule == nil
+		s.Spec.Trigger.PeriodicSchedule == nil &&
+		s.Spec.Trigger.ObjectStoreEvent == nil &&
+		s.Spec.Trigger.RunCompletion == nil
 }
 
 func (s *ScheduledWorkflow) nextResourceID() string {
@@ -164,6 +166,31 @@ func (s *ScheduledWorkflow) getFormattedWorkflowParametersAsMap(
 // the Schedule resource that 'owns' it.
 func (s *ScheduledWorkflow) NewWorkflow(
 	nextScheduledEpoch int64, nowEpoch int64) (*commonutil.Workflow, error) {
+	return s.newWorkflow(nextScheduledEpoch, s.previousScheduledEpoch(nextScheduledEpoch), nowEpoch, nil)
+}
+
+// previousScheduledEpoch returns the scheduled time of the last workflow of
+// the schedule, or scheduledEpoch if there is none.
+func (s *ScheduledWorkflow) previousScheduledEpoch(scheduledEpoch int64) int64 {
+	if s.Status.Trigger.LastTriggeredTime == nil {
+		return scheduledEpoch
+	}
+	return s.Status.Trigger.LastTriggeredTime.Unix()
+}
+
+// jobName returns the name of the job of the schedule, or the name of the
+// schedule if it has no job.
+func (s *ScheduledWorkflow) jobName() string {
+	if name, ok := s.Annotations[commonutil.AnnotationKeyJobName]; ok {
+		return name
+	}
+	return s.Name
+}
+
+// newWorkflow creates a workflow for this schedule, with the parameters of the
+// schedule formatted and then overridden by parameterOverrides.
+func (s *ScheduledWorkflow) newWorkflow(nextScheduledEpoch int64, previousScheduledEpoch int64,
+	nowEpoch int64, parameterOverrides map[string]string) (*commonutil.Workflow, error) {
 
 	const (
 		workflowKind       = "Workflow"
@@ -181,18 +208,23 @@ func (s *ScheduledWorkflow) NewWorkflow(
 	// Set the name of the workflow.
 	result.OverrideName(s.NextResourceName())
 
+	uuid, err := s.uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
 	// Get the workflow parameters and format them.
-	formatter := NewParameterFormatter(nextScheduledEpoch, nowEpoch, s.nextIndex())
+	formatter := NewParameterFormatter(nextScheduledEpoch, previousScheduledEpoch, nowEpoch, s.nextIndex(),
+		s.location(), s.jobName(), string(s.UID), uuid.String())
 	formattedParams := s.getFormattedWorkflowParametersAsMap(formatter)
+	for key, value := range parameterOverrides {
+		formattedParams[key] = value
+	}
 
 	// Set the parameters.
 	result.OverrideParameters(formattedParams)
 
 	result.SetCannonicalLabels(s.Name, nextScheduledEpoch, s.nextIndex())
-	uuid, err := s.uuid.NewRandom()
-	if err != nil {
-		return nil, err
-	}
 	result.SetLabels(commonutil.LabelKeyWorkflowRunId, uuid.String())
 	// Replace {{workflow.uid}} with runId
 	err = result.ReplaceUID(uuid.String())
@@ -218,6 +250,11 @@ func (s *ScheduledWorkflow) GetNextScheduledEpoch(activeWorkflowCount int64, now
 		return nextScheduledEpoch, false
 	}
 
+	// If the schedule is in a blackout window, we should not schedule the workflow now.
+	if s.inBlackout(nowEpoch) {
+		return nextScheduledEpoch, false
+	}
+
 	// If the maxConcurrency is exceeded, return.
 	if activeWorkflowCount >= s.maxConcurrency() {
 		return nextScheduledEpoch, false
@@ -250,6 +287,12 @@ func (s *ScheduledWorkflow) getNextScheduledEpoch(nowEpoch int64) int64 {
 		}
 	}
 
+	// Object store event or run completion: workflows are not created at
+	// scheduled times.
+	if s.Spec.Trigger.ObjectStoreEvent != nil || s.Spec.Trigger.RunCompletion != nil {
+		return math.MaxInt64
+	}
+
 	// Cron schedule
 	if s.Spec.Trigger.CronSchedule != nil {
 		schedule := NewCronSchedule(s.Spec.Trigger.CronSchedule)
@@ -267,6 +310,20 @@ func (s *ScheduledWorkflow) getNextScheduledEpoch(nowEpoch int64) int64 {
 	return s.getNextScheduledEpochForOneTimeRun()
 }
 
+// location returns the time zone in which the scheduled and current times
+// are formatted in the workflow parameters.
+func (s *ScheduledWorkflow) location() *time.Location {
+	if s.Spec.Trigger.CronSchedule == nil {
+		return time.UTC
+	}
+	location, err := NewCronSchedule(s.Spec.Trigger.CronSchedule).Location()
+	if err != nil {
+		// This should never happen, validation should have caught this at resource creation.
+		return time.UTC
+	}
+	return location
+}
+
 func (s *ScheduledWorkflow) getNextScheduledEpochForOneTimeRun() int64 {
 	if s.Status.Trigger.LastTriggeredTime != nil {
 		return math.MaxInt64
@@ -289,7 +346,8 @@ func (s *ScheduledWorkflow) UpdateStatus(updatedEpoch int64, workflow *commonuti
 
 	updatedTime := metav1.NewTime(time.Unix(updatedEpoch, 0).UTC())
 
-	conditionType, status, message := s.getStatusAndMessage(len(active))
+	s.updateFailurePolicyStatus()
+	conditionType, status, message := s.getStatusAndMessage(len(active), updatedEpoch)
 
 	condition := swfapi.ScheduledWorkflowCondition{
 		Type:               conditionType,
@@ -349,7 +407,7 @@ func (s *ScheduledWorkflow) updateNextTriggeredTime(epoch int64) {
 	}
 }
 
-func (s *ScheduledWorkflow) getStatusAndMessage(activeCount int) (
+func (s *ScheduledWorkflow) getStatusAndMessage(activeCount int, nowEpoch int64) (
 	conditionType swfapi.ScheduledWorkflowConditionType,
 	status core.ConditionStatus, message string) {
 	// Schedule messages
@@ -358,6 +416,10 @@ func (s *ScheduledWorkflow) getStatusAndMessage(activeCount int) (
 		ScheduleDisabledMessage  = "The schedule is disabled."
 		ScheduleRunningMessage   = "The one-off schedule is running."
 		ScheduleSucceededMessage = "The one-off schedule has succeeded."
+		// Format of the message of a schedule disabled by its failure policy.
+		ScheduleDisabledByFailuresMessage = "The schedule was disabled after %v consecutive failed workflows."
+		// Format of the message of a schedule in a blackout window.
+		SchedulePausedMessage = "The schedule is paused until %v."
 	)
 
 	if s.isOneOffRun() {
@@ -368,7 +430,14 @@ func (s *ScheduledWorkflow) getStatusAndMessage(activeCount int) (
 		}
 	} else {
 		if s.enabled() {
+			if endEpoch, ok := s.blackoutEndEpoch(nowEpoch); ok {
+				return swfapi.ScheduledWorkflowPaused, core.ConditionTrue,
+					fmt.Sprintf(SchedulePausedMessage, time.Unix(endEpoch, 0).UTC().Format(time.RFC3339))
+			}
 			return swfapi.ScheduledWorkflowEnabled, core.ConditionTrue, ScheduleEnabledMessage
+		} else if s.disabledByFailures() {
+			return swfapi.ScheduledWorkflowDisabledByFailures, core.ConditionTrue,
+				fmt.Sprintf(ScheduleDisabledByFailuresMessage, s.maxConsecutiveFailures())
 		} else {
 			return swfapi.ScheduledWorkflowDisabled, core.ConditionTrue, ScheduleDisabledMessage
 		}
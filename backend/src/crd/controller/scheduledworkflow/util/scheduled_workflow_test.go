@@ -371,6 +371,21 @@ func TestScheduledWorkflow_GetNextScheduledEpoch_PeriodicSchedule(t *testing.T)
 
 }
 
+func TestScheduledWorkflow_GetNextScheduledEpoch_RunCompletion(t *testing.T) {
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled: true,
+			Trigger: swfapi.Trigger{
+				RunCompletion: &swfapi.RunCompletionTrigger{JobID: "JOB1"},
+			},
+		},
+	})
+	assert.False(t, schedule.isOneOffRun())
+	nextScheduledEpoch, shouldRunNow := schedule.GetNextScheduledEpoch(0, 10*hour)
+	assert.False(t, shouldRunNow)
+	assert.Equal(t, int64(math.MaxInt64), nextScheduledEpoch)
+}
+
 func TestScheduledWorkflow_GetNextScheduledEpoch_UpdateStatus_NoWorkflow(t *testing.T) {
 	// Must run now
 	scheduledEpoch := int64(10 * hour)
@@ -678,3 +693,51 @@ func TestScheduledWorkflow_NewWorkflow_Parameterized(t *testing.T) {
 
 	assert.Equal(t, expected, result.Get())
 }
+
+func TestScheduledWorkflow_NewWorkflow_JobMacros(t *testing.T) {
+	scheduledEpoch := int64(10 * hour)
+	nowEpoch := int64(11 * hour)
+
+	schedule := ScheduledWorkflow{&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "SCHEDULE1",
+			UID:         "SCHEDULE_ID",
+			Annotations: map[string]string{commonutil.AnnotationKeyJobName: "my job"},
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:        true,
+			MaxConcurrency: commonutil.Int64Pointer(int64(10)),
+			Trigger: swfapi.Trigger{
+				PeriodicSchedule: &swfapi.PeriodicSchedule{
+					IntervalSecond: int64(hour),
+				},
+			},
+			Workflow: &swfapi.WorkflowResource{
+				Parameters: []swfapi.Parameter{
+					{Name: "PARAM1", Value: "[[PreviousScheduledTime.15]]-[[ScheduledTime.15]]"},
+					{Name: "PARAM2", Value: "[[JobName]]/[[JobID]]/[[RunID]]"},
+				},
+				Spec: workflowapi.WorkflowSpec{
+					Arguments: workflowapi.Arguments{
+						Parameters: []workflowapi.Parameter{
+							{Name: "PARAM1", Value: commonutil.StringPointer("VALUE1")},
+							{Name: "PARAM2", Value: commonutil.StringPointer("VALUE2")},
+						},
+					},
+				},
+			},
+		},
+		Status: swfapi.ScheduledWorkflowStatus{
+			Trigger: swfapi.TriggerStatus{
+				LastTriggeredTime: commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(9*hour, 0).UTC())),
+			},
+		},
+	}, commonutil.NewFakeUUIDGeneratorOrFatal("123e4567-e89b-12d3-a456-426655440001", nil)}
+
+	result, err := schedule.NewWorkflow(scheduledEpoch, nowEpoch)
+	assert.Nil(t, err)
+	assert.Equal(t, []workflowapi.Parameter{
+		{Name: "PARAM1", Value: commonutil.StringPointer("09-10")},
+		{Name: "PARAM2", Value: commonutil.StringPointer("my job/SCHEDULE_ID/123e4567-e89b-12d3-a456-426655440001")},
+	}, result.Spec.Arguments.Parameters)
+}
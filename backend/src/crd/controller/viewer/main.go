@@ -70,7 +70,8 @@ func main() {
 	}
 
 	// Create a controller that is in charge of Viewer types, and also responds to
-	// changes to any deployment and services that is owned by any Viewer instance.
+	// changes to any deployment, service and secret that is owned by any Viewer
+	// instance.
 	mgr, err := manager.New(cfg, manager.Options{Namespace: *namespace})
 	if err != nil {
 		log.Fatal(err)
@@ -81,6 +82,7 @@ func main() {
 		ForType(&viewerV1beta1.Viewer{}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Service{}).
+		Owns(&corev1.Secret{}).
 		WithConfig(cfg).
 		Build(reconciler)
 
@@ -16,17 +16,21 @@
 // reconciler takes care of the main logic for ensuring every Viewer CRD
 // corresponds to a unique deployment and backing service. The service is
 // annotated such that it is compatible with Ambassador managed routing.
-// Currently, only supports Tensorboard viewers. Adding a new viewer CRD for
-// tensorboard with the name 'abc123' will result in the tensorboard instance
-// serving under the path '/tensorboard/abc123'.
+// Supports the viewer types registered with RegisterViewerType. Adding a new
+// viewer CRD for tensorboard with the name 'abc123' will result in the
+// tensorboard instance serving under the path '/tensorboard/abc123'.
 package reconciler
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/golang/glog"
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
 	viewerV1beta1 "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/viewer/v1beta1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -50,6 +54,7 @@ type Reconciler struct {
 	client.Client
 	scheme *runtime.Scheme
 	opts   *Options
+	time   commonutil.TimeInterface
 }
 
 // Options are the set of options to configure the behaviour of Reconciler.
@@ -65,7 +70,7 @@ func New(cli client.Client, scheme *runtime.Scheme, opts *Options) (*Reconciler,
 	if opts.MaxNumViewers < 1 {
 		return nil, fmt.Errorf("MaxNumViewers should at least be 1. Got %d", opts.MaxNumViewers)
 	}
-	return &Reconciler{Client: cli, scheme: scheme, opts: opts}, nil
+	return &Reconciler{Client: cli, scheme: scheme, opts: opts, time: commonutil.NewRealTime()}, nil
 }
 
 // Reconcile runs the main logic for reconciling the state of a viewer with a
@@ -86,8 +91,19 @@ func (r *Reconciler) Reconcile(req reconcile.Request) (reconcile.Result, error)
 	}
 	glog.Infof("Got instance: %+v", view)
 
-	// Ignore other viewer types for now.
-	if view.Spec.Type != viewerV1beta1.ViewerTypeTensorboard {
+	// Delete the viewer if it has been idle for longer than its TTL.
+	now := r.time.Now()
+	lastAccessTime := lastAccessTimeOf(view)
+	if view.Spec.IdleTTL != nil && !now.Before(lastAccessTime.Add(view.Spec.IdleTTL.Duration)) {
+		glog.Infof("Deleting viewer %s/%s idle since %v", view.Namespace, view.Name, lastAccessTime)
+		if err := r.Client.Delete(context.Background(), view); err != nil && !errors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
+	// Ignore viewer types that are not registered.
+	if _, ok := lookupViewerType(view.Spec.Type); !ok {
 		glog.Infof("Unsupported spec type: %q", view.Spec.Type)
 		// Return nil to indicate nothing more to do here.
 		return reconcile.Result{}, nil
@@ -104,7 +120,7 @@ func (r *Reconciler) Reconcile(req reconcile.Request) (reconcile.Result, error)
 	}
 
 	// Set up potential deployment.
-	dpl, err := deploymentFrom(view)
+	dpl, port, err := deploymentFrom(view)
 	if err != nil {
 		utilruntime.HandleError(err)
 		// User error, don't requeue key.
@@ -119,6 +135,13 @@ func (r *Reconciler) Reconcile(req reconcile.Request) (reconcile.Result, error)
 		return reconcile.Result{}, err
 	}
 
+	if view.Spec.Type == viewerV1beta1.ViewerTypeJupyter {
+		if err := r.ensureJupyterTokenSecret(view); err != nil {
+			utilruntime.HandleError(fmt.Errorf("error creating token secret: %v", err))
+			return reconcile.Result{}, err
+		}
+	}
+
 	foundDpl := &appsv1.Deployment{}
 	nsn := types.NamespacedName{Name: dpl.Name, Namespace: dpl.Namespace}
 	if err := r.Client.Get(context.Background(), nsn, foundDpl); err != nil {
@@ -128,6 +151,7 @@ func (r *Reconciler) Reconcile(req reconcile.Request) (reconcile.Result, error)
 				utilruntime.HandleError(fmt.Errorf("error creating deployment: %v", createErr))
 				return reconcile.Result{}, createErr
 			}
+			foundDpl = dpl
 		} else {
 			// Some other error.
 			utilruntime.HandleError(err)
@@ -137,7 +161,7 @@ func (r *Reconciler) Reconcile(req reconcile.Request) (reconcile.Result, error)
 	glog.Infof("Created new deployment with spec: %+v", dpl)
 
 	// Set up a service for the deployment above.
-	svc := serviceFrom(view, dpl.Name)
+	svc := serviceFrom(view, dpl.Name, port)
 	// Set the service to be owned by the view instance as well.
 	if err := controllerutil.SetControllerReference(view, svc, r.scheme); err != nil {
 		// Error means that the service is already owned by some other instance.
@@ -162,10 +186,95 @@ func (r *Reconciler) Reconcile(req reconcile.Request) (reconcile.Result, error)
 	}
 	glog.Infof("Created new service with spec: %+v", svc)
 
+	if err := r.updateStatus(view, foundDpl, lastAccessTime, now); err != nil {
+		utilruntime.HandleError(fmt.Errorf("error updating viewer status: %v", err))
+		return reconcile.Result{}, err
+	}
+
+	// Check again once the viewer would become idle.
+	if view.Spec.IdleTTL != nil {
+		return reconcile.Result{RequeueAfter: lastAccessTime.Add(view.Spec.IdleTTL.Duration).Sub(now)}, nil
+	}
 	return reconcile.Result{}, nil
 }
 
-func setPodSpecForTensorboard(view *viewerV1beta1.Viewer, s *corev1.PodSpec) {
+// lastAccessTimeOf returns the time at which the viewer was last accessed
+// according to its annotation, or its creation time if it was never accessed.
+func lastAccessTimeOf(view *viewerV1beta1.Viewer) time.Time {
+	lastAccessTime := view.CreationTimestamp.Time
+	if value, ok := view.Annotations[viewerV1beta1.LastAccessTimeAnnotation]; ok {
+		accessTime, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			glog.Warningf("Ignoring invalid annotation %s=%q of viewer %s/%s: %v",
+				viewerV1beta1.LastAccessTimeAnnotation, value, view.Namespace, view.Name, err)
+		} else if accessTime.After(lastAccessTime) {
+			lastAccessTime = accessTime
+		}
+	}
+	return lastAccessTime
+}
+
+// updateStatus reports the readiness of the deployment of the viewer and the
+// time it was last accessed in the status of the viewer.
+func (r *Reconciler) updateStatus(view *viewerV1beta1.Viewer, dpl *appsv1.Deployment,
+	lastAccessTime time.Time, now time.Time) error {
+	status := viewerV1beta1.ViewerStatus{
+		Phase:          viewerV1beta1.ViewerPending,
+		URL:            viewerPath(view),
+		ReadyTime:      view.Status.ReadyTime,
+		LastAccessTime: &metav1.Time{Time: lastAccessTime},
+	}
+	if view.Spec.Type == viewerV1beta1.ViewerTypeJupyter {
+		status.TokenSecretName = jupyterTokenSecretName(view)
+	}
+	if dpl.Status.ReadyReplicas > 0 {
+		status.Phase = viewerV1beta1.ViewerReady
+		if status.ReadyTime == nil {
+			status.ReadyTime = &metav1.Time{Time: now}
+		}
+	}
+	// The times are compared with Equal, as their location changes when they
+	// are serialized.
+	if status.Phase == view.Status.Phase && status.URL == view.Status.URL &&
+		status.ReadyTime.Equal(view.Status.ReadyTime) && status.LastAccessTime.Equal(view.Status.LastAccessTime) &&
+		status.TokenSecretName == view.Status.TokenSecretName {
+		return nil
+	}
+	view.Status = status
+	return r.Client.Status().Update(context.Background(), view)
+}
+
+// ensureJupyterTokenSecret creates the Secret holding the token to log in to a
+// Jupyter viewer, unless it exists. The token is generated randomly, and the
+// Secret is owned by the viewer, so that it is deleted along with it.
+func (r *Reconciler) ensureJupyterTokenSecret(view *viewerV1beta1.Viewer) error {
+	nsn := types.NamespacedName{Name: jupyterTokenSecretName(view), Namespace: view.Namespace}
+	if err := r.Client.Get(context.Background(), nsn, &corev1.Secret{}); err == nil || !errors.IsNotFound(err) {
+		return err
+	}
+
+	token := make([]byte, 24)
+	if _, err := rand.Read(token); err != nil {
+		return fmt.Errorf("failed to generate the token: %v", err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nsn.Name,
+			Namespace: nsn.Namespace,
+			Labels: map[string]string{
+				"app":    "viewer",
+				"viewer": view.Name,
+			},
+		},
+		Data: map[string][]byte{jupyterTokenSecretKey: []byte(hex.EncodeToString(token))},
+	}
+	if err := controllerutil.SetControllerReference(view, secret, r.scheme); err != nil {
+		return err
+	}
+	return r.Client.Create(context.Background(), secret)
+}
+
+func setPodSpecForTensorboard(view *viewerV1beta1.Viewer, s *corev1.PodSpec) (int32, error) {
 	if len(s.Containers) == 0 {
 		s.Containers = append(s.Containers, corev1.Container{})
 	}
@@ -188,10 +297,12 @@ func setPodSpecForTensorboard(view *viewerV1beta1.Viewer, s *corev1.PodSpec) {
 	c.Ports = []corev1.ContainerPort{
 		corev1.ContainerPort{ContainerPort: viewerTargetPort},
 	}
-
+	return viewerTargetPort, nil
 }
 
-func deploymentFrom(view *viewerV1beta1.Viewer) (*appsv1.Deployment, error) {
+// deploymentFrom returns the deployment of the viewer and the port on which
+// the viewer serves.
+func deploymentFrom(view *viewerV1beta1.Viewer) (*appsv1.Deployment, int32, error) {
 	name := view.Name + "-deployment"
 	dpl := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
@@ -218,14 +329,16 @@ func deploymentFrom(view *viewerV1beta1.Viewer) (*appsv1.Deployment, error) {
 	dpl.Spec.Template.ObjectMeta.Labels["app"] = "viewer"
 	dpl.Spec.Template.ObjectMeta.Labels["viewer"] = view.Name
 
-	switch view.Spec.Type {
-	case viewerV1beta1.ViewerTypeTensorboard:
-		setPodSpecForTensorboard(view, &dpl.Spec.Template.Spec)
-	default:
-		return nil, fmt.Errorf("unknown viewer type: %q", view.Spec.Type)
+	builder, ok := lookupViewerType(view.Spec.Type)
+	if !ok {
+		return nil, 0, fmt.Errorf("unknown viewer type: %q", view.Spec.Type)
+	}
+	port, err := builder(view, &dpl.Spec.Template.Spec)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid %s viewer %q: %v", view.Spec.Type, view.Name, err)
 	}
 
-	return dpl, nil
+	return dpl, port, nil
 }
 
 const mappingTpl = `
@@ -237,9 +350,14 @@ prefix: %s
 rewrite: %s
 service: %s`
 
-func serviceFrom(v *viewerV1beta1.Viewer, deploymentName string) *corev1.Service {
+// viewerPath returns the path under which the viewer serves.
+func viewerPath(v *viewerV1beta1.Viewer) string {
+	return fmt.Sprintf("/%s/%s/", v.Spec.Type, v.Name)
+}
+
+func serviceFrom(v *viewerV1beta1.Viewer, deploymentName string, port int32) *corev1.Service {
 	name := v.Name + "-service"
-	path := fmt.Sprintf("/%s/%s/", v.Spec.Type, v.Name)
+	path := viewerPath(v)
 	mapping := fmt.Sprintf(mappingTpl, v.Name, path, path, name)
 
 	return &corev1.Service{
@@ -263,7 +381,7 @@ func serviceFrom(v *viewerV1beta1.Viewer, deploymentName string) *corev1.Service
 					Name:       "http",
 					Protocol:   corev1.ProtocolTCP,
 					Port:       80,
-					TargetPort: intstr.IntOrString{IntVal: viewerTargetPort}},
+					TargetPort: intstr.IntOrString{IntVal: port}},
 			},
 		},
 	}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	_ "github.com/google/go-cmp/cmp/cmpopts"
+	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
 	viewerV1beta1 "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/viewer/v1beta1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -595,3 +596,134 @@ func TestReconcile_MaxNumViewersIsEnforced(t *testing.T) {
 		t.Errorf("Got services %v\n. Want %v", gotSvcs, wantSvcs)
 	}
 }
+
+func getViewer(t *testing.T, c client.Client, n types.NamespacedName) *viewerV1beta1.Viewer {
+	v := &viewerV1beta1.Viewer{}
+	if err := c.Get(context.Background(), n, v); err != nil {
+		t.Fatalf("Failed to get viewer %v with fake client: %v", n, err)
+	}
+	return v
+}
+
+func TestReconcile_StatusReportsDeploymentReadiness(t *testing.T) {
+	n, v := makeViewer(1)
+	cli := fake.NewFakeClient(v)
+	reconciler, _ := New(cli, scheme.Scheme, &Options{MaxNumViewers: 10})
+	// The fake time advances by one second on each call.
+	reconciler.time = commonutil.NewFakeTime(time.Unix(99, 0))
+
+	req := reconcile.Request{NamespacedName: *n}
+	if _, err := reconciler.Reconcile(req); err != nil {
+		t.Fatalf("Reconcile(%+v) = %v; Want nil error", req, err)
+	}
+
+	want := viewerV1beta1.ViewerStatus{
+		Phase:          viewerV1beta1.ViewerPending,
+		URL:            "/tensorboard/viewer-1/",
+		LastAccessTime: &metav1.Time{Time: time.Unix(1, 0)},
+	}
+	got := getViewer(t, cli, *n).Status
+	if !cmp.Equal(got, want) {
+		t.Errorf("Reconcile(%+v)\nGot status: %+v\nWant status: %+v\nDiff: %s",
+			req, got, want, cmp.Diff(want, got))
+	}
+
+	// The deployment becomes ready.
+	dpl := getDeployments(t, cli)[0]
+	dpl.Status.ReadyReplicas = 1
+	if err := cli.Update(context.Background(), dpl); err != nil {
+		t.Fatalf("Failed to update deployment with fake client: %v", err)
+	}
+	if _, err := reconciler.Reconcile(req); err != nil {
+		t.Fatalf("Reconcile(%+v) = %v; Want nil error", req, err)
+	}
+
+	want.Phase = viewerV1beta1.ViewerReady
+	want.ReadyTime = &metav1.Time{Time: time.Unix(101, 0)}
+	got = getViewer(t, cli, *n).Status
+	if !cmp.Equal(got, want) {
+		t.Errorf("Reconcile(%+v)\nGot status: %+v\nWant status: %+v\nDiff: %s",
+			req, got, want, cmp.Diff(want, got))
+	}
+}
+
+func TestReconcile_IdleViewerIsDeleted(t *testing.T) {
+	n, v := makeViewer(1)
+	v.Spec.IdleTTL = &metav1.Duration{Duration: time.Hour}
+	cli := fake.NewFakeClient(v)
+	reconciler, _ := New(cli, scheme.Scheme, &Options{MaxNumViewers: 10})
+	req := reconcile.Request{NamespacedName: *n}
+
+	// The viewer is not idle yet, so it is checked again once it would be.
+	// The fake time advances by one second on each call.
+	reconciler.time = commonutil.NewFakeTime(time.Unix(1+1800-1, 0))
+	got, err := reconciler.Reconcile(req)
+	want := reconcile.Result{RequeueAfter: 30 * time.Minute}
+	if err != nil || !cmp.Equal(got, want) {
+		t.Errorf("Reconcile(%+v) =\nGot %+v, %v\nWant %+v, <nil>", req, got, err, want)
+	}
+
+	// An access postpones the deletion.
+	v = getViewer(t, cli, *n)
+	v.Annotations = map[string]string{
+		viewerV1beta1.LastAccessTimeAnnotation: time.Unix(1+3000, 0).UTC().Format(time.RFC3339),
+	}
+	if err := cli.Update(context.Background(), v); err != nil {
+		t.Fatalf("Failed to update viewer with fake client: %v", err)
+	}
+	reconciler.time = commonutil.NewFakeTime(time.Unix(1+3600-1, 0))
+	got, err = reconciler.Reconcile(req)
+	want = reconcile.Result{RequeueAfter: 50 * time.Minute}
+	if err != nil || !cmp.Equal(got, want) {
+		t.Errorf("Reconcile(%+v) =\nGot %+v, %v\nWant %+v, <nil>", req, got, err, want)
+	}
+	if gotAccess := getViewer(t, cli, *n).Status.LastAccessTime; !gotAccess.Equal(&metav1.Time{Time: time.Unix(1+3000, 0)}) {
+		t.Errorf("Reconcile(%+v)\nGot last access time: %v\nWant: %v", req, gotAccess, time.Unix(1+3000, 0))
+	}
+
+	// The viewer is idle past its TTL.
+	reconciler.time = commonutil.NewFakeTime(time.Unix(1+3000+3600-1, 0))
+	got, err = reconciler.Reconcile(req)
+	want = reconcile.Result{}
+	if err != nil || !cmp.Equal(got, want) {
+		t.Errorf("Reconcile(%+v) =\nGot %+v, %v\nWant %+v, <nil>", req, got, err, want)
+	}
+	if gotViewers := getViewers(t, cli); len(gotViewers) > 0 {
+		t.Errorf("Reconcile(%+v)\nGot viewers: %v\nWant none.", req, viewerNames(gotViewers))
+	}
+}
+
+func TestReconcile_JupyterViewerGetsTokenSecret(t *testing.T) {
+	n, v := makeViewer(1)
+	v.Spec.Type = viewerV1beta1.ViewerTypeJupyter
+	cli := fake.NewFakeClient(v)
+	reconciler, _ := New(cli, scheme.Scheme, &Options{MaxNumViewers: 10})
+	req := reconcile.Request{NamespacedName: *n}
+	if _, err := reconciler.Reconcile(req); err != nil {
+		t.Fatalf("Reconcile(%+v) = %v; Want nil error", req, err)
+	}
+
+	secret := &corev1.Secret{}
+	secretName := types.NamespacedName{Name: "viewer-1-token", Namespace: n.Namespace}
+	if err := cli.Get(context.Background(), secretName, secret); err != nil {
+		t.Fatalf("Failed to get secret %v with fake client: %v", secretName, err)
+	}
+	token := string(secret.Data["token"])
+	if len(token) != 48 {
+		t.Errorf("Reconcile(%+v)\nGot token %q; Want 48 hex digits", req, token)
+	}
+	if got := getViewer(t, cli, *n).Status.TokenSecretName; got != "viewer-1-token" {
+		t.Errorf("Reconcile(%+v)\nGot token secret name %q; Want %q", req, got, "viewer-1-token")
+	}
+
+	// The token is kept when the viewer is reconciled again.
+	if _, err := reconciler.Reconcile(req); err != nil {
+		t.Fatalf("Reconcile(%+v) = %v; Want nil error", req, err)
+	}
+	if err := cli.Get(context.Background(), secretName, secret); err != nil {
+		t.Fatalf("Failed to get secret %v with fake client: %v", secretName, err)
+	}
+	if got := string(secret.Data["token"]); got != token {
+		t.Errorf("Reconcile(%+v)\nGot token %q; Want the previous token %q", req, got, token)
+	}
+}
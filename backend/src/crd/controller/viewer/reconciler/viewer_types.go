@@ -0,0 +1,279 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"sync"
+
+	viewerV1beta1 "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/viewer/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	defaultHTMLGCSFetchImage = "google/cloud-sdk:alpine"
+	defaultHTMLS3FetchImage  = "minio/mc:latest"
+	defaultHTMLServerImage   = "python:3.7-alpine"
+	defaultNotebookImage     = "jupyter/base-notebook:latest"
+
+	// The directory from which the HTML viewer serves the files.
+	htmlContentDir        = "/srv"
+	htmlContentVolumeName = "viewer-content"
+
+	// The defaults for the object store of s3:// and minio:// sources of the
+	// HTML viewer, which match the object store of KFP.
+	defaultS3Endpoint                   = "https://s3.amazonaws.com"
+	defaultMinioEndpoint                = "http://minio-service.kubeflow:9000"
+	defaultObjectStoreCredentialsSecret = "mlpipeline-minio-artifact"
+	objectStoreAccessKeySecretKey       = "accesskey"
+	objectStoreSecretKeySecretKey       = "secretkey"
+
+	// The environment variables holding the credentials of the object store,
+	// and the alias under which the MinIO client accesses it.
+	objectStoreAccessKeyEnv = "OBJECT_STORE_ACCESS_KEY"
+	objectStoreSecretKeyEnv = "OBJECT_STORE_SECRET_KEY"
+	htmlSourceAlias         = "source"
+
+	// The environment variable holding the path of a custom viewer.
+	viewerPathPrefixEnv = "VIEWER_PATH_PREFIX"
+
+	// The environment variable and the key of the Secret holding the token
+	// to log in to a Jupyter viewer.
+	jupyterTokenEnv       = "JUPYTER_TOKEN"
+	jupyterTokenSecretKey = "token"
+)
+
+// PodSpecBuilder sets up the pod spec launching a viewer of a type. The pod
+// spec is the PodTemplateSpec of the viewer, so volumes and volume mounts
+// specified by the user are kept. The viewer must serve under its path, see
+// viewerPath. PodSpecBuilder returns the port on which the viewer serves, or
+// an error if the viewer is invalid.
+type PodSpecBuilder func(view *viewerV1beta1.Viewer, s *corev1.PodSpec) (int32, error)
+
+var viewerTypes = struct {
+	sync.RWMutex
+	builders map[viewerV1beta1.ViewerType]PodSpecBuilder
+}{
+	builders: map[viewerV1beta1.ViewerType]PodSpecBuilder{
+		viewerV1beta1.ViewerTypeTensorboard: setPodSpecForTensorboard,
+		viewerV1beta1.ViewerTypeHTML:        setPodSpecForHTML,
+		viewerV1beta1.ViewerTypeJupyter:     setPodSpecForJupyter,
+		viewerV1beta1.ViewerTypeCustom:      setPodSpecForCustom,
+	},
+}
+
+// RegisterViewerType registers the pod spec builder of a viewer type, so that
+// the reconciler launches viewers of the type. The services of all viewer
+// types are routed the same way. It panics if the type is already registered.
+func RegisterViewerType(t viewerV1beta1.ViewerType, builder PodSpecBuilder) {
+	viewerTypes.Lock()
+	defer viewerTypes.Unlock()
+	if _, ok := viewerTypes.builders[t]; ok {
+		panic(fmt.Sprintf("viewer type %q is already registered", t))
+	}
+	viewerTypes.builders[t] = builder
+}
+
+func lookupViewerType(t viewerV1beta1.ViewerType) (PodSpecBuilder, bool) {
+	viewerTypes.RLock()
+	defer viewerTypes.RUnlock()
+	builder, ok := viewerTypes.builders[t]
+	return builder, ok
+}
+
+// firstContainer returns the first container of the pod spec, adding it if
+// the pod spec has none.
+func firstContainer(s *corev1.PodSpec) *corev1.Container {
+	if len(s.Containers) == 0 {
+		s.Containers = append(s.Containers, corev1.Container{})
+	}
+	return &s.Containers[0]
+}
+
+// secretKeyEnvVar returns the environment variable set to the value of the
+// key of the Secret.
+func secretKeyEnvVar(name, secret, key string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: secret},
+			Key:                  key,
+		}},
+	}
+}
+
+// htmlFetchContainer returns the init container copying the files of the
+// source of an HTML viewer to the directory, with the client of the object
+// store of the source. It returns an error if the source is not in a
+// supported object store.
+func htmlFetchContainer(view *viewerV1beta1.Viewer, dir string) (corev1.Container, error) {
+	spec := view.Spec.HTMLSpec
+	if spec.Source == "" {
+		return corev1.Container{}, fmt.Errorf("the source of the files must be set")
+	}
+	source, err := url.Parse(spec.Source)
+	if err != nil {
+		return corev1.Container{}, fmt.Errorf("invalid source %q: %v", spec.Source, err)
+	}
+	if source.Host == "" {
+		return corev1.Container{}, fmt.Errorf("the source %q must name a bucket", spec.Source)
+	}
+
+	c := corev1.Container{Name: view.Name + "-fetch", Image: spec.FetchImage}
+	switch source.Scheme {
+	case "gs":
+		if c.Image == "" {
+			c.Image = defaultHTMLGCSFetchImage
+		}
+		c.Args = []string{"gsutil", "-m", "rsync", "-r", spec.Source, dir}
+	case "s3", "minio":
+		endpoint := spec.Endpoint
+		if endpoint == "" {
+			endpoint = defaultS3Endpoint
+			if source.Scheme == "minio" {
+				endpoint = defaultMinioEndpoint
+			}
+		}
+		endpointURL, err := url.Parse(endpoint)
+		if err != nil || endpointURL.Scheme == "" || endpointURL.Host == "" {
+			return corev1.Container{}, fmt.Errorf("invalid object store endpoint %q", endpoint)
+		}
+		secret := spec.CredentialsSecret
+		if secret == "" {
+			secret = defaultObjectStoreCredentialsSecret
+		}
+		if c.Image == "" {
+			c.Image = defaultHTMLS3FetchImage
+		}
+		// The MinIO client reads the object store of an alias, along with its
+		// credentials, from the MC_HOST_<alias> environment variable.
+		c.Command = []string{"mc"}
+		c.Args = []string{"mirror", "--quiet", path.Join(htmlSourceAlias, source.Host, source.Path), dir}
+		c.Env = []corev1.EnvVar{
+			secretKeyEnvVar(objectStoreAccessKeyEnv, secret, objectStoreAccessKeySecretKey),
+			secretKeyEnvVar(objectStoreSecretKeyEnv, secret, objectStoreSecretKeySecretKey),
+			corev1.EnvVar{
+				Name: "MC_HOST_" + htmlSourceAlias,
+				Value: fmt.Sprintf("%s://$(%s):$(%s)@%s",
+					endpointURL.Scheme, objectStoreAccessKeyEnv, objectStoreSecretKeyEnv, endpointURL.Host),
+			},
+		}
+	default:
+		return corev1.Container{}, fmt.Errorf("unsupported source %q, the scheme must be one of gs, s3 or minio", spec.Source)
+	}
+	return c, nil
+}
+
+// setPodSpecForHTML copies the files under the object store prefix to a
+// volume in an init container, and serves them with a static file server.
+func setPodSpecForHTML(view *viewerV1beta1.Viewer, s *corev1.PodSpec) (int32, error) {
+	// The files are served under the path of the viewer, which the routing
+	// keeps.
+	mount := corev1.VolumeMount{Name: htmlContentVolumeName, MountPath: path.Join(htmlContentDir, viewerPath(view))}
+	fetch, err := htmlFetchContainer(view, mount.MountPath)
+	if err != nil {
+		return 0, err
+	}
+	fetch.VolumeMounts = []corev1.VolumeMount{mount}
+	serverImage := view.Spec.HTMLSpec.ServerImage
+	if serverImage == "" {
+		serverImage = defaultHTMLServerImage
+	}
+
+	s.Volumes = append(s.Volumes, corev1.Volume{
+		Name:         htmlContentVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+	s.InitContainers = append(s.InitContainers, fetch)
+
+	c := firstContainer(s)
+	c.Name = view.Name + "-pod"
+	c.Image = serverImage
+	c.Args = []string{
+		"python3", "-m", "http.server", fmt.Sprint(viewerTargetPort),
+		"--directory", htmlContentDir,
+	}
+	c.VolumeMounts = append(c.VolumeMounts, mount)
+	c.Ports = []corev1.ContainerPort{
+		corev1.ContainerPort{ContainerPort: viewerTargetPort},
+	}
+	return viewerTargetPort, nil
+}
+
+// jupyterTokenSecretName returns the name of the Secret holding the token to
+// log in to a Jupyter viewer.
+func jupyterTokenSecretName(view *viewerV1beta1.Viewer) string {
+	return view.Name + "-token"
+}
+
+// setPodSpecForJupyter launches a Jupyter notebook server. Logging in requires
+// the token the reconciler generates in the Secret of the viewer.
+func setPodSpecForJupyter(view *viewerV1beta1.Viewer, s *corev1.PodSpec) (int32, error) {
+	spec := view.Spec.JupyterSpec
+	image := spec.NotebookImage
+	if image == "" {
+		image = defaultNotebookImage
+	}
+
+	c := firstContainer(s)
+	c.Name = view.Name + "-pod"
+	c.Image = image
+	c.Args = []string{
+		"jupyter", "notebook",
+		"--ip=0.0.0.0",
+		fmt.Sprintf("--port=%d", viewerTargetPort),
+		"--no-browser",
+		fmt.Sprintf("--NotebookApp.base_url=%s", viewerPath(view)),
+		fmt.Sprintf("--NotebookApp.token=$(%s)", jupyterTokenEnv),
+	}
+	if spec.NotebookDir != "" {
+		c.Args = append(c.Args, fmt.Sprintf("--notebook-dir=%s", spec.NotebookDir))
+	}
+	c.Env = append(c.Env, corev1.EnvVar{
+		Name: jupyterTokenEnv,
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: jupyterTokenSecretName(view)},
+			Key:                  jupyterTokenSecretKey,
+		}},
+	})
+	c.Ports = []corev1.ContainerPort{
+		corev1.ContainerPort{ContainerPort: viewerTargetPort},
+	}
+	return viewerTargetPort, nil
+}
+
+// setPodSpecForCustom launches the image provided by the user.
+func setPodSpecForCustom(view *viewerV1beta1.Viewer, s *corev1.PodSpec) (int32, error) {
+	spec := view.Spec.CustomSpec
+	if spec.Image == "" {
+		return 0, fmt.Errorf("the image must be set")
+	}
+	if spec.Port < 1 || spec.Port > 65535 {
+		return 0, fmt.Errorf("the port must be in the range 1-65535, got %d", spec.Port)
+	}
+
+	c := firstContainer(s)
+	c.Name = view.Name + "-pod"
+	c.Image = spec.Image
+	c.Command = spec.Command
+	c.Args = spec.Args
+	c.Env = append(c.Env, corev1.EnvVar{Name: viewerPathPrefixEnv, Value: viewerPath(view)})
+	c.Ports = []corev1.ContainerPort{
+		corev1.ContainerPort{ContainerPort: spec.Port},
+	}
+	return spec.Port, nil
+}
@@ -76,6 +76,24 @@ type ScheduledWorkflowSpec struct {
 	// +optional
 	Workflow *WorkflowResource `json:"workflow,omitempty"`
 
+	// Backfill of the schedule over a past time range.
+	// +optional
+	Backfill *Backfill `json:"backfill,omitempty"`
+
+	// Request to create one workflow right away, in addition to the workflows
+	// of the trigger.
+	// +optional
+	ManualTrigger *ManualTrigger `json:"manualTrigger,omitempty"`
+
+	// Policy applied when workflows fail.
+	// +optional
+	FailurePolicy *FailurePolicy `json:"failurePolicy,omitempty"`
+
+	// Time windows during which the trigger creates no workflow, as if the
+	// schedule was disabled.
+	// +optional
+	Blackouts []TimeWindow `json:"blackouts,omitempty"`
+
 	// TODO: support additional resource types: K8 jobs, etc.
 
 }
@@ -88,6 +106,10 @@ type WorkflowResource struct {
 	// [[Index]] is substituted by the index of the workflow (e.g. 3 means that it was the 3rd workflow created)
 	// [[ScheduledTime.15-04-05]] is substituted by the sheduled time (custom format specified as a Go time format: https://golang.org/pkg/time/#Parse)
 	// [[CurrentTime.15-04-05]] is substituted by the current time (custom format specified as a Go time format: https://golang.org/pkg/time/#Parse)
+	// [[PreviousScheduledTime]] is substituted by the scheduled time of the previous workflow, or of the workflow if it is the first one
+	// [[ScheduledTime-24h.2006-01-02]] is substituted by the scheduled time minus one day (offset specified as a Go duration: https://golang.org/pkg/time/#ParseDuration)
+	// [[JobName]], [[JobID]] and [[RunID]] are substituted by the name and ID of the job, and by the ID of the run of the workflow
+	// Any other [[...]] string is invalid.
 
 	Parameters []Parameter `json:"parameters,omitempty"`
 
@@ -113,6 +135,14 @@ type Trigger struct {
 
 	// Create workflows periodically.
 	PeriodicSchedule *PeriodicSchedule `json:"periodicSchedule,omitempty"`
+
+	// Create a workflow for each new object in an object store bucket.
+	ObjectStoreEvent *ObjectStoreEventTrigger `json:"objectStoreEvent,omitempty"`
+
+	// Create workflows when upstream runs complete. The API server queues the
+	// completed upstream runs in the trigger, and the controller creates
+	// their workflows.
+	RunCompletion *RunCompletionTrigger `json:"runCompletion,omitempty"`
 }
 
 type CronSchedule struct {
@@ -130,6 +160,113 @@ type CronSchedule struct {
 	// time interval defined by StartTime and EndTime.
 	// +optional
 	Cron string `json:"cron,omitempty"`
+
+	// IANA time zone name, such as "America/New_York", in which the Cron
+	// string is evaluated and the scheduled time is formatted. If no time
+	// zone is specified, UTC is used.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+type ObjectStoreEventTrigger struct {
+	// Bucket in which new objects create workflows.
+	Bucket string `json:"bucket"`
+
+	// Only objects whose key starts with Prefix create workflows.
+	// If no prefix is specified, all the objects of the bucket create workflows.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// Name of the workflow parameter set to the key of the new object.
+	KeyParameter string `json:"keyParameter"`
+}
+
+type RunCompletionTrigger struct {
+	// ID of the job whose runs create workflows.
+	// +optional
+	JobID string `json:"jobId,omitempty"`
+
+	// ID of the experiment whose runs create workflows.
+	// +optional
+	ExperimentID string `json:"experimentId,omitempty"`
+
+	// Final states of the upstream run that create a workflow.
+	// If no state is specified, only Succeeded creates a workflow.
+	// +optional
+	States []string `json:"states,omitempty"`
+
+	// Name of the workflow parameter set to the ID of the upstream run.
+	// +optional
+	RunIDParameter string `json:"runIdParameter,omitempty"`
+
+	// Names of the workflow parameters set to output parameters of the
+	// upstream run, mapped to the names of these output parameters.
+	// +optional
+	OutputParameters map[string]string `json:"outputParameters,omitempty"`
+
+	// Completed upstream runs that still have to create a workflow, oldest
+	// first. The API server adds them, and the controller removes them once
+	// their workflow is created, as soon as MaxConcurrency allows it.
+	// +optional
+	PendingRuns []PendingRun `json:"pendingRuns,omitempty"`
+}
+
+// PendingRun is a completed upstream run of a run completion trigger.
+type PendingRun struct {
+	// ID of the upstream run.
+	RunID string `json:"runId"`
+
+	// Workflow parameters set from the upstream run: its ID and its output
+	// parameters. They override the parameters of the workflow.
+	// +optional
+	Parameters []Parameter `json:"parameters,omitempty"`
+}
+
+// Backfill creates a workflow for each time of the cron or periodic schedule
+// within a time range, regardless of the start time, end time and last
+// triggered time of the schedule. The workflows count towards MaxConcurrency,
+// and are created even if the schedule is disabled.
+type Backfill struct {
+	// Unique ID of the backfill. A backfill with a new ID restarts from
+	// StartTime.
+	ID string `json:"id"`
+
+	// Time of the first workflow, inclusive.
+	StartTime metav1.Time `json:"startTime"`
+
+	// Time after the last workflow, exclusive.
+	EndTime metav1.Time `json:"endTime"`
+
+	// If the backfill is cancelled, it does not create any new workflow.
+	// +optional
+	Cancelled bool `json:"cancelled,omitempty"`
+}
+
+// ManualTrigger creates one workflow as soon as MaxConcurrency allows it,
+// even if the schedule is disabled. The workflow is scheduled at the time it
+// is created.
+type ManualTrigger struct {
+	// Unique ID of the manual trigger. A manual trigger with a new ID creates
+	// a new workflow.
+	ID string `json:"id"`
+}
+
+// FailurePolicy disables the schedule after consecutive failed workflows.
+type FailurePolicy struct {
+	// Number of consecutive failed workflows after which the schedule is
+	// disabled. The workflows are ordered by index. Only the completed
+	// workflows of the history count, so it cannot be larger than MaxHistory.
+	// 0 means that failed workflows never disable the schedule.
+	// +optional
+	MaxConsecutiveFailures int64 `json:"maxConsecutiveFailures,omitempty"`
+}
+
+type TimeWindow struct {
+	// Start of the window, inclusive.
+	StartTime metav1.Time `json:"startTime"`
+
+	// End of the window, exclusive.
+	EndTime metav1.Time `json:"endTime"`
 }
 
 type PeriodicSchedule struct {
@@ -161,6 +298,14 @@ type ScheduledWorkflowStatus struct {
 
 	// Status of workflow resources.
 	WorkflowHistory *WorkflowHistory `json:"workflowHistory,omitempty"`
+
+	// Progress of the backfill of the schedule, if any.
+	// +optional
+	Backfill *BackfillStatus `json:"backfill,omitempty"`
+
+	// Status of the failure policy.
+	// +optional
+	FailurePolicy *FailurePolicyStatus `json:"failurePolicy,omitempty"`
 }
 
 type ScheduledWorkflowConditionType string
@@ -172,6 +317,10 @@ const (
 	ScheduledWorkflowRunning   ScheduledWorkflowConditionType = "Running"
 	ScheduledWorkflowSucceeded ScheduledWorkflowConditionType = "Succeeded"
 	ScheduledWorkflowError     ScheduledWorkflowConditionType = "Error"
+	// The failure policy disabled the schedule.
+	ScheduledWorkflowDisabledByFailures ScheduledWorkflowConditionType = "DisabledByFailures"
+	// The schedule is in a blackout window.
+	ScheduledWorkflowPaused ScheduledWorkflowConditionType = "Paused"
 )
 
 type ScheduledWorkflowCondition struct {
@@ -202,6 +351,75 @@ type TriggerStatus struct {
 
 	// Index of the last workflow created.
 	LastIndex *int64 `json:"lastWorkflowIndex,omitempty"`
+
+	// Objects that already created a workflow, for object store event triggers.
+	// +optional
+	ObjectStoreEvent *ObjectStoreEventTriggerStatus `json:"objectStoreEvent,omitempty"`
+
+	// ID of the last manual trigger that created a workflow.
+	// +optional
+	LastManualTriggerID string `json:"lastManualTriggerId,omitempty"`
+
+	// Upstream runs that created a workflow, for run completion triggers.
+	// +optional
+	RunCompletion *RunCompletionTriggerStatus `json:"runCompletion,omitempty"`
+}
+
+type RunCompletionTriggerStatus struct {
+	// IDs of the most recent upstream runs that created a workflow, oldest
+	// first, so that an upstream run queued again does not create another one.
+	TriggeredRunIDs []string `json:"triggeredRunIds,omitempty"`
+}
+
+type ObjectStoreEventTriggerStatus struct {
+	// Last modification time of the most recent object that created a workflow.
+	// Objects modified before this time do not create workflows.
+	LastObjectModifiedTime *metav1.Time `json:"lastObjectModifiedTime,omitempty"`
+
+	// Keys of the objects modified at LastObjectModifiedTime that created a workflow.
+	LastObjectKeys []string `json:"lastObjectKeys,omitempty"`
+}
+
+type FailurePolicyStatus struct {
+	// Whether the failure policy disabled the schedule. It is reset once the
+	// schedule is enabled again.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// The workflows with an index lower than or equal to this index do not
+	// count as consecutive failures, so that the schedule is not disabled again
+	// by the same failures once it is enabled again.
+	// +optional
+	IgnoredUntilIndex int64 `json:"ignoredUntilIndex,omitempty"`
+}
+
+type BackfillPhase string
+
+// These are valid phases of a Backfill.
+const (
+	BackfillRunning   BackfillPhase = "Running"
+	BackfillCompleted BackfillPhase = "Completed"
+	BackfillCancelled BackfillPhase = "Cancelled"
+)
+
+type BackfillStatus struct {
+	// ID of the backfill.
+	ID string `json:"id,omitempty"`
+
+	// Phase of the backfill.
+	Phase BackfillPhase `json:"phase,omitempty"`
+
+	// Number of workflows to create over the time range of the backfill.
+	TotalCount int64 `json:"totalCount,omitempty"`
+
+	// Number of workflows created so far.
+	CreatedCount int64 `json:"createdCount,omitempty"`
+
+	// Scheduled time of the last workflow created.
+	LastScheduledTime *metav1.Time `json:"lastScheduledTime,omitempty"`
+
+	// Scheduled time of the next workflow to create, if any.
+	NextScheduledTime *metav1.Time `json:"nextScheduledTime,omitempty"`
 }
 
 type WorkflowHistory struct {
@@ -21,6 +21,48 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Backfill) DeepCopyInto(out *Backfill) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Backfill.
+func (in *Backfill) DeepCopy() *Backfill {
+	if in == nil {
+		return nil
+	}
+	out := new(Backfill)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackfillStatus) DeepCopyInto(out *BackfillStatus) {
+	*out = *in
+	if in.LastScheduledTime != nil {
+		in, out := &in.LastScheduledTime, &out.LastScheduledTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduledTime != nil {
+		in, out := &in.NextScheduledTime, &out.NextScheduledTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackfillStatus.
+func (in *BackfillStatus) DeepCopy() *BackfillStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackfillStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CronSchedule) DeepCopyInto(out *CronSchedule) {
 	*out = *in
@@ -45,6 +87,95 @@ func (in *CronSchedule) DeepCopy() *CronSchedule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailurePolicy) DeepCopyInto(out *FailurePolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailurePolicy.
+func (in *FailurePolicy) DeepCopy() *FailurePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FailurePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailurePolicyStatus) DeepCopyInto(out *FailurePolicyStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailurePolicyStatus.
+func (in *FailurePolicyStatus) DeepCopy() *FailurePolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FailurePolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManualTrigger) DeepCopyInto(out *ManualTrigger) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManualTrigger.
+func (in *ManualTrigger) DeepCopy() *ManualTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(ManualTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStoreEventTrigger) DeepCopyInto(out *ObjectStoreEventTrigger) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStoreEventTrigger.
+func (in *ObjectStoreEventTrigger) DeepCopy() *ObjectStoreEventTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStoreEventTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStoreEventTriggerStatus) DeepCopyInto(out *ObjectStoreEventTriggerStatus) {
+	*out = *in
+	if in.LastObjectModifiedTime != nil {
+		in, out := &in.LastObjectModifiedTime, &out.LastObjectModifiedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastObjectKeys != nil {
+		in, out := &in.LastObjectKeys, &out.LastObjectKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStoreEventTriggerStatus.
+func (in *ObjectStoreEventTriggerStatus) DeepCopy() *ObjectStoreEventTriggerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStoreEventTriggerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Parameter) DeepCopyInto(out *Parameter) {
 	*out = *in
@@ -61,6 +192,27 @@ func (in *Parameter) DeepCopy() *Parameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingRun) DeepCopyInto(out *PendingRun) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]Parameter, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingRun.
+func (in *PendingRun) DeepCopy() *PendingRun {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PeriodicSchedule) DeepCopyInto(out *PeriodicSchedule) {
 	*out = *in
@@ -85,6 +237,62 @@ func (in *PeriodicSchedule) DeepCopy() *PeriodicSchedule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunCompletionTrigger) DeepCopyInto(out *RunCompletionTrigger) {
+	*out = *in
+	if in.States != nil {
+		in, out := &in.States, &out.States
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OutputParameters != nil {
+		in, out := &in.OutputParameters, &out.OutputParameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PendingRuns != nil {
+		in, out := &in.PendingRuns, &out.PendingRuns
+		*out = make([]PendingRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunCompletionTrigger.
+func (in *RunCompletionTrigger) DeepCopy() *RunCompletionTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(RunCompletionTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunCompletionTriggerStatus) DeepCopyInto(out *RunCompletionTriggerStatus) {
+	*out = *in
+	if in.TriggeredRunIDs != nil {
+		in, out := &in.TriggeredRunIDs, &out.TriggeredRunIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunCompletionTriggerStatus.
+func (in *RunCompletionTriggerStatus) DeepCopy() *RunCompletionTriggerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RunCompletionTriggerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScheduledWorkflow) DeepCopyInto(out *ScheduledWorkflow) {
 	*out = *in
@@ -183,6 +391,28 @@ func (in *ScheduledWorkflowSpec) DeepCopyInto(out *ScheduledWorkflowSpec) {
 		*out = new(WorkflowResource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Backfill != nil {
+		in, out := &in.Backfill, &out.Backfill
+		*out = new(Backfill)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManualTrigger != nil {
+		in, out := &in.ManualTrigger, &out.ManualTrigger
+		*out = new(ManualTrigger)
+		**out = **in
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(FailurePolicy)
+		**out = **in
+	}
+	if in.Blackouts != nil {
+		in, out := &in.Blackouts, &out.Blackouts
+		*out = make([]TimeWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -212,6 +442,16 @@ func (in *ScheduledWorkflowStatus) DeepCopyInto(out *ScheduledWorkflowStatus) {
 		*out = new(WorkflowHistory)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Backfill != nil {
+		in, out := &in.Backfill, &out.Backfill
+		*out = new(BackfillStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(FailurePolicyStatus)
+		**out = **in
+	}
 	return
 }
 
@@ -225,6 +465,24 @@ func (in *ScheduledWorkflowStatus) DeepCopy() *ScheduledWorkflowStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeWindow) DeepCopyInto(out *TimeWindow) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeWindow.
+func (in *TimeWindow) DeepCopy() *TimeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Trigger) DeepCopyInto(out *Trigger) {
 	*out = *in
@@ -238,6 +496,16 @@ func (in *Trigger) DeepCopyInto(out *Trigger) {
 		*out = new(PeriodicSchedule)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ObjectStoreEvent != nil {
+		in, out := &in.ObjectStoreEvent, &out.ObjectStoreEvent
+		*out = new(ObjectStoreEventTrigger)
+		**out = **in
+	}
+	if in.RunCompletion != nil {
+		in, out := &in.RunCompletion, &out.RunCompletion
+		*out = new(RunCompletionTrigger)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -267,6 +535,16 @@ func (in *TriggerStatus) DeepCopyInto(out *TriggerStatus) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.ObjectStoreEvent != nil {
+		in, out := &in.ObjectStoreEvent, &out.ObjectStoreEvent
+		*out = new(ObjectStoreEventTriggerStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RunCompletion != nil {
+		in, out := &in.RunCompletion, &out.RunCompletion
+		*out = new(RunCompletionTriggerStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -20,7 +20,6 @@ import (
 )
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Viewer is a specification for a Viewer resource.
@@ -31,6 +30,10 @@ type Viewer struct {
 	// Spec contains specifications that pertain to how the viewer is launched and
 	// managed by its controller.
 	Spec ViewerSpec `json:"spec"`
+
+	// Status contains the most recently observed status of the viewer.
+	// +optional
+	Status ViewerStatus `json:"status,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -44,8 +47,8 @@ type ViewerList struct {
 	Items []Viewer `json:"items"`
 }
 
-// ViewerType is the underlying type of the view. Currently, only Tensorboard is
-// explicitly supported by the Viewer CRD.
+// ViewerType is the underlying type of the view. An instance named
+// `instance123` of type `type` will serve under /type/instance123.
 type ViewerType string
 
 const (
@@ -53,6 +56,15 @@ const (
 	// underlying type is Tensorboard. An instance named `instance123` will serve
 	// under /tensorboard/instance123.
 	ViewerTypeTensorboard ViewerType = "tensorboard"
+	// ViewerTypeHTML is the ViewerType constant used to indicate that the
+	// underlying type is a static file server over an object store prefix.
+	ViewerTypeHTML ViewerType = "html"
+	// ViewerTypeJupyter is the ViewerType constant used to indicate that the
+	// underlying type is a Jupyter notebook server.
+	ViewerTypeJupyter ViewerType = "jupyter"
+	// ViewerTypeCustom is the ViewerType constant used to indicate that the
+	// underlying type is a user provided image.
+	ViewerTypeCustom ViewerType = "custom"
 )
 
 // TensorboardSpec contains fields specific to launching a tensorboard instance.
@@ -63,12 +75,117 @@ type TensorboardSpec struct {
 	TensorflowImage string `json:"tensorflowImage"`
 }
 
+// HTMLSpec contains fields specific to launching a static file server.
+type HTMLSpec struct {
+	// Source is the object store prefix whose files are served, such as
+	// gs://bucket/path, s3://bucket/path or minio://bucket/path. It is copied
+	// when the viewer starts, with gsutil for gs:// sources and with the MinIO
+	// client for s3:// and minio:// sources.
+	Source string `json:"source"`
+	// FetchImage is the image copying the files. It must contain gsutil for
+	// gs:// sources and mc for s3:// and minio:// sources.
+	// +optional
+	FetchImage string `json:"fetchImage,omitempty"`
+	// Endpoint is the URL of the object store of s3:// and minio:// sources.
+	// It defaults to https://s3.amazonaws.com for s3:// sources and to
+	// http://minio-service.kubeflow:9000 for minio:// sources.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+	// CredentialsSecret is the name of the Secret in the namespace of the
+	// viewer holding the credentials of s3:// and minio:// sources, under the
+	// keys "accesskey" and "secretkey". It defaults to
+	// mlpipeline-minio-artifact.
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+	// ServerImage is the image serving the files. It must contain python3.
+	// +optional
+	ServerImage string `json:"serverImage,omitempty"`
+}
+
+// JupyterSpec contains fields specific to launching a Jupyter notebook server.
+// Logging in requires the token in the Secret named in the status of the
+// viewer.
+type JupyterSpec struct {
+	// NotebookImage is the image of the notebook server. It must contain
+	// jupyter.
+	// +optional
+	NotebookImage string `json:"notebookImage,omitempty"`
+	// NotebookDir is the directory whose notebooks are served, such as the
+	// mount path of a volume of the PodTemplateSpec.
+	// +optional
+	NotebookDir string `json:"notebookDir,omitempty"`
+}
+
+// CustomSpec contains fields specific to launching a user provided image. The
+// image must serve under the path of the viewer, which is passed in the
+// VIEWER_PATH_PREFIX environment variable.
+type CustomSpec struct {
+	// Image is the image of the viewer.
+	Image string `json:"image"`
+	// Command overrides the entrypoint of the image.
+	// +optional
+	Command []string `json:"command,omitempty"`
+	// Args are the arguments of the entrypoint.
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// Port is the port on which the image serves.
+	Port int32 `json:"port"`
+}
+
 // ViewerSpec is the spec for a Viewer resource.
 type ViewerSpec struct {
 	// Type is the type of the viewer.
 	Type ViewerType `json:"type"`
 	// TensorboardSpec is only checked if the Type is ViewerTypeTensorboard.
 	TensorboardSpec TensorboardSpec `json:"tensorboardSpec,omitempty"`
+	// HTMLSpec is only checked if the Type is ViewerTypeHTML.
+	HTMLSpec HTMLSpec `json:"htmlSpec,omitempty"`
+	// JupyterSpec is only checked if the Type is ViewerTypeJupyter.
+	JupyterSpec JupyterSpec `json:"jupyterSpec,omitempty"`
+	// CustomSpec is only checked if the Type is ViewerTypeCustom.
+	CustomSpec CustomSpec `json:"customSpec,omitempty"`
 	// PodTemplateSpec is the template spec used to launch the viewer.
 	PodTemplateSpec v1.PodTemplateSpec `json:"podTemplateSpec"`
+	// IdleTTL is how long the viewer is kept after it was last accessed. An idle
+	// viewer is deleted along with its deployment and service. If not set, the
+	// viewer is only deleted when the maximum number of viewers is exceeded.
+	// +optional
+	IdleTTL *metav1.Duration `json:"idleTTL,omitempty"`
+}
+
+// LastAccessTimeAnnotation is the annotation clients set on a viewer to the
+// RFC3339 time at which they accessed it, so that it is not deleted as idle.
+// The KFP UI sets it whenever it looks up a Tensorboard viewer.
+const LastAccessTimeAnnotation = "viewer.kubeflow.org/last-access-time"
+
+// ViewerPhase is the phase of a viewer.
+type ViewerPhase string
+
+const (
+	// ViewerPending means that the deployment of the viewer is not ready yet.
+	ViewerPending ViewerPhase = "Pending"
+	// ViewerReady means that the viewer serves under its URL.
+	ViewerReady ViewerPhase = "Ready"
+)
+
+// ViewerStatus is the status of a Viewer resource.
+type ViewerStatus struct {
+	// Phase is whether the viewer is ready to serve.
+	// +optional
+	Phase ViewerPhase `json:"phase,omitempty"`
+	// URL is the path under which the viewer serves, such as
+	// /tensorboard/instance123/.
+	// +optional
+	URL string `json:"url,omitempty"`
+	// ReadyTime is the time at which the viewer first became ready.
+	// +optional
+	ReadyTime *metav1.Time `json:"readyTime,omitempty"`
+	// LastAccessTime is the time at which the viewer was last accessed, or its
+	// creation time if it was never accessed. The idle TTL counts from it.
+	// +optional
+	LastAccessTime *metav1.Time `json:"lastAccessTime,omitempty"`
+	// TokenSecretName is the name of the Secret holding the token to log in
+	// to a Jupyter viewer, under the key "token".
+	// +optional
+	TokenSecretName string `json:"tokenSecretName,omitempty"`
 }
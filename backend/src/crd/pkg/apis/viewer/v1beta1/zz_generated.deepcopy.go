@@ -18,9 +18,68 @@
 package v1beta1
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomSpec) DeepCopyInto(out *CustomSpec) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomSpec.
+func (in *CustomSpec) DeepCopy() *CustomSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTMLSpec) DeepCopyInto(out *HTMLSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTMLSpec.
+func (in *HTMLSpec) DeepCopy() *HTMLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTMLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JupyterSpec) DeepCopyInto(out *JupyterSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JupyterSpec.
+func (in *JupyterSpec) DeepCopy() *JupyterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JupyterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TensorboardSpec) DeepCopyInto(out *TensorboardSpec) {
 	*out = *in
@@ -43,6 +102,7 @@ func (in *Viewer) DeepCopyInto(out *Viewer) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -101,7 +161,15 @@ func (in *ViewerList) DeepCopyObject() runtime.Object {
 func (in *ViewerSpec) DeepCopyInto(out *ViewerSpec) {
 	*out = *in
 	out.TensorboardSpec = in.TensorboardSpec
+	out.HTMLSpec = in.HTMLSpec
+	out.JupyterSpec = in.JupyterSpec
+	in.CustomSpec.DeepCopyInto(&out.CustomSpec)
 	in.PodTemplateSpec.DeepCopyInto(&out.PodTemplateSpec)
+	if in.IdleTTL != nil {
+		in, out := &in.IdleTTL, &out.IdleTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -114,3 +182,27 @@ func (in *ViewerSpec) DeepCopy() *ViewerSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ViewerStatus) DeepCopyInto(out *ViewerStatus) {
+	*out = *in
+	if in.ReadyTime != nil {
+		in, out := &in.ReadyTime, &out.ReadyTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastAccessTime != nil {
+		in, out := &in.LastAccessTime, &out.LastAccessTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ViewerStatus.
+func (in *ViewerStatus) DeepCopy() *ViewerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ViewerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -866,6 +866,7 @@ describe('UIServer apis', () => {
     let k8sGetCustomObjectSpy: jest.SpyInstance;
     let k8sDeleteCustomObjectSpy: jest.SpyInstance;
     let k8sCreateCustomObjectSpy: jest.SpyInstance;
+    let k8sPatchCustomObjectSpy: jest.SpyInstance;
     let kfpApiServer: Server;
 
     function newGetTensorboardResponse({
@@ -906,6 +907,11 @@ describe('UIServer apis', () => {
         K8S_TEST_EXPORT.k8sV1CustomObjectClient,
         'createNamespacedCustomObject',
       );
+      k8sPatchCustomObjectSpy = jest.spyOn(
+        K8S_TEST_EXPORT.k8sV1CustomObjectClient,
+        'patchNamespacedCustomObject',
+      );
+      k8sPatchCustomObjectSpy.mockImplementation(() => Promise.resolve());
     });
 
     afterEach(() => {
@@ -1086,6 +1092,45 @@ describe('UIServer apis', () => {
             },
           );
       });
+
+      it('records the access to the tensorboard viewer', done => {
+        app = new UIServer(loadConfigs(argv, {}));
+        k8sGetCustomObjectSpy.mockImplementation(() =>
+          Promise.resolve(newGetTensorboardResponse({ name: 'viewer-abcdefg' })),
+        );
+
+        requests(app.start())
+          .get(`/apps/tensorboard?logdir=${encodeURIComponent('log-dir-1')}&namespace=test-ns`)
+          .expect(200, err => {
+            expect(k8sPatchCustomObjectSpy).toHaveBeenCalledTimes(1);
+            const [body, options] = k8sPatchCustomObjectSpy.mock.calls[0].slice(5);
+            expect(k8sPatchCustomObjectSpy.mock.calls[0].slice(0, 5)).toEqual([
+              'kubeflow.org',
+              'v1beta1',
+              'test-ns',
+              'viewers',
+              'viewer-5e1404e679e27b0f0b8ecee8fe515830eaa736c5',
+            ]);
+            const lastAccessTime = body.metadata.annotations['viewer.kubeflow.org/last-access-time'];
+            expect(Date.now() - Date.parse(lastAccessTime)).toBeLessThan(60 * 1000);
+            expect(options).toEqual({
+              headers: { 'Content-Type': 'application/merge-patch+json' },
+            });
+            done(err);
+          });
+      });
+
+      it('does not record an access when there is no tensorboard viewer', done => {
+        app = new UIServer(loadConfigs(argv, {}));
+        k8sGetCustomObjectSpy.mockImplementation(() => Promise.reject('Not found'));
+
+        requests(app.start())
+          .get(`/apps/tensorboard?logdir=${encodeURIComponent('log-dir-1')}&namespace=test-ns`)
+          .expect(200, JSON.stringify({ podAddress: '', tfVersion: '' }), err => {
+            expect(k8sPatchCustomObjectSpy).not.toHaveBeenCalled();
+            done(err);
+          });
+      });
     });
 
     describe('post (create)', () => {
@@ -23,8 +23,25 @@ export const getTensorboardHandlers = (
   authorizeFn: AuthorizeFn,
 ): { get: Handler; create: Handler; delete: Handler } => {
   /**
-   * A handler which retrieve the endpoint for a tensorboard instance. The
-   * handler expects a query string `logdir`.
+   * Records an access to a tensorboard instance, so that its viewer is not
+   * deleted as idle. A failure is only logged, as it does not keep the
+   * instance from being used.
+   */
+  async function recordAccess(logdir: string, namespace: string): Promise<void> {
+    try {
+      await k8sHelper.recordTensorboardInstanceAccess(logdir, namespace);
+    } catch (err) {
+      const details = await parseError(err);
+      console.warn(
+        `Failed to record access to Tensorboard app: ${details.message}`,
+        details.additionalInfo,
+      );
+    }
+  }
+
+  /**
+   * A handler which retrieve the endpoint for a tensorboard instance and
+   * records the access to it. The handler expects a query string `logdir`.
    */
   const get: Handler = async (req, res) => {
     const { logdir, namespace } = req.query;
@@ -50,7 +67,11 @@ export const getTensorboardHandlers = (
         res.status(401).send(authError.message);
         return;
       }
-      res.send(await k8sHelper.getTensorboardInstance(logdir, namespace));
+      const tensorboardInstance = await k8sHelper.getTensorboardInstance(logdir, namespace);
+      if (tensorboardInstance.podAddress) {
+        await recordAccess(logdir, namespace);
+      }
+      res.send(tensorboardInstance);
     } catch (err) {
       const details = await parseError(err);
       console.error(`Failed to list Tensorboard pods: ${details.message}`, details.additionalInfo);
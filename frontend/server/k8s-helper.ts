@@ -34,6 +34,9 @@ let serverNamespace: string | undefined = undefined;
 const viewerGroup = 'kubeflow.org';
 const viewerVersion = 'v1beta1';
 const viewerPlural = 'viewers';
+// Annotation on a viewer holding the last time it was accessed, so that the
+// viewer controller does not delete it as idle.
+const viewerLastAccessTimeAnnotation = 'viewer.kubeflow.org/last-access-time';
 
 // Constants for argo workflow
 const workflowGroup = 'argoproj.io';
@@ -188,6 +191,29 @@ export async function getTensorboardInstance(
     );
 }
 
+/**
+ * Records on the viewer of the Tensorboard instance with the given logdir that
+ * it has just been accessed.
+ */
+export async function recordTensorboardInstanceAccess(
+  logdir: string,
+  namespace: string,
+): Promise<void> {
+  await k8sV1CustomObjectClient.patchNamespacedCustomObject(
+    viewerGroup,
+    viewerVersion,
+    namespace,
+    viewerPlural,
+    getNameOfViewerResource(logdir),
+    {
+      metadata: {
+        annotations: { [viewerLastAccessTimeAnnotation]: new Date().toISOString() },
+      },
+    },
+    { headers: { 'Content-Type': 'application/merge-patch+json' } },
+  );
+}
+
 /**
  * Find a running Tensorboard instance with the given logdir, delete the instance
  * and returns the deleted podAddress
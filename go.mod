@@ -1,7 +1,7 @@
 module github.com/kubeflow/pipelines
 
 require (
-	cloud.google.com/go v0.44.3 // indirect
+	cloud.google.com/go v0.44.3
 	github.com/Masterminds/squirrel v0.0.0-20190107164353-fa735ea14f09
 	github.com/VividCortex/mysqlerr v0.0.0-20170204212430-6c6b55f8796f
 	github.com/argoproj/argo v2.3.0+incompatible